@@ -0,0 +1,178 @@
+// Command api-keys manages the API keys services authenticate with, as an alternative to mutual
+// TLS client certificates:
+//
+//	api-keys create -name <name> -usages <usage,...> -operations <sign,list,admin>
+//	api-keys list [-revoked]
+//	api-keys revoke -id <id>
+//
+// The secret of a new key is printed once by create; only its digest is stored, so it cannot be
+// shown again. Lost secrets are replaced by revoking the key and creating a new one.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/user"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+const (
+	// tablePadding is the space between the columns of the list output.
+	tablePadding = 2
+	// minArgs is the program name and the command.
+	minArgs = 2
+)
+
+const usage = `usage:
+  api-keys create -name <name> -usages <usage,...> -operations <sign,list,admin>
+  api-keys list [-revoked]
+  api-keys revoke -id <id>`
+
+func main() {
+	log.SetFlags(log.LstdFlags | log.Lmsgprefix)
+	log.SetPrefix("api-keys: ")
+
+	if len(os.Args) < minArgs {
+		log.Fatalln(usage)
+	}
+
+	ctx := lo.Must(postgres.NewContext(context.Background(), config.PostgresPresetDefault))
+	ctx = core.NewAuditContext(ctx, core.NewAuditRecord(dao.NewPgAuditEventInsert(), nil))
+	ctx = core.NewAuditCallerContext(ctx, auditCaller())
+
+	var err error
+
+	switch command, args := os.Args[1], os.Args[2:]; command {
+	case "create":
+		err = create(ctx, args)
+	case "list":
+		err = list(ctx, args)
+	case "revoke":
+		err = revoke(ctx, args)
+	default:
+		log.Fatalf("unknown command %q\n%s", command, usage) //nolint:gosec // %q escapes the command.
+	}
+
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+}
+
+// auditCaller identifies the operator in the audit trail, by their system account when it is known.
+func auditCaller() string {
+	caller := "cli:api-keys"
+
+	current, err := user.Current()
+	if err == nil {
+		caller += ":" + current.Username
+	}
+
+	return caller
+}
+
+func create(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("create", flag.ExitOnError)
+	name := flags.String("name", "", "label of the key, such as the name of the calling service")
+	usages := flags.String("usages", "", "comma-separated key usages the key grants access to")
+	operations := flags.String("operations", "", "comma-separated operations the key grants (sign, list, admin)")
+
+	_ = flags.Parse(args)
+
+	resp, err := core.NewApiKeyCreate(dao.NewPgApiKeyInsert()).Exec(ctx, &core.ApiKeyCreateRequest{
+		Name:   *name,
+		Usages: splitList(*usages),
+		Operations: lo.Map(splitList(*operations), func(operation string, _ int) core.ApiKeyOperation {
+			return core.ApiKeyOperation(operation)
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("create api key: %w", err)
+	}
+
+	log.Printf("created api key %s (%s), prefix %s", resp.ApiKey.ID, resp.ApiKey.Name, resp.ApiKey.Prefix)
+	log.Println("store the secret below now: it cannot be shown again")
+	fmt.Println(resp.Secret) //nolint:forbidigo // the secret is the output of the command.
+
+	return nil
+}
+
+func list(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("list", flag.ExitOnError)
+	revoked := flags.Bool("revoked", false, "also list revoked keys")
+
+	_ = flags.Parse(args)
+
+	keys, err := core.NewApiKeyList(dao.NewPgApiKeyList()).Exec(ctx, &core.ApiKeyListRequest{
+		IncludeRevoked: *revoked,
+	})
+	if err != nil {
+		return fmt.Errorf("list api keys: %w", err)
+	}
+
+	table := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+	_, _ = fmt.Fprintln(table, "ID\tNAME\tPREFIX\tUSAGES\tOPERATIONS\tCREATED\tLAST USED\tREVOKED")
+
+	for _, key := range keys {
+		_, _ = fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			key.ID, key.Name, key.Prefix,
+			strings.Join(key.Usages, ","),
+			strings.Join(lo.Map(key.Operations, func(operation core.ApiKeyOperation, _ int) string {
+				return string(operation)
+			}), ","),
+			key.CreatedAt.Format(time.RFC3339),
+			formatTime(key.LastUsedAt),
+			formatTime(key.RevokedAt),
+		)
+	}
+
+	return table.Flush()
+}
+
+func revoke(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("revoke", flag.ExitOnError)
+	id := flags.String("id", "", "identifier of the key to revoke")
+
+	_ = flags.Parse(args)
+
+	keyID, err := uuid.Parse(*id)
+	if err != nil {
+		return fmt.Errorf("parse key id: %w", err)
+	}
+
+	key, err := core.NewApiKeyRevoke(dao.NewPgApiKeyRevoke()).Exec(ctx, &core.ApiKeyRevokeRequest{ID: keyID})
+	if err != nil {
+		return fmt.Errorf("revoke api key: %w", err)
+	}
+
+	log.Printf("revoked api key %s (%s)", key.ID, key.Name)
+
+	return nil
+}
+
+func splitList(raw string) []string {
+	return lo.Compact(lo.Map(strings.Split(raw, ","), func(item string, _ int) string {
+		return strings.TrimSpace(item)
+	}))
+}
+
+func formatTime(value *time.Time) string {
+	if value == nil {
+		return "-"
+	}
+
+	return value.Format(time.RFC3339)
+}
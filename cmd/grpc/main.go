@@ -7,20 +7,28 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/samber/lo"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/a-novel-kit/golib/grpcf"
+	golibproto "github.com/a-novel-kit/golib/grpcf/proto/gen"
+	"github.com/a-novel-kit/golib/logging"
 	"github.com/a-novel-kit/golib/otel"
 	"github.com/a-novel-kit/golib/postgres"
 
@@ -33,6 +41,10 @@ import (
 	"github.com/a-novel/service-json-keys/v2/internal/lib"
 )
 
+// invalidationRetryInterval is the wait before listening to key invalidations again, after the
+// listener stopped.
+const invalidationRetryInterval = 5 * time.Second
+
 func main() {
 	cfg := config.AppPresetDefault
 	ctx := context.Background()
@@ -55,6 +67,23 @@ func main() {
 
 	daoJwkSearch := dao.NewPgJwkSearch()
 	daoJwkSelect := dao.NewPgJwkSelect()
+	daoJwkInsert := dao.NewPgJwkInsert()
+	daoJwkDelete := dao.NewPgJwkDelete()
+	daoJwkInvalidate := dao.NewPgJwkInvalidate()
+	daoJwkListenInvalidations := dao.NewPgJwkListenInvalidations()
+	daoJwkLock := dao.NewPgJwkLock()
+	daoJobLeaseAcquire := dao.NewPgJobLeaseAcquire()
+	daoJobLeaseRecordRun := dao.NewPgJobLeaseRecordRun()
+	daoJobLeaseSelect := dao.NewPgJobLeaseSelect()
+	daoApiKeySelect := dao.NewPgApiKeySelect()
+	daoApiKeyTouch := dao.NewPgApiKeyTouch()
+	daoAuditEventInsert := dao.NewPgAuditEventInsert()
+	daoAuditEventSearch := dao.NewPgAuditEventSearch()
+	daoSigningFreezeInsert := dao.NewPgSigningFreezeInsert()
+	daoSigningFreezeDelete := dao.NewPgSigningFreezeDelete()
+	daoSigningFreezeList := dao.NewPgSigningFreezeList()
+	daoRevokedTokenInsert := dao.NewPgRevokedTokenInsert()
+	daoRevokedTokenList := dao.NewPgRevokedTokenList()
 
 	// =================================================================================================================
 	// SERVICES
@@ -63,57 +92,148 @@ func main() {
 	serviceJwkExtract := core.NewJwkExtract()
 	serviceJwkSearch := core.NewJwkSearch(daoJwkSearch, serviceJwkExtract)
 	serviceJwkSelect := core.NewJwkSelect(daoJwkSelect, serviceJwkExtract)
+	serviceJwkAlgMigration := core.NewJwkAlgMigration(daoJwkSearch, serviceJwkExtract, config.JwkPresetDefault)
+	serviceJwkLifecycle := core.NewJwkLifecycle(daoJwkSearch, serviceJwkExtract, config.JwkPresetDefault)
+	serviceJwkGen := core.NewJwkGen(daoJwkSearch, daoJwkInsert, serviceJwkExtract, config.JwkPresetDefault)
+	serviceJwkMetadataSearch := core.NewJwkMetadataSearch(daoJwkSearch, serviceJwkExtract, config.JwkPresetDefault)
+	serviceJwkRevoke := core.NewJwkRevoke(daoJwkDelete, daoJwkInvalidate, serviceJwkExtract)
+	serviceJwkRotate := core.NewJwkRotate(
+		daoJwkLock, daoJwkInvalidate, serviceJwkMetadataSearch, serviceJwkGen, serviceJwkRevoke,
+		postgres.NewTransactor(nil),
+	)
+	serviceJwkBurn := core.NewJwkBurn(
+		daoJwkLock, daoJwkSearch, daoJwkDelete, daoJwkInvalidate, serviceJwkGen, serviceJwkExtract,
+		postgres.NewTransactor(nil), config.JwkPresetDefault,
+	)
+	serviceSigningFreezeSet := core.NewSigningFreezeSet(
+		daoSigningFreezeInsert, daoSigningFreezeDelete, config.JwkPresetDefault,
+	)
+	serviceSigningFreezeList := core.NewSigningFreezeList(daoSigningFreezeList)
+	serviceRevokedTokenSync := core.NewRevokedTokenSync(daoRevokedTokenList)
+	serviceJwkBootstrap := core.NewJwkBootstrap(
+		daoJwkLock, daoJwkSearch, serviceJwkGen, postgres.NewTransactor(nil), config.JwkPresetDefault,
+	)
+
+	// The scheduled rotation: the server holding the lease rotates for every replica. The holder
+	// name stays unique when a host restarts.
+	rotationHolder := lo.Must(os.Hostname()) + "-" + strings.ToLower(rand.Text()[:8])
+	serviceJwkRotateAll := core.NewJwkRotateAll(
+		daoJwkLock, serviceJwkGen, postgres.NewTransactor(nil), config.JwkPresetDefault,
+	)
+	serviceJwkScheduledRotate := core.NewJwkScheduledRotate(
+		daoJobLeaseAcquire, daoJobLeaseRecordRun, serviceJwkRotateAll, rotationHolder, cfg.Grpc.Rotation.Interval,
+	)
+	serviceJwkRotationSchedule := core.NewJwkRotationSchedule(
+		daoJobLeaseSelect, rotationHolder, cfg.Grpc.Rotation.Interval,
+	)
 
 	// The signing chain: a cached private-key source feeds the per-usage producer plugins, so
-	// ClaimsSign signs tokens without hitting the database on every request.
+	// ClaimsSign signs tokens without hitting the database on every request. PayloadSign and
+	// HttpSignatureSign read the same private-key source directly. The keys are cached in front of
+	// the source, where invalidations sent by other servers drop them. ClaimsSign refuses the
+	// usages whose signing is frozen.
+	//
+	// ClaimsVerify reads the public keys through a cache of their own, dropped by the same
+	// invalidations, and refuses the revoked tokens.
 	serviceExportLocal := core.NewJwkExportLocal(serviceJwkSearch)
-	serviceJwkSource := lo.Must(core.NewJwkPrivateSource(serviceExportLocal, config.JwkPresetDefault))
+	serviceJwkSourceCache := core.NewJwkSourceCache(serviceExportLocal, config.JwkPresetDefault)
+	serviceJwkPublicSourceCache := core.NewJwkSourceCache(
+		core.NewJwkExportLocalPublic(serviceJwkSearch), config.JwkPresetDefault,
+	)
+	serviceJwkSourceCaches := core.JwkSourceCaches{serviceJwkSourceCache, serviceJwkPublicSourceCache}
+	serviceJwkInvalidationSync := core.NewJwkInvalidationSync(daoJwkListenInvalidations, serviceJwkSourceCaches)
+	serviceJwkSource := lo.Must(core.NewJwkPrivateSource(serviceJwkSourceCache, config.JwkPresetDefault))
 	serviceJwkProducer := lo.Must(core.NewJwkProducers(serviceJwkSource, config.JwkPresetDefault))
-	serviceClaimsSign := core.NewClaimsSign(serviceJwkProducer, config.JwkPresetDefault)
+	serviceSigningFreezeCheck := core.NewSigningFreezeCheck(serviceSigningFreezeList)
+	serviceClaimsSign := core.NewClaimsSign(serviceJwkProducer, serviceSigningFreezeCheck, config.JwkPresetDefault)
+	serviceRevokedTokenCheck := core.NewRevokedTokenCheck(serviceRevokedTokenSync, core.RevokedTokenRefreshInterval)
+	serviceClaimsInspect := newClaimsInspect(serviceJwkPublicSourceCache, serviceRevokedTokenCheck)
+	serviceTokenRevoke := core.NewTokenRevoke(daoRevokedTokenInsert, serviceClaimsInspect, config.JwkPresetDefault)
+	servicePayloadSign := core.NewPayloadSign(serviceJwkSource, serviceSigningFreezeCheck, config.JwkPresetDefault)
+	serviceHttpSignatureSign := core.NewHttpSignatureSign(
+		serviceJwkSource, serviceSigningFreezeCheck, config.JwkPresetDefault,
+	)
+	serviceJwkWarmUp := core.NewJwkWarmUp(serviceJwkSource, config.JwkPresetDefault)
+	serviceApiKeyAuthenticate := core.NewApiKeyAuthenticate(daoApiKeySelect, daoApiKeyTouch)
+	serviceAuditEventSearch := core.NewAuditEventSearch(daoAuditEventSearch)
+
+	// Audited services find the recorder in their context.
+	// Signing events are stored in the background, so signing does not wait for the database.
+	serviceAuditRecord := core.NewAuditRecord(
+		daoAuditEventInsert, lo.Ternary[logging.Log](cfg.Audit.Log, cfg.Logger, nil),
+	)
+	serviceAuditBuffer := core.NewAuditBuffer(serviceAuditRecord, core.DefaultAuditBufferSize)
+	ctx = core.NewAuditContext(ctx, serviceAuditBuffer)
+
+	// Signing services report their signatures to the metrics found in their context.
+	metrics := handlers.NewMetrics(serviceJwkLifecycle, config.JwkPresetDefault, cfg.Logger)
+	ctx = core.NewSignatureRecorderContext(ctx, metrics)
 
 	// =================================================================================================================
 	// HANDLERS
 	// =================================================================================================================
 
-	handlerStatus := handlers.NewGrpcStatus()
+	handlerEcho := handlers.NewGrpcEcho()
+	handlerHealth := handlers.NewGrpcHealth(serviceJwkLifecycle, serviceJwkWarmUp, config.JwkPresetDefault)
+	handlerStatus := handlers.NewGrpcStatus(
+		serviceJwkAlgMigration,
+		serviceJwkLifecycle,
+		serviceSigningFreezeList,
+		lo.Ternary[handlers.GrpcStatusServiceRotationSchedule](
+			cfg.Grpc.Rotation.Enabled(), serviceJwkRotationSchedule, nil,
+		),
+		config.JwkPresetDefault,
+	)
 	handlerClaimsSign := handlers.NewGrpcClaimsSign(serviceClaimsSign)
+	handlerClaimsVerify := handlers.NewGrpcClaimsVerify(serviceClaimsInspect)
+	handlerPayloadSign := handlers.NewGrpcPayloadSign(servicePayloadSign)
+	handlerHttpSignatureSign := handlers.NewGrpcHttpSignatureSign(serviceHttpSignatureSign)
 	handlerJwkGet := handlers.NewGrpcJwkGet(serviceJwkSelect)
 	handlerJwkList := handlers.NewGrpcJwkList(serviceJwkSearch)
+	handlerAuditEventSearch := handlers.NewGrpcAuditEventSearch(serviceAuditEventSearch)
+	handlerJwkRotate := handlers.NewGrpcJwkRotate(serviceJwkRotate)
+	handlerJwkBurn := handlers.NewGrpcJwkBurn(serviceJwkBurn)
+	handlerSigningFreeze := handlers.NewGrpcSigningFreeze(serviceSigningFreezeSet)
+	handlerRevokeToken := handlers.NewGrpcRevokeToken(serviceTokenRevoke)
+	handlerRevokedTokenSync := handlers.NewGrpcRevokedTokenSync(serviceRevokedTokenSync)
+
+	interceptorApiKeys := handlers.NewGrpcApiKeys(
+		serviceApiKeyAuthenticate, cfg.Grpc.ApiKeys.Required, cfg.Grpc.ApiKeys.AdminIdentities,
+	)
+	interceptorProducers := handlers.NewGrpcProducers(config.JwkPresetDefault)
+	interceptorAuditCaller := handlers.NewGrpcAuditCaller()
 
 	// =================================================================================================================
 	// SERVER
 	// =================================================================================================================
 
-	ctxInterceptor := func(rpCtx context.Context) context.Context {
-		rpCtx = postgres.TransferContext(ctx, rpCtx)
-		rpCtx = lib.TransferMasterKeyContext(ctx, rpCtx)
-
-		return rpCtx
-	}
-
 	listenerConfig := new(net.ListenConfig)
 	listener := lo.Must(listenerConfig.Listen(ctx, "tcp", fmt.Sprintf("0.0.0.0:%d", cfg.Grpc.Port)))
-	server := grpc.NewServer(
-		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-		cfg.Otel.RpcInterceptor(),
-		grpc.ChainUnaryInterceptor(
-			grpcf.BaseContextUnaryInterceptor(ctxInterceptor),
-			cfg.GrpcLogger.UnaryInterceptor(),
-			cfg.GrpcLogger.PanicUnaryInterceptor(),
-		),
-		grpc.ChainStreamInterceptor(
-			grpcf.BaseContextStreamInterceptor(ctxInterceptor),
-			cfg.GrpcLogger.StreamInterceptor(),
-			cfg.GrpcLogger.PanicStreamInterceptor(),
-		),
+	serverOptions := newServerOptions(
+		ctx, cfg, metrics,
+		// API keys first: callers authenticated by a key skip the producers check.
+		interceptorApiKeys.UnaryInterceptor(),
+		interceptorProducers.UnaryInterceptor(),
+		interceptorAuditCaller.UnaryInterceptor(),
 	)
 
-	grpcf.SetEchoServersContext(ctx, server, cfg.Grpc.Ping)
+	server := grpc.NewServer(serverOptions...)
 
+	golibproto.RegisterEchoServiceServer(server, handlerEcho)
+	healthpb.RegisterHealthServer(server, handlerHealth)
 	jsonkeysv2.RegisterStatusServiceServer(server, handlerStatus)
 	jsonkeysv2.RegisterClaimsSignServiceServer(server, handlerClaimsSign)
+	jsonkeysv2.RegisterClaimsVerifyServiceServer(server, handlerClaimsVerify)
+	jsonkeysv2.RegisterPayloadSignServiceServer(server, handlerPayloadSign)
+	jsonkeysv2.RegisterHttpSignatureSignServiceServer(server, handlerHttpSignatureSign)
 	jsonkeysv2.RegisterJwkGetServiceServer(server, handlerJwkGet)
 	jsonkeysv2.RegisterJwkListServiceServer(server, handlerJwkList)
+	jsonkeysv2.RegisterAuditEventSearchServiceServer(server, handlerAuditEventSearch)
+	jsonkeysv2.RegisterJwkRotateServiceServer(server, handlerJwkRotate)
+	jsonkeysv2.RegisterJwkBurnServiceServer(server, handlerJwkBurn)
+	jsonkeysv2.RegisterSigningFreezeServiceServer(server, handlerSigningFreeze)
+	jsonkeysv2.RegisterRevokeTokenServiceServer(server, handlerRevokeToken)
+	jsonkeysv2.RegisterRevokedTokenSyncServiceServer(server, handlerRevokedTokenSync)
 
 	reflection.Register(server)
 
@@ -121,8 +241,32 @@ func main() {
 	// RUN
 	// =================================================================================================================
 
+	prepareKeys(ctx, cfg, serviceJwkBootstrap, serviceJwkWarmUp)
+	prepareDenylist(ctx, serviceRevokedTokenCheck)
+
 	log.Println("Starting gRPC server on :" + strconv.Itoa(cfg.Grpc.Port))
 
+	// Metrics get their own HTTP listener, next to the gRPC one.
+	if cfg.Metrics.Enabled() {
+		serveMetrics(ctx, cfg, metrics)
+	}
+
+	if cfg.Grpc.Rotation.Enabled() {
+		log.Println("Scheduling key rotation every " + cfg.Grpc.Rotation.Interval.String() + " as " + rotationHolder)
+
+		// Rotations are audited with the scheduler as caller.
+		go runKeyRotation(
+			core.NewAuditCallerContext(ctx, "grpc:rotate-keys"), serviceJwkScheduledRotate, cfg.Grpc.Rotation.Interval,
+		)
+	}
+
+	go syncKeyInvalidations(ctx, serviceJwkInvalidationSync, serviceJwkSourceCaches)
+
+	go serviceAuditBuffer.Run(ctx)
+
+	// Health statuses follow Postgres and the signing keys, and are pushed to Watch streams.
+	go handlerHealth.Run(ctx, cfg.Grpc.Ping)
+
 	go func() {
 		err := server.Serve(listener)
 		if err != nil {
@@ -135,5 +279,160 @@ func main() {
 	<-quit
 
 	log.Println("Shutting down gRPC server...")
+
+	// Report NOT_SERVING while draining, so probes and load balancers stop sending traffic.
+	handlerHealth.Shutdown()
 	server.GracefulStop()
+
+	// Store the signing events still queued by the last requests.
+	serviceAuditBuffer.Close()
+}
+
+// prepareKeys generates the missing keys when the server bootstraps them, then loads the signing
+// keys.
+func prepareKeys(ctx context.Context, cfg config.App, bootstrap *core.JwkBootstrap, warmUp *core.JwkWarmUp) {
+	// Generate the keys missing in a fresh environment, before they are loaded.
+	if cfg.Grpc.BootstrapKeys {
+		bootstrapped, err := bootstrap.Exec(
+			core.NewAuditCallerContext(ctx, "grpc:bootstrap-keys"), &core.JwkBootstrapRequest{},
+		)
+		if err != nil {
+			panic(fmt.Errorf("bootstrap keys: %w", err))
+		}
+
+		log.Printf("Bootstrapped keys for %d usage(s)", len(bootstrapped.Generated))
+	}
+
+	// Load the signing keys before the first request. A usage without a key to sign with stops the
+	// server in fail-fast mode; other failures are retried by the health refreshes, and the server
+	// reports not ready until one succeeds.
+	err := warmUp.Exec(ctx)
+	if err != nil {
+		if cfg.Grpc.FailFast && errors.Is(err, core.ErrJwkNotFound) {
+			panic(fmt.Errorf("warm up signing keys: %w", err))
+		}
+
+		log.Println("Warming up signing keys: " + err.Error())
+	}
+}
+
+// prepareDenylist pulls the revoked tokens before the first verification. On failure, the
+// verifications sync it in the background.
+func prepareDenylist(ctx context.Context, denylist *core.RevokedTokenCheck) {
+	err := denylist.Sync(ctx)
+	if err != nil {
+		log.Println("Syncing revoked tokens: " + err.Error())
+	}
+}
+
+// newClaimsInspect builds the verification chain: the public keys, read from cache, feed the
+// per-usage verification plugins, and the denylist refuses the revoked tokens.
+func newClaimsInspect(cache *core.JwkSourceCache, denylist *core.RevokedTokenCheck) *core.ClaimsInspect {
+	sources := lo.Must(core.NewJwkPublicSource(cache, config.JwkPresetDefault))
+	recipients := lo.Must(core.NewJwkRecipients(sources, config.JwkPresetDefault))
+
+	return core.NewClaimsInspect(cache, recipients, denylist, config.JwkPresetDefault)
+}
+
+// newServerOptions returns the options of the gRPC server. Requests inherit the dependencies of
+// ctx, and go through the common interceptors, then through the given ones, in order.
+func newServerOptions(
+	ctx context.Context, cfg config.App, metrics *handlers.Metrics, interceptors ...grpc.UnaryServerInterceptor,
+) []grpc.ServerOption {
+	ctxInterceptor := func(rpCtx context.Context) context.Context {
+		rpCtx = postgres.TransferContext(ctx, rpCtx)
+		rpCtx = lib.TransferMasterKeyContext(ctx, rpCtx)
+		rpCtx = core.TransferAuditContext(ctx, rpCtx)
+		rpCtx = core.TransferSignatureRecorderContext(ctx, rpCtx)
+
+		return rpCtx
+	}
+
+	output := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		cfg.Otel.RpcInterceptor(),
+		grpc.ChainUnaryInterceptor(append([]grpc.UnaryServerInterceptor{
+			grpcf.BaseContextUnaryInterceptor(ctxInterceptor),
+			metrics.UnaryInterceptor(),
+			cfg.GrpcLogger.UnaryInterceptor(),
+			cfg.GrpcLogger.PanicUnaryInterceptor(),
+		}, interceptors...)...),
+		grpc.ChainStreamInterceptor(
+			grpcf.BaseContextStreamInterceptor(ctxInterceptor),
+			cfg.GrpcLogger.StreamInterceptor(),
+			cfg.GrpcLogger.PanicStreamInterceptor(),
+		),
+	}
+
+	if cfg.Grpc.TLS.Enabled() {
+		output = append(output, grpc.Creds(lo.Must(cfg.Grpc.TLS.Credentials())))
+	}
+
+	return output
+}
+
+// runKeyRotation runs the scheduled key rotation at once, then every interval, until ctx is done.
+// Failures are logged, and retried at the next interval.
+func runKeyRotation(ctx context.Context, service *core.JwkScheduledRotate, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := service.Exec(ctx, &core.JwkScheduledRotateRequest{})
+		if err != nil {
+			log.Println("Rotating keys: " + err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// syncKeyInvalidations applies the key invalidations sent by any server or command sharing the
+// database to caches, until ctx is done. When the listener stops, invalidations may have been
+// missed: the whole caches are dropped, and the listener restarted after invalidationRetryInterval.
+func syncKeyInvalidations(ctx context.Context, service *core.JwkInvalidationSync, caches core.JwkSourceCaches) {
+	for {
+		err := service.Exec(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			log.Println("Syncing key invalidations: " + err.Error())
+		}
+
+		caches.InvalidateAll()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(invalidationRetryInterval):
+		}
+	}
+}
+
+// serveMetrics starts the HTTP listener of the metrics endpoint, in the background.
+func serveMetrics(ctx context.Context, cfg config.App, metrics *handlers.Metrics) {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metrics.Handler())
+
+	metricsServer := &http.Server{
+		Addr:              ":" + strconv.Itoa(cfg.Metrics.Port),
+		Handler:           mux,
+		ReadHeaderTimeout: cfg.Rest.Timeouts.ReadHeader,
+		BaseContext:       func(_ net.Listener) context.Context { return ctx },
+	}
+
+	log.Println("Starting metrics server on " + metricsServer.Addr)
+
+	go func() {
+		err := metricsServer.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
+	}()
 }
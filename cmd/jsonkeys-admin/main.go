@@ -0,0 +1,660 @@
+// Command jsonkeys-admin gives operators the key operations that otherwise take psql and ad-hoc
+// scripts:
+//
+//	jsonkeys-admin keys [-usage <usage>] [-json]
+//	jsonkeys-admin key -kid <kid> [-json]
+//	jsonkeys-admin revoke -kid <kid> -comment <reason> [-json]
+//	jsonkeys-admin rotate -usages <usage,...> [-json]
+//	jsonkeys-admin burn -usage <usage> -comment <reason> [-json]
+//	jsonkeys-admin freeze -usage <usage> -comment <reason> [-json]
+//	jsonkeys-admin unfreeze -usage <usage> [-comment <reason>] [-json]
+//	jsonkeys-admin sign -usage <usage> [-claims <json>]
+//	jsonkeys-admin verify -usage <usage> [-token <token>] [-ignore-expired] [-json]
+//	jsonkeys-admin revoke-token -usage <usage> [-token <token> | -jti <jti>] [-json]
+//
+// keys lists the active keys of a usage, or of every usage, and key describes one of them. revoke
+// removes a key before its expiry, and rotate generates a new key for the listed usages right
+// away, whatever the age of their current one; servers drop their cached keys of the usage when
+// either commits. burn revokes every key of a usage and generates a
+// new one, when its signing key has leaked; servers drop their cached keys at once. freeze stops
+// the servers from signing tokens for a usage, which still verify, until unfreeze. sign issues a
+// token for a usage, to test its consumers. verify checks a token, read from standard input when
+// -token is not set, and lists every check it fails; it exits with an error when the token is
+// invalid. revoke-token revokes a single token, read like verify does, or named by its ID; servers
+// and client verifiers refuse it from then on.
+//
+// It reads the database and the master key from the environment of the servers. Operations are
+// audited with the operator's system account as caller.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/user"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/config/env"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/lib"
+)
+
+const (
+	// tablePadding is the space between the columns of the table outputs.
+	tablePadding = 2
+	// minArgs is the program name and the command.
+	minArgs = 2
+)
+
+const usage = `usage:
+  jsonkeys-admin keys [-usage <usage>] [-json]
+  jsonkeys-admin key -kid <kid> [-json]
+  jsonkeys-admin revoke -kid <kid> -comment <reason> [-json]
+  jsonkeys-admin rotate -usages <usage,...> [-json]
+  jsonkeys-admin burn -usage <usage> -comment <reason> [-json]
+  jsonkeys-admin freeze -usage <usage> -comment <reason> [-json]
+  jsonkeys-admin unfreeze -usage <usage> [-comment <reason>] [-json]
+  jsonkeys-admin sign -usage <usage> [-claims <json>]
+  jsonkeys-admin verify -usage <usage> [-token <token>] [-ignore-expired] [-json]
+  jsonkeys-admin revoke-token -usage <usage> [-token <token> | -jti <jti>] [-json]`
+
+var (
+	// errTokenInvalid fails verify for tokens that fail a check, once the checks are printed.
+	errTokenInvalid = errors.New("token is invalid")
+	// errNoUsages fails rotate when no usage is named.
+	errNoUsages = errors.New("rotate needs -usages")
+)
+
+func main() {
+	log.SetFlags(log.LstdFlags | log.Lmsgprefix)
+	log.SetPrefix("jsonkeys-admin: ")
+
+	if len(os.Args) < minArgs {
+		log.Fatalln(usage)
+	}
+
+	ctx := lo.Must(postgres.NewContext(context.Background(), config.PostgresPresetDefault))
+	ctx = lo.Must(lib.NewMasterKeyContext(ctx, env.AppMasterKey))
+	ctx = core.NewAuditContext(ctx, core.NewAuditRecord(dao.NewPgAuditEventInsert(), nil))
+	ctx = core.NewAuditCallerContext(ctx, auditCaller())
+
+	var err error
+
+	switch command, args := os.Args[1], os.Args[2:]; command {
+	case "keys":
+		err = keys(ctx, args)
+	case "key":
+		err = key(ctx, args)
+	case "revoke":
+		err = revoke(ctx, args)
+	case "rotate":
+		err = rotate(ctx, args)
+	case "burn":
+		err = burn(ctx, args)
+	case "freeze":
+		err = freeze(ctx, args, true)
+	case "unfreeze":
+		err = freeze(ctx, args, false)
+	case "sign":
+		err = sign(ctx, args)
+	case "verify":
+		err = verify(ctx, args)
+	case "revoke-token":
+		err = revokeToken(ctx, args)
+	default:
+		log.Fatalf("unknown command %q\n%s", command, usage) //nolint:gosec // %q escapes the command.
+	}
+
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+}
+
+// auditCaller identifies the operator in the audit trail, by their system account when it is known.
+func auditCaller() string {
+	caller := "cli:jsonkeys-admin"
+
+	current, err := user.Current()
+	if err == nil {
+		caller += ":" + current.Username
+	}
+
+	return caller
+}
+
+// keyView is the JSON output of a key.
+type keyView struct {
+	KID            string     `json:"kid"`
+	Usage          string     `json:"usage"`
+	Alg            string     `json:"alg"`
+	Main           bool       `json:"main"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	ExpiresAt      time.Time  `json:"expiresAt"`
+	RevokedAt      *time.Time `json:"revokedAt,omitempty"`
+	RevokedComment string     `json:"revokedComment,omitempty"`
+}
+
+func newKeyView(key *core.JwkMetadata) *keyView {
+	return &keyView{
+		KID:            key.KID.String(),
+		Usage:          key.Usage,
+		Alg:            string(key.Alg),
+		Main:           key.Main,
+		CreatedAt:      key.CreatedAt,
+		ExpiresAt:      key.ExpiresAt,
+		RevokedAt:      key.RevokedAt,
+		RevokedComment: key.RevokedComment,
+	}
+}
+
+func keys(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("keys", flag.ExitOnError)
+	keyUsage := flags.String("usage", "", "key usage to list (default: every configured usage)")
+	asJSON := flags.Bool("json", false, "print JSON")
+
+	_ = flags.Parse(args)
+
+	usages := []string{*keyUsage}
+	if *keyUsage == "" {
+		usages = lo.Keys(config.JwkPresetDefault)
+		slices.Sort(usages)
+	}
+
+	service := core.NewJwkMetadataSearch(dao.NewPgJwkSearch(), core.NewJwkExtract(), config.JwkPresetDefault)
+
+	views := make([]*keyView, 0)
+
+	for _, keyUsage := range usages {
+		usageKeys, err := service.Exec(ctx, &core.JwkMetadataSearchRequest{Usage: keyUsage})
+		if err != nil {
+			return fmt.Errorf("list keys of %s: %w", keyUsage, err)
+		}
+
+		views = append(views, lo.Map(usageKeys, func(key *core.JwkMetadata, _ int) *keyView {
+			return newKeyView(key)
+		})...)
+	}
+
+	if *asJSON {
+		return printJSON(views)
+	}
+
+	return printKeys(views)
+}
+
+func key(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("key", flag.ExitOnError)
+	kid := flags.String("kid", "", "identifier of the key to describe")
+	asJSON := flags.Bool("json", false, "print JSON")
+
+	_ = flags.Parse(args)
+
+	keyID, err := uuid.Parse(*kid)
+	if err != nil {
+		return fmt.Errorf("parse kid: %w", err)
+	}
+
+	resp, err := core.NewJwkMetadataSelect(dao.NewPgJwkSelect(), dao.NewPgJwkSearch(), core.NewJwkExtract()).
+		Exec(ctx, &core.JwkMetadataSelectRequest{ID: keyID})
+	if err != nil {
+		return fmt.Errorf("describe key: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(newKeyView(resp))
+	}
+
+	return printKeys([]*keyView{newKeyView(resp)})
+}
+
+func revoke(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("revoke", flag.ExitOnError)
+	kid := flags.String("kid", "", "identifier of the key to revoke")
+	comment := flags.String("comment", "", "reason of the revocation, kept for auditing")
+	asJSON := flags.Bool("json", false, "print JSON")
+
+	_ = flags.Parse(args)
+
+	keyID, err := uuid.Parse(*kid)
+	if err != nil {
+		return fmt.Errorf("parse kid: %w", err)
+	}
+
+	resp, err := core.NewJwkRevoke(dao.NewPgJwkDelete(), dao.NewPgJwkInvalidate(), core.NewJwkExtract()).
+		Exec(ctx, &core.JwkRevokeRequest{ID: keyID, Comment: *comment})
+	if err != nil {
+		return fmt.Errorf("revoke key: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(newKeyView(resp))
+	}
+
+	log.Printf("revoked key %s (%s)", resp.KID, resp.Usage)
+	log.Println("servers sharing the database stop trusting it now; rotate the usage if it was its main key")
+
+	return nil
+}
+
+func rotate(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("rotate", flag.ExitOnError)
+	usages := flags.String("usages", "", "comma-separated key usages to rotate")
+	asJSON := flags.Bool("json", false, "print JSON")
+
+	_ = flags.Parse(args)
+
+	// Sorted, so concurrent rotations take the usage locks in the same order.
+	keyUsages := lo.Uniq(splitList(*usages))
+	slices.Sort(keyUsages)
+
+	// Rotating every usage at once is the job's business; here, usages are named.
+	if len(keyUsages) == 0 {
+		return errNoUsages
+	}
+
+	daoJwkSearch := dao.NewPgJwkSearch()
+	daoJwkInvalidate := dao.NewPgJwkInvalidate()
+	serviceJwkExtract := core.NewJwkExtract()
+	serviceJwkGen := core.NewJwkGen(daoJwkSearch, dao.NewPgJwkInsert(), serviceJwkExtract, config.JwkPresetDefault)
+	transactor := postgres.NewTransactor(nil)
+
+	// Each usage is rotated under its lock, and the servers drop their cached keys when the
+	// rotation commits.
+	service := core.NewJwkRotate(
+		dao.NewPgJwkLock(),
+		daoJwkInvalidate,
+		core.NewJwkMetadataSearch(daoJwkSearch, serviceJwkExtract, config.JwkPresetDefault),
+		serviceJwkGen,
+		core.NewJwkRevoke(dao.NewPgJwkDelete(), daoJwkInvalidate, serviceJwkExtract),
+		transactor,
+	)
+
+	views := make([]*keyView, 0, len(keyUsages))
+
+	// The usages are rotated as one unit of work: the rotations join the surrounding transaction.
+	err := transactor.WithinTx(ctx, func(ctx context.Context) error {
+		for _, keyUsage := range keyUsages {
+			resp, err := service.Exec(ctx, &core.JwkRotateRequest{Usage: keyUsage})
+			if err != nil {
+				return fmt.Errorf("rotate %s: %w", keyUsage, err)
+			}
+
+			views = append(views, newKeyView(resp.Key))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("rotate keys: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(views)
+	}
+
+	for _, view := range views {
+		log.Printf("rotated %s: new main key %s", view.Usage, view.KID)
+	}
+
+	return nil
+}
+
+// burnView is the JSON output of a burn.
+type burnView struct {
+	Key     *keyView   `json:"key"`
+	Revoked []*keyView `json:"revoked"`
+}
+
+func burn(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("burn", flag.ExitOnError)
+	keyUsage := flags.String("usage", "", "key usage to burn")
+	comment := flags.String("comment", "", "reason of the burn, kept for auditing")
+	asJSON := flags.Bool("json", false, "print JSON")
+
+	_ = flags.Parse(args)
+
+	daoJwkSearch := dao.NewPgJwkSearch()
+	serviceJwkExtract := core.NewJwkExtract()
+	serviceJwkGen := core.NewJwkGen(daoJwkSearch, dao.NewPgJwkInsert(), serviceJwkExtract, config.JwkPresetDefault)
+
+	resp, err := core.NewJwkBurn(
+		dao.NewPgJwkLock(), daoJwkSearch, dao.NewPgJwkDelete(), dao.NewPgJwkInvalidate(),
+		serviceJwkGen, serviceJwkExtract, postgres.NewTransactor(nil), config.JwkPresetDefault,
+	).Exec(ctx, &core.JwkBurnRequest{Usage: *keyUsage, Comment: *comment})
+	if err != nil {
+		return fmt.Errorf("burn usage: %w", err)
+	}
+
+	view := &burnView{
+		Key: newKeyView(resp.Key),
+		Revoked: lo.Map(resp.Revoked, func(key *core.JwkMetadata, _ int) *keyView {
+			return newKeyView(key)
+		}),
+	}
+
+	if *asJSON {
+		return printJSON(view)
+	}
+
+	for _, key := range view.Revoked {
+		log.Printf("revoked key %s", key.KID)
+	}
+
+	log.Printf("burned %s: new main key %s", view.Key.Usage, view.Key.KID)
+
+	return nil
+}
+
+// freezeView is the JSON output of a signing freeze.
+type freezeView struct {
+	Usage    string     `json:"usage"`
+	Frozen   bool       `json:"frozen"`
+	Comment  string     `json:"comment,omitempty"`
+	FrozenAt *time.Time `json:"frozenAt,omitempty"`
+}
+
+// freeze freezes the signing of a usage, or lifts its freeze.
+func freeze(ctx context.Context, args []string, frozen bool) error {
+	flags := flag.NewFlagSet(lo.Ternary(frozen, "freeze", "unfreeze"), flag.ExitOnError)
+	keyUsage := flags.String("usage", "", "key usage to freeze or unfreeze")
+	comment := flags.String("comment", "", "reason of the freeze, kept for auditing")
+	asJSON := flags.Bool("json", false, "print JSON")
+
+	_ = flags.Parse(args)
+
+	resp, err := core.NewSigningFreezeSet(
+		dao.NewPgSigningFreezeInsert(), dao.NewPgSigningFreezeDelete(), config.JwkPresetDefault,
+	).Exec(ctx, &core.SigningFreezeSetRequest{Usage: *keyUsage, Frozen: frozen, Comment: *comment})
+	if err != nil {
+		return fmt.Errorf("%s usage: %w", flags.Name(), err)
+	}
+
+	view := &freezeView{Usage: *keyUsage}
+	if resp != nil {
+		view.Frozen = true
+		view.Comment = resp.Comment
+		view.FrozenAt = &resp.FrozenAt
+	}
+
+	if *asJSON {
+		return printJSON(view)
+	}
+
+	if !view.Frozen {
+		log.Printf("signing of %s is not frozen", view.Usage)
+
+		return nil
+	}
+
+	log.Printf("signing of %s frozen since %s: %s", view.Usage, view.FrozenAt.Format(time.RFC3339), view.Comment)
+
+	return nil
+}
+
+func sign(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("sign", flag.ExitOnError)
+	keyUsage := flags.String("usage", "", "key usage to sign for")
+	claims := flags.String("claims", "{}", "claims of the token, as a JSON object")
+
+	_ = flags.Parse(args)
+
+	var payload map[string]any
+
+	err := json.Unmarshal([]byte(*claims), &payload)
+	if err != nil {
+		return fmt.Errorf("parse claims: %w", err)
+	}
+
+	serviceJwkSearch := core.NewJwkSearch(dao.NewPgJwkSearch(), core.NewJwkExtract())
+
+	sources, err := core.NewJwkPrivateSource(core.NewJwkExportLocal(serviceJwkSearch), config.JwkPresetDefault)
+	if err != nil {
+		return fmt.Errorf("new private sources: %w", err)
+	}
+
+	producers, err := core.NewJwkProducers(sources, config.JwkPresetDefault)
+	if err != nil {
+		return fmt.Errorf("new producers: %w", err)
+	}
+
+	serviceFreeze := core.NewSigningFreezeCheck(core.NewSigningFreezeList(dao.NewPgSigningFreezeList()))
+
+	token, err := core.NewClaimsSign(producers, serviceFreeze, config.JwkPresetDefault).Exec(ctx, &core.ClaimsSignRequest{
+		Claims: payload,
+		Usage:  *keyUsage,
+	})
+	if err != nil {
+		return fmt.Errorf("sign claims: %w", err)
+	}
+
+	_, err = fmt.Fprintln(os.Stdout, token)
+
+	return err
+}
+
+// verifyView is the JSON output of a token verification.
+type verifyView struct {
+	Valid    bool               `json:"valid"`
+	Alg      string             `json:"alg,omitempty"`
+	KID      string             `json:"kid,omitempty"`
+	Signer   string             `json:"signer,omitempty"`
+	Claims   json.RawMessage    `json:"claims,omitempty"`
+	Failures []*verifyCheckView `json:"failures"`
+}
+
+type verifyCheckView struct {
+	Check   string `json:"check"`
+	Message string `json:"message"`
+}
+
+func verify(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	keyUsage := flags.String("usage", "", "key usage the token is signed under")
+	token := flags.String("token", "", "token to verify (default: read from standard input)")
+	ignoreExpired := flags.Bool("ignore-expired", false, "skip the expiration checks")
+	asJSON := flags.Bool("json", false, "print JSON")
+
+	_ = flags.Parse(args)
+
+	if *token == "" {
+		input, err := readToken()
+		if err != nil {
+			return err
+		}
+
+		*token = input
+	}
+
+	serviceInspect, err := newClaimsInspect(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := serviceInspect.
+		Exec(ctx, &core.ClaimsInspectRequest{Token: *token, Usage: *keyUsage, IgnoreExpired: *ignoreExpired})
+	if err != nil {
+		return fmt.Errorf("inspect token: %w", err)
+	}
+
+	view := &verifyView{
+		Valid:  resp.Valid(),
+		Signer: resp.Signer,
+		Claims: resp.Claims,
+		Failures: lo.Map(resp.Failures, func(failure *core.TokenCheckFailure, _ int) *verifyCheckView {
+			return &verifyCheckView{Check: string(failure.Check), Message: failure.Message}
+		}),
+	}
+
+	if resp.Header != nil {
+		view.Alg, view.KID = string(resp.Header.Alg), resp.Header.KID
+	}
+
+	if *asJSON {
+		err = printJSON(view)
+	} else {
+		err = printVerify(view)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !view.Valid {
+		return errTokenInvalid
+	}
+
+	return nil
+}
+
+// revokedTokenView is the JSON output of a token revocation.
+type revokedTokenView struct {
+	TokenID   string    `json:"jti"`
+	Usage     string    `json:"usage"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	RevokedAt time.Time `json:"revokedAt"`
+}
+
+// revokeToken revokes a single token, given whole or by its ID.
+func revokeToken(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("revoke-token", flag.ExitOnError)
+	keyUsage := flags.String("usage", "", "key usage the token is signed under")
+	token := flags.String("token", "", "token to revoke (default: read from standard input, unless -jti is set)")
+	tokenID := flags.String("jti", "", "ID of the token to revoke, when the token is lost")
+	asJSON := flags.Bool("json", false, "print JSON")
+
+	_ = flags.Parse(args)
+
+	if *token == "" && *tokenID == "" {
+		input, err := readToken()
+		if err != nil {
+			return err
+		}
+
+		*token = input
+	}
+
+	serviceInspect, err := newClaimsInspect(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := core.NewTokenRevoke(dao.NewPgRevokedTokenInsert(), serviceInspect, config.JwkPresetDefault).
+		Exec(ctx, &core.TokenRevokeRequest{Usage: *keyUsage, Token: *token, TokenID: *tokenID})
+	if err != nil {
+		return fmt.Errorf("revoke token: %w", err)
+	}
+
+	view := &revokedTokenView{
+		TokenID:   resp.TokenID,
+		Usage:     resp.Usage,
+		ExpiresAt: resp.ExpiresAt,
+		RevokedAt: resp.RevokedAt,
+	}
+
+	if *asJSON {
+		return printJSON(view)
+	}
+
+	log.Printf(
+		"token %s of %s revoked since %s, until %s", view.TokenID, view.Usage,
+		view.RevokedAt.Format(time.RFC3339), view.ExpiresAt.Format(time.RFC3339),
+	)
+
+	return nil
+}
+
+// readToken reads a token from standard input.
+func readToken() (string, error) {
+	input, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", fmt.Errorf("read token: %w", err)
+	}
+
+	return strings.TrimSpace(string(input)), nil
+}
+
+// newClaimsInspect builds the verification chain from the database, without caches: the command
+// runs once. Revoked tokens fail verification.
+func newClaimsInspect(ctx context.Context) (*core.ClaimsInspect, error) {
+	exportPublic := core.NewJwkExportLocalPublic(core.NewJwkSearch(dao.NewPgJwkSearch(), core.NewJwkExtract()))
+
+	sources, err := core.NewJwkPublicSource(exportPublic, config.JwkPresetDefault)
+	if err != nil {
+		return nil, fmt.Errorf("new public sources: %w", err)
+	}
+
+	recipients, err := core.NewJwkRecipients(sources, config.JwkPresetDefault)
+	if err != nil {
+		return nil, fmt.Errorf("new recipients: %w", err)
+	}
+
+	denylist := core.NewRevokedTokenCheck(
+		core.NewRevokedTokenSync(dao.NewPgRevokedTokenList()), core.RevokedTokenRefreshInterval,
+	)
+
+	// The command checks a single token: the denylist must be pulled before.
+	err = denylist.Sync(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.NewClaimsInspect(exportPublic, recipients, denylist, config.JwkPresetDefault), nil
+}
+
+func printKeys(views []*keyView) error {
+	table := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+	_, _ = fmt.Fprintln(table, "KID\tUSAGE\tALG\tMAIN\tCREATED\tEXPIRES")
+
+	for _, view := range views {
+		_, _ = fmt.Fprintf(table, "%s\t%s\t%s\t%t\t%s\t%s\n",
+			view.KID, view.Usage, view.Alg, view.Main,
+			view.CreatedAt.Format(time.RFC3339),
+			view.ExpiresAt.Format(time.RFC3339),
+		)
+	}
+
+	return table.Flush()
+}
+
+func printVerify(view *verifyView) error {
+	table := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+	_, _ = fmt.Fprintf(table, "valid\t%t\n", view.Valid)
+	_, _ = fmt.Fprintf(table, "alg\t%s\n", lo.CoalesceOrEmpty(view.Alg, "-"))
+	_, _ = fmt.Fprintf(table, "kid\t%s\n", lo.CoalesceOrEmpty(view.KID, "-"))
+	_, _ = fmt.Fprintf(table, "signer\t%s\n", lo.CoalesceOrEmpty(view.Signer, "-"))
+	_, _ = fmt.Fprintf(table, "claims\t%s\n", lo.CoalesceOrEmpty(string(view.Claims), "-"))
+
+	for _, failure := range view.Failures {
+		_, _ = fmt.Fprintf(table, "failed %s\t%s\n", failure.Check, failure.Message)
+	}
+
+	return table.Flush()
+}
+
+func printJSON(value any) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(value)
+}
+
+func splitList(raw string) []string {
+	return lo.Compact(lo.Map(strings.Split(raw, ","), func(item string, _ int) string {
+		return strings.TrimSpace(item)
+	}))
+}
@@ -1,8 +1,13 @@
 // Command rest runs the public REST server for the JSON-keys service. It serves read-only
 // JSON Web Key endpoints, unauthenticated, so any client can fetch public keys for local
-// token verification. Signing and private key material stay off this surface.
+// token verification. Private key material stays off this surface.
 //
-// For the private authenticated gRPC API (including token signing), see cmd/grpc.
+// Token signing and introspection are only served when bearer tokens are configured
+// (REST_AUTH_TOKENS), or API keys are accepted (REST_AUTH_API_KEYS), for callers that cannot use
+// gRPC. They are then reachable with one of those tokens, or an API key allowed to sign for, or
+// list the keys of, the usage, only.
+//
+// For the private gRPC API, see cmd/grpc.
 package main
 
 import (
@@ -15,12 +20,14 @@ import (
 	"os/signal"
 	"strconv"
 	"syscall"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
 	"github.com/samber/lo"
 
+	"github.com/a-novel-kit/golib/logging"
 	"github.com/a-novel-kit/golib/otel"
 	"github.com/a-novel-kit/golib/postgres"
 
@@ -32,6 +39,10 @@ import (
 	"github.com/a-novel/service-json-keys/v2/internal/lib"
 )
 
+// invalidationRetryInterval is the wait before listening to key invalidations again, after the
+// listener stopped.
+const invalidationRetryInterval = 5 * time.Second
+
 func main() {
 	cfg := config.AppPresetDefault
 	ctx := context.Background()
@@ -54,6 +65,12 @@ func main() {
 
 	daoJwkSearch := dao.NewPgJwkSearch()
 	daoJwkSelect := dao.NewPgJwkSelect()
+	daoJwkListenInvalidations := dao.NewPgJwkListenInvalidations()
+	daoApiKeySelect := dao.NewPgApiKeySelect()
+	daoApiKeyTouch := dao.NewPgApiKeyTouch()
+	daoAuditEventInsert := dao.NewPgAuditEventInsert()
+	daoSigningFreezeList := dao.NewPgSigningFreezeList()
+	daoRevokedTokenList := dao.NewPgRevokedTokenList()
 
 	// =================================================================================================================
 	// SERVICES
@@ -62,15 +79,65 @@ func main() {
 	serviceJwkExtract := core.NewJwkExtract()
 	serviceJwkSearch := core.NewJwkSearch(daoJwkSearch, serviceJwkExtract)
 	serviceJwkSelect := core.NewJwkSelect(daoJwkSelect, serviceJwkExtract)
+	serviceJwkLifecycle := core.NewJwkLifecycle(daoJwkSearch, serviceJwkExtract, config.JwkPresetDefault)
+
+	// The signing and verification chains, as in cmd/grpc. They are only reached when
+	// authentication is enabled.
+	serviceExportLocal := core.NewJwkExportLocal(serviceJwkSearch)
+	serviceJwkSourceCache := core.NewJwkSourceCache(serviceExportLocal, config.JwkPresetDefault)
+	serviceJwkPublicSourceCache := core.NewJwkSourceCache(
+		core.NewJwkExportLocalPublic(serviceJwkSearch), config.JwkPresetDefault,
+	)
+	serviceJwkSourceCaches := core.JwkSourceCaches{serviceJwkSourceCache, serviceJwkPublicSourceCache}
+	serviceJwkInvalidationSync := core.NewJwkInvalidationSync(daoJwkListenInvalidations, serviceJwkSourceCaches)
+	serviceJwkSource := lo.Must(core.NewJwkPrivateSource(serviceJwkSourceCache, config.JwkPresetDefault))
+	serviceJwkProducer := lo.Must(core.NewJwkProducers(serviceJwkSource, config.JwkPresetDefault))
+	serviceSigningFreezeCheck := core.NewSigningFreezeCheck(core.NewSigningFreezeList(daoSigningFreezeList))
+	serviceClaimsSign := core.NewClaimsSign(serviceJwkProducer, serviceSigningFreezeCheck, config.JwkPresetDefault)
+	serviceJwkPublicSource := lo.Must(core.NewJwkPublicSource(serviceJwkPublicSourceCache, config.JwkPresetDefault))
+	serviceJwkRecipients := lo.Must(core.NewJwkRecipients(serviceJwkPublicSource, config.JwkPresetDefault))
+	serviceRevokedTokenCheck := core.NewRevokedTokenCheck(
+		core.NewRevokedTokenSync(daoRevokedTokenList), core.RevokedTokenRefreshInterval,
+	)
+	serviceClaimsInspect := core.NewClaimsInspect(
+		serviceJwkPublicSourceCache, serviceJwkRecipients, serviceRevokedTokenCheck, config.JwkPresetDefault,
+	)
+	serviceApiKeyAuthenticate := core.NewApiKeyAuthenticate(daoApiKeySelect, daoApiKeyTouch)
+
+	// Audited services find the recorder in their context, which requests inherit.
+	// Signing events are stored in the background, so signing does not wait for the database.
+	serviceAuditRecord := core.NewAuditRecord(
+		daoAuditEventInsert, lo.Ternary[logging.Log](cfg.Audit.Log, cfg.Logger, nil),
+	)
+	serviceAuditBuffer := core.NewAuditBuffer(serviceAuditRecord, core.DefaultAuditBufferSize)
+	ctx = core.NewAuditContext(ctx, serviceAuditBuffer)
+
+	// Signing services report their signatures to the metrics found in their context.
+	metrics := handlers.NewMetrics(serviceJwkLifecycle, config.JwkPresetDefault, cfg.Logger)
+	ctx = core.NewSignatureRecorderContext(ctx, metrics)
 
 	// =================================================================================================================
 	// HANDLERS
 	// =================================================================================================================
 
 	handlerPing := handlers.NewRestPing()
-	handlerHealth := handlers.NewRestHealth()
-	handlerJwkList := handlers.NewRestJwkList(serviceJwkSearch, cfg.Logger)
-	handlerJwkGet := handlers.NewRestJwkGet(serviceJwkSelect, cfg.Logger)
+	handlerHealth := handlers.NewRestHealth(serviceJwkLifecycle, config.JwkPresetDefault)
+	handlerJwkList := handlers.NewRestJwkList(
+		serviceJwkSearch, config.JwkPresetDefault, cfg.Rest.JwksDefaultUsage, cfg.Logger,
+	)
+	handlerJwkGet := handlers.NewRestJwkGet(serviceJwkSelect, config.JwkPresetDefault, cfg.Logger)
+	handlerOpenIDConfiguration := handlers.NewRestOpenIDConfiguration(
+		config.JwkPresetDefault, cfg.Rest.PublicURL, cfg.Rest.JwksDefaultUsage, cfg.Logger,
+	)
+	handlerClaimsSign := handlers.NewRestClaimsSign(serviceClaimsSign, config.JwkPresetDefault, cfg.Logger)
+	handlerIntrospect := handlers.NewRestIntrospect(serviceClaimsInspect, cfg.Rest.JwksDefaultUsage, cfg.Logger)
+
+	var apiKeys handlers.RestBearerAuthService
+	if cfg.Rest.Auth.ApiKeys {
+		apiKeys = serviceApiKeyAuthenticate
+	}
+
+	middlewareAuth := handlers.NewRestBearerAuth(cfg.Rest.Auth.Tokens, apiKeys, cfg.Logger)
 
 	// =================================================================================================================
 	// ROUTER
@@ -90,16 +157,42 @@ func main() {
 		AllowedMethods: []string{
 			http.MethodHead,
 			http.MethodGet,
+			http.MethodPost,
 		},
-		MaxAge: cfg.Rest.Cors.MaxAge,
+		// Browser clients need the ETag to revalidate cached key sets.
+		ExposedHeaders: []string{"ETag"},
+		MaxAge:         cfg.Rest.Cors.MaxAge,
 	}))
 	router.Use(cfg.RestLogger.Logger())
+	router.Use(metrics.Middleware)
 
 	router.Route("/v2", func(api chi.Router) {
 		api.Get("/ping", handlerPing.ServeHTTP)
 		api.Get("/healthcheck", handlerHealth.ServeHTTP)
 		api.Get("/jwks", handlerJwkList.ServeHTTP)
+		api.Get("/jwks/{kid}", handlerJwkGet.ServeHTTP)
 		api.Get("/jwk", handlerJwkGet.ServeHTTP)
+
+		// Signing and introspection are off unless tokens or API keys are accepted: without them,
+		// nobody could be let in.
+		if cfg.Rest.Auth.Enabled() {
+			api.Group(func(authenticated chi.Router) {
+				authenticated.Use(middlewareAuth.Handler)
+				authenticated.Post("/claims/sign", handlerClaimsSign.ServeHTTP)
+				authenticated.Post("/introspect", handlerIntrospect.ServeHTTP)
+			})
+		}
+	})
+
+	// Standard discovery locations, for JWT libraries, gateways and OpenID Connect relying parties.
+	router.Route("/.well-known", func(wellKnown chi.Router) {
+		wellKnown.Get("/{usage}/jwks.json", handlerJwkList.ServeHTTP)
+		wellKnown.Get("/{usage}/openid-configuration", handlerOpenIDConfiguration.ServeHTTP)
+
+		if cfg.Rest.JwksDefaultUsage != "" {
+			wellKnown.Get("/jwks.json", handlerJwkList.ServeHTTP)
+			wellKnown.Get("/openid-configuration", handlerOpenIDConfiguration.ServeHTTP)
+		}
 	})
 
 	// =================================================================================================================
@@ -116,8 +209,21 @@ func main() {
 		BaseContext:       func(_ net.Listener) context.Context { return ctx },
 	}
 
+	prepareDenylist(ctx, serviceRevokedTokenCheck)
+
 	log.Println("Starting REST server on " + httpServer.Addr)
 
+	// Metrics get their own listener, so they are not exposed along with the public API.
+	var metricsServer *http.Server
+
+	if cfg.Metrics.Enabled() {
+		metricsServer = serveMetrics(ctx, cfg, metrics)
+	}
+
+	go syncKeyInvalidations(ctx, serviceJwkInvalidationSync, serviceJwkSourceCaches)
+
+	go serviceAuditBuffer.Run(ctx)
+
 	go func() {
 		err := httpServer.ListenAndServe()
 		if err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -138,4 +244,72 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+
+	// Store the signing events still queued by the last requests.
+	serviceAuditBuffer.Close()
+
+	if metricsServer != nil {
+		err = metricsServer.Shutdown(shutdownCtx)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// prepareDenylist pulls the revoked tokens before the first introspection. On failure, the
+// introspections sync it in the background.
+func prepareDenylist(ctx context.Context, denylist *core.RevokedTokenCheck) {
+	err := denylist.Sync(ctx)
+	if err != nil {
+		log.Println("Syncing revoked tokens: " + err.Error())
+	}
+}
+
+// serveMetrics starts the HTTP listener of the metrics endpoint, in the background, and returns
+// it so it can be shut down along with the REST server.
+func serveMetrics(ctx context.Context, cfg config.App, metrics *handlers.Metrics) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metrics.Handler())
+
+	metricsServer := &http.Server{
+		Addr:              ":" + strconv.Itoa(cfg.Metrics.Port),
+		Handler:           mux,
+		ReadHeaderTimeout: cfg.Rest.Timeouts.ReadHeader,
+		BaseContext:       func(_ net.Listener) context.Context { return ctx },
+	}
+
+	log.Println("Starting metrics server on " + metricsServer.Addr)
+
+	go func() {
+		err := metricsServer.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
+	}()
+
+	return metricsServer
+}
+
+// syncKeyInvalidations applies the key invalidations sent by any server or command sharing the
+// database to caches, until ctx is done. When the listener stops, invalidations may have been
+// missed: the whole caches are dropped, and the listener restarted after invalidationRetryInterval.
+func syncKeyInvalidations(ctx context.Context, service *core.JwkInvalidationSync, caches core.JwkSourceCaches) {
+	for {
+		err := service.Exec(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			log.Println("Syncing key invalidations: " + err.Error())
+		}
+
+		caches.InvalidateAll()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(invalidationRetryInterval):
+		}
+	}
 }
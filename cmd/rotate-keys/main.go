@@ -2,13 +2,23 @@
 // new key once the rotation interval has elapsed. Consumers pick the key up on their next
 // fetch, since active_keys is a plain view with no snapshot to refresh.
 //
-// Designed to run as a periodic job (e.g., a Kubernetes CronJob).
+// Designed to run as a periodic job (e.g., a Kubernetes CronJob). Flags narrow a manual run:
+//
+//	rotate-keys [-usages <usage,...>] [-force] [-dry-run]
+//
+// -usages restricts the run to the listed usages, instead of every configured one. -force
+// rotates them even within their rotation window, for instance to replace a compromised key.
+// -dry-run prints what the run would rotate, and when each usage is next due, without rotating.
 package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/samber/lo"
@@ -22,10 +32,19 @@ import (
 	"github.com/a-novel/service-json-keys/v2/internal/lib"
 )
 
+// tablePadding is the space between the columns of the dry-run output.
+const tablePadding = 2
+
 func main() {
 	log.SetFlags(log.LstdFlags | log.Lmsgprefix)
 	log.SetPrefix("rotate-keys: ")
 
+	usages := flag.String("usages", "", "comma-separated key usages to rotate (default: every configured usage)")
+	force := flag.Bool("force", false, "rotate even within the rotation window")
+	dryRun := flag.Bool("dry-run", false, "print what would be rotated, without rotating")
+
+	flag.Parse()
+
 	start := time.Now()
 
 	// --- Bootstrap: load config, init telemetry and context ---
@@ -40,6 +59,11 @@ func main() {
 	ctx = lo.Must(lib.NewMasterKeyContext(ctx, cfg.App.MasterKey))
 	ctx = lo.Must(postgres.NewContext(ctx, config.PostgresPresetDefault))
 
+	// Rotations are audited with the job as caller. Events are stored in the rotation's
+	// transaction, so they commit with it.
+	ctx = core.NewAuditContext(ctx, core.NewAuditRecord(dao.NewPgAuditEventInsert(), nil))
+	ctx = core.NewAuditCallerContext(ctx, "job:rotate-keys")
+
 	ctx, span := otel.Tracer().Start(ctx, "job.RotateKeys")
 	defer span.End()
 
@@ -55,20 +79,73 @@ func main() {
 		config.JwkPresetDefault,
 	)
 
+	if *dryRun {
+		err := plan(ctx, core.NewJwkRotationPlan(daoJwkSearch, serviceJwkExtract, config.JwkPresetDefault),
+			&core.JwkRotationPlanRequest{Usages: splitList(*usages), Force: *force},
+		)
+		if err != nil {
+			err = otel.ReportError(span, fmt.Errorf("plan rotation: %w", err))
+			log.Fatalln(err.Error()) //nolint:gocritic
+		}
+
+		otel.ReportSuccessNoContent(span)
+
+		return
+	}
+
 	// --- Rotate keys for each usage, as one unit of work ---
-	log.Printf("rotating keys for %d configured usage(s)", len(config.JwkPresetDefault))
+	request := &core.JwkRotateAllRequest{Usages: splitList(*usages), Force: *force}
+
+	if len(request.Usages) > 0 {
+		log.Printf("rotating keys for usage(s) %s", strings.Join(request.Usages, ", "))
+	} else {
+		log.Printf("rotating keys for %d configured usage(s)", len(config.JwkPresetDefault))
+	}
+
+	if request.Force {
+		log.Println("forcing rotation: usages are rotated whatever the age of their keys")
+	}
 
 	serviceJwkRotateAll := core.NewJwkRotateAll(
-		serviceJwkGen, postgres.NewTransactor(nil), config.JwkPresetDefault,
+		dao.NewPgJwkLock(), serviceJwkGen, postgres.NewTransactor(nil), config.JwkPresetDefault,
 	)
 
-	resp, err := serviceJwkRotateAll.Exec(ctx, &core.JwkRotateAllRequest{})
+	resp, err := serviceJwkRotateAll.Exec(ctx, request)
 	if err != nil {
 		err = otel.ReportError(span, fmt.Errorf("rotate keys: %w", err))
-		log.Fatalln(err.Error()) //nolint:gocritic
+		log.Fatalln(err.Error())
 	}
 
 	otel.ReportSuccessNoContent(span)
 	log.Printf("done — %d usage(s) processed, completed in %s",
 		resp.Processed, time.Since(start).Round(time.Millisecond))
 }
+
+// plan prints the usages a rotation would process, whether each would rotate and why, and when
+// each is next due.
+func plan(ctx context.Context, service *core.JwkRotationPlan, request *core.JwkRotationPlanRequest) error {
+	entries, err := service.Exec(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	table := tabwriter.NewWriter(os.Stdout, 0, 0, tablePadding, ' ', 0)
+	_, _ = fmt.Fprintln(table, "USAGE\tROTATE\tREASON\tNEXT DUE")
+
+	for _, entry := range entries {
+		_, _ = fmt.Fprintf(table, "%s\t%t\t%s\t%s\n",
+			entry.Usage,
+			entry.Rotate(),
+			lo.CoalesceOrEmpty(string(entry.Reason), "-"),
+			entry.NextDueAt.Format(time.RFC3339),
+		)
+	}
+
+	return table.Flush()
+}
+
+func splitList(raw string) []string {
+	return lo.Compact(lo.Map(strings.Split(raw, ","), func(item string, _ int) string {
+		return strings.TrimSpace(item)
+	}))
+}
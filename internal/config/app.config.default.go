@@ -47,6 +47,20 @@ var AppPresetDefault = App{
 	Grpc: Grpc{
 		Port: env.GrpcPort,
 		Ping: env.GrpcPing,
+		TLS: GrpcTLS{
+			CertFile:     env.GrpcTLSCertFile,
+			KeyFile:      env.GrpcTLSKeyFile,
+			ClientCAFile: env.GrpcTLSClientCAFile,
+		},
+		ApiKeys: GrpcApiKeys{
+			Required:        env.GrpcApiKeysRequired,
+			AdminIdentities: env.GrpcAdminIdentities,
+		},
+		FailFast:      env.GrpcFailFast,
+		BootstrapKeys: env.GrpcBootstrapKeys,
+		Rotation: GrpcRotation{
+			Interval: env.GrpcRotationInterval,
+		},
 	},
 	Rest: Rest{
 		Port: env.RestPort,
@@ -64,6 +78,18 @@ var AppPresetDefault = App{
 			AllowCredentials: env.CorsAllowCredentials,
 			MaxAge:           env.CorsMaxAge,
 		},
+		JwksDefaultUsage: env.RestJwksDefaultUsage,
+		PublicURL:        env.RestPublicUrl,
+		Auth: RestAuth{
+			Tokens:  env.RestAuthTokens,
+			ApiKeys: env.RestAuthApiKeys,
+		},
+	},
+	Audit: Audit{
+		Log: env.AuditLog,
+	},
+	Metrics: Metrics{
+		Port: env.MetricsPort,
 	},
 
 	Otel: lo.If[otel.Config](!env.Otel, &otelpresets.Disabled{}).
@@ -1,13 +1,23 @@
 package config
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
 	"time"
 
+	"google.golang.org/grpc/credentials"
+
 	"github.com/a-novel-kit/golib/logging"
 	"github.com/a-novel-kit/golib/otel"
 	"github.com/a-novel-kit/golib/postgres"
 )
 
+// ErrNoClientCA is returned when the client CA file of the gRPC server holds no certificate.
+var ErrNoClientCA = errors.New("no certificate found in client CA file")
+
 // RestCors holds CORS configuration for the REST server.
 type RestCors struct {
 	AllowedOrigins   []string `json:"allowedOrigins"   yaml:"allowedOrigins"`
@@ -25,12 +35,95 @@ type Main struct {
 	MasterKey string `json:"masterKey" yaml:"masterKey"`
 }
 
+// GrpcTLS holds the TLS configuration of the gRPC server. All paths point to PEM files.
+type GrpcTLS struct {
+	// CertFile is the server certificate chain. TLS is disabled when empty.
+	CertFile string `json:"certFile" yaml:"certFile"`
+	// KeyFile is the private key of the server certificate.
+	KeyFile string `json:"keyFile" yaml:"keyFile"`
+	// ClientCAFile lists the certificate authorities trusted to issue client certificates. When
+	// set, every caller must present a certificate they issued (mutual TLS), which gives the
+	// server a caller identity to check usage producers against. See [Jwk.Producers].
+	ClientCAFile string `json:"clientCaFile" yaml:"clientCaFile"`
+}
+
+// Enabled reports whether the gRPC server serves TLS.
+func (config GrpcTLS) Enabled() bool {
+	return config.CertFile != ""
+}
+
+// Credentials loads the files and returns the server transport credentials.
+func (config GrpcTLS) Credentials() (credentials.TransportCredentials, error) {
+	certificate, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load server certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if config.ClientCAFile != "" {
+		clientCAs, err := os.ReadFile(config.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read client CA file: %w", err)
+		}
+
+		tlsConfig.ClientCAs = x509.NewCertPool()
+		if !tlsConfig.ClientCAs.AppendCertsFromPEM(clientCAs) {
+			return nil, fmt.Errorf("%w: %s", ErrNoClientCA, config.ClientCAFile)
+		}
+
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// GrpcApiKeys holds the API key configuration of the gRPC server.
+type GrpcApiKeys struct {
+	// Required refuses signing and key listing to callers that present no API key. When false,
+	// callers without a key are left to the other checks, such as usage producers.
+	Required bool `json:"required" yaml:"required"`
+	// AdminIdentities lists the client certificate identities allowed the admin RPCs without an
+	// API key. Admin RPCs are refused to other callers without a key, whatever Required says.
+	AdminIdentities []string `json:"adminIdentities" yaml:"adminIdentities"`
+}
+
+// GrpcRotation holds the configuration of the key rotation scheduled inside the gRPC server, in
+// place of the rotate-keys job.
+type GrpcRotation struct {
+	// Interval is the time between two rotations. Keys are only rotated once their usage's
+	// rotation interval has elapsed, so it can be much shorter. The rotation is not scheduled
+	// when it is 0.
+	Interval time.Duration `json:"interval" yaml:"interval"`
+}
+
+// Enabled reports whether the server schedules the key rotation.
+func (config GrpcRotation) Enabled() bool {
+	return config.Interval > 0
+}
+
 // Grpc holds the gRPC server configuration.
 type Grpc struct {
 	// Port is the port on which the gRPC server listens for incoming requests.
 	Port int `json:"port" yaml:"port"`
-	// Ping configures the refresh interval for the gRPC server internal healthcheck.
+	// Ping configures the refresh interval of the health statuses served through grpc.health.v1.
 	Ping time.Duration `json:"ping" yaml:"ping"`
+	// TLS holds the TLS configuration. The server runs in plaintext when it is not enabled.
+	TLS GrpcTLS `json:"tls" yaml:"tls"`
+	// ApiKeys holds the API key configuration. Presented API keys are always checked.
+	ApiKeys GrpcApiKeys `json:"apiKeys" yaml:"apiKeys"`
+	// FailFast stops the server at startup when a configured usage has no key to sign with.
+	// Otherwise, the server starts and reports not ready until every usage has one.
+	FailFast bool `json:"failFast" yaml:"failFast"`
+	// BootstrapKeys generates a first key, at startup, for every configured usage that has none.
+	// Servers starting together do not generate duplicates.
+	BootstrapKeys bool `json:"bootstrapKeys" yaml:"bootstrapKeys"`
+	// Rotation holds the configuration of the scheduled key rotation. One server, elected among
+	// those sharing the database, runs it.
+	Rotation GrpcRotation `json:"rotation" yaml:"rotation"`
 }
 
 // RestTimeouts holds timeout configuration for the REST server.
@@ -42,6 +135,20 @@ type RestTimeouts struct {
 	Request    time.Duration `json:"request"    yaml:"request"`
 }
 
+// RestAuth holds the authentication configuration of the REST server.
+type RestAuth struct {
+	// Tokens lists the static bearer tokens accepted by the authenticated endpoints.
+	Tokens []string `json:"tokens" yaml:"tokens"`
+	// ApiKeys also accepts API keys as bearer tokens. The authenticated endpoints are not served
+	// when this is false and Tokens is empty.
+	ApiKeys bool `json:"apiKeys" yaml:"apiKeys"`
+}
+
+// Enabled reports whether the authenticated endpoints can let anyone in.
+func (config RestAuth) Enabled() bool {
+	return len(config.Tokens) > 0 || config.ApiKeys
+}
+
 // Rest holds the REST server configuration.
 type Rest struct {
 	// Port is the port on which the REST server listens for incoming requests.
@@ -52,6 +159,34 @@ type Rest struct {
 	MaxRequestSize int64 `json:"maxRequestSize" yaml:"maxRequestSize"`
 	// Cors holds the CORS configuration.
 	Cors RestCors `json:"cors" yaml:"cors"`
+	// JwksDefaultUsage is the key usage served at /.well-known/jwks.json, for consumers that
+	// only know the standard location. Leave empty to serve keys per usage only.
+	JwksDefaultUsage string `json:"jwksDefaultUsage" yaml:"jwksDefaultUsage"`
+	// PublicURL is the external base URL of the server, as seen by clients (for example
+	// "https://keys.example.com"). Discovery documents use it to build absolute links. When
+	// empty, links are derived from the incoming request.
+	PublicURL string `json:"publicURL" yaml:"publicURL"`
+	// Auth holds the authentication configuration for the endpoints that require it.
+	Auth RestAuth `json:"auth" yaml:"auth"`
+}
+
+// Audit holds the configuration of the audit trail of signing and key-management operations.
+// Events are always stored in the database.
+type Audit struct {
+	// Log also writes every event to the application logger, for log-based pipelines.
+	Log bool `json:"log" yaml:"log"`
+}
+
+// Metrics holds the configuration of the Prometheus metrics endpoint.
+type Metrics struct {
+	// Port is the port of the dedicated listener serving /metrics, kept apart from the API so it
+	// is not exposed with it. Metrics are not served when it is 0.
+	Port int `json:"port" yaml:"port"`
+}
+
+// Enabled reports whether metrics are served.
+func (config Metrics) Enabled() bool {
+	return config.Port != 0
 }
 
 // App aggregates the configuration needed to run the gRPC and REST servers.
@@ -62,6 +197,10 @@ type App struct {
 	Grpc Grpc `json:"grpc" yaml:"grpc"`
 	// Rest holds the REST server configuration.
 	Rest Rest `json:"rest" yaml:"rest"`
+	// Audit holds the audit trail configuration.
+	Audit Audit `json:"audit" yaml:"audit"`
+	// Metrics holds the Prometheus metrics configuration.
+	Metrics Metrics `json:"metrics" yaml:"metrics"`
 
 	// Otel configures the OpenTelemetry exporter for traces and metrics.
 	Otel otel.Config `json:"otel" yaml:"otel"`
@@ -64,6 +64,18 @@ var (
 	grpcUrl  = getEnv("GRPC_URL")
 	grpcPing = getEnv("GRPC_PING")
 
+	grpcTLSCertFile     = getEnv("GRPC_TLS_CERT_FILE")
+	grpcTLSKeyFile      = getEnv("GRPC_TLS_KEY_FILE")
+	grpcTLSClientCAFile = getEnv("GRPC_TLS_CLIENT_CA_FILE")
+
+	grpcApiKeysRequired = getEnv("GRPC_API_KEYS_REQUIRED")
+	grpcAdminIdentities = getEnv("GRPC_ADMIN_IDENTITIES")
+
+	grpcFailFast      = getEnv("GRPC_FAIL_FAST")
+	grpcBootstrapKeys = getEnv("GRPC_BOOTSTRAP_KEYS")
+
+	grpcRotationInterval = getEnv("GRPC_ROTATION_INTERVAL")
+
 	restPort              = getEnv("REST_PORT")
 	restTimeoutRead       = getEnv("REST_TIMEOUT_READ")
 	restTimeoutReadHeader = getEnv("REST_TIMEOUT_READ_HEADER")
@@ -71,6 +83,14 @@ var (
 	restTimeoutIdle       = getEnv("REST_TIMEOUT_IDLE")
 	restTimeoutRequest    = getEnv("REST_TIMEOUT_REQUEST")
 	restMaxRequestSize    = getEnv("REST_MAX_REQUEST_SIZE")
+	restJwksDefaultUsage  = getEnv("REST_JWKS_DEFAULT_USAGE")
+	restPublicUrl         = getEnv("REST_PUBLIC_URL")
+	restAuthTokens        = getEnv("REST_AUTH_TOKENS")
+	restAuthApiKeys       = getEnv("REST_AUTH_API_KEYS")
+
+	auditLog = getEnv("AUDIT_LOG")
+
+	metricsPort = getEnv("METRICS_PORT")
 
 	corsAllowedOrigins   = getEnv("REST_CORS_ALLOWED_ORIGINS")
 	corsAllowedHeaders   = getEnv("REST_CORS_ALLOWED_HEADERS")
@@ -102,8 +122,29 @@ var (
 	GrpcPort = config.LoadEnv(grpcPort, GrpcPortDefault, config.IntParser)
 	// GrpcUrl is the address of the gRPC service, in the form <host>:<port>.
 	GrpcUrl = grpcUrl
-	// GrpcPing configures the refresh interval for the gRPC server internal healthcheck.
+	// GrpcPing configures the refresh interval of the gRPC server health statuses (grpc.health.v1).
 	GrpcPing = config.LoadEnv(grpcPing, GrpcDefaultPing, config.DurationParser)
+	// GrpcTLSCertFile is the PEM certificate chain of the gRPC server. TLS is disabled when empty.
+	GrpcTLSCertFile = grpcTLSCertFile
+	// GrpcTLSKeyFile is the PEM private key of the gRPC server certificate.
+	GrpcTLSKeyFile = grpcTLSKeyFile
+	// GrpcTLSClientCAFile lists the PEM certificate authorities trusted to issue client
+	// certificates. When set, the gRPC server requires mutual TLS.
+	GrpcTLSClientCAFile = grpcTLSClientCAFile
+	// GrpcApiKeysRequired refuses signing and key listing to gRPC callers that present no API key.
+	GrpcApiKeysRequired = config.LoadEnv(grpcApiKeysRequired, false, config.BoolParser)
+	// GrpcAdminIdentities lists the client certificate identities allowed the admin gRPC RPCs
+	// without an API key.
+	GrpcAdminIdentities = config.LoadEnv(
+		grpcAdminIdentities, []string(nil), config.SliceParser(config.StringParser),
+	)
+	// GrpcFailFast stops the gRPC server at startup when a configured usage has no key to sign with.
+	GrpcFailFast = config.LoadEnv(grpcFailFast, false, config.BoolParser)
+	// GrpcBootstrapKeys generates a first key, at startup, for the usages that have none.
+	GrpcBootstrapKeys = config.LoadEnv(grpcBootstrapKeys, false, config.BoolParser)
+	// GrpcRotationInterval schedules the key rotation inside the gRPC server, at this interval.
+	// Disabled when 0.
+	GrpcRotationInterval = config.LoadEnv(grpcRotationInterval, 0, config.DurationParser)
 
 	// RestPort is the port on which the REST server listens for incoming requests.
 	RestPort = config.LoadEnv(restPort, RestPortDefault, config.IntParser)
@@ -119,6 +160,25 @@ var (
 	RestTimeoutRequest = config.LoadEnv(restTimeoutRequest, RestTimeoutRequestDefault, config.DurationParser)
 	// RestMaxRequestSize is the maximum size of an incoming REST request body.
 	RestMaxRequestSize = config.LoadEnv(restMaxRequestSize, RestMaxRequestSizeDefault, config.Int64Parser)
+	// RestJwksDefaultUsage is the key usage served at /.well-known/jwks.json. When empty, the
+	// route is not mounted, and keys are only served per usage.
+	RestJwksDefaultUsage = restJwksDefaultUsage
+	// RestPublicUrl is the external base URL of the REST server, as seen by clients, used to
+	// build absolute links in discovery documents. When empty, links are derived from requests.
+	RestPublicUrl = restPublicUrl
+	// RestAuthTokens lists the bearer tokens accepted by the authenticated REST endpoints, such as
+	// token signing. When empty, those endpoints are not served.
+	RestAuthTokens = config.LoadEnv(restAuthTokens, []string(nil), config.SliceParser(config.StringParser))
+	// RestAuthApiKeys lets API keys authenticate on the authenticated REST endpoints, along with
+	// the static tokens.
+	RestAuthApiKeys = config.LoadEnv(restAuthApiKeys, false, config.BoolParser)
+
+	// AuditLog also writes every audit event to the application logs, on top of the database.
+	AuditLog = config.LoadEnv(auditLog, false, config.BoolParser)
+
+	// MetricsPort is the port on which the server exposes its Prometheus metrics, at /metrics.
+	// Metrics are not served when it is 0.
+	MetricsPort = config.LoadEnv(metricsPort, 0, config.IntParser)
 
 	// CorsAllowedOrigins lists the origins allowed to access the REST API.
 	CorsAllowedOrigins = config.LoadEnv(
@@ -4,6 +4,7 @@ import (
 	_ "embed"
 
 	"github.com/goccy/go-yaml"
+	"github.com/samber/lo"
 
 	"github.com/a-novel-kit/golib/config"
 )
@@ -12,5 +13,8 @@ import (
 var defaultJWKSConfigFile []byte
 
 // JwkPresetDefault is the default JWK configuration for all registered usages,
-// loaded from the bundled YAML file.
-var JwkPresetDefault = config.MustUnmarshal[map[string]*Jwk](yaml.Unmarshal, defaultJWKSConfigFile)
+// loaded from the bundled YAML file. Loading panics if a usage breaks [JwkPolicyPresetDefault].
+var JwkPresetDefault = lo.Must(ApplyJwkPolicy(
+	config.MustUnmarshal[map[string]*Jwk](yaml.Unmarshal, defaultJWKSConfigFile),
+	&JwkPolicyPresetDefault,
+))
@@ -41,8 +41,28 @@ type JwkToken struct {
 type Jwk struct {
 	// Alg is the signing algorithm for keys under this usage.
 	Alg jwa.Alg `json:"alg" yaml:"alg"`
+	// PreviousAlgs lists algorithms the usage signed with before Alg. Tokens and keys of those
+	// algorithms are still verified until the last such key expires, so Alg can change without
+	// breaking tokens already issued. New keys always use Alg.
+	PreviousAlgs []jwa.Alg `json:"previousAlgs,omitempty" yaml:"previousAlgs,omitempty"`
 	// Key holds the lifetime and caching parameters for the JSON Web Key.
 	Key JwkKey `json:"key" yaml:"key"`
 	// Token holds the claims parameters applied to every JWT signed with this key.
 	Token JwkToken `json:"token" yaml:"token"`
+	// CoSigners lists other usages whose current key adds its signature to the multi-signature
+	// tokens of this usage. Claims and token parameters still come from this usage alone.
+	CoSigners []string `json:"coSigners,omitempty" yaml:"coSigners,omitempty"`
+	// Producers lists the caller identities allowed to sign with this usage: the services that
+	// registered it. A caller identity is read from its mutual TLS client certificate. When
+	// empty, any caller that can reach the gRPC server may sign.
+	Producers []string `json:"producers,omitempty" yaml:"producers,omitempty"`
+	// Policy narrows the global algorithm policy for this usage. Once the configuration is loaded
+	// through [ApplyJwkPolicy], it holds the effective policy instead.
+	Policy *JwkPolicy `json:"policy,omitempty" yaml:"policy,omitempty"`
+}
+
+// Algs returns every algorithm a token of this usage may be signed with: Alg first, then
+// PreviousAlgs.
+func (jwk *Jwk) Algs() []jwa.Alg {
+	return append([]jwa.Alg{jwk.Alg}, jwk.PreviousAlgs...)
 }
@@ -0,0 +1,15 @@
+package config
+
+import (
+	_ "embed"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/a-novel-kit/golib/config"
+)
+
+//go:embed jwks.policy.config.yaml
+var defaultJWKSPolicyFile []byte
+
+// JwkPolicyPresetDefault is the default global algorithm policy, loaded from the bundled YAML file.
+var JwkPolicyPresetDefault = config.MustUnmarshal[JwkPolicy](yaml.Unmarshal, defaultJWKSPolicyFile)
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+	"github.com/a-novel-kit/jwt/v2/jwk"
+)
+
+var (
+	// ErrJwkPolicyAlgNotAllowed is returned when an algorithm is not listed by the applicable policy.
+	ErrJwkPolicyAlgNotAllowed = errors.New("algorithm not allowed by policy")
+	// ErrJwkPolicyKeyTooWeak is returned when a key is smaller than the minimum strength the
+	// applicable policy requires for its family.
+	ErrJwkPolicyKeyTooWeak = errors.New("key strength below policy minimum")
+	// ErrJwkPolicyUnknownAlg is returned when the policy cannot tell the key strength of an algorithm.
+	ErrJwkPolicyUnknownAlg = errors.New("algorithm unknown to policy")
+)
+
+// JwkPolicyKeySize holds minimum key strengths, in bits, for each key family.
+type JwkPolicyKeySize struct {
+	// RSA is the minimum modulus size of RSA keys (RS* and PS* algorithms).
+	RSA int `json:"rsa" yaml:"rsa"`
+	// EC is the minimum curve size of elliptic-curve keys (ES* and EdDSA algorithms).
+	EC int `json:"ec" yaml:"ec"`
+}
+
+// JwkPolicy restricts the algorithms and key strengths a usage may sign with.
+//
+// A global policy applies to every usage; a usage may narrow it with its own, but never widen it.
+// See [ApplyJwkPolicy].
+type JwkPolicy struct {
+	// Algs lists the allowed signing algorithms. An empty list allows every supported algorithm.
+	Algs []jwa.Alg `json:"algs" yaml:"algs"`
+	// MinKeySize holds the minimum key strength of each family. Zero disables the check.
+	MinKeySize JwkPolicyKeySize `json:"minKeySize" yaml:"minKeySize"`
+}
+
+// jwkEd25519KeySize is the size, in bits, of Ed25519 keys.
+const jwkEd25519KeySize = ed25519.PublicKeySize * 8
+
+// jwkPolicyRsaAlgs lists the algorithms backed by RSA keys. Every other supported algorithm is
+// backed by an elliptic curve.
+var jwkPolicyRsaAlgs = []jwa.Alg{jwa.RS256, jwa.RS384, jwa.RS512, jwa.PS256, jwa.PS384, jwa.PS512}
+
+// JwkAlgKeySizes maps each supported algorithm to the size, in bits, of the keys the service
+// generates for it: the modulus for RSA presets, the curve size otherwise.
+var JwkAlgKeySizes = map[jwa.Alg]int{
+	jwa.EdDSA: jwkEd25519KeySize,
+	jwa.ES256: jwk.ES256.Curve.Params().BitSize,
+	jwa.ES384: jwk.ES384.Curve.Params().BitSize,
+	jwa.ES512: jwk.ES512.Curve.Params().BitSize,
+	jwa.RS256: jwk.RS256.KeySize,
+	jwa.RS384: jwk.RS384.KeySize,
+	jwa.RS512: jwk.RS512.KeySize,
+	jwa.PS256: jwk.PS256.KeySize,
+	jwa.PS384: jwk.PS384.KeySize,
+	jwa.PS512: jwk.PS512.KeySize,
+}
+
+// Narrow returns the policy resulting from applying other on top of policy: only algorithms both
+// allow remain, and each minimum key size is the strictest of the two. Either side may be nil.
+func (policy *JwkPolicy) Narrow(other *JwkPolicy) *JwkPolicy {
+	if policy == nil {
+		return other
+	}
+
+	if other == nil {
+		return policy
+	}
+
+	output := &JwkPolicy{
+		MinKeySize: JwkPolicyKeySize{
+			RSA: max(policy.MinKeySize.RSA, other.MinKeySize.RSA),
+			EC:  max(policy.MinKeySize.EC, other.MinKeySize.EC),
+		},
+	}
+
+	switch {
+	case len(policy.Algs) == 0:
+		output.Algs = other.Algs
+	case len(other.Algs) == 0:
+		output.Algs = policy.Algs
+	default:
+		output.Algs = make([]jwa.Alg, 0, len(policy.Algs))
+
+		for _, alg := range policy.Algs {
+			if slices.Contains(other.Algs, alg) {
+				output.Algs = append(output.Algs, alg)
+			}
+		}
+
+		// An empty list reads as "allow everything". "none" is never a signing algorithm, so a list
+		// holding only it allows nothing, which is what disjoint policies mean.
+		if len(output.Algs) == 0 {
+			output.Algs = []jwa.Alg{jwa.None}
+		}
+	}
+
+	return output
+}
+
+// CheckAlg returns an error wrapping [ErrJwkPolicyAlgNotAllowed] if alg is outside the policy.
+// A nil policy allows everything.
+func (policy *JwkPolicy) CheckAlg(alg jwa.Alg) error {
+	if policy == nil || len(policy.Algs) == 0 || slices.Contains(policy.Algs, alg) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrJwkPolicyAlgNotAllowed, alg)
+}
+
+// CheckKeySize returns an error wrapping [ErrJwkPolicyKeyTooWeak] if a key of size bits, used
+// with alg, is below the minimum the policy sets for its family. A nil policy allows everything.
+func (policy *JwkPolicy) CheckKeySize(alg jwa.Alg, bits int) error {
+	if policy == nil {
+		return nil
+	}
+
+	minSize := policy.MinKeySize.EC
+	if slices.Contains(jwkPolicyRsaAlgs, alg) {
+		minSize = policy.MinKeySize.RSA
+	}
+
+	if bits < minSize {
+		return fmt.Errorf("%w: %s key of %d bits, policy requires %d", ErrJwkPolicyKeyTooWeak, alg, bits, minSize)
+	}
+
+	return nil
+}
+
+// Check runs both [JwkPolicy.CheckAlg] and [JwkPolicy.CheckKeySize] against alg, using the size of
+// the keys the service generates for it.
+func (policy *JwkPolicy) Check(alg jwa.Alg) error {
+	err := policy.CheckAlg(alg)
+	if err != nil {
+		return err
+	}
+
+	bits, ok := JwkAlgKeySizes[alg]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrJwkPolicyUnknownAlg, alg)
+	}
+
+	return policy.CheckKeySize(alg, bits)
+}
+
+// ApplyJwkPolicy resolves the effective policy of every usage in keys — global narrowed by the
+// usage's own policy — and checks the usage's algorithms against it. On success, each usage's Policy
+// holds its effective policy, so later checks need not know about global.
+//
+// Previous algorithms are checked too: verifiers refuse tokens outside the policy, so a previous
+// algorithm the policy disallows could never be verified anyway.
+func ApplyJwkPolicy(keys map[string]*Jwk, global *JwkPolicy) (map[string]*Jwk, error) {
+	for usage, keyConfig := range keys {
+		keyConfig.Policy = global.Narrow(keyConfig.Policy)
+
+		for _, alg := range keyConfig.Algs() {
+			err := keyConfig.Policy.Check(alg)
+			if err != nil {
+				return nil, fmt.Errorf("usage %s: %w", usage, err)
+			}
+		}
+	}
+
+	return keys, nil
+}
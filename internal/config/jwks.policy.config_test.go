@@ -0,0 +1,237 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+)
+
+func TestJwkPolicyNarrow(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		policy *config.JwkPolicy
+		other  *config.JwkPolicy
+
+		expect *config.JwkPolicy
+	}{
+		{
+			name: "NilOther",
+
+			policy: &config.JwkPolicy{Algs: []jwa.Alg{jwa.EdDSA}},
+
+			expect: &config.JwkPolicy{Algs: []jwa.Alg{jwa.EdDSA}},
+		},
+		{
+			name: "NilPolicy",
+
+			other: &config.JwkPolicy{Algs: []jwa.Alg{jwa.EdDSA}},
+
+			expect: &config.JwkPolicy{Algs: []jwa.Alg{jwa.EdDSA}},
+		},
+		{
+			name: "Intersection",
+
+			policy: &config.JwkPolicy{
+				Algs:       []jwa.Alg{jwa.EdDSA, jwa.ES256, jwa.RS256},
+				MinKeySize: config.JwkPolicyKeySize{RSA: 2048, EC: 384},
+			},
+			other: &config.JwkPolicy{
+				Algs:       []jwa.Alg{jwa.RS256, jwa.EdDSA},
+				MinKeySize: config.JwkPolicyKeySize{RSA: 3072, EC: 256},
+			},
+
+			expect: &config.JwkPolicy{
+				Algs:       []jwa.Alg{jwa.EdDSA, jwa.RS256},
+				MinKeySize: config.JwkPolicyKeySize{RSA: 3072, EC: 384},
+			},
+		},
+		{
+			name: "EmptyAlgsKeepOtherSide",
+
+			policy: &config.JwkPolicy{},
+			other:  &config.JwkPolicy{Algs: []jwa.Alg{jwa.ES384}},
+
+			expect: &config.JwkPolicy{Algs: []jwa.Alg{jwa.ES384}},
+		},
+		{
+			name: "Disjoint",
+
+			policy: &config.JwkPolicy{Algs: []jwa.Alg{jwa.EdDSA}},
+			other:  &config.JwkPolicy{Algs: []jwa.Alg{jwa.ES256}},
+
+			expect: &config.JwkPolicy{Algs: []jwa.Alg{jwa.None}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testCase.expect, testCase.policy.Narrow(testCase.other))
+		})
+	}
+}
+
+func TestApplyJwkPolicy(t *testing.T) {
+	t.Parallel()
+
+	global := &config.JwkPolicy{
+		Algs:       []jwa.Alg{jwa.EdDSA, jwa.ES256, jwa.RS256, jwa.RS512},
+		MinKeySize: config.JwkPolicyKeySize{RSA: 2048, EC: 256},
+	}
+
+	testCases := []struct {
+		name string
+
+		keys map[string]*config.Jwk
+
+		expectPolicy *config.JwkPolicy
+		expectErr    error
+	}{
+		{
+			name: "Success/Global",
+
+			keys: map[string]*config.Jwk{"test-usage": {Alg: jwa.EdDSA}},
+
+			expectPolicy: global,
+		},
+		{
+			name: "Success/Narrowed",
+
+			keys: map[string]*config.Jwk{"test-usage": {
+				Alg:    jwa.RS512,
+				Policy: &config.JwkPolicy{MinKeySize: config.JwkPolicyKeySize{RSA: 4096}},
+			}},
+
+			expectPolicy: &config.JwkPolicy{
+				Algs:       global.Algs,
+				MinKeySize: config.JwkPolicyKeySize{RSA: 4096, EC: 256},
+			},
+		},
+		{
+			name: "Error/AlgNotAllowed",
+
+			keys: map[string]*config.Jwk{"test-usage": {Alg: jwa.PS256}},
+
+			expectErr: config.ErrJwkPolicyAlgNotAllowed,
+		},
+		{
+			name: "Error/UsageCannotWiden",
+
+			keys: map[string]*config.Jwk{"test-usage": {
+				Alg:    jwa.ES512,
+				Policy: &config.JwkPolicy{Algs: []jwa.Alg{jwa.ES512}},
+			}},
+
+			expectErr: config.ErrJwkPolicyAlgNotAllowed,
+		},
+		{
+			name: "Error/KeyTooWeak",
+
+			keys: map[string]*config.Jwk{"test-usage": {
+				Alg:    jwa.RS256,
+				Policy: &config.JwkPolicy{MinKeySize: config.JwkPolicyKeySize{RSA: 3072}},
+			}},
+
+			expectErr: config.ErrJwkPolicyKeyTooWeak,
+		},
+		{
+			// HMAC is never part of a policy: the global list excludes it.
+			name: "Error/AlgOutsideGlobal",
+
+			keys: map[string]*config.Jwk{"test-usage": {Alg: jwa.HS256}},
+
+			expectErr: config.ErrJwkPolicyAlgNotAllowed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			keys, err := config.ApplyJwkPolicy(testCase.keys, global)
+			require.ErrorIs(t, err, testCase.expectErr)
+
+			if testCase.expectErr == nil {
+				require.Equal(t, testCase.expectPolicy, keys["test-usage"].Policy)
+			}
+		})
+	}
+}
+
+func TestJwkPolicyCheck(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		policy *config.JwkPolicy
+		alg    jwa.Alg
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			policy: &config.JwkPolicy{
+				Algs:       []jwa.Alg{jwa.RS256},
+				MinKeySize: config.JwkPolicyKeySize{RSA: 2048},
+			},
+			alg: jwa.RS256,
+		},
+		{
+			name: "Success/NilPolicy",
+
+			alg: jwa.EdDSA,
+		},
+		{
+			name: "Error/AlgNotAllowed",
+
+			policy: &config.JwkPolicy{Algs: []jwa.Alg{jwa.EdDSA}},
+			alg:    jwa.RS256,
+
+			expectErr: config.ErrJwkPolicyAlgNotAllowed,
+		},
+		{
+			name: "Error/KeyTooWeak",
+
+			policy: &config.JwkPolicy{MinKeySize: config.JwkPolicyKeySize{RSA: 4096}},
+			alg:    jwa.RS256,
+
+			expectErr: config.ErrJwkPolicyKeyTooWeak,
+		},
+		{
+			// The policy allows the algorithm, but the service does not know the size of its keys.
+			name: "Error/UnknownAlg",
+
+			policy: &config.JwkPolicy{Algs: []jwa.Alg{"XX256"}},
+			alg:    "XX256",
+
+			expectErr: config.ErrJwkPolicyUnknownAlg,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ErrorIs(t, testCase.policy.Check(testCase.alg), testCase.expectErr)
+		})
+	}
+}
+
+func TestJwkPresetDefaultHasPolicy(t *testing.T) {
+	t.Parallel()
+
+	for usage, keyConfig := range config.JwkPresetDefault {
+		require.NotNil(t, keyConfig.Policy, usage)
+		require.NoError(t, keyConfig.Policy.Check(keyConfig.Alg), usage)
+	}
+}
@@ -0,0 +1,170 @@
+package core
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// ApiKeySecretScheme starts every API key secret, so leaked secrets are easy to spot, for
+// example by secret scanners.
+const ApiKeySecretScheme = "jsk"
+
+const (
+	apiKeyPrefixSize = 6
+	apiKeySecretSize = 32
+)
+
+var (
+	// ErrApiKeyInvalid is returned when a presented API key is malformed, unknown, revoked, or
+	// does not match the stored digest. The cases are not told apart, so callers learn nothing
+	// about which keys exist.
+	ErrApiKeyInvalid = errors.New("invalid api key")
+	// ErrApiKeyNotFound is returned when no unrevoked API key matches the requested ID.
+	ErrApiKeyNotFound = errors.New("api key not found")
+	// ErrApiKeyUnknownOperation is returned when an API key is issued for an operation that does
+	// not exist.
+	ErrApiKeyUnknownOperation = errors.New("unknown api key operation")
+	// ErrApiKeyInvalidScope is returned when an API key is issued without a name, a usage or an
+	// operation.
+	ErrApiKeyInvalidScope = errors.New("api key needs a name, a usage and an operation")
+)
+
+// ApiKeyOperation is an operation an API key may be allowed to perform.
+type ApiKeyOperation string
+
+const (
+	// ApiKeyOperationSign allows signing with the private keys of a usage.
+	ApiKeyOperationSign ApiKeyOperation = "sign"
+	// ApiKeyOperationList allows reading the public keys of a usage.
+	ApiKeyOperationList ApiKeyOperation = "list"
+	// ApiKeyOperationAdmin allows managing the keys of a usage.
+	ApiKeyOperationAdmin ApiKeyOperation = "admin"
+)
+
+// ApiKeyOperations lists every known [ApiKeyOperation].
+var ApiKeyOperations = []ApiKeyOperation{ApiKeyOperationSign, ApiKeyOperationList, ApiKeyOperationAdmin}
+
+// An ApiKey is an API key a service authenticates with, scoped to a set of usages and operations.
+// It never holds the secret.
+type ApiKey struct {
+	// ID is the key's unique identifier.
+	ID uuid.UUID
+	// Name is the human-readable label of the key.
+	Name string
+	// Prefix is the public part of the key, also found in its secret. It is safe to log.
+	Prefix string
+
+	// Usages lists the key usages the key grants access to.
+	Usages []string
+	// Operations lists the operations the key grants on those usages.
+	Operations []ApiKeyOperation
+
+	// CreatedAt is when the key was issued.
+	CreatedAt time.Time
+	// LastUsedAt is when the key last authenticated a request; nil for keys never used.
+	LastUsedAt *time.Time
+	// RevokedAt is when the key was revoked; nil for active keys.
+	RevokedAt *time.Time
+}
+
+// Allows reports whether the key may perform the operation on the usage. An empty usage only
+// checks the operation, for requests that are not tied to a usage.
+func (key *ApiKey) Allows(operation ApiKeyOperation, usage string) bool {
+	if !slices.Contains(key.Operations, operation) {
+		return false
+	}
+
+	return usage == "" || slices.Contains(key.Usages, usage)
+}
+
+func newApiKey(entity *dao.ApiKey) *ApiKey {
+	operations := make([]ApiKeyOperation, len(entity.Operations))
+	for i, operation := range entity.Operations {
+		operations[i] = ApiKeyOperation(operation)
+	}
+
+	return &ApiKey{
+		ID:         entity.ID,
+		Name:       entity.Name,
+		Prefix:     entity.Prefix,
+		Usages:     entity.Usages,
+		Operations: operations,
+		CreatedAt:  entity.CreatedAt,
+		LastUsedAt: entity.LastUsedAt,
+		RevokedAt:  entity.RevokedAt,
+	}
+}
+
+// apiKeyAuditDetail describes a key in the audit trail. API keys span several usages, so the
+// event names the key rather than a usage.
+func apiKeyAuditDetail(key *ApiKey) string {
+	return fmt.Sprintf("api key %s (%s)", key.ID, key.Name)
+}
+
+// apiKeyContext is the context key used to store the API key that authenticated a request.
+type apiKeyContext struct{}
+
+// NewApiKeyContext returns a copy of ctx that carries the API key that authenticated the request.
+func NewApiKeyContext(ctx context.Context, key *ApiKey) context.Context {
+	return context.WithValue(ctx, apiKeyContext{}, key)
+}
+
+// ApiKeyFromContext returns the API key that authenticated the request, if any.
+func ApiKeyFromContext(ctx context.Context) (*ApiKey, bool) {
+	key, ok := ctx.Value(apiKeyContext{}).(*ApiKey)
+
+	return key, ok && key != nil
+}
+
+// newApiKeySecret generates a new secret, and returns its prefix along with it. Secrets take the
+// form "jsk_<prefix>_<secret>"; the prefix is hex-encoded, so the first underscore after it
+// always ends it.
+func newApiKeySecret() (string, string, error) {
+	prefixBytes := make([]byte, apiKeyPrefixSize)
+	secretBytes := make([]byte, apiKeySecretSize)
+
+	_, err := rand.Read(prefixBytes)
+	if err == nil {
+		_, err = rand.Read(secretBytes)
+	}
+
+	if err != nil {
+		return "", "", fmt.Errorf("generate secret: %w", err)
+	}
+
+	prefix := hex.EncodeToString(prefixBytes)
+
+	return prefix, ApiKeySecretScheme + "_" + prefix + "_" + base64.RawURLEncoding.EncodeToString(secretBytes), nil
+}
+
+// parseApiKeySecret splits a secret into its prefix and its secret part.
+func parseApiKeySecret(secret string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(secret, ApiKeySecretScheme+"_")
+	if !ok {
+		return "", "", false
+	}
+
+	prefix, secretPart, ok := strings.Cut(rest, "_")
+
+	return prefix, secretPart, ok && prefix != "" && secretPart != ""
+}
+
+// hashApiKeySecret returns the digest stored for a secret. The secret is random and long, so a
+// fast, unsalted hash is enough: there is no dictionary to attack.
+func hashApiKeySecret(secretPart string) string {
+	digest := sha256.Sum256([]byte(secretPart))
+
+	return hex.EncodeToString(digest[:])
+}
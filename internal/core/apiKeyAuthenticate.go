@@ -0,0 +1,91 @@
+package core
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// ApiKeyAuthenticateDaoSelect is the DAO select dependency of [ApiKeyAuthenticate].
+type ApiKeyAuthenticateDaoSelect interface {
+	Exec(ctx context.Context, request *dao.ApiKeySelectRequest) (*dao.ApiKey, error)
+}
+
+// ApiKeyAuthenticateDaoTouch is the DAO dependency of [ApiKeyAuthenticate] that records key use.
+type ApiKeyAuthenticateDaoTouch interface {
+	Exec(ctx context.Context, request *dao.ApiKeyTouchRequest) error
+}
+
+// ApiKeyAuthenticateRequest holds the parameters for an [ApiKeyAuthenticate.Exec] call.
+type ApiKeyAuthenticateRequest struct {
+	// Secret is the API key presented by the caller.
+	Secret string
+}
+
+// An ApiKeyAuthenticate checks a presented API key, and returns it when it is valid. Any
+// failure yields [ErrApiKeyInvalid].
+//
+// Each successful check records the use of the key. Failing to record it does not fail the
+// check: it is reported on the trace span only.
+type ApiKeyAuthenticate struct {
+	daoSelect ApiKeyAuthenticateDaoSelect
+	daoTouch  ApiKeyAuthenticateDaoTouch
+}
+
+// NewApiKeyAuthenticate returns a new ApiKeyAuthenticate service.
+func NewApiKeyAuthenticate(
+	daoSelect ApiKeyAuthenticateDaoSelect,
+	daoTouch ApiKeyAuthenticateDaoTouch,
+) *ApiKeyAuthenticate {
+	return &ApiKeyAuthenticate{
+		daoSelect: daoSelect,
+		daoTouch:  daoTouch,
+	}
+}
+
+func (service *ApiKeyAuthenticate) Exec(ctx context.Context, request *ApiKeyAuthenticateRequest) (*ApiKey, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.ApiKeyAuthenticate")
+	defer span.End()
+
+	prefix, secretPart, ok := parseApiKeySecret(request.Secret)
+	if !ok {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: malformed secret", ErrApiKeyInvalid))
+	}
+
+	span.SetAttributes(attribute.String("api_key.prefix", prefix))
+
+	entity, err := service.daoSelect.Exec(ctx, &dao.ApiKeySelectRequest{Prefix: prefix})
+	if errors.Is(err, dao.ErrApiKeySelectNotFound) {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: unknown prefix", ErrApiKeyInvalid))
+	}
+
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select api key: %w", err))
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashApiKeySecret(secretPart)), []byte(entity.SecretHash)) != 1 {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: secret mismatch", ErrApiKeyInvalid))
+	}
+
+	span.SetAttributes(attribute.String("api_key.id", entity.ID.String()))
+
+	key := newApiKey(entity)
+	now := time.Now()
+
+	err = service.daoTouch.Exec(ctx, &dao.ApiKeyTouchRequest{ID: entity.ID, Now: now})
+	if err != nil {
+		span.RecordError(fmt.Errorf("record api key use: %w", err))
+	} else {
+		key.LastUsedAt = &now
+	}
+
+	return otel.ReportSuccess(span, key), nil
+}
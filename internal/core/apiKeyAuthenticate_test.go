@@ -0,0 +1,177 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestApiKeyAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	// Issue a real key, to get a secret and the digest stored for it.
+	var stored *dao.ApiKey
+
+	daoInsert := coremocks.NewMockApiKeyCreateDao(t)
+	daoInsert.EXPECT().
+		Exec(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, request *dao.ApiKeyInsertRequest) (*dao.ApiKey, error) {
+			stored = &dao.ApiKey{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Name:       request.Name,
+				Prefix:     request.Prefix,
+				SecretHash: request.SecretHash,
+				Usages:     request.Usages,
+				Operations: request.Operations,
+				CreatedAt:  request.Now,
+			}
+
+			return stored, nil
+		}).
+		Once()
+
+	created, err := core.NewApiKeyCreate(daoInsert).Exec(t.Context(), &core.ApiKeyCreateRequest{
+		Name:       "service-authentication",
+		Usages:     []string{"auth"},
+		Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+	})
+	require.NoError(t, err)
+
+	secret := created.Secret
+	// Same prefix, another secret part.
+	forged := core.ApiKeySecretScheme + "_" + stored.Prefix + "_" + "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+
+	malformed := "not-an-api-key"
+
+	type daoSelectMock struct {
+		resp *dao.ApiKey
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.ApiKeyAuthenticateRequest
+
+		daoSelectMock *daoSelectMock
+		daoTouchErr   error
+		expectTouch   bool
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.ApiKeyAuthenticateRequest{Secret: secret},
+
+			daoSelectMock: &daoSelectMock{resp: stored},
+			expectTouch:   true,
+		},
+		{
+			name: "Success/TouchFails",
+
+			request: &core.ApiKeyAuthenticateRequest{Secret: secret},
+
+			daoSelectMock: &daoSelectMock{resp: stored},
+			daoTouchErr:   errFoo,
+			expectTouch:   true,
+		},
+		{
+			name: "Error/Malformed",
+
+			request: &core.ApiKeyAuthenticateRequest{Secret: malformed},
+
+			expectErr: core.ErrApiKeyInvalid,
+		},
+		{
+			name: "Error/UnknownPrefix",
+
+			request: &core.ApiKeyAuthenticateRequest{Secret: secret},
+
+			daoSelectMock: &daoSelectMock{err: dao.ErrApiKeySelectNotFound},
+
+			expectErr: core.ErrApiKeyInvalid,
+		},
+		{
+			name: "Error/SecretMismatch",
+
+			request: &core.ApiKeyAuthenticateRequest{Secret: forged},
+
+			daoSelectMock: &daoSelectMock{resp: stored},
+
+			expectErr: core.ErrApiKeyInvalid,
+		},
+		{
+			name: "Error/Select",
+
+			request: &core.ApiKeyAuthenticateRequest{Secret: secret},
+
+			daoSelectMock: &daoSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoSelect := coremocks.NewMockApiKeyAuthenticateDaoSelect(t)
+			daoTouch := coremocks.NewMockApiKeyAuthenticateDaoTouch(t)
+
+			if testCase.daoSelectMock != nil {
+				daoSelect.EXPECT().
+					Exec(mock.Anything, &dao.ApiKeySelectRequest{Prefix: stored.Prefix}).
+					Return(testCase.daoSelectMock.resp, testCase.daoSelectMock.err).
+					Once()
+			}
+
+			if testCase.expectTouch {
+				daoTouch.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(request *dao.ApiKeyTouchRequest) bool {
+						return request.ID == stored.ID
+					})).
+					Return(testCase.daoTouchErr).
+					Once()
+			}
+
+			service := core.NewApiKeyAuthenticate(daoSelect, daoTouch)
+
+			res, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+
+			if testCase.expectErr == nil {
+				require.Equal(t, stored.ID, res.ID)
+				require.True(t, res.Allows(core.ApiKeyOperationSign, "auth"))
+				require.Equal(t, testCase.daoTouchErr == nil, res.LastUsedAt != nil)
+			}
+
+			daoSelect.AssertExpectations(t)
+			daoTouch.AssertExpectations(t)
+		})
+	}
+}
+
+func TestApiKeyAllows(t *testing.T) {
+	t.Parallel()
+
+	key := &core.ApiKey{
+		Usages:     []string{"auth"},
+		Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+	}
+
+	require.True(t, key.Allows(core.ApiKeyOperationSign, "auth"))
+	require.True(t, key.Allows(core.ApiKeyOperationSign, ""))
+	require.False(t, key.Allows(core.ApiKeyOperationSign, "refresh"))
+	require.False(t, key.Allows(core.ApiKeyOperationList, "auth"))
+}
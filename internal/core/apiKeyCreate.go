@@ -0,0 +1,116 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// ApiKeyCreateDao is the DAO dependency of [ApiKeyCreate].
+type ApiKeyCreateDao interface {
+	Exec(ctx context.Context, request *dao.ApiKeyInsertRequest) (*dao.ApiKey, error)
+}
+
+// ApiKeyCreateRequest holds the parameters for an [ApiKeyCreate.Exec] call.
+type ApiKeyCreateRequest struct {
+	// Name is a human-readable label for the key, such as the name of the calling service.
+	Name string
+	// Usages lists the key usages the key grants access to.
+	Usages []string
+	// Operations lists the operations the key grants on those usages.
+	Operations []ApiKeyOperation
+}
+
+// ApiKeyCreateResponse is the result of an [ApiKeyCreate.Exec] call.
+type ApiKeyCreateResponse struct {
+	// ApiKey is the created key.
+	ApiKey *ApiKey
+	// Secret is the value the caller authenticates with. It is not stored, and cannot be
+	// retrieved again.
+	Secret string
+}
+
+// An ApiKeyCreate issues a new API key. Only a digest of the secret is stored.
+type ApiKeyCreate struct {
+	dao ApiKeyCreateDao
+}
+
+// NewApiKeyCreate returns a new ApiKeyCreate service.
+func NewApiKeyCreate(dao ApiKeyCreateDao) *ApiKeyCreate {
+	return &ApiKeyCreate{dao: dao}
+}
+
+func (service *ApiKeyCreate) Exec(ctx context.Context, request *ApiKeyCreateRequest) (*ApiKeyCreateResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.ApiKeyCreate")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("api_key.name", request.Name),
+		attribute.StringSlice("api_key.usages", request.Usages),
+	)
+
+	response, err := service.create(ctx, span, request)
+	if err != nil {
+		recordAudit(ctx, &AuditRecordRequest{
+			Action: AuditActionApiKeyCreate,
+			Err:    fmt.Errorf("api key (%s): %w", request.Name, err),
+		})
+
+		return nil, err
+	}
+
+	recordAudit(ctx, &AuditRecordRequest{Action: AuditActionApiKeyCreate, Detail: apiKeyAuditDetail(response.ApiKey)})
+
+	return otel.ReportSuccess(span, response), nil
+}
+
+func (service *ApiKeyCreate) create(
+	ctx context.Context, span trace.Span, request *ApiKeyCreateRequest,
+) (*ApiKeyCreateResponse, error) {
+	if request.Name == "" || len(request.Usages) == 0 || len(request.Operations) == 0 {
+		return nil, otel.ReportError(span, ErrApiKeyInvalidScope)
+	}
+
+	operations := make([]string, len(request.Operations))
+
+	for i, operation := range request.Operations {
+		if !slices.Contains(ApiKeyOperations, operation) {
+			return nil, otel.ReportError(span, fmt.Errorf("%w: %s", ErrApiKeyUnknownOperation, operation))
+		}
+
+		operations[i] = string(operation)
+	}
+
+	prefix, secret, err := newApiKeySecret()
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	_, secretPart, _ := parseApiKeySecret(secret)
+
+	entity, err := service.dao.Exec(ctx, &dao.ApiKeyInsertRequest{
+		ID:         uuid.New(),
+		Name:       request.Name,
+		Prefix:     prefix,
+		SecretHash: hashApiKeySecret(secretPart),
+		Usages:     request.Usages,
+		Operations: operations,
+		Now:        time.Now(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("insert api key: %w", err))
+	}
+
+	span.SetAttributes(attribute.String("api_key.prefix", entity.Prefix))
+
+	return &ApiKeyCreateResponse{ApiKey: newApiKey(entity), Secret: secret}, nil
+}
@@ -0,0 +1,136 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestApiKeyCreate(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	testCases := []struct {
+		name string
+
+		request *core.ApiKeyCreateRequest
+
+		daoErr    error
+		expectDao bool
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.ApiKeyCreateRequest{
+				Name:       "service-authentication",
+				Usages:     []string{"auth"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign, core.ApiKeyOperationList},
+			},
+
+			expectDao: true,
+		},
+		{
+			name: "Error/UnknownOperation",
+
+			request: &core.ApiKeyCreateRequest{
+				Name:       "service-authentication",
+				Usages:     []string{"auth"},
+				Operations: []core.ApiKeyOperation{"delete"},
+			},
+
+			expectErr: core.ErrApiKeyUnknownOperation,
+		},
+		{
+			name: "Error/NoName",
+
+			request: &core.ApiKeyCreateRequest{
+				Usages:     []string{"auth"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+			},
+
+			expectErr: core.ErrApiKeyInvalidScope,
+		},
+		{
+			name: "Error/NoUsage",
+
+			request: &core.ApiKeyCreateRequest{
+				Name:       "service-authentication",
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+			},
+
+			expectErr: core.ErrApiKeyInvalidScope,
+		},
+		{
+			name: "Error/Insert",
+
+			request: &core.ApiKeyCreateRequest{
+				Name:       "service-authentication",
+				Usages:     []string{"auth"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+			},
+
+			daoErr:    errFoo,
+			expectDao: true,
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoInsert := coremocks.NewMockApiKeyCreateDao(t)
+
+			if testCase.expectDao {
+				daoInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(request *dao.ApiKeyInsertRequest) bool {
+						return request.Name == testCase.request.Name &&
+							len(request.Prefix) == 12 &&
+							len(request.SecretHash) == 64
+					})).
+					RunAndReturn(func(_ context.Context, request *dao.ApiKeyInsertRequest) (*dao.ApiKey, error) {
+						if testCase.daoErr != nil {
+							return nil, testCase.daoErr
+						}
+
+						return &dao.ApiKey{
+							ID:         request.ID,
+							Name:       request.Name,
+							Prefix:     request.Prefix,
+							SecretHash: request.SecretHash,
+							Usages:     request.Usages,
+							Operations: request.Operations,
+							CreatedAt:  request.Now,
+						}, nil
+					}).
+					Once()
+			}
+
+			service := core.NewApiKeyCreate(daoInsert)
+
+			res, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+
+			if testCase.expectErr == nil {
+				require.Equal(t, testCase.request.Name, res.ApiKey.Name)
+				require.Equal(t, testCase.request.Usages, res.ApiKey.Usages)
+				require.Equal(t, testCase.request.Operations, res.ApiKey.Operations)
+				require.True(t, strings.HasPrefix(res.Secret, core.ApiKeySecretScheme+"_"+res.ApiKey.Prefix+"_"))
+			}
+
+			daoInsert.AssertExpectations(t)
+		})
+	}
+}
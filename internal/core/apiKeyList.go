@@ -0,0 +1,50 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// ApiKeyListDao is the DAO dependency of [ApiKeyList].
+type ApiKeyListDao interface {
+	Exec(ctx context.Context, request *dao.ApiKeyListRequest) ([]*dao.ApiKey, error)
+}
+
+// ApiKeyListRequest holds the parameters for an [ApiKeyList.Exec] call.
+type ApiKeyListRequest struct {
+	// IncludeRevoked also returns revoked keys.
+	IncludeRevoked bool
+}
+
+// An ApiKeyList lists the issued API keys, newest first.
+type ApiKeyList struct {
+	dao ApiKeyListDao
+}
+
+// NewApiKeyList returns a new ApiKeyList service.
+func NewApiKeyList(dao ApiKeyListDao) *ApiKeyList {
+	return &ApiKeyList{dao: dao}
+}
+
+func (service *ApiKeyList) Exec(ctx context.Context, request *ApiKeyListRequest) ([]*ApiKey, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.ApiKeyList")
+	defer span.End()
+
+	span.SetAttributes(attribute.Bool("api_keys.include_revoked", request.IncludeRevoked))
+
+	entities, err := service.dao.Exec(ctx, &dao.ApiKeyListRequest{IncludeRevoked: request.IncludeRevoked})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list api keys: %w", err))
+	}
+
+	return otel.ReportSuccess(span, lo.Map(entities, func(entity *dao.ApiKey, _ int) *ApiKey {
+		return newApiKey(entity)
+	})), nil
+}
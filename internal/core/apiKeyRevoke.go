@@ -0,0 +1,65 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// ApiKeyRevokeDao is the DAO dependency of [ApiKeyRevoke].
+type ApiKeyRevokeDao interface {
+	Exec(ctx context.Context, request *dao.ApiKeyRevokeRequest) (*dao.ApiKey, error)
+}
+
+// ApiKeyRevokeRequest holds the parameters for an [ApiKeyRevoke.Exec] call.
+type ApiKeyRevokeRequest struct {
+	// ID is the identifier of the key to revoke.
+	ID uuid.UUID
+}
+
+// An ApiKeyRevoke revokes an API key. The key stops authenticating requests at once.
+type ApiKeyRevoke struct {
+	dao ApiKeyRevokeDao
+}
+
+// NewApiKeyRevoke returns a new ApiKeyRevoke service.
+func NewApiKeyRevoke(dao ApiKeyRevokeDao) *ApiKeyRevoke {
+	return &ApiKeyRevoke{dao: dao}
+}
+
+func (service *ApiKeyRevoke) Exec(ctx context.Context, request *ApiKeyRevokeRequest) (*ApiKey, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.ApiKeyRevoke")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("api_key.id", request.ID.String()))
+
+	entity, err := service.dao.Exec(ctx, &dao.ApiKeyRevokeRequest{ID: request.ID, Now: time.Now()})
+	if err != nil {
+		if errors.Is(err, dao.ErrApiKeyRevokeNotFound) {
+			err = ErrApiKeyNotFound
+		} else {
+			err = fmt.Errorf("revoke api key: %w", err)
+		}
+
+		recordAudit(ctx, &AuditRecordRequest{
+			Action: AuditActionApiKeyRevoke,
+			Err:    fmt.Errorf("api key %s: %w", request.ID, err),
+		})
+
+		return nil, otel.ReportError(span, err)
+	}
+
+	key := newApiKey(entity)
+
+	recordAudit(ctx, &AuditRecordRequest{Action: AuditActionApiKeyRevoke, Detail: apiKeyAuditDetail(key)})
+
+	return otel.ReportSuccess(span, key), nil
+}
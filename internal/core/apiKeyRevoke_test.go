@@ -0,0 +1,109 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestApiKeyRevoke(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	now := time.Now()
+
+	type daoRevokeMock struct {
+		resp *dao.ApiKey
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.ApiKeyRevokeRequest
+
+		daoRevokeMock *daoRevokeMock
+
+		expect    *core.ApiKey
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.ApiKeyRevokeRequest{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+
+			daoRevokeMock: &daoRevokeMock{
+				resp: &dao.ApiKey{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Name:       "service-authentication",
+					Prefix:     "prefix01",
+					SecretHash: "secret-hash-1",
+					Usages:     []string{"auth"},
+					Operations: []string{"sign"},
+					CreatedAt:  now.Add(-time.Hour),
+					RevokedAt:  lo.ToPtr(now),
+				},
+			},
+
+			expect: &core.ApiKey{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Name:       "service-authentication",
+				Prefix:     "prefix01",
+				Usages:     []string{"auth"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+				CreatedAt:  now.Add(-time.Hour),
+				RevokedAt:  lo.ToPtr(now),
+			},
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &core.ApiKeyRevokeRequest{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+
+			daoRevokeMock: &daoRevokeMock{err: dao.ErrApiKeyRevokeNotFound},
+
+			expectErr: core.ErrApiKeyNotFound,
+		},
+		{
+			name: "Error/Revoke",
+
+			request: &core.ApiKeyRevokeRequest{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+
+			daoRevokeMock: &daoRevokeMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoRevoke := coremocks.NewMockApiKeyRevokeDao(t)
+
+			daoRevoke.EXPECT().
+				Exec(mock.Anything, mock.MatchedBy(func(request *dao.ApiKeyRevokeRequest) bool {
+					return request.ID == testCase.request.ID
+				})).
+				Return(testCase.daoRevokeMock.resp, testCase.daoRevokeMock.err).
+				Once()
+
+			service := core.NewApiKeyRevoke(daoRevoke)
+
+			res, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			daoRevoke.AssertExpectations(t)
+		})
+	}
+}
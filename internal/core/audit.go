@@ -0,0 +1,211 @@
+package core
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// AuditAction names an operation recorded in the audit trail.
+type AuditAction string
+
+const (
+	// AuditActionClaimsSign records the signature of a set of claims. See [ClaimsSign].
+	AuditActionClaimsSign AuditAction = "claims.sign"
+	// AuditActionJwkRotate records the generation of a new key for a usage. See [JwkGen].
+	AuditActionJwkRotate AuditAction = "jwk.rotate"
+	// AuditActionJwkRevoke records the revocation of a key before its expiry. See [JwkRevoke].
+	AuditActionJwkRevoke AuditAction = "jwk.revoke"
+	// AuditActionJwkBurn records the replacement of every key of a usage. See [JwkBurn].
+	AuditActionJwkBurn AuditAction = "jwk.burn"
+	// AuditActionSigningFreeze records the freeze of a usage's signing. See [SigningFreezeSet].
+	AuditActionSigningFreeze AuditAction = "signing.freeze"
+	// AuditActionSigningUnfreeze records the end of a usage's signing freeze. See [SigningFreezeSet].
+	AuditActionSigningUnfreeze AuditAction = "signing.unfreeze"
+	// AuditActionTokenRevoke records the revocation of a single token. See [TokenRevoke].
+	AuditActionTokenRevoke AuditAction = "token.revoke"
+	// AuditActionApiKeyCreate records the issuance of an API key. See [ApiKeyCreate].
+	AuditActionApiKeyCreate AuditAction = "api_key.create"
+	// AuditActionApiKeyRevoke records the revocation of an API key. See [ApiKeyRevoke].
+	AuditActionApiKeyRevoke AuditAction = "api_key.revoke" //nolint:gosec // an action name, not a credential.
+)
+
+// AuditOutcome tells whether an audited operation succeeded.
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+)
+
+// An AuditEvent is an entry of the audit trail. Optional fields are empty when they do not apply
+// to the action.
+type AuditEvent struct {
+	// ID is the event's unique identifier.
+	ID uuid.UUID
+	// OccurredAt is when the operation completed.
+	OccurredAt time.Time
+
+	// Action names the operation.
+	Action AuditAction
+	// Outcome tells whether the operation succeeded.
+	Outcome AuditOutcome
+	// Caller identifies who asked for the operation. See [AuditCallerFromContext].
+	Caller string
+
+	// Usage is the key usage the operation applied to.
+	Usage string
+	// KID is the ID of the key used or produced by the operation.
+	KID string
+	// TokenID is the "jti" claim of the token issued by the operation.
+	TokenID string
+	// TokenHash is the hex-encoded SHA-256 digest of the token issued by the operation. The
+	// token itself is never recorded.
+	TokenHash string
+
+	// Detail holds free-form context, such as the reason of a failure.
+	Detail string
+}
+
+func newAuditEvent(entity *dao.AuditEvent) *AuditEvent {
+	return &AuditEvent{
+		ID:         entity.ID,
+		OccurredAt: entity.OccurredAt,
+		Action:     AuditAction(entity.Action),
+		Outcome:    AuditOutcome(entity.Outcome),
+		Caller:     lo.FromPtr(entity.Caller),
+		Usage:      lo.FromPtr(entity.Usage),
+		KID:        lo.FromPtr(entity.KID),
+		TokenID:    lo.FromPtr(entity.TokenID),
+		TokenHash:  lo.FromPtr(entity.TokenHash),
+		Detail:     lo.FromPtr(entity.Detail),
+	}
+}
+
+// AuditRecorder records audit events. It is implemented by [AuditRecord].
+type AuditRecorder interface {
+	Exec(ctx context.Context, request *AuditRecordRequest) (*AuditEvent, error)
+}
+
+// auditContext is the context key used to store the recorder of audit events.
+type auditContext struct{}
+
+// NewAuditContext returns a copy of ctx that records the audited operations run with it. Without
+// a recorder in their context, operations are not audited.
+func NewAuditContext(ctx context.Context, recorder AuditRecorder) context.Context {
+	return context.WithValue(ctx, auditContext{}, recorder)
+}
+
+// TransferAuditContext copies the recorder held by baseCtx onto a context derived from destCtx.
+// When baseCtx holds no recorder, destCtx is returned unchanged.
+func TransferAuditContext(baseCtx, destCtx context.Context) context.Context {
+	recorder, ok := baseCtx.Value(auditContext{}).(AuditRecorder)
+	if !ok {
+		return destCtx
+	}
+
+	return NewAuditContext(destCtx, recorder)
+}
+
+// auditCallerContext is the context key used to store the identity of the caller of a request.
+type auditCallerContext struct{}
+
+// NewAuditCallerContext returns a copy of ctx that attributes the audited operations to caller,
+// such as the identity of a client certificate, or the name of a command.
+func NewAuditCallerContext(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, auditCallerContext{}, caller)
+}
+
+// AuditCallerFromContext returns the identity audited operations are attributed to. An API key
+// (see [ApiKeyFromContext]) takes precedence, as "api-key:<name>"; otherwise the caller set with
+// [NewAuditCallerContext] is returned. It is empty when the caller is unknown.
+func AuditCallerFromContext(ctx context.Context) string {
+	if key, ok := ApiKeyFromContext(ctx); ok {
+		return "api-key:" + key.Name
+	}
+
+	caller, _ := ctx.Value(auditCallerContext{}).(string)
+
+	return caller
+}
+
+// recordAudit records an event with the recorder of ctx, if any. Auditing is best-effort: a
+// failure is reported on the current span, and never fails the audited operation.
+func recordAudit(ctx context.Context, request *AuditRecordRequest) {
+	recorder, ok := ctx.Value(auditContext{}).(AuditRecorder)
+	if !ok {
+		return
+	}
+
+	_, err := recorder.Exec(ctx, request)
+	if err != nil {
+		trace.SpanFromContext(ctx).AddEvent("audit.failed", trace.WithAttributes(
+			attribute.String("audit.action", string(request.Action)),
+			attribute.String("audit.error", err.Error()),
+		))
+	}
+}
+
+// hashAuditToken returns the digest recorded for a token.
+func hashAuditToken(token string) string {
+	digest := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(digest[:])
+}
+
+// readTokenIDs reads the "kid" header and the "jti" claim of a token, compact or JWS JSON. A
+// multi-signature token reports the key of its first signature, which is the usage's own key.
+// Values that cannot be read are left empty: they only enrich the audit trail and metrics.
+func readTokenIDs(token string) (string, string) {
+	if IsJwsJSON(token) {
+		parsed, err := ParseJwsJSON(token)
+		if err != nil {
+			return "", ""
+		}
+
+		compact := parsed.Compact()
+		if len(compact) == 0 {
+			return "", ""
+		}
+
+		token = compact[0]
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != jwsCompactSegments {
+		return "", ""
+	}
+
+	var header struct {
+		KID string `json:"kid"`
+	}
+
+	var claims struct {
+		Jti string `json:"jti"`
+	}
+
+	decodeTokenPart(parts[0], &header)
+	decodeTokenPart(parts[1], &claims)
+
+	return header.KID, claims.Jti
+}
+
+func decodeTokenPart(part string, output any) {
+	decoded, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return
+	}
+
+	_ = json.Unmarshal(decoded, output)
+}
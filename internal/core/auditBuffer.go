@@ -0,0 +1,127 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DefaultAuditBufferSize is the number of events an [AuditBuffer] queues before the operations
+// recording them wait for room.
+const DefaultAuditBufferSize = 4096
+
+// ErrAuditBufferClosed is returned when an event is recorded after its [AuditBuffer] was closed.
+var ErrAuditBufferClosed = errors.New("audit buffer closed")
+
+// auditBufferedActions lists the actions an [AuditBuffer] stores in the background. Other actions
+// are rare, and may run in a transaction their event must commit with: they are stored right away.
+var auditBufferedActions = []AuditAction{AuditActionClaimsSign}
+
+// auditBufferItem is a queued event. The request it was recorded by may be over when the event
+// is stored: only the caller and the trace of the request are kept.
+type auditBufferItem struct {
+	caller  string
+	span    trace.SpanContext
+	request *AuditRecordRequest
+}
+
+// An AuditBuffer is an [AuditRecorder] that keeps the audit trail off the signing path. Events of
+// signing operations are queued, and stored by [AuditBuffer.Run] in the background; other events
+// go to the underlying recorder directly.
+//
+// Recording stays reliable: when the queue is full, the operation waits for room rather than drop
+// its event, and [AuditBuffer.Close] stores the queued events before returning.
+type AuditBuffer struct {
+	recorder AuditRecorder
+	queue    chan auditBufferItem
+
+	closeOnce sync.Once
+	closed    chan struct{}
+	done      chan struct{}
+}
+
+// NewAuditBuffer returns a new AuditBuffer, that queues up to size events before recorder stores
+// them.
+func NewAuditBuffer(recorder AuditRecorder, size int) *AuditBuffer {
+	return &AuditBuffer{
+		recorder: recorder,
+		queue:    make(chan auditBufferItem, size),
+		closed:   make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Exec records the event. Events of signing operations are only queued: it then returns no event,
+// and storage errors are reported on the trace of the operation by [AuditBuffer.Run].
+func (buffer *AuditBuffer) Exec(ctx context.Context, request *AuditRecordRequest) (*AuditEvent, error) {
+	if !slices.Contains(auditBufferedActions, request.Action) {
+		return buffer.recorder.Exec(ctx, request)
+	}
+
+	// The event is timed now, not when it is stored.
+	queued := *request
+	if queued.OccurredAt.IsZero() {
+		queued.OccurredAt = time.Now()
+	}
+
+	item := auditBufferItem{
+		caller:  AuditCallerFromContext(ctx),
+		span:    trace.SpanContextFromContext(ctx),
+		request: &queued,
+	}
+
+	select {
+	case <-buffer.closed:
+		return nil, ErrAuditBufferClosed
+	default:
+	}
+
+	select {
+	case buffer.queue <- item:
+		return nil, nil
+	case <-buffer.closed:
+		return nil, ErrAuditBufferClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Run stores the queued events, one at a time, until [AuditBuffer.Close] is called. It then stores
+// the events still queued, and returns. Events are stored with ctx, which must give access to the
+// database.
+func (buffer *AuditBuffer) Run(ctx context.Context) {
+	defer close(buffer.done)
+
+	for {
+		select {
+		case item := <-buffer.queue:
+			buffer.store(ctx, item)
+		case <-buffer.closed:
+			for {
+				select {
+				case item := <-buffer.queue:
+					buffer.store(ctx, item)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// Close stops accepting events, and waits for [AuditBuffer.Run] to store the queued ones. Run must
+// have been started.
+func (buffer *AuditBuffer) Close() {
+	buffer.closeOnce.Do(func() { close(buffer.closed) })
+	<-buffer.done
+}
+
+func (buffer *AuditBuffer) store(ctx context.Context, item auditBufferItem) {
+	ctx = trace.ContextWithSpanContext(NewAuditCallerContext(ctx, item.caller), item.span)
+
+	recordAudit(NewAuditContext(ctx, buffer.recorder), item.request)
+}
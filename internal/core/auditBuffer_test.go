@@ -0,0 +1,115 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+)
+
+func TestAuditBuffer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		request *core.AuditRecordRequest
+
+		expectEvent  bool
+		expectQueued bool
+	}{
+		{
+			name: "Success/Queued",
+
+			request: &core.AuditRecordRequest{Action: core.AuditActionClaimsSign, Usage: "test-usage"},
+
+			expectQueued: true,
+		},
+		{
+			name: "Success/Direct",
+
+			request: &core.AuditRecordRequest{Action: core.AuditActionJwkBurn, Usage: "test-usage"},
+
+			expectEvent: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := coremocks.NewMockAuditRecorder(t)
+
+			stored := make(chan *core.AuditRecordRequest, 1)
+
+			recorder.EXPECT().
+				Exec(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, request *core.AuditRecordRequest) (*core.AuditEvent, error) {
+					// The caller of the request is still known when the event is stored.
+					require.Equal(t, "test-caller", core.AuditCallerFromContext(ctx))
+
+					stored <- request
+
+					return &core.AuditEvent{Action: request.Action}, nil
+				})
+
+			buffer := core.NewAuditBuffer(recorder, 1)
+
+			callerCtx := core.NewAuditCallerContext(t.Context(), "test-caller")
+			ctx, cancel := context.WithCancel(callerCtx)
+
+			event, err := buffer.Exec(ctx, testCase.request)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectEvent, event != nil)
+
+			// A queued event outlives the request it was recorded by.
+			cancel()
+
+			if testCase.expectQueued {
+				require.Empty(t, stored)
+			}
+
+			go buffer.Run(t.Context())
+
+			buffer.Close()
+
+			request := <-stored
+			require.Equal(t, testCase.request.Action, request.Action)
+
+			if testCase.expectQueued {
+				require.WithinDuration(t, time.Now(), request.OccurredAt, time.Minute)
+			}
+
+			_, err = buffer.Exec(callerCtx, testCase.request)
+			if testCase.expectQueued {
+				require.ErrorIs(t, err, core.ErrAuditBufferClosed)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAuditBufferFull(t *testing.T) {
+	t.Parallel()
+
+	recorder := coremocks.NewMockAuditRecorder(t)
+	buffer := core.NewAuditBuffer(recorder, 1)
+
+	request := &core.AuditRecordRequest{Action: core.AuditActionClaimsSign}
+
+	_, err := buffer.Exec(t.Context(), request)
+	require.NoError(t, err)
+
+	// Without room, the operation waits: here, until its context is done.
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = buffer.Exec(ctx, request)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,80 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// ErrAuditEventSearchInvalidRange is returned when the end of a searched time range is not after
+// its start.
+var ErrAuditEventSearchInvalidRange = errors.New("invalid time range")
+
+// AuditEventSearchDao is the DAO dependency of [AuditEventSearch].
+type AuditEventSearchDao interface {
+	Exec(ctx context.Context, request *dao.AuditEventSearchRequest) ([]*dao.AuditEvent, error)
+}
+
+// AuditEventSearchRequest holds the parameters for an [AuditEventSearch.Exec] call.
+type AuditEventSearchRequest struct {
+	// Usage only returns the events of this key usage. All events are returned when empty.
+	Usage string
+	// From is the start of the time range, inclusive.
+	From time.Time
+	// To is the end of the time range, exclusive. It defaults to now.
+	To time.Time
+	// Limit is the maximum number of events to return, up to [dao.AuditEventsMaxBatchSize].
+	Limit int
+}
+
+// An AuditEventSearch lists the events of the audit trail within a time range, newest first.
+type AuditEventSearch struct {
+	dao AuditEventSearchDao
+}
+
+// NewAuditEventSearch returns a new AuditEventSearch service.
+func NewAuditEventSearch(dao AuditEventSearchDao) *AuditEventSearch {
+	return &AuditEventSearch{dao: dao}
+}
+
+func (service *AuditEventSearch) Exec(ctx context.Context, request *AuditEventSearchRequest) ([]*AuditEvent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.AuditEventSearch")
+	defer span.End()
+
+	to := request.To
+	if to.IsZero() {
+		to = time.Now()
+	}
+
+	span.SetAttributes(
+		attribute.String("audit.usage", request.Usage),
+		attribute.Int64("audit.from", request.From.Unix()),
+		attribute.Int64("audit.to", to.Unix()),
+	)
+
+	if !to.After(request.From) {
+		return nil, otel.ReportError(span, ErrAuditEventSearchInvalidRange)
+	}
+
+	entities, err := service.dao.Exec(ctx, &dao.AuditEventSearchRequest{
+		Usage: request.Usage,
+		From:  request.From,
+		To:    to,
+		Limit: request.Limit,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("search audit events: %w", err))
+	}
+
+	return otel.ReportSuccess(span, lo.Map(entities, func(item *dao.AuditEvent, _ int) *AuditEvent {
+		return newAuditEvent(item)
+	})), nil
+}
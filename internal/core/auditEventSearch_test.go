@@ -0,0 +1,142 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestAuditEventSearch(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	now := time.Now()
+
+	type daoSearchMock struct {
+		resp []*dao.AuditEvent
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.AuditEventSearchRequest
+
+		daoSearchMock *daoSearchMock
+
+		expect    []*core.AuditEvent
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.AuditEventSearchRequest{
+				Usage: "auth",
+				From:  now.Add(-time.Hour),
+				To:    now,
+				Limit: 10,
+			},
+
+			daoSearchMock: &daoSearchMock{
+				resp: []*dao.AuditEvent{
+					{
+						ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						OccurredAt: now.Add(-time.Minute),
+						Action:     "claims.sign",
+						Outcome:    "success",
+						Caller:     lo.ToPtr("api-key:service-authentication"),
+						Usage:      lo.ToPtr("auth"),
+						KID:        lo.ToPtr("kid-1"),
+						TokenID:    lo.ToPtr("jti-1"),
+						TokenHash:  lo.ToPtr("hash-1"),
+					},
+				},
+			},
+
+			expect: []*core.AuditEvent{
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					OccurredAt: now.Add(-time.Minute),
+					Action:     core.AuditActionClaimsSign,
+					Outcome:    core.AuditOutcomeSuccess,
+					Caller:     "api-key:service-authentication",
+					Usage:      "auth",
+					KID:        "kid-1",
+					TokenID:    "jti-1",
+					TokenHash:  "hash-1",
+				},
+			},
+		},
+		{
+			name: "Success/OpenRange",
+
+			request: &core.AuditEventSearchRequest{
+				From: now.Add(-time.Hour),
+			},
+
+			daoSearchMock: &daoSearchMock{},
+
+			expect: []*core.AuditEvent{},
+		},
+		{
+			name: "Error/InvalidRange",
+
+			request: &core.AuditEventSearchRequest{
+				From: now,
+				To:   now.Add(-time.Hour),
+			},
+
+			expectErr: core.ErrAuditEventSearchInvalidRange,
+		},
+		{
+			name: "Error/Search",
+
+			request: &core.AuditEventSearchRequest{
+				From: now.Add(-time.Hour),
+				To:   now,
+			},
+
+			daoSearchMock: &daoSearchMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoSearch := coremocks.NewMockAuditEventSearchDao(t)
+
+			if testCase.daoSearchMock != nil {
+				daoSearch.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(request *dao.AuditEventSearchRequest) bool {
+						return request.Usage == testCase.request.Usage &&
+							request.From.Equal(testCase.request.From) &&
+							request.To.After(request.From) &&
+							(testCase.request.To.IsZero() || request.To.Equal(testCase.request.To)) &&
+							request.Limit == testCase.request.Limit
+					})).
+					Return(testCase.daoSearchMock.resp, testCase.daoSearchMock.err).
+					Once()
+			}
+
+			service := core.NewAuditEventSearch(daoSearch)
+
+			res, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			daoSearch.AssertExpectations(t)
+		})
+	}
+}
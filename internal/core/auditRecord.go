@@ -0,0 +1,126 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// AuditRecordDao is the DAO dependency of [AuditRecord].
+type AuditRecordDao interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+// AuditRecordRequest holds the parameters for an [AuditRecord.Exec] call.
+type AuditRecordRequest struct {
+	// Action names the audited operation.
+	Action AuditAction
+	// Usage is the key usage the operation applied to, if any.
+	Usage string
+	// KID is the ID of the key used or produced by the operation, if any. For signing
+	// operations, it is read from Token when empty.
+	KID string
+	// Token is the token issued by the operation, if any. Only its "jti" claim and its digest
+	// are recorded.
+	Token string
+	// TokenID is the "jti" claim of the token the operation applied to, if any. It is read from
+	// Token when empty.
+	TokenID string
+	// Err is the error the operation failed with; nil when it succeeded. Its message is recorded
+	// as the event detail.
+	Err error
+	// Detail holds free-form context about a successful operation.
+	Detail string
+	// OccurredAt is when the operation completed. It defaults to now.
+	OccurredAt time.Time
+}
+
+// An AuditRecord appends an event to the audit trail. The caller is read from the context, see
+// [AuditCallerFromContext].
+//
+// Events are stored in the database. When a logger is set, they are also written to it, as
+// structured fields, before being stored: log-based pipelines see them even when the database
+// does not.
+type AuditRecord struct {
+	dao    AuditRecordDao
+	logger logging.Log
+}
+
+// NewAuditRecord returns a new AuditRecord service. The logger is optional.
+func NewAuditRecord(dao AuditRecordDao, logger logging.Log) *AuditRecord {
+	return &AuditRecord{dao: dao, logger: logger}
+}
+
+func (service *AuditRecord) Exec(ctx context.Context, request *AuditRecordRequest) (*AuditEvent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.AuditRecord")
+	defer span.End()
+
+	insert := &dao.AuditEventInsertRequest{
+		ID:      uuid.New(),
+		Now:     lo.CoalesceOrEmpty(request.OccurredAt, time.Now()),
+		Action:  string(request.Action),
+		Outcome: string(AuditOutcomeSuccess),
+		Caller:  lo.EmptyableToPtr(AuditCallerFromContext(ctx)),
+		Usage:   lo.EmptyableToPtr(request.Usage),
+		KID:     lo.EmptyableToPtr(request.KID),
+		TokenID: lo.EmptyableToPtr(request.TokenID),
+		Detail:  lo.EmptyableToPtr(request.Detail),
+	}
+
+	if request.Err != nil {
+		insert.Outcome = string(AuditOutcomeFailure)
+		insert.Detail = lo.ToPtr(request.Err.Error())
+	}
+
+	if request.Token != "" {
+		kid, jti := readTokenIDs(request.Token)
+
+		insert.TokenHash = lo.ToPtr(hashAuditToken(request.Token))
+
+		if insert.TokenID == nil {
+			insert.TokenID = lo.EmptyableToPtr(jti)
+		}
+
+		if insert.KID == nil {
+			insert.KID = lo.EmptyableToPtr(kid)
+		}
+	}
+
+	span.SetAttributes(
+		attribute.String("audit.id", insert.ID.String()),
+		attribute.String("audit.action", insert.Action),
+		attribute.String("audit.outcome", insert.Outcome),
+	)
+
+	if service.logger != nil {
+		service.logger.Info(
+			ctx, "audit event",
+			slog.String("audit.id", insert.ID.String()),
+			slog.String("audit.action", insert.Action),
+			slog.String("audit.outcome", insert.Outcome),
+			slog.String("audit.caller", lo.FromPtr(insert.Caller)),
+			slog.String("audit.usage", lo.FromPtr(insert.Usage)),
+			slog.String("audit.kid", lo.FromPtr(insert.KID)),
+			slog.String("audit.token_id", lo.FromPtr(insert.TokenID)),
+			slog.String("audit.token_hash", lo.FromPtr(insert.TokenHash)),
+			slog.String("audit.detail", lo.FromPtr(insert.Detail)),
+		)
+	}
+
+	entity, err := service.dao.Exec(ctx, insert)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("insert audit event: %w", err))
+	}
+
+	return otel.ReportSuccess(span, newAuditEvent(entity)), nil
+}
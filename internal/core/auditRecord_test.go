@@ -0,0 +1,302 @@
+package core_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestAuditRecord(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	now := time.Now()
+
+	token := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"EdDSA","kid":"kid-1"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(`{"jti":"jti-1","foo":"bar"}`)) + ".c2lnbmF0dXJl"
+	tokenDigest := sha256.Sum256([]byte(token))
+	tokenHash := hex.EncodeToString(tokenDigest[:])
+
+	multiSignatureToken, err := core.NewJwsJSON(token)
+	require.NoError(t, err)
+
+	multiSignatureTokenSerialized, err := multiSignatureToken.Serialize()
+	require.NoError(t, err)
+
+	multiSignatureDigest := sha256.Sum256([]byte(multiSignatureTokenSerialized))
+	multiSignatureHash := hex.EncodeToString(multiSignatureDigest[:])
+
+	type daoInsertMock struct {
+		resp *dao.AuditEvent
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		ctx     func(ctx context.Context) context.Context
+		request *core.AuditRecordRequest
+
+		// expectInsert is compared to the DAO request, ignoring its ID and time.
+		expectInsert  *dao.AuditEventInsertRequest
+		daoInsertMock *daoInsertMock
+
+		expect    *core.AuditEvent
+		expectErr error
+	}{
+		{
+			name: "Success/Token",
+
+			ctx: func(ctx context.Context) context.Context {
+				return core.NewAuditCallerContext(ctx, "spiffe://example.org/auth")
+			},
+			request: &core.AuditRecordRequest{
+				Action: core.AuditActionClaimsSign,
+				Usage:  "auth",
+				Token:  token,
+			},
+
+			expectInsert: &dao.AuditEventInsertRequest{
+				Action:    "claims.sign",
+				Outcome:   "success",
+				Caller:    lo.ToPtr("spiffe://example.org/auth"),
+				Usage:     lo.ToPtr("auth"),
+				KID:       lo.ToPtr("kid-1"),
+				TokenID:   lo.ToPtr("jti-1"),
+				TokenHash: lo.ToPtr(tokenHash),
+			},
+			daoInsertMock: &daoInsertMock{
+				resp: &dao.AuditEvent{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					OccurredAt: now,
+					Action:     "claims.sign",
+					Outcome:    "success",
+					Caller:     lo.ToPtr("spiffe://example.org/auth"),
+					Usage:      lo.ToPtr("auth"),
+					KID:        lo.ToPtr("kid-1"),
+					TokenID:    lo.ToPtr("jti-1"),
+					TokenHash:  lo.ToPtr(tokenHash),
+				},
+			},
+
+			expect: &core.AuditEvent{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				OccurredAt: now,
+				Action:     core.AuditActionClaimsSign,
+				Outcome:    core.AuditOutcomeSuccess,
+				Caller:     "spiffe://example.org/auth",
+				Usage:      "auth",
+				KID:        "kid-1",
+				TokenID:    "jti-1",
+				TokenHash:  tokenHash,
+			},
+		},
+		{
+			name: "Success/MultiSignatureToken",
+
+			request: &core.AuditRecordRequest{
+				Action: core.AuditActionClaimsSign,
+				Usage:  "auth",
+				Token:  multiSignatureTokenSerialized,
+			},
+
+			expectInsert: &dao.AuditEventInsertRequest{
+				Action:    "claims.sign",
+				Outcome:   "success",
+				Usage:     lo.ToPtr("auth"),
+				KID:       lo.ToPtr("kid-1"),
+				TokenID:   lo.ToPtr("jti-1"),
+				TokenHash: lo.ToPtr(multiSignatureHash),
+			},
+			daoInsertMock: &daoInsertMock{
+				resp: &dao.AuditEvent{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					OccurredAt: now,
+					Action:     "claims.sign",
+					Outcome:    "success",
+				},
+			},
+
+			expect: &core.AuditEvent{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				OccurredAt: now,
+				Action:     core.AuditActionClaimsSign,
+				Outcome:    core.AuditOutcomeSuccess,
+			},
+		},
+		{
+			name: "Success/TokenID",
+
+			request: &core.AuditRecordRequest{
+				Action:  core.AuditActionTokenRevoke,
+				Usage:   "auth",
+				TokenID: "jti-2",
+			},
+
+			expectInsert: &dao.AuditEventInsertRequest{
+				Action:  "token.revoke",
+				Outcome: "success",
+				Usage:   lo.ToPtr("auth"),
+				TokenID: lo.ToPtr("jti-2"),
+			},
+			daoInsertMock: &daoInsertMock{
+				resp: &dao.AuditEvent{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					OccurredAt: now,
+					Action:     "token.revoke",
+					Outcome:    "success",
+					Usage:      lo.ToPtr("auth"),
+					TokenID:    lo.ToPtr("jti-2"),
+				},
+			},
+
+			expect: &core.AuditEvent{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				OccurredAt: now,
+				Action:     core.AuditActionTokenRevoke,
+				Outcome:    core.AuditOutcomeSuccess,
+				Usage:      "auth",
+				TokenID:    "jti-2",
+			},
+		},
+		{
+			name: "Success/ApiKeyCaller",
+
+			ctx: func(ctx context.Context) context.Context {
+				ctx = core.NewAuditCallerContext(ctx, "spiffe://example.org/auth")
+
+				return core.NewApiKeyContext(ctx, &core.ApiKey{Name: "service-authentication"})
+			},
+			request: &core.AuditRecordRequest{
+				Action: core.AuditActionJwkRotate,
+				Usage:  "auth",
+				KID:    "kid-2",
+			},
+
+			expectInsert: &dao.AuditEventInsertRequest{
+				Action:  "jwk.rotate",
+				Outcome: "success",
+				Caller:  lo.ToPtr("api-key:service-authentication"),
+				Usage:   lo.ToPtr("auth"),
+				KID:     lo.ToPtr("kid-2"),
+			},
+			daoInsertMock: &daoInsertMock{
+				resp: &dao.AuditEvent{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					OccurredAt: now,
+					Action:     "jwk.rotate",
+					Outcome:    "success",
+				},
+			},
+
+			expect: &core.AuditEvent{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				OccurredAt: now,
+				Action:     core.AuditActionJwkRotate,
+				Outcome:    core.AuditOutcomeSuccess,
+			},
+		},
+		{
+			name: "Success/Failure",
+
+			request: &core.AuditRecordRequest{
+				Action: core.AuditActionClaimsSign,
+				Usage:  "auth",
+				Err:    errFoo,
+			},
+
+			expectInsert: &dao.AuditEventInsertRequest{
+				Action:  "claims.sign",
+				Outcome: "failure",
+				Usage:   lo.ToPtr("auth"),
+				Detail:  lo.ToPtr("foo"),
+			},
+			daoInsertMock: &daoInsertMock{
+				resp: &dao.AuditEvent{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					OccurredAt: now,
+					Action:     "claims.sign",
+					Outcome:    "failure",
+					Usage:      lo.ToPtr("auth"),
+					Detail:     lo.ToPtr("foo"),
+				},
+			},
+
+			expect: &core.AuditEvent{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				OccurredAt: now,
+				Action:     core.AuditActionClaimsSign,
+				Outcome:    core.AuditOutcomeFailure,
+				Usage:      "auth",
+				Detail:     "foo",
+			},
+		},
+		{
+			name: "Error/Insert",
+
+			request: &core.AuditRecordRequest{
+				Action: core.AuditActionApiKeyCreate,
+				Detail: "api key",
+			},
+
+			expectInsert: &dao.AuditEventInsertRequest{
+				Action:  "api_key.create",
+				Outcome: "success",
+				Detail:  lo.ToPtr("api key"),
+			},
+			daoInsertMock: &daoInsertMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			if testCase.ctx != nil {
+				ctx = testCase.ctx(ctx)
+			}
+
+			daoInsert := coremocks.NewMockAuditRecordDao(t)
+
+			daoInsert.EXPECT().
+				Exec(mock.Anything, mock.MatchedBy(func(request *dao.AuditEventInsertRequest) bool {
+					if request.ID == uuid.Nil || request.Now.IsZero() {
+						return false
+					}
+
+					expect := *testCase.expectInsert
+					expect.ID = request.ID
+					expect.Now = request.Now
+
+					return assert.ObjectsAreEqual(expect, *request)
+				})).
+				Return(testCase.daoInsertMock.resp, testCase.daoInsertMock.err).
+				Once()
+
+			service := core.NewAuditRecord(daoInsert, nil)
+
+			res, err := service.Exec(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			daoInsert.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestAuditCallerFromContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	require.Empty(t, core.AuditCallerFromContext(ctx))
+
+	ctx = core.NewAuditCallerContext(ctx, "job:rotate-keys")
+	require.Equal(t, "job:rotate-keys", core.AuditCallerFromContext(ctx))
+
+	// An API key takes precedence over any other identity.
+	ctx = core.NewApiKeyContext(ctx, &core.ApiKey{Name: "service-authentication"})
+	require.Equal(t, "api-key:service-authentication", core.AuditCallerFromContext(ctx))
+}
+
+func TestAuditBestEffort(t *testing.T) {
+	t.Parallel()
+
+	recorder := coremocks.NewMockAuditRecorder(t)
+
+	recorder.EXPECT().
+		Exec(mock.Anything, mock.MatchedBy(func(request *core.AuditRecordRequest) bool {
+			return request.Action == core.AuditActionApiKeyRevoke && request.Err == nil
+		})).
+		Return(nil, errors.New("foo")).
+		Once()
+
+	daoRevoke := coremocks.NewMockApiKeyRevokeDao(t)
+
+	daoRevoke.EXPECT().
+		Exec(mock.Anything, mock.Anything).
+		Return(&dao.ApiKey{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")}, nil).
+		Once()
+
+	ctx := core.NewAuditContext(t.Context(), recorder)
+
+	// A failure to record the event does not fail the audited operation.
+	_, err := core.NewApiKeyRevoke(daoRevoke).Exec(ctx, &core.ApiKeyRevokeRequest{
+		ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	})
+	require.NoError(t, err)
+
+	recorder.AssertExpectations(t)
+	daoRevoke.AssertExpectations(t)
+}
+
+func TestTransferAuditContext(t *testing.T) {
+	t.Parallel()
+
+	recorder := coremocks.NewMockAuditRecorder(t)
+
+	recorder.EXPECT().
+		Exec(mock.Anything, mock.Anything).
+		Return(&core.AuditEvent{}, nil).
+		Once()
+
+	base := core.NewAuditContext(t.Context(), recorder)
+	ctx := core.TransferAuditContext(base, t.Context())
+
+	daoRevoke := coremocks.NewMockApiKeyRevokeDao(t)
+
+	daoRevoke.EXPECT().
+		Exec(mock.Anything, mock.Anything).
+		Return(nil, dao.ErrApiKeyRevokeNotFound).
+		Once()
+
+	_, err := core.NewApiKeyRevoke(daoRevoke).Exec(ctx, &core.ApiKeyRevokeRequest{
+		ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	})
+	require.ErrorIs(t, err, core.ErrApiKeyNotFound)
+
+	recorder.AssertExpectations(t)
+}
@@ -0,0 +1,437 @@
+package core
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/jwt/v2"
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+)
+
+// TokenCheck names a check of the verification of a token. Checks are listed in the order they
+// run.
+type TokenCheck string
+
+const (
+	// TokenCheckFormat fails for tokens that are not a compact JWS or a JWS JSON serialization,
+	// or whose header or payload is not JSON.
+	TokenCheckFormat TokenCheck = "format"
+	// TokenCheckAlgorithm fails when the header algorithm is not accepted by the usage or any of
+	// its co-signers, or falls outside their policy.
+	TokenCheckAlgorithm TokenCheck = "algorithm"
+	// TokenCheckKey fails when the header key ID names no active key of the signers accepting the
+	// algorithm: the key expired, was revoked, or belongs to another usage.
+	TokenCheckKey TokenCheck = "key"
+	// TokenCheckSignature fails when the signature does not verify.
+	TokenCheckSignature TokenCheck = "signature"
+	// TokenCheckAudience fails when the "aud" claim does not contain the usage's audience.
+	TokenCheckAudience TokenCheck = "audience"
+	// TokenCheckIssuer fails when the "iss" claim is not the usage's issuer.
+	TokenCheckIssuer TokenCheck = "issuer"
+	// TokenCheckSubject fails when the "sub" claim is not the usage's subject.
+	TokenCheckSubject TokenCheck = "subject"
+	// TokenCheckExpiration fails when the "exp" claim is missing or past.
+	TokenCheckExpiration TokenCheck = "expiration"
+	// TokenCheckNotBefore fails when the "nbf" claim is in the future.
+	TokenCheckNotBefore TokenCheck = "not_before"
+	// TokenCheckRevoked fails when the token was revoked. See [TokenRevoke].
+	TokenCheckRevoked TokenCheck = "revoked"
+)
+
+// tokenChecks lists every [TokenCheck], in order.
+var tokenChecks = []TokenCheck{
+	TokenCheckFormat,
+	TokenCheckAlgorithm,
+	TokenCheckKey,
+	TokenCheckSignature,
+	TokenCheckAudience,
+	TokenCheckIssuer,
+	TokenCheckSubject,
+	TokenCheckExpiration,
+	TokenCheckNotBefore,
+	TokenCheckRevoked,
+}
+
+// TokenCheckFailure reports a failed check.
+type TokenCheckFailure struct {
+	// Check is the check that failed.
+	Check TokenCheck
+	// Message explains the failure.
+	Message string
+}
+
+// ClaimsInspectSource is the key source dependency of [ClaimsInspect], to tell unknown keys apart
+// from invalid signatures. See [JwkPublicSource].
+type ClaimsInspectSource interface {
+	SearchKeys(ctx context.Context, usage string) ([]*jwa.JWK, error)
+}
+
+// ClaimsInspectServiceRevoked is the denylist dependency of [ClaimsInspect]. See
+// [RevokedTokenCheck].
+type ClaimsInspectServiceRevoked interface {
+	Exec(ctx context.Context, request *RevokedTokenCheckRequest) error
+}
+
+// ClaimsInspectRequest holds the parameters for a [ClaimsInspect.Exec] call. See
+// [ClaimsVerifyRequest].
+type ClaimsInspectRequest struct {
+	// Token is the token to inspect, compact or in JWS JSON serialization.
+	Token string
+	// Usage is the key usage the token is expected to be signed under.
+	Usage string
+	// IgnoreExpired skips the time checks, as [ClaimsVerifyRequest.IgnoreExpired] does.
+	IgnoreExpired bool
+}
+
+// ClaimsInspectResult reports the outcome of a [ClaimsInspect.Exec] call.
+type ClaimsInspectResult struct {
+	// Header is the header of the signature that verified, or of the first one when none did.
+	// Nil when the token could not be parsed.
+	Header *jwa.JWH
+	// Claims is the payload of the token, decoded. It is only trusted when Signer is set.
+	Claims json.RawMessage
+	// Signer is the usage, or co-signer, whose key verified the token. Empty when none did.
+	Signer string
+	// Failures lists the checks that failed, in the order they run. Empty for a valid token.
+	Failures []*TokenCheckFailure
+}
+
+// Valid reports whether the token passed every check.
+func (result *ClaimsInspectResult) Valid() bool {
+	return len(result.Failures) == 0
+}
+
+// A ClaimsInspect verifies a token like [ClaimsVerify], but reports which checks failed instead of
+// stopping at the first failure, for troubleshooting. The claims are checked even when the
+// signature fails, so a token can fail several checks at once.
+//
+// Multi-signature tokens pass the signature checks once the usage's own signature verifies, as
+// under [SignaturePolicyAny]; when none does, the signature that went furthest is reported.
+type ClaimsInspect struct {
+	source         ClaimsInspectSource
+	recipients     map[string][]jwt.RecipientPlugin
+	serviceRevoked ClaimsInspectServiceRevoked
+	keysConfig     map[string]*config.Jwk
+}
+
+// NewClaimsInspect creates a ClaimsInspect service. Recipients provide the per-usage verification
+// plugins (see [NewJwkRecipients]), reading their keys from source; serviceRevoked tells the
+// revoked tokens apart, and is nil when tokens cannot be revoked.
+func NewClaimsInspect(
+	source ClaimsInspectSource,
+	recipients map[string][]jwt.RecipientPlugin,
+	serviceRevoked ClaimsInspectServiceRevoked,
+	keysConfig map[string]*config.Jwk,
+) *ClaimsInspect {
+	return &ClaimsInspect{
+		source:         source,
+		recipients:     recipients,
+		serviceRevoked: serviceRevoked,
+		keysConfig:     keysConfig,
+	}
+}
+
+func (service *ClaimsInspect) Exec(ctx context.Context, request *ClaimsInspectRequest) (*ClaimsInspectResult, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.ClaimsInspect")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("key.usage", request.Usage))
+
+	keyConfig, ok := service.keysConfig[request.Usage]
+	if !ok {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: %s", ErrConfigNotFound, request.Usage))
+	}
+
+	// A compact token is its own single signature.
+	signatures := []string{request.Token}
+
+	if IsJwsJSON(request.Token) {
+		token, err := ParseJwsJSON(request.Token)
+		if err != nil {
+			return otel.ReportSuccess(span, &ClaimsInspectResult{
+				Failures: []*TokenCheckFailure{{Check: TokenCheckFormat, Message: err.Error()}},
+			}), nil
+		}
+
+		signatures = token.Compact()
+	}
+
+	// Only the usage's own signature vouches for its tokens: co-signers sign alongside it, never
+	// in its place.
+	signers := []string{request.Usage}
+
+	var (
+		output  *ClaimsInspectResult
+		failure *TokenCheckFailure
+	)
+
+	for _, signature := range signatures {
+		result, signatureFailure, err := service.inspectSignature(ctx, signature, signers)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+
+		// Keep the signature that went furthest.
+		if output == nil || signatureFailure == nil ||
+			slices.Index(tokenChecks, signatureFailure.Check) > slices.Index(tokenChecks, failure.Check) {
+			output, failure = result, signatureFailure
+		}
+
+		if failure == nil {
+			break
+		}
+	}
+
+	if failure != nil {
+		output.Failures = append(output.Failures, failure)
+	}
+
+	// A token that does not parse has no claims to check.
+	if output.Header != nil {
+		claimsFailures, err := checkClaims(output.Claims, keyConfig, request.IgnoreExpired)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+
+		output.Failures = append(output.Failures, claimsFailures...)
+
+		revokedFailure, err := service.checkRevoked(ctx, request.Usage, output.Claims)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+
+		if revokedFailure != nil {
+			output.Failures = append(output.Failures, revokedFailure)
+		}
+	}
+
+	span.SetAttributes(
+		attribute.String("token.signer", output.Signer),
+		attribute.Int("token.failures", len(output.Failures)),
+	)
+
+	return otel.ReportSuccess(span, output), nil
+}
+
+// inspectSignature runs the signature checks of a compact signature, and decodes its header and
+// payload. It returns the first check that failed, if any.
+func (service *ClaimsInspect) inspectSignature(
+	ctx context.Context, signature string, signers []string,
+) (*ClaimsInspectResult, *TokenCheckFailure, error) {
+	output := new(ClaimsInspectResult)
+
+	header, claims, err := decodeSignature(signature)
+	if err != nil {
+		// A malformed token is a failed check, not an error.
+		return output, &TokenCheckFailure{Check: TokenCheckFormat, Message: err.Error()}, nil //nolint:nilerr
+	}
+
+	output.Header, output.Claims = header, claims
+
+	// Payload signatures share the keys of their usage, but are no tokens.
+	err = checkTokenType(header)
+	if err != nil {
+		return output, &TokenCheckFailure{Check: TokenCheckFormat, Message: err.Error()}, nil //nolint:nilerr
+	}
+
+	// Only the signers whose configuration accepts the algorithm may have signed.
+	signers = slices.DeleteFunc(slices.Clone(signers), func(signer string) bool {
+		keyConfig, ok := service.keysConfig[signer]
+
+		return !ok || !slices.Contains(keyConfig.Algs(), header.Alg) || keyConfig.Policy.CheckAlg(header.Alg) != nil
+	})
+	if len(signers) == 0 {
+		return output, &TokenCheckFailure{
+			Check:   TokenCheckAlgorithm,
+			Message: fmt.Sprintf("algorithm %q is not accepted", header.Alg),
+		}, nil
+	}
+
+	signers, err = service.keySigners(ctx, header.KID, signers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(signers) == 0 {
+		return output, &TokenCheckFailure{
+			Check:   TokenCheckKey,
+			Message: fmt.Sprintf("key %q is not an active key of the usage", header.KID),
+		}, nil
+	}
+
+	var lastErr error
+
+	for _, signer := range signers {
+		recipient := jwt.NewRecipient(jwt.RecipientConfig{Plugins: service.recipients[signer]})
+
+		var verified json.RawMessage
+
+		lastErr = recipient.Consume(ctx, signature, &verified)
+		if lastErr == nil {
+			output.Signer = signer
+
+			return output, nil, nil
+		}
+	}
+
+	return output, &TokenCheckFailure{Check: TokenCheckSignature, Message: lastErr.Error()}, nil
+}
+
+// checkRevoked fails tokens whose "jti" claim was revoked under the usage.
+func (service *ClaimsInspect) checkRevoked(
+	ctx context.Context, usage string, raw json.RawMessage,
+) (*TokenCheckFailure, error) {
+	if service.serviceRevoked == nil {
+		return nil, nil
+	}
+
+	var claims struct {
+		Jti string `json:"jti"`
+	}
+
+	// A payload that is not a claims set already failed the format check, and has no ID to check.
+	_ = json.Unmarshal(raw, &claims)
+
+	err := service.serviceRevoked.Exec(ctx, &RevokedTokenCheckRequest{Usage: usage, TokenID: claims.Jti})
+	if errors.Is(err, ErrTokenRevoked) {
+		return &TokenCheckFailure{Check: TokenCheckRevoked, Message: fmt.Sprintf("token %q is revoked", claims.Jti)}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("check revocation: %w", err)
+	}
+
+	return nil, nil
+}
+
+// keySigners returns the signers with an active key named kid. Any key may verify a token that
+// names none.
+func (service *ClaimsInspect) keySigners(ctx context.Context, kid string, signers []string) ([]string, error) {
+	output := make([]string, 0, len(signers))
+
+	for _, signer := range signers {
+		keys, err := service.source.SearchKeys(ctx, signer)
+		if err != nil {
+			return nil, fmt.Errorf("list keys of %s: %w", signer, err)
+		}
+
+		if slices.ContainsFunc(keys, func(key *jwa.JWK) bool { return kid == "" || key.KID == kid }) {
+			output = append(output, signer)
+		}
+	}
+
+	return output, nil
+}
+
+// decodeSignature decodes the header and the payload of a compact signature, without verifying it.
+func decodeSignature(signature string) (*jwa.JWH, json.RawMessage, error) {
+	token, err := (&jwt.SignedTokenDecoder{}).Decode(signature)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var header *jwa.JWH
+
+	err = decodeSegment(token.Header, &header)
+	if err != nil || header == nil {
+		return nil, nil, fmt.Errorf("header is not a JSON object: %w", err)
+	}
+
+	var claims json.RawMessage
+
+	err = decodeSegment(token.Payload, &claims)
+	if err != nil {
+		return nil, nil, fmt.Errorf("payload is not JSON: %w", err)
+	}
+
+	return header, claims, nil
+}
+
+func decodeSegment(segment string, dst any) error {
+	decoded, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return fmt.Errorf("decode segment: %w", err)
+	}
+
+	return json.Unmarshal(decoded, dst)
+}
+
+// claimsInspectChecks lists the claims checks of [ClaimsInspect], each with the claims it checks.
+var claimsInspectChecks = []struct {
+	check TokenCheck
+	claim func(dst, src *jwa.ClaimsCommon)
+}{
+	{TokenCheckAudience, func(dst, src *jwa.ClaimsCommon) { dst.Aud = src.Aud }},
+	{TokenCheckIssuer, func(dst, src *jwa.ClaimsCommon) { dst.Iss = src.Iss }},
+	{TokenCheckSubject, func(dst, src *jwa.ClaimsCommon) { dst.Sub = src.Sub }},
+	{TokenCheckExpiration, func(dst, src *jwa.ClaimsCommon) { dst.Exp = src.Exp }},
+	{TokenCheckNotBefore, func(dst, src *jwa.ClaimsCommon) { dst.Nbf = src.Nbf }},
+}
+
+// checkClaims runs the claims checker of [ClaimsVerify] claim by claim, and returns the checks that
+// fail. Each check runs on a claims set the checker accepts, where only the claims it checks come
+// from the token, so a failure tells which claims are wrong.
+func checkClaims(raw json.RawMessage, keyConfig *config.Jwk, ignoreExpired bool) ([]*TokenCheckFailure, error) {
+	var claims jwa.Claims
+
+	err := json.Unmarshal(raw, &claims)
+	if err != nil {
+		// A payload that is not a claims set is a failed check, not an error.
+		failure := &TokenCheckFailure{Check: TokenCheckFormat, Message: "payload is not a claims set: " + err.Error()}
+
+		return []*TokenCheckFailure{failure}, nil //nolint:nilerr
+	}
+
+	checker := newClaimsChecker(keyConfig, ignoreExpired)
+
+	valid := jwa.ClaimsCommon{
+		Iss: keyConfig.Token.Issuer,
+		Sub: keyConfig.Token.Subject,
+		Aud: jwa.Audience{keyConfig.Token.Audience},
+		Exp: time.Now().Add(time.Hour).Unix(),
+	}
+
+	var output []*TokenCheckFailure
+
+	for _, claimsCheck := range claimsInspectChecks {
+		checked := valid
+		claimsCheck.claim(&checked, &claims.ClaimsCommon)
+
+		payload, err := json.Marshal(checked)
+		if err != nil {
+			return nil, fmt.Errorf("marshal %s claims: %w", claimsCheck.check, err)
+		}
+
+		err = checker.Unmarshal(payload, new(json.RawMessage))
+		if err != nil {
+			output = append(output, &TokenCheckFailure{Check: claimsCheck.check, Message: claimsCheckReason(err)})
+		}
+	}
+
+	return output, nil
+}
+
+// claimsCheckReason returns the message of the claims check that failed, without the wrapping of
+// [jwp.ClaimsChecker].
+func claimsCheckReason(err error) string {
+	var joined interface{ Unwrap() []error }
+
+	if errors.As(err, &joined) {
+		errs := joined.Unwrap()
+		if len(errs) > 0 {
+			return errs[len(errs)-1].Error()
+		}
+	}
+
+	return err.Error()
+}
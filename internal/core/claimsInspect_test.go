@@ -0,0 +1,296 @@
+package core_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+	"github.com/a-novel-kit/jwt/v2/jwk"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+)
+
+func TestClaimsInspect(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	privateKeys, publicKeys := generateAuthTokenKeySet(t, 2)
+
+	// The first key is trusted; the second one is unknown to the inspector.
+	trustedKeys := staticKeySource{publicKeys[0].JWK}
+
+	keyConfig := func(token config.JwkToken) map[string]*config.Jwk {
+		return map[string]*config.Jwk{"test-usage": {Alg: jwa.EdDSA, Token: token}}
+	}
+
+	inspectConfig := keyConfig(config.JwkToken{
+		TTL:      time.Hour,
+		Issuer:   "test-issuer",
+		Audience: "test-audience",
+		Subject:  "test-subject",
+	})
+
+	sign := func(t *testing.T, key *jwk.Key[ed25519.PrivateKey], keysConfig map[string]*config.Jwk) string {
+		t.Helper()
+
+		producers, err := core.NewJwkProducers(&core.JwkPrivateSources{
+			EdDSA: map[string]*jwk.Source{
+				"test-usage": jwk.NewSource(jwk.SourceConfig{
+					Fetch: func(_ context.Context) ([]*jwa.JWK, error) { return []*jwa.JWK{key.JWK}, nil },
+				}),
+			},
+		}, keysConfig)
+		require.NoError(t, err)
+
+		token, err := core.NewClaimsSign(producers, nil, keysConfig).Exec(t.Context(), &core.ClaimsSignRequest{
+			Claims: map[string]any{"foo": "bar"},
+			Usage:  "test-usage",
+		})
+		require.NoError(t, err)
+
+		return token
+	}
+
+	recipients, err := core.NewJwkRecipients(&core.JwkPublicSources{
+		EdDSA: map[string]*jwk.Source{
+			"test-usage": jwk.NewSource(jwk.SourceConfig{
+				Fetch: func(_ context.Context) ([]*jwa.JWK, error) { return trustedKeys, nil },
+			}),
+		},
+	}, inspectConfig)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+
+		token         func(t *testing.T) string
+		usage         string
+		ignoreExpired bool
+
+		// revokedErr is the answer of the denylist, for tokens that pass the parsing checks.
+		revokedErr error
+
+		expectSigner string
+		expectChecks []core.TokenCheck
+		expectErr    error
+	}{
+		{
+			name: "Success",
+
+			token: func(t *testing.T) string {
+				t.Helper()
+
+				return sign(t, privateKeys[0], inspectConfig)
+			},
+			usage: "test-usage",
+
+			expectSigner: "test-usage",
+		},
+		{
+			// Tokens signed before they were typed carry no type, and still pass.
+			name: "Success/Untyped",
+
+			token: func(t *testing.T) string {
+				t.Helper()
+
+				return untypeToken(t, sign(t, privateKeys[0], inspectConfig), privateKeys[0])
+			},
+			usage: "test-usage",
+
+			expectSigner: "test-usage",
+		},
+		{
+			name: "Success/IgnoreExpired",
+
+			token: func(t *testing.T) string {
+				t.Helper()
+
+				return sign(t, privateKeys[0], keyConfig(config.JwkToken{
+					TTL:      -time.Hour,
+					Issuer:   "test-issuer",
+					Audience: "test-audience",
+					Subject:  "test-subject",
+				}))
+			},
+			usage:         "test-usage",
+			ignoreExpired: true,
+
+			expectSigner: "test-usage",
+		},
+		{
+			name: "Failure/Format",
+
+			token: func(_ *testing.T) string { return "not-a-token" },
+			usage: "test-usage",
+
+			expectChecks: []core.TokenCheck{core.TokenCheckFormat},
+		},
+		{
+			name: "Failure/Algorithm",
+
+			token: func(_ *testing.T) string {
+				encode := func(segment string) string { return base64.RawURLEncoding.EncodeToString([]byte(segment)) }
+
+				return encode(`{"alg":"HS256","typ":"JWT"}`) + "." +
+					encode(`{"iss":"test-issuer","aud":"test-audience","sub":"test-subject","exp":4102444800}`) +
+					".c2lnbmF0dXJl"
+			},
+			usage: "test-usage",
+
+			expectChecks: []core.TokenCheck{core.TokenCheckAlgorithm},
+		},
+		{
+			// A payload signature, with its payload attached back, has the shape of a token.
+			name: "Failure/Type",
+
+			token: func(_ *testing.T) string {
+				encode := func(segment string) string { return base64.RawURLEncoding.EncodeToString([]byte(segment)) }
+
+				return encode(`{"alg":"EdDSA","typ":"payload+jose","b64":true,"crit":["b64"]}`) + "." +
+					encode(`{"iss":"test-issuer","aud":"test-audience","sub":"test-subject","exp":4102444800}`) +
+					".c2lnbmF0dXJl"
+			},
+			usage: "test-usage",
+
+			expectChecks: []core.TokenCheck{core.TokenCheckFormat},
+		},
+		{
+			name: "Failure/Key",
+
+			token: func(t *testing.T) string {
+				t.Helper()
+
+				return sign(t, privateKeys[1], inspectConfig)
+			},
+			usage: "test-usage",
+
+			expectChecks: []core.TokenCheck{core.TokenCheckKey},
+		},
+		{
+			name: "Failure/Signature",
+
+			token: func(t *testing.T) string {
+				t.Helper()
+
+				token := sign(t, privateKeys[0], inspectConfig)
+
+				// Flip the last signature byte: the key still matches, the signature does not.
+				return token[:len(token)-2] + lo.Ternary(token[len(token)-2:] == "AA", "AB", "AA")
+			},
+			usage: "test-usage",
+
+			expectChecks: []core.TokenCheck{core.TokenCheckSignature},
+		},
+		{
+			// Every failing claim is reported, not only the first.
+			name: "Failure/Claims",
+
+			token: func(t *testing.T) string {
+				t.Helper()
+
+				return sign(t, privateKeys[0], keyConfig(config.JwkToken{
+					TTL:      -time.Hour,
+					Issuer:   "other-issuer",
+					Audience: "other-audience",
+					Subject:  "test-subject",
+				}))
+			},
+			usage: "test-usage",
+
+			expectSigner: "test-usage",
+			expectChecks: []core.TokenCheck{core.TokenCheckAudience, core.TokenCheckIssuer, core.TokenCheckExpiration},
+		},
+		{
+			name: "Failure/Revoked",
+
+			token: func(t *testing.T) string {
+				t.Helper()
+
+				return sign(t, privateKeys[0], inspectConfig)
+			},
+			usage:      "test-usage",
+			revokedErr: core.ErrTokenRevoked,
+
+			expectSigner: "test-usage",
+			expectChecks: []core.TokenCheck{core.TokenCheckRevoked},
+		},
+		{
+			name: "Error/RevokedCheck",
+
+			token: func(t *testing.T) string {
+				t.Helper()
+
+				return sign(t, privateKeys[0], inspectConfig)
+			},
+			usage:      "test-usage",
+			revokedErr: errFoo,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ConfigNotFound",
+
+			token: func(_ *testing.T) string { return "some.token.value" },
+			usage: "unknown-usage",
+
+			expectErr: core.ErrConfigNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			serviceRevoked := coremocks.NewMockClaimsInspectServiceRevoked(t)
+
+			serviceRevoked.EXPECT().
+				Exec(mock.Anything, mock.Anything).
+				Return(testCase.revokedErr).
+				Maybe()
+
+			service := core.NewClaimsInspect(trustedKeys, recipients, serviceRevoked, inspectConfig)
+
+			token := testCase.token(t)
+
+			resp, err := service.Exec(t.Context(), &core.ClaimsInspectRequest{
+				Token:         token,
+				Usage:         testCase.usage,
+				IgnoreExpired: testCase.ignoreExpired,
+			})
+			require.ErrorIs(t, err, testCase.expectErr)
+
+			if testCase.expectErr != nil {
+				return
+			}
+
+			checks := lo.Map(resp.Failures, func(failure *core.TokenCheckFailure, _ int) core.TokenCheck {
+				return failure.Check
+			})
+
+			require.Equal(t, testCase.expectSigner, resp.Signer)
+			require.Equal(t, testCase.expectChecks, lo.Ternary(len(checks) > 0, checks, nil))
+			require.Equal(t, len(testCase.expectChecks) == 0, resp.Valid())
+
+			// The inspection runs the checks of the verification: they agree on every token.
+			_, err = core.NewClaimsVerify[map[string]any](recipients, serviceRevoked, inspectConfig).Exec(
+				t.Context(), &core.ClaimsVerifyRequest{
+					Token:         token,
+					Usage:         testCase.usage,
+					IgnoreExpired: testCase.ignoreExpired,
+				},
+			)
+			require.Equal(t, resp.Valid(), err == nil)
+		})
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/a-novel-kit/golib/otel"
 	"github.com/a-novel-kit/jwt/v2"
@@ -14,6 +16,11 @@ import (
 	"github.com/a-novel/service-json-keys/v2/internal/config"
 )
 
+// ClaimsSignServiceFreeze is the signing freeze dependency of [ClaimsSign].
+type ClaimsSignServiceFreeze interface {
+	Exec(ctx context.Context, request *SigningFreezeCheckRequest) error
+}
+
 // ClaimsSignRequest holds the parameters for a [ClaimsSign.Exec] call.
 type ClaimsSignRequest struct {
 	// Claims is the caller-supplied payload to embed in the JWT. Any JSON-serializable value
@@ -21,22 +28,33 @@ type ClaimsSignRequest struct {
 	Claims any
 	// Usage identifies the key and token parameters to use for signing. See [config.Jwk].
 	Usage string
+	// MultiSignature requests a JWS JSON general serialization, signed by the usage's key and by
+	// the current key of each of its co-signers (see [config.Jwk.CoSigners]), instead of a
+	// compact JWT.
+	MultiSignature bool
 }
 
-// A ClaimsSign signs a set of claims and returns a compact JWT. The signing key and all token
-// parameters are determined by the requested usage.
+// A ClaimsSign signs a set of claims and returns a compact JWT, or a multi-signature JWS JSON
+// serialization on request. The signing key and all token parameters are determined by the
+// requested usage. Every token carries a unique "jti" claim, its token ID.
+//
+// No token is issued while the signing of the usage, or of one of its co-signers, is frozen:
+// see [SigningFreezeSet].
 type ClaimsSign struct {
-	producers  map[string][]jwt.ProducerPlugin
-	keysConfig map[string]*config.Jwk
+	producers     map[string][]jwt.ProducerPlugin
+	serviceFreeze ClaimsSignServiceFreeze
+	keysConfig    map[string]*config.Jwk
 }
 
 // NewClaimsSign creates a ClaimsSign service. Producers provide the per-usage signing plugins
-// (see [NewJwkProducers]); keysConfig provides the token parameters for each usage.
+// (see [NewJwkProducers]); serviceFreeze tells the frozen usages apart, and is nil when signing
+// cannot be frozen; keysConfig provides the token parameters for each usage.
 func NewClaimsSign(
 	producers map[string][]jwt.ProducerPlugin,
+	serviceFreeze ClaimsSignServiceFreeze,
 	keysConfig map[string]*config.Jwk,
 ) *ClaimsSign {
-	return &ClaimsSign{producers: producers, keysConfig: keysConfig}
+	return &ClaimsSign{producers: producers, serviceFreeze: serviceFreeze, keysConfig: keysConfig}
 }
 
 func (service *ClaimsSign) Exec(ctx context.Context, request *ClaimsSignRequest) (string, error) {
@@ -45,6 +63,24 @@ func (service *ClaimsSign) Exec(ctx context.Context, request *ClaimsSignRequest)
 
 	span.SetAttributes(attribute.String("key.usage", request.Usage))
 
+	token, err := service.sign(ctx, span, request)
+
+	// Every attempt is audited, successful or not. The token itself is never recorded.
+	recordAudit(ctx, &AuditRecordRequest{
+		Action: AuditActionClaimsSign,
+		Usage:  request.Usage,
+		Token:  token,
+		Err:    err,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return otel.ReportSuccess(span, token), nil
+}
+
+func (service *ClaimsSign) sign(ctx context.Context, span trace.Span, request *ClaimsSignRequest) (string, error) {
 	keyConfig, ok := service.keysConfig[request.Usage]
 	if !ok {
 		return "", fmt.Errorf("%w: %s", ErrConfigNotFound, request.Usage)
@@ -64,24 +100,72 @@ func (service *ClaimsSign) Exec(ctx context.Context, request *ClaimsSignRequest)
 		return "", otel.ReportError(span, fmt.Errorf("create claims: %w", err))
 	}
 
-	producerPlugins, ok := service.producers[request.Usage]
-	if !ok {
-		return "", fmt.Errorf("%w: %s", ErrConfigNotFound, request.Usage)
+	// Every token gets its own ID, so it can be revoked on its own: see [TokenRevoke].
+	claims.Jti = uuid.NewString()
+
+	signers := []string{request.Usage}
+	if request.MultiSignature {
+		signers = append(signers, keyConfig.CoSigners...)
+	}
+
+	if service.serviceFreeze != nil {
+		for _, signer := range signers {
+			err = service.serviceFreeze.Exec(ctx, &SigningFreezeCheckRequest{Usage: signer})
+			if err != nil {
+				return "", fmt.Errorf("check freeze (%s): %w", signer, err)
+			}
+		}
+	}
+
+	// Every signer signs the very same claims value, so all tokens share one payload.
+	tokens := make([]string, len(signers))
+
+	for i, signer := range signers {
+		producerPlugins, ok := service.producers[signer]
+		if !ok {
+			return "", fmt.Errorf("%w: %s", ErrConfigNotFound, signer)
+		}
+
+		// The type tells tokens apart from the payload signatures of the same keys: see
+		// [JwkTypRecipient].
+		producer := jwt.NewProducer(jwt.ProducerConfig{
+			Header:  jwt.HeaderProducerConfig{Typ: jwa.TypJWT},
+			Plugins: producerPlugins,
+		})
+
+		// A caller claim that collides with the envelope above is rejected at
+		// encoding time, inside Issue. The request is malformed, so it is classified
+		// rather than reported as a fault; the wrapped error names the members.
+		tokens[i], err = producer.Issue(ctx, claims, nil)
+		if errors.Is(err, jwa.ErrReservedMember) {
+			return "", fmt.Errorf("%w: %w", ErrReservedClaim, err)
+		}
+
+		if err != nil {
+			return "", otel.ReportError(span, fmt.Errorf("issue token (%s): %w", signer, err))
+		}
 	}
 
-	producer := jwt.NewProducer(jwt.ProducerConfig{Plugins: producerPlugins})
+	for i, signer := range signers {
+		kid, _ := readTokenIDs(tokens[i])
+		recordSignature(ctx, SignatureOperationClaims, signer, kid)
+	}
 
-	// A caller claim that collides with the envelope above is rejected at
-	// encoding time, inside Issue. The request is malformed, so it is classified
-	// rather than reported as a fault; the wrapped error names the members.
-	token, err := producer.Issue(ctx, claims, nil)
-	if errors.Is(err, jwa.ErrReservedMember) {
-		return "", fmt.Errorf("%w: %w", ErrReservedClaim, err)
+	if !request.MultiSignature {
+		return tokens[0], nil
 	}
 
+	span.SetAttributes(attribute.Int("token.signatures", len(tokens)))
+
+	token, err := NewJwsJSON(tokens...)
 	if err != nil {
-		return "", otel.ReportError(span, fmt.Errorf("issue token: %w", err))
+		return "", otel.ReportError(span, fmt.Errorf("gather signatures: %w", err))
 	}
 
-	return otel.ReportSuccess(span, token), nil
+	serialized, err := token.Serialize()
+	if err != nil {
+		return "", otel.ReportError(span, fmt.Errorf("serialize token: %w", err))
+	}
+
+	return serialized, nil
 }
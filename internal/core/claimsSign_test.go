@@ -1,15 +1,18 @@
 package core_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel-kit/jwt/v2/jwa"
 
 	"github.com/a-novel/service-json-keys/v2/internal/config"
 	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
 )
 
 func TestClaimsSign(t *testing.T) {
@@ -37,6 +40,12 @@ func TestClaimsSign(t *testing.T) {
 		},
 	}
 
+	errFoo := errors.New("foo")
+
+	type serviceFreezeMock struct {
+		err error
+	}
+
 	testCases := []struct {
 		name string
 
@@ -45,6 +54,8 @@ func TestClaimsSign(t *testing.T) {
 		producers  core.JwkProducers
 		keysConfig map[string]*config.Jwk
 
+		serviceFreezeMock *serviceFreezeMock
+
 		expectErr error
 	}{
 		{
@@ -71,6 +82,8 @@ func TestClaimsSign(t *testing.T) {
 			keysConfig: testConfig,
 			producers:  make(core.JwkProducers),
 
+			serviceFreezeMock: &serviceFreezeMock{},
+
 			expectErr: core.ErrConfigNotFound,
 		},
 		{
@@ -88,8 +101,40 @@ func TestClaimsSign(t *testing.T) {
 			keysConfig: testConfig,
 			producers:  core.JwkProducers{"test-usage": nil},
 
+			serviceFreezeMock: &serviceFreezeMock{},
+
 			expectErr: core.ErrReservedClaim,
 		},
+		{
+			name: "Error/Frozen",
+
+			request: &core.ClaimsSignRequest{
+				Claims: &testClaims{Foo: "bar"},
+				Usage:  "test-usage",
+			},
+
+			keysConfig: testConfig,
+			producers:  core.JwkProducers{"test-usage": nil},
+
+			serviceFreezeMock: &serviceFreezeMock{err: core.ErrSigningFrozen},
+
+			expectErr: core.ErrSigningFrozen,
+		},
+		{
+			name: "Error/FreezeCheck",
+
+			request: &core.ClaimsSignRequest{
+				Claims: &testClaims{Foo: "bar"},
+				Usage:  "test-usage",
+			},
+
+			keysConfig: testConfig,
+			producers:  core.JwkProducers{"test-usage": nil},
+
+			serviceFreezeMock: &serviceFreezeMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
 		{
 			name: "Success/UnreservedClaims",
 
@@ -101,6 +146,8 @@ func TestClaimsSign(t *testing.T) {
 			keysConfig: testConfig,
 			producers:  core.JwkProducers{"test-usage": nil},
 
+			serviceFreezeMock: &serviceFreezeMock{},
+
 			expectErr: nil,
 		},
 	}
@@ -109,10 +156,34 @@ func TestClaimsSign(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
 
-			service := core.NewClaimsSign(testCase.producers, testCase.keysConfig)
+			recorder := coremocks.NewMockAuditRecorder(t)
+
+			// Every attempt is audited, with the token on success, and the error on failure.
+			recorder.EXPECT().
+				Exec(mock.Anything, mock.MatchedBy(func(request *core.AuditRecordRequest) bool {
+					return request.Action == core.AuditActionClaimsSign &&
+						request.Usage == testCase.request.Usage &&
+						errors.Is(request.Err, testCase.expectErr) &&
+						(request.Err != nil) == (request.Token == "")
+				})).
+				Return(&core.AuditEvent{}, nil)
+
+			ctx := core.NewAuditContext(t.Context(), recorder)
 
-			_, err := service.Exec(t.Context(), testCase.request)
+			serviceFreeze := coremocks.NewMockClaimsSignServiceFreeze(t)
+
+			if testCase.serviceFreezeMock != nil {
+				serviceFreeze.EXPECT().
+					Exec(mock.Anything, &core.SigningFreezeCheckRequest{Usage: testCase.request.Usage}).
+					Return(testCase.serviceFreezeMock.err)
+			}
+
+			service := core.NewClaimsSign(testCase.producers, serviceFreeze, testCase.keysConfig)
+
+			_, err := service.Exec(ctx, testCase.request)
 			require.ErrorIs(t, err, testCase.expectErr)
+
+			serviceFreeze.AssertExpectations(t)
 		})
 	}
 }
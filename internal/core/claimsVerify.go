@@ -2,9 +2,12 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/a-novel-kit/golib/otel"
 	"github.com/a-novel-kit/jwt/v2"
@@ -14,30 +17,62 @@ import (
 	"github.com/a-novel/service-json-keys/v2/internal/config"
 )
 
+// ErrClaimsVerifyMissingSignature is returned under [SignaturePolicyAll] when a token lacks a
+// valid signature from one of the usage's signers.
+var ErrClaimsVerifyMissingSignature = errors.New("token lacks a required signature")
+
+// SignaturePolicy decides how many signatures of a multi-signature token must verify.
+type SignaturePolicy int
+
+const (
+	// SignaturePolicyAny accepts a token as soon as the usage's own signature verifies. Co-signers
+	// only sign alongside the usage: a token signed by a co-signer alone is refused.
+	SignaturePolicyAny SignaturePolicy = iota
+	// SignaturePolicyAll accepts a token only if every signature it carries verifies, and the usage
+	// and each of its co-signers contributed one. Stripping a signature off the token fails it.
+	SignaturePolicyAll
+)
+
+// ClaimsVerifyServiceRevoked is the denylist dependency of [ClaimsVerify]. See
+// [RevokedTokenCheck].
+type ClaimsVerifyServiceRevoked interface {
+	Exec(ctx context.Context, request *RevokedTokenCheckRequest) error
+}
+
 // ClaimsVerifyRequest holds the parameters for a [ClaimsVerify.Exec] call.
 type ClaimsVerifyRequest struct {
-	// Token is the compact JWT to verify.
+	// Token is the token to verify, compact or in JWS JSON serialization.
 	Token string
 	// Usage is the key usage the token was signed under; must match the value used at signing time.
 	Usage string
 	// IgnoreExpired allows expired tokens to pass verification. Useful for refresh flows.
 	IgnoreExpired bool
+	// Signatures sets how many signatures of a multi-signature token must verify. Compact tokens
+	// carry a single signature.
+	Signatures SignaturePolicy
 }
 
-// A ClaimsVerify verifies a signed JWT and decodes its claims into Out, validating
+// A ClaimsVerify verifies a signed JWT, compact or in JWS JSON general serialization, and
+// decodes its claims into Out, validating
 // all token claims against the configuration registered for the given usage.
+//
+// A token that verifies is then refused with [ErrTokenRevoked] if it was revoked: see
+// [TokenRevoke].
 type ClaimsVerify[Out any] struct {
-	recipients map[string][]jwt.RecipientPlugin
-	keysConfig map[string]*config.Jwk
+	recipients     map[string][]jwt.RecipientPlugin
+	serviceRevoked ClaimsVerifyServiceRevoked
+	keysConfig     map[string]*config.Jwk
 }
 
 // NewClaimsVerify creates a ClaimsVerify service. Recipients provide the per-usage verification
-// plugins (see [NewJwkRecipients]); keysConfig provides the token parameters for each usage.
+// plugins (see [NewJwkRecipients]); serviceRevoked tells the revoked tokens apart, and is nil when
+// tokens cannot be revoked; keysConfig provides the token parameters for each usage.
 func NewClaimsVerify[Out any](
 	recipients map[string][]jwt.RecipientPlugin,
+	serviceRevoked ClaimsVerifyServiceRevoked,
 	keysConfig map[string]*config.Jwk,
 ) *ClaimsVerify[Out] {
-	return &ClaimsVerify[Out]{recipients: recipients, keysConfig: keysConfig}
+	return &ClaimsVerify[Out]{recipients: recipients, serviceRevoked: serviceRevoked, keysConfig: keysConfig}
 }
 
 func (service *ClaimsVerify[Out]) Exec(ctx context.Context, request *ClaimsVerifyRequest) (*Out, error) {
@@ -53,7 +88,137 @@ func (service *ClaimsVerify[Out]) Exec(ctx context.Context, request *ClaimsVerif
 
 	var claims Out
 
-	// Validate the claims against the usage's configured target, and its expiry unless waived.
+	deserializer := newClaimsChecker(keyConfig, request.IgnoreExpired)
+
+	if _, ok := service.recipients[request.Usage]; !ok {
+		return nil, fmt.Errorf("%w: no recipients found for usage %s", ErrConfigNotFound, request.Usage)
+	}
+
+	// A compact token is its own single signature.
+	signatures := []string{request.Token}
+
+	if IsJwsJSON(request.Token) {
+		token, err := ParseJwsJSON(request.Token)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+
+		signatures = token.Compact()
+	}
+
+	signers := append([]string{request.Usage}, keyConfig.CoSigners...)
+	verifiedSigners := make([]string, 0, len(signers))
+
+	var lastErr error
+
+	// A signature may come from the usage or any of its co-signers. Each signer's recipients are
+	// tried in turn, so signers sharing an algorithm never mistake each other's keys.
+	for _, signature := range signatures {
+		signer, err := service.verifySignature(ctx, signature, signers, deserializer, &claims)
+		if err != nil {
+			lastErr = err
+
+			if request.Signatures == SignaturePolicyAll {
+				return nil, otel.ReportError(span, err)
+			}
+
+			continue
+		}
+
+		verifiedSigners = append(verifiedSigners, signer)
+
+		if request.Signatures == SignaturePolicyAny && signer == request.Usage {
+			break
+		}
+	}
+
+	if request.Signatures == SignaturePolicyAny {
+		return service.checkAny(ctx, span, request, verifiedSigners, lastErr, &claims)
+	}
+
+	for _, signer := range signers {
+		if !slices.Contains(verifiedSigners, signer) {
+			return nil, otel.ReportError(span, fmt.Errorf("%w: %s", ErrClaimsVerifyMissingSignature, signer))
+		}
+	}
+
+	return service.checkRevoked(ctx, span, request, &claims)
+}
+
+// checkAny settles a token under [SignaturePolicyAny]: a co-signer signature does not vouch for the
+// usage's tokens on its own, so only the usage's signature counts.
+func (service *ClaimsVerify[Out]) checkAny(
+	ctx context.Context, span trace.Span, request *ClaimsVerifyRequest, verifiedSigners []string, lastErr error,
+	claims *Out,
+) (*Out, error) {
+	if slices.Contains(verifiedSigners, request.Usage) {
+		return service.checkRevoked(ctx, span, request, claims)
+	}
+
+	if lastErr != nil {
+		return nil, otel.ReportError(span, lastErr)
+	}
+
+	return nil, otel.ReportError(span, fmt.Errorf("%w: %s", ErrClaimsVerifyMissingSignature, request.Usage))
+}
+
+// checkRevoked refuses a verified token if it was revoked, and returns its claims otherwise.
+func (service *ClaimsVerify[Out]) checkRevoked(
+	ctx context.Context, span trace.Span, request *ClaimsVerifyRequest, claims *Out,
+) (*Out, error) {
+	if service.serviceRevoked == nil {
+		return otel.ReportSuccess(span, claims), nil
+	}
+
+	// Every signature of a token shares its payload, and so its ID.
+	_, jti := readTokenIDs(request.Token)
+
+	err := service.serviceRevoked.Exec(ctx, &RevokedTokenCheckRequest{Usage: request.Usage, TokenID: jti})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("check revocation: %w", err))
+	}
+
+	return otel.ReportSuccess(span, claims), nil
+}
+
+// verifySignature verifies a single compact signature against the recipients of each signer, and
+// returns the first signer it verifies under.
+func (service *ClaimsVerify[Out]) verifySignature(
+	ctx context.Context, signature string, signers []string, deserializer *jwp.ClaimsChecker, claims *Out,
+) (string, error) {
+	var output error
+
+	for _, signer := range signers {
+		recipientPlugins, ok := service.recipients[signer]
+		if !ok {
+			continue
+		}
+
+		recipient := jwt.NewRecipient(
+			jwt.RecipientConfig{
+				Plugins:      recipientPlugins,
+				Deserializer: deserializer.Unmarshal,
+			})
+
+		err := recipient.Consume(ctx, signature, claims)
+		if err == nil {
+			return signer, nil
+		}
+
+		// Keep the most telling error: a signer whose keys do not even match the token says less
+		// than one that rejected it.
+		if output == nil || errors.Is(output, jwt.ErrMismatchRecipientPlugin) {
+			output = err
+		}
+	}
+
+	return "", output
+}
+
+// newClaimsChecker returns the checker of the claims of a usage's tokens: it validates them against
+// the usage's configured target, and their expiry unless ignoreExpired is set. [ClaimsInspect]
+// runs the same checker.
+func newClaimsChecker(keyConfig *config.Jwk, ignoreExpired bool) *jwp.ClaimsChecker {
 	checks := []jwp.ClaimsCheck{
 		jwp.NewClaimsCheckTarget(jwt.TargetConfig{
 			Issuer:   keyConfig.Token.Issuer,
@@ -61,29 +226,11 @@ func (service *ClaimsVerify[Out]) Exec(ctx context.Context, request *ClaimsVerif
 			Subject:  keyConfig.Token.Subject,
 		}),
 	}
-	if !request.IgnoreExpired {
+	if !ignoreExpired {
 		checks = append(checks, jwp.NewClaimsCheckTimestamp(keyConfig.Token.Leeway, true))
 	}
 
-	deserializer := jwp.NewClaimsChecker(&jwp.ClaimsCheckerConfig{
+	return jwp.NewClaimsChecker(&jwp.ClaimsCheckerConfig{
 		Checks: checks,
 	})
-
-	recipientPlugins, ok := service.recipients[request.Usage]
-	if !ok {
-		return nil, fmt.Errorf("%w: no recipients found for usage %s", ErrConfigNotFound, request.Usage)
-	}
-
-	recipient := jwt.NewRecipient(
-		jwt.RecipientConfig{
-			Plugins:      recipientPlugins,
-			Deserializer: deserializer.Unmarshal,
-		})
-
-	err := recipient.Consume(ctx, request.Token, &claims)
-	if err != nil {
-		return nil, otel.ReportError(span, err)
-	}
-
-	return otel.ReportSuccess(span, &claims), nil
 }
@@ -79,7 +79,7 @@ func TestClaimsVerify(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
 
-			service := core.NewClaimsVerify[testClaims](testCase.recipients, testCase.keysConfig)
+			service := core.NewClaimsVerify[testClaims](testCase.recipients, nil, testCase.keysConfig)
 
 			_, err := service.Exec(t.Context(), testCase.request)
 			require.ErrorIs(t, err, testCase.expectErr)
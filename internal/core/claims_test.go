@@ -7,13 +7,16 @@ import (
 	"time"
 
 	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel-kit/jwt/v2/jwa"
 	"github.com/a-novel-kit/jwt/v2/jwk"
+	"github.com/a-novel-kit/jwt/v2/jws"
 
 	"github.com/a-novel/service-json-keys/v2/internal/config"
 	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
 )
 
 func TestClaimsSignAndVerify(t *testing.T) {
@@ -81,20 +84,28 @@ func TestClaimsSignAndVerify(t *testing.T) {
 		name string
 
 		claims *testClaims
+		// untyped strips the "typ" header of the token, like tokens signed before it was set.
+		untyped bool
 	}{
 		{
 			name: "Success",
 
 			claims: &testClaims{Foo: "bar"},
 		},
+		{
+			name: "Success/Untyped",
+
+			claims:  &testClaims{Foo: "bar"},
+			untyped: true,
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
 
-			signer := core.NewClaimsSign(producers, testConfig)
-			verifier := core.NewClaimsVerify[testClaims](recipients, testConfig)
+			signer := core.NewClaimsSign(producers, nil, testConfig)
+			verifier := core.NewClaimsVerify[testClaims](recipients, nil, testConfig)
 
 			signedClaims, err := signer.Exec(t.Context(), &core.ClaimsSignRequest{
 				Claims: testCase.claims,
@@ -102,6 +113,10 @@ func TestClaimsSignAndVerify(t *testing.T) {
 			})
 			require.NoError(t, err)
 
+			if testCase.untyped {
+				signedClaims = untypeToken(t, signedClaims, privateKeys[0])
+			}
+
 			verifiedClaims, err := verifier.Exec(t.Context(), &core.ClaimsVerifyRequest{
 				Token: signedClaims,
 				Usage: "test-usage",
@@ -112,3 +127,449 @@ func TestClaimsSignAndVerify(t *testing.T) {
 		})
 	}
 }
+
+func TestClaimsSignAndVerifyRevoked(t *testing.T) {
+	t.Parallel()
+
+	privateKeys, publicKeys := generateAuthTokenKeySet(t, 1)
+
+	testConfig := map[string]*config.Jwk{
+		"test-usage": {
+			Alg:   jwa.EdDSA,
+			Key:   config.JwkKey{TTL: 168 * time.Hour, Rotation: 24 * time.Hour, Cache: 30 * time.Minute},
+			Token: config.JwkToken{TTL: 24 * time.Hour, Leeway: 5 * time.Minute},
+		},
+	}
+
+	producers, err := core.NewJwkProducers(&core.JwkPrivateSources{
+		EdDSA: map[string]*jwk.Source{
+			"test-usage": jwk.NewSource(jwk.SourceConfig{
+				Fetch: func(_ context.Context) ([]*jwa.JWK, error) {
+					return []*jwa.JWK{privateKeys[0].JWK}, nil
+				},
+			}),
+		},
+		ES:  make(map[string]*jwk.Source),
+		RSA: make(map[string]*jwk.Source),
+	}, testConfig)
+	require.NoError(t, err)
+
+	recipients, err := core.NewJwkRecipients(&core.JwkPublicSources{
+		EdDSA: map[string]*jwk.Source{
+			"test-usage": jwk.NewSource(jwk.SourceConfig{
+				Fetch: func(_ context.Context) ([]*jwa.JWK, error) {
+					return []*jwa.JWK{publicKeys[0].JWK}, nil
+				},
+			}),
+		},
+		ES:  make(map[string]*jwk.Source),
+		RSA: make(map[string]*jwk.Source),
+	}, testConfig)
+	require.NoError(t, err)
+
+	signer := core.NewClaimsSign(producers, nil, testConfig)
+
+	signToken := func() (string, string) {
+		token, err := signer.Exec(t.Context(), &core.ClaimsSignRequest{Claims: map[string]any{}, Usage: "test-usage"})
+		require.NoError(t, err)
+
+		claims, err := core.NewClaimsVerify[jwa.Claims](recipients, nil, testConfig).
+			Exec(t.Context(), &core.ClaimsVerifyRequest{Token: token, Usage: "test-usage"})
+		require.NoError(t, err)
+
+		return token, claims.Jti
+	}
+
+	revokedToken, revokedID := signToken()
+	validToken, validID := signToken()
+
+	// Every token gets its own ID.
+	require.NotEmpty(t, revokedID)
+	require.NotEmpty(t, validID)
+	require.NotEqual(t, revokedID, validID)
+
+	syncService := coremocks.NewMockRevokedTokenCheckService(t)
+	syncService.EXPECT().
+		Exec(mock.Anything, mock.Anything).
+		Return(&core.RevokedTokenSyncResult{
+			Tokens: []*core.RevokedToken{{
+				TokenID:   revokedID,
+				Usage:     "test-usage",
+				ExpiresAt: time.Now().Add(time.Hour),
+				RevokedAt: time.Now(),
+			}},
+			Cursor: time.Now(),
+		}, nil).
+		Once()
+
+	denylist := core.NewRevokedTokenCheck(syncService, time.Hour)
+	require.NoError(t, denylist.Sync(t.Context()))
+
+	verifier := core.NewClaimsVerify[map[string]any](recipients, denylist, testConfig)
+
+	_, err = verifier.Exec(t.Context(), &core.ClaimsVerifyRequest{Token: revokedToken, Usage: "test-usage"})
+	require.ErrorIs(t, err, core.ErrTokenRevoked)
+
+	_, err = verifier.Exec(t.Context(), &core.ClaimsVerifyRequest{Token: validToken, Usage: "test-usage"})
+	require.NoError(t, err)
+}
+
+func TestClaimsVerifyRefusesAlgOutsidePolicy(t *testing.T) {
+	t.Parallel()
+
+	privateKeys, publicKeys := generateAuthTokenKeySet(t, 1)
+
+	signConfig := map[string]*config.Jwk{
+		"test-usage": {
+			Alg: jwa.EdDSA,
+			Token: config.JwkToken{
+				TTL:      24 * time.Hour,
+				Issuer:   "test-issuer",
+				Audience: "test-audience",
+				Subject:  "test-subject",
+			},
+		},
+	}
+
+	// The verifier trusts the same keys, but its policy no longer allows the algorithm they sign with.
+	verifyConfig := map[string]*config.Jwk{
+		"test-usage": {
+			Alg:    jwa.EdDSA,
+			Token:  signConfig["test-usage"].Token,
+			Policy: &config.JwkPolicy{Algs: []jwa.Alg{jwa.ES256}},
+		},
+	}
+
+	producers, err := core.NewJwkProducers(&core.JwkPrivateSources{
+		EdDSA: map[string]*jwk.Source{
+			"test-usage": jwk.NewSource(jwk.SourceConfig{
+				Fetch: func(_ context.Context) ([]*jwa.JWK, error) {
+					return []*jwa.JWK{privateKeys[0].JWK}, nil
+				},
+			}),
+		},
+		ES:  make(map[string]*jwk.Source),
+		RSA: make(map[string]*jwk.Source),
+	}, signConfig)
+	require.NoError(t, err)
+
+	recipients, err := core.NewJwkRecipients(&core.JwkPublicSources{
+		EdDSA: map[string]*jwk.Source{
+			"test-usage": jwk.NewSource(jwk.SourceConfig{
+				Fetch: func(_ context.Context) ([]*jwa.JWK, error) {
+					return []*jwa.JWK{publicKeys[0].JWK}, nil
+				},
+			}),
+		},
+		ES:  make(map[string]*jwk.Source),
+		RSA: make(map[string]*jwk.Source),
+	}, verifyConfig)
+	require.NoError(t, err)
+
+	signedClaims, err := core.NewClaimsSign(producers, nil, signConfig).Exec(t.Context(), &core.ClaimsSignRequest{
+		Claims: map[string]any{"foo": "bar"},
+		Usage:  "test-usage",
+	})
+	require.NoError(t, err)
+
+	_, err = core.NewClaimsVerify[map[string]any](recipients, nil, verifyConfig).
+		Exec(t.Context(), &core.ClaimsVerifyRequest{Token: signedClaims, Usage: "test-usage"})
+	require.ErrorIs(t, err, config.ErrJwkPolicyAlgNotAllowed)
+}
+
+func TestClaimsVerifyDuringAlgMigration(t *testing.T) {
+	t.Parallel()
+
+	legacyPrivateKey, legacyPublicKey, err := jwk.GenerateECDSA(jwk.ES256)
+	require.NoError(t, err)
+
+	privateKeys, publicKeys := generateAuthTokenKeySet(t, 1)
+
+	token := config.JwkToken{
+		TTL:      24 * time.Hour,
+		Issuer:   "test-issuer",
+		Audience: "test-audience",
+		Subject:  "test-subject",
+	}
+
+	legacyConfig := map[string]*config.Jwk{
+		"test-usage": {Alg: jwa.ES256, Token: token},
+	}
+
+	// The usage moved from ES256 to EdDSA: the newest key uses the new algorithm, while the legacy key
+	// remains active until it expires.
+	migratedConfig := map[string]*config.Jwk{
+		"test-usage": {Alg: jwa.EdDSA, PreviousAlgs: []jwa.Alg{jwa.ES256}, Token: token},
+	}
+
+	privateSource := jwk.NewSource(jwk.SourceConfig{
+		Fetch: func(_ context.Context) ([]*jwa.JWK, error) {
+			return []*jwa.JWK{privateKeys[0].JWK, legacyPrivateKey.JWK}, nil
+		},
+	})
+
+	publicSource := jwk.NewSource(jwk.SourceConfig{
+		Fetch: func(_ context.Context) ([]*jwa.JWK, error) {
+			return []*jwa.JWK{publicKeys[0].JWK, legacyPublicKey.JWK}, nil
+		},
+	})
+
+	legacyProducers, err := core.NewJwkProducers(&core.JwkPrivateSources{
+		EdDSA: make(map[string]*jwk.Source),
+		ES:    map[string]*jwk.Source{"test-usage": privateSource},
+		RSA:   make(map[string]*jwk.Source),
+	}, legacyConfig)
+	require.NoError(t, err)
+
+	producers, err := core.NewJwkProducers(&core.JwkPrivateSources{
+		EdDSA: map[string]*jwk.Source{"test-usage": privateSource},
+		ES:    make(map[string]*jwk.Source),
+		RSA:   make(map[string]*jwk.Source),
+	}, migratedConfig)
+	require.NoError(t, err)
+
+	recipients, err := core.NewJwkRecipients(&core.JwkPublicSources{
+		EdDSA: map[string]*jwk.Source{"test-usage": publicSource},
+		ES:    make(map[string]*jwk.Source),
+		RSA:   make(map[string]*jwk.Source),
+	}, migratedConfig)
+	require.NoError(t, err)
+
+	verifier := core.NewClaimsVerify[map[string]any](recipients, nil, migratedConfig)
+
+	testCases := []struct {
+		name string
+
+		producers  core.JwkProducers
+		keysConfig map[string]*config.Jwk
+	}{
+		{
+			name: "LegacyToken",
+
+			producers:  legacyProducers,
+			keysConfig: legacyConfig,
+		},
+		{
+			name: "NewToken",
+
+			producers:  producers,
+			keysConfig: migratedConfig,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			signedClaims, err := core.NewClaimsSign(testCase.producers, nil, testCase.keysConfig).
+				Exec(t.Context(), &core.ClaimsSignRequest{
+					Claims: map[string]any{"foo": "bar"},
+					Usage:  "test-usage",
+				})
+			require.NoError(t, err)
+
+			claims, err := verifier.Exec(t.Context(), &core.ClaimsVerifyRequest{
+				Token: signedClaims,
+				Usage: "test-usage",
+			})
+			require.NoError(t, err)
+			require.Equal(t, "bar", (*claims)["foo"])
+		})
+	}
+}
+
+func TestClaimsSignAndVerifyMultiSignature(t *testing.T) {
+	t.Parallel()
+
+	privateKeys, publicKeys := generateAuthTokenKeySet(t, 2)
+
+	coSignerPrivateKey, coSignerPublicKey, err := jwk.GenerateECDSA(jwk.ES384)
+	require.NoError(t, err)
+
+	testConfig := map[string]*config.Jwk{
+		"test-usage": {
+			Alg:       jwa.EdDSA,
+			CoSigners: []string{"test-cosigner"},
+			Token: config.JwkToken{
+				TTL:      24 * time.Hour,
+				Issuer:   "test-issuer",
+				Audience: "test-audience",
+				Subject:  "test-subject",
+			},
+		},
+		// The co-signer issues tokens with the same claims, so only the key tells them apart.
+		"test-cosigner": {
+			Alg: jwa.ES384,
+			Token: config.JwkToken{
+				TTL:      24 * time.Hour,
+				Issuer:   "test-issuer",
+				Audience: "test-audience",
+				Subject:  "test-subject",
+			},
+		},
+	}
+
+	staticSource := func(keys ...*jwa.JWK) *jwk.Source {
+		return jwk.NewSource(jwk.SourceConfig{
+			Fetch: func(_ context.Context) ([]*jwa.JWK, error) {
+				return keys, nil
+			},
+		})
+	}
+
+	producers, err := core.NewJwkProducers(&core.JwkPrivateSources{
+		EdDSA: map[string]*jwk.Source{"test-usage": staticSource(privateKeys[0].JWK, privateKeys[1].JWK)},
+		ES:    map[string]*jwk.Source{"test-cosigner": staticSource(coSignerPrivateKey.JWK)},
+		RSA:   make(map[string]*jwk.Source),
+	}, testConfig)
+	require.NoError(t, err)
+
+	recipients, err := core.NewJwkRecipients(&core.JwkPublicSources{
+		EdDSA: map[string]*jwk.Source{"test-usage": staticSource(publicKeys[0].JWK, publicKeys[1].JWK)},
+		ES:    map[string]*jwk.Source{"test-cosigner": staticSource(coSignerPublicKey.JWK)},
+		RSA:   make(map[string]*jwk.Source),
+	}, testConfig)
+	require.NoError(t, err)
+
+	signer := core.NewClaimsSign(producers, nil, testConfig)
+
+	multiSigned, err := signer.Exec(t.Context(), &core.ClaimsSignRequest{
+		Claims:         map[string]any{"foo": "bar"},
+		Usage:          "test-usage",
+		MultiSignature: true,
+	})
+	require.NoError(t, err)
+	require.True(t, core.IsJwsJSON(multiSigned))
+
+	compact, err := signer.Exec(t.Context(), &core.ClaimsSignRequest{
+		Claims: map[string]any{"foo": "bar"},
+		Usage:  "test-usage",
+	})
+	require.NoError(t, err)
+	require.False(t, core.IsJwsJSON(compact))
+
+	coSigned, err := signer.Exec(t.Context(), &core.ClaimsSignRequest{
+		Claims: map[string]any{"foo": "bar"},
+		Usage:  "test-cosigner",
+	})
+	require.NoError(t, err)
+
+	parsed, err := core.ParseJwsJSON(multiSigned)
+	require.NoError(t, err)
+	require.Len(t, parsed.Signatures, 2)
+
+	// Only the co-signer signature remains.
+	stripped, err := (&core.JwsJSON{Payload: parsed.Payload, Signatures: parsed.Signatures[1:]}).Serialize()
+	require.NoError(t, err)
+
+	// The co-signer signature is replaced by the usage's own, over the same payload.
+	tampered, err := (&core.JwsJSON{
+		Payload: parsed.Payload,
+		Signatures: []core.JwsJSONSignature{
+			parsed.Signatures[0],
+			{Protected: parsed.Signatures[1].Protected, Signature: parsed.Signatures[0].Signature},
+		},
+	}).Serialize()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+
+		token      string
+		signatures core.SignaturePolicy
+
+		expectErr error
+	}{
+		{
+			name: "Any/MultiSignature",
+
+			token:      multiSigned,
+			signatures: core.SignaturePolicyAny,
+		},
+		{
+			name: "All/MultiSignature",
+
+			token:      multiSigned,
+			signatures: core.SignaturePolicyAll,
+		},
+		{
+			// A co-signer signature does not vouch for the usage's tokens on its own.
+			name: "Any/Stripped",
+
+			token:      stripped,
+			signatures: core.SignaturePolicyAny,
+
+			expectErr: core.ErrClaimsVerifyMissingSignature,
+		},
+		{
+			name: "All/Stripped",
+
+			token:      stripped,
+			signatures: core.SignaturePolicyAll,
+
+			expectErr: core.ErrClaimsVerifyMissingSignature,
+		},
+		{
+			name: "Any/Compact",
+
+			token:      compact,
+			signatures: core.SignaturePolicyAny,
+		},
+		{
+			name: "All/Compact",
+
+			token:      compact,
+			signatures: core.SignaturePolicyAll,
+
+			expectErr: core.ErrClaimsVerifyMissingSignature,
+		},
+		{
+			name: "Any/CoSignerCompact",
+
+			token:      coSigned,
+			signatures: core.SignaturePolicyAny,
+
+			expectErr: core.ErrClaimsVerifyMissingSignature,
+		},
+		{
+			name: "All/CoSignerCompact",
+
+			token:      coSigned,
+			signatures: core.SignaturePolicyAll,
+
+			expectErr: core.ErrClaimsVerifyMissingSignature,
+		},
+		{
+			name: "Any/Tampered",
+
+			token:      tampered,
+			signatures: core.SignaturePolicyAny,
+		},
+		{
+			name: "All/Tampered",
+
+			token:      tampered,
+			signatures: core.SignaturePolicyAll,
+
+			expectErr: jws.ErrInvalidSignature,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			claims, err := core.NewClaimsVerify[map[string]any](recipients, nil, testConfig).
+				Exec(t.Context(), &core.ClaimsVerifyRequest{
+					Token:      testCase.token,
+					Usage:      "test-usage",
+					Signatures: testCase.signatures,
+				})
+			require.ErrorIs(t, err, testCase.expectErr)
+
+			if testCase.expectErr == nil {
+				require.Equal(t, "bar", (*claims)["foo"])
+			}
+		})
+	}
+}
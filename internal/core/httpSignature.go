@@ -0,0 +1,553 @@
+package core
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+)
+
+var (
+	// ErrHttpSignatureInvalidComponent is returned when a covered component cannot be part of a
+	// signature base: unknown derived component, malformed name, or a value spanning several lines.
+	ErrHttpSignatureInvalidComponent = errors.New("invalid http signature component")
+	// ErrHttpSignatureMalformed is returned when a Signature-Input or Signature header cannot be parsed.
+	ErrHttpSignatureMalformed = errors.New("malformed http signature header")
+)
+
+// HttpSignatureParamsComponent is the component that closes every signature base. It cannot be
+// covered explicitly.
+const HttpSignatureParamsComponent = "@signature-params"
+
+// HttpSignatureDerivedComponents lists the derived components (RFC 9421, section 2.2) a signature
+// may cover. Any other component must name an HTTP field, in lowercase.
+var HttpSignatureDerivedComponents = []string{
+	"@method", "@target-uri", "@authority", "@scheme", "@request-target", "@path", "@query",
+}
+
+// HttpSignatureAlgs maps JWS algorithms to their HTTP Signature Algorithms registry name, when they
+// have one (RFC 9421, section 6.2.2). The signature bytes are the same in both registries.
+//
+// Other algorithms are still usable: the alg parameter is then left out, and the verifier reads the
+// algorithm from the key (RFC 9421, section 3.3.7).
+var HttpSignatureAlgs = map[jwa.Alg]string{
+	jwa.EdDSA: "ed25519",
+	jwa.ES256: "ecdsa-p256-sha256",
+	jwa.ES384: "ecdsa-p384-sha384",
+	jwa.RS256: "rsa-v1_5-sha256",
+	jwa.PS512: "rsa-pss-sha512",
+}
+
+// HttpSignatureParams are the signature parameters of an HTTP message signature: the ordered list
+// of covered components, and the metadata signed along with them.
+//
+// See RFC 9421, section 2.3: https://datatracker.ietf.org/doc/html/rfc9421#section-2.3
+type HttpSignatureParams struct {
+	// Components lists the covered component identifiers, in signing order.
+	Components []string
+	// Created is the signature creation time. Zero when absent.
+	Created time.Time
+	// Expires is the time after which the signature must be refused. Zero when absent.
+	Expires time.Time
+	// Nonce is an optional value unique to the signature.
+	Nonce string
+	// Alg is the HTTP Signature Algorithms registry name of the algorithm. See [HttpSignatureAlgs].
+	Alg string
+	// KeyID identifies the signing key.
+	KeyID string
+	// Tag is an optional application-specific tag for the signature.
+	Tag string
+	// Raw is the inner list the params were parsed from, in a Signature-Input field. Signers may
+	// order the parameters as they like, and add some this service does not know: the signature
+	// base holds the list exactly as signed. Empty for params built by this service.
+	Raw string
+}
+
+// String returns the serialization of params as a structured field inner list, which is both
+// the value of the "@signature-params" line and the Signature-Input member. Parsed params
+// serialize back to their Raw list, verbatim.
+func (params *HttpSignatureParams) String() string {
+	if params.Raw != "" {
+		return params.Raw
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString("(")
+
+	for i, component := range params.Components {
+		if i > 0 {
+			builder.WriteString(" ")
+		}
+
+		builder.WriteString(httpSfString(component))
+	}
+
+	builder.WriteString(")")
+
+	if !params.Created.IsZero() {
+		builder.WriteString(";created=" + strconv.FormatInt(params.Created.Unix(), 10))
+	}
+
+	if !params.Expires.IsZero() {
+		builder.WriteString(";expires=" + strconv.FormatInt(params.Expires.Unix(), 10))
+	}
+
+	for _, param := range params.stringParams() {
+		if *param.value != "" {
+			builder.WriteString(";" + param.name + "=" + httpSfString(*param.value))
+		}
+	}
+
+	return builder.String()
+}
+
+// Validate checks that params can be serialized without ambiguity.
+func (params *HttpSignatureParams) Validate() error {
+	for _, component := range params.Components {
+		err := httpSignatureCheckName(component)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, param := range params.stringParams() {
+		if !httpIsPrintable(*param.value) {
+			return fmt.Errorf("%w: %s value %q", ErrHttpSignatureInvalidComponent, param.name, *param.value)
+		}
+	}
+
+	return nil
+}
+
+// httpSignatureStringParam points to a string parameter of [HttpSignatureParams].
+type httpSignatureStringParam struct {
+	name  string
+	value *string
+}
+
+// stringParams lists the string parameters of params, in serialization order.
+func (params *HttpSignatureParams) stringParams() []httpSignatureStringParam {
+	return []httpSignatureStringParam{
+		{"nonce", &params.Nonce},
+		{"alg", &params.Alg},
+		{"keyid", &params.KeyID},
+		{"tag", &params.Tag},
+	}
+}
+
+// HttpSignatureBase returns the signature base covering values, the values of the components
+// listed in params, in the same order.
+//
+// See RFC 9421, section 2.5: https://datatracker.ietf.org/doc/html/rfc9421#section-2.5
+func HttpSignatureBase(params *HttpSignatureParams, values []string) ([]byte, error) {
+	if len(values) != len(params.Components) {
+		return nil, fmt.Errorf(
+			"%w: %d values for %d components", ErrHttpSignatureInvalidComponent, len(values), len(params.Components),
+		)
+	}
+
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	var builder strings.Builder
+
+	for i, component := range params.Components {
+		// A line break would let a value forge the lines that follow it.
+		if strings.ContainsAny(values[i], "\r\n") {
+			return nil, fmt.Errorf("%w: value of %s spans several lines", ErrHttpSignatureInvalidComponent, component)
+		}
+
+		builder.WriteString(httpSfString(component) + ": " + values[i] + "\n")
+	}
+
+	builder.WriteString(httpSfString(HttpSignatureParamsComponent) + ": " + params.String())
+
+	return []byte(builder.String()), nil
+}
+
+// httpSignatureCheckName returns an error if component is not a derived component this service
+// knows, nor a lowercase HTTP field name.
+func httpSignatureCheckName(component string) error {
+	if strings.HasPrefix(component, "@") {
+		if !slices.Contains(HttpSignatureDerivedComponents, component) {
+			return fmt.Errorf("%w: unsupported derived component %s", ErrHttpSignatureInvalidComponent, component)
+		}
+
+		return nil
+	}
+
+	if component == "" {
+		return fmt.Errorf("%w: empty component name", ErrHttpSignatureInvalidComponent)
+	}
+
+	for _, char := range component {
+		isLower := char >= 'a' && char <= 'z'
+		isDigit := char >= '0' && char <= '9'
+
+		if !isLower && !isDigit && !strings.ContainsRune("!#$%&'*+-.^_`|~", char) {
+			return fmt.Errorf("%w: invalid field name %q", ErrHttpSignatureInvalidComponent, component)
+		}
+	}
+
+	return nil
+}
+
+// ParseHttpSignatureInput reads a Signature-Input field value: a dictionary mapping each signature
+// label to its parameters.
+func ParseHttpSignatureInput(value string) (map[string]*HttpSignatureParams, error) {
+	output := make(map[string]*HttpSignatureParams)
+
+	parser := &httpSfParser{input: value}
+
+	err := parser.dictionary(func(label string) error {
+		start := parser.pos
+
+		components, err := parser.innerList()
+		if err != nil {
+			return err
+		}
+
+		params := &HttpSignatureParams{Components: components}
+
+		err = parser.params(func(name string, value any) error {
+			return params.set(name, value)
+		})
+		if err != nil {
+			return err
+		}
+
+		params.Raw = value[start:parser.pos]
+		output[label] = params
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// ParseHttpByteSequences reads a dictionary of byte sequences, such as a Signature field value
+// (mapping each signature label to its signature) or a Content-Digest one (RFC 9530).
+func ParseHttpByteSequences(value string) (map[string][]byte, error) {
+	output := make(map[string][]byte)
+
+	parser := &httpSfParser{input: value}
+
+	err := parser.dictionary(func(label string) error {
+		value, err := parser.byteSequence()
+		if err != nil {
+			return err
+		}
+
+		output[label] = value
+
+		// Parameters are allowed on the member, but carry no meaning here.
+		return parser.params(func(string, any) error { return nil })
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// set assigns a parsed signature parameter. Unknown parameters are ignored, as the structured
+// field specification requires; they still take part in the signature base, through Raw.
+func (params *HttpSignatureParams) set(name string, value any) error {
+	switch name {
+	case "created", "expires":
+		timestamp, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("%w: %s must be an integer", ErrHttpSignatureMalformed, name)
+		}
+
+		if name == "created" {
+			params.Created = time.Unix(timestamp, 0)
+		} else {
+			params.Expires = time.Unix(timestamp, 0)
+		}
+	default:
+		for _, param := range params.stringParams() {
+			if param.name != name {
+				continue
+			}
+
+			str, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("%w: %s must be a string", ErrHttpSignatureMalformed, name)
+			}
+
+			*param.value = str
+		}
+	}
+
+	return nil
+}
+
+// httpSfString serializes value as a structured field string.
+func httpSfString(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// httpIsPrintable reports whether value only holds printable ASCII, as structured field strings require.
+func httpIsPrintable(value string) bool {
+	for _, char := range value {
+		if char < ' ' || char > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// httpSfParser reads the subset of structured fields (RFC 8941) HTTP message signatures use:
+// dictionaries of inner lists of strings or byte sequences, with string and integer parameters.
+type httpSfParser struct {
+	input string
+	pos   int
+}
+
+func (parser *httpSfParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s at offset %d", ErrHttpSignatureMalformed, fmt.Sprintf(format, args...), parser.pos)
+}
+
+func (parser *httpSfParser) done() bool {
+	return parser.pos >= len(parser.input)
+}
+
+func (parser *httpSfParser) peek() byte {
+	if parser.done() {
+		return 0
+	}
+
+	return parser.input[parser.pos]
+}
+
+func (parser *httpSfParser) skipSpaces(chars string) {
+	for !parser.done() && strings.IndexByte(chars, parser.peek()) >= 0 {
+		parser.pos++
+	}
+}
+
+// dictionary reads every member of a dictionary, calling member once its key is consumed.
+func (parser *httpSfParser) dictionary(member func(key string) error) error {
+	parser.skipSpaces(" ")
+
+	for !parser.done() {
+		key, err := parser.key()
+		if err != nil {
+			return err
+		}
+
+		if parser.peek() != '=' {
+			return parser.errorf("expected '=' after %s", key)
+		}
+
+		parser.pos++
+
+		err = member(key)
+		if err != nil {
+			return err
+		}
+
+		parser.skipSpaces(" \t")
+
+		if parser.done() {
+			return nil
+		}
+
+		if parser.peek() != ',' {
+			return parser.errorf("expected ','")
+		}
+
+		parser.pos++
+		parser.skipSpaces(" \t")
+
+		if parser.done() {
+			return parser.errorf("trailing ','")
+		}
+	}
+
+	return nil
+}
+
+func (parser *httpSfParser) key() (string, error) {
+	start := parser.pos
+
+	for !parser.done() {
+		char := parser.peek()
+		if (char < 'a' || char > 'z') && (char < '0' || char > '9') && !strings.ContainsRune("_-.*", rune(char)) {
+			break
+		}
+
+		parser.pos++
+	}
+
+	if start == parser.pos {
+		return "", parser.errorf("expected a key")
+	}
+
+	return parser.input[start:parser.pos], nil
+}
+
+func (parser *httpSfParser) innerList() ([]string, error) {
+	if parser.peek() != '(' {
+		return nil, parser.errorf("expected '('")
+	}
+
+	parser.pos++
+
+	output := make([]string, 0)
+
+	for {
+		parser.skipSpaces(" ")
+
+		if parser.peek() == ')' {
+			parser.pos++
+
+			return output, nil
+		}
+
+		item, err := parser.string()
+		if err != nil {
+			return nil, err
+		}
+
+		// Component parameters (";sf", ";key", ...) are not supported.
+		if parser.peek() == ';' {
+			return nil, fmt.Errorf("%w: component parameters on %s", ErrHttpSignatureInvalidComponent, item)
+		}
+
+		output = append(output, item)
+	}
+}
+
+func (parser *httpSfParser) params(param func(name string, value any) error) error {
+	for parser.peek() == ';' {
+		parser.pos++
+		parser.skipSpaces(" ")
+
+		name, err := parser.key()
+		if err != nil {
+			return err
+		}
+
+		var value any = true
+
+		if parser.peek() == '=' {
+			parser.pos++
+
+			value, err = parser.bareItem()
+			if err != nil {
+				return err
+			}
+		}
+
+		err = param(name, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (parser *httpSfParser) bareItem() (any, error) {
+	switch char := parser.peek(); {
+	case char == '"':
+		return parser.string()
+	case char == '-' || (char >= '0' && char <= '9'):
+		start := parser.pos
+		parser.pos++
+
+		for !parser.done() && parser.peek() >= '0' && parser.peek() <= '9' {
+			parser.pos++
+		}
+
+		value, err := strconv.ParseInt(parser.input[start:parser.pos], 10, 64)
+		if err != nil {
+			return nil, parser.errorf("invalid integer")
+		}
+
+		return value, nil
+	case char == ':':
+		return parser.byteSequence()
+	default:
+		// Tokens and booleans carry no meaning for the parameters read here.
+		start := parser.pos
+
+		for !parser.done() && !strings.ContainsRune(";, \t)", rune(parser.peek())) {
+			parser.pos++
+		}
+
+		if start == parser.pos {
+			return nil, parser.errorf("expected a value")
+		}
+
+		return parser.input[start:parser.pos], nil
+	}
+}
+
+func (parser *httpSfParser) string() (string, error) {
+	if parser.peek() != '"' {
+		return "", parser.errorf("expected '\"'")
+	}
+
+	parser.pos++
+
+	var builder strings.Builder
+
+	for !parser.done() {
+		char := parser.peek()
+		parser.pos++
+
+		switch {
+		case char == '"':
+			return builder.String(), nil
+		case char == '\\':
+			if parser.done() || (parser.peek() != '"' && parser.peek() != '\\') {
+				return "", parser.errorf("invalid escape")
+			}
+
+			builder.WriteByte(parser.peek())
+			parser.pos++
+		case char < ' ' || char > '~':
+			return "", parser.errorf("invalid character in string")
+		default:
+			builder.WriteByte(char)
+		}
+	}
+
+	return "", parser.errorf("unterminated string")
+}
+
+func (parser *httpSfParser) byteSequence() ([]byte, error) {
+	if parser.peek() != ':' {
+		return nil, parser.errorf("expected ':'")
+	}
+
+	end := strings.IndexByte(parser.input[parser.pos+1:], ':')
+	if end < 0 {
+		return nil, parser.errorf("unterminated byte sequence")
+	}
+
+	encoded := parser.input[parser.pos+1 : parser.pos+1+end]
+	parser.pos += end + 2 //nolint:mnd // both ':' delimiters.
+
+	value, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, parser.errorf("invalid base64: %v", err)
+	}
+
+	return value, nil
+}
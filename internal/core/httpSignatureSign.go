@@ -0,0 +1,109 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+)
+
+// HttpSignatureSignServiceFreeze is the signing freeze dependency of [HttpSignatureSign].
+type HttpSignatureSignServiceFreeze interface {
+	Exec(ctx context.Context, request *SigningFreezeCheckRequest) error
+}
+
+// HttpSignatureSignRequest holds the parameters for a [HttpSignatureSign.Exec] call.
+type HttpSignatureSignRequest struct {
+	// Usage identifies the key to sign with. See [config.Jwk].
+	Usage string
+	// Params lists the covered components and the optional signature metadata. Alg and KeyID are
+	// set by the service.
+	Params *HttpSignatureParams
+	// Values holds the value of each covered component, in the order of Params.Components.
+	Values []string
+}
+
+// HttpSignatureSignResponse holds the result of a [HttpSignatureSign.Exec] call.
+type HttpSignatureSignResponse struct {
+	// Params are the signature parameters that were signed, alg and keyid included. Their
+	// serialization is the Signature-Input member of the signature.
+	Params *HttpSignatureParams
+	// Signature is the raw signature over the signature base.
+	Signature []byte
+}
+
+// A HttpSignatureSign signs the signature base of an HTTP message, following RFC 9421. The caller
+// extracts the covered component values from the message; the service builds the signature base
+// itself, so it only ever signs well-formed bases.
+//
+// A signature base always holds a '"', which never occurs in a JWS signing input: a signature from
+// this service cannot pass for a token signature, although both come from the same key.
+//
+// No message is signed while the signing of the usage is frozen: see [SigningFreezeSet].
+type HttpSignatureSign struct {
+	sources       *JwkPrivateSources
+	serviceFreeze HttpSignatureSignServiceFreeze
+	keysConfig    map[string]*config.Jwk
+}
+
+// NewHttpSignatureSign creates a HttpSignatureSign service. Sources provide the per-usage private
+// keys (see [NewJwkPrivateSource]); serviceFreeze tells the frozen usages apart, and is nil when
+// signing cannot be frozen.
+func NewHttpSignatureSign(
+	sources *JwkPrivateSources, serviceFreeze HttpSignatureSignServiceFreeze, keysConfig map[string]*config.Jwk,
+) *HttpSignatureSign {
+	return &HttpSignatureSign{sources: sources, serviceFreeze: serviceFreeze, keysConfig: keysConfig}
+}
+
+func (service *HttpSignatureSign) Exec(
+	ctx context.Context, request *HttpSignatureSignRequest,
+) (*HttpSignatureSignResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.HttpSignatureSign")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("key.usage", request.Usage),
+		attribute.StringSlice("signature.components", request.Params.Components),
+	)
+
+	keyConfig, ok := service.keysConfig[request.Usage]
+	if !ok {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: %s", ErrConfigNotFound, request.Usage))
+	}
+
+	if service.serviceFreeze != nil {
+		err := service.serviceFreeze.Exec(ctx, &SigningFreezeCheckRequest{Usage: request.Usage})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("check freeze: %w", err))
+		}
+	}
+
+	key, err := service.sources.SigningKey(ctx, request.Usage, keyConfig)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(attribute.String("key.kid", key.KID))
+
+	params := *request.Params
+	params.KeyID = key.KID
+	params.Alg = HttpSignatureAlgs[key.Alg]
+
+	base, err := HttpSignatureBase(&params, request.Values)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	signature, err := jwsSignRaw(ctx, key, base)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("sign signature base: %w", err))
+	}
+
+	recordSignature(ctx, SignatureOperationHttp, request.Usage, key.KID)
+
+	return otel.ReportSuccess(span, &HttpSignatureSignResponse{Params: &params, Signature: signature}), nil
+}
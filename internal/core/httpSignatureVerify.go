@@ -0,0 +1,122 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/jwt/v2/jwk"
+	"github.com/a-novel-kit/jwt/v2/jws"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+)
+
+var (
+	// ErrHttpSignatureExpired is returned when a signature is past its expires parameter.
+	ErrHttpSignatureExpired = errors.New("http signature expired")
+	// ErrHttpSignatureMissingKeyID is returned when a signature has no keyid parameter.
+	ErrHttpSignatureMissingKeyID = errors.New("http signature has no keyid")
+)
+
+// HttpSignatureVerifyRequest holds the parameters for a [HttpSignatureVerify.Exec] call.
+type HttpSignatureVerifyRequest struct {
+	// Usage identifies the keys to verify with. See [config.Jwk].
+	Usage string
+	// Params are the signature parameters, read from the Signature-Input field.
+	Params *HttpSignatureParams
+	// Values holds the value of each covered component, in the order of Params.Components.
+	Values []string
+	// Signature is the raw signature, read from the Signature field.
+	Signature []byte
+}
+
+// A HttpSignatureVerify checks an HTTP message signature issued by [HttpSignatureSign], resolving
+// its keyid among the public keys of the usage.
+//
+// The key must use an algorithm of the usage, current or previous (see [config.Jwk.PreviousAlgs]),
+// allowed by its policy; an alg parameter, when present, must match it. It returns an error
+// wrapping [jws.ErrInvalidSignature] when the signature does not verify.
+type HttpSignatureVerify struct {
+	sources    *JwkPublicSources
+	keysConfig map[string]*config.Jwk
+}
+
+// NewHttpSignatureVerify creates a HttpSignatureVerify service. Sources provide the per-usage
+// public keys (see [NewJwkPublicSource]).
+func NewHttpSignatureVerify(sources *JwkPublicSources, keysConfig map[string]*config.Jwk) *HttpSignatureVerify {
+	return &HttpSignatureVerify{sources: sources, keysConfig: keysConfig}
+}
+
+func (service *HttpSignatureVerify) Exec(ctx context.Context, request *HttpSignatureVerifyRequest) error {
+	ctx, span := otel.Tracer().Start(ctx, "core.HttpSignatureVerify")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("key.usage", request.Usage),
+		attribute.String("key.kid", request.Params.KeyID),
+	)
+
+	keyConfig, ok := service.keysConfig[request.Usage]
+	if !ok {
+		return otel.ReportError(span, fmt.Errorf("%w: %s", ErrConfigNotFound, request.Usage))
+	}
+
+	source, ok := service.sources.Usage(request.Usage)
+	if !ok {
+		return otel.ReportError(span, fmt.Errorf("%w: no key source for usage %s", ErrConfigNotFound, request.Usage))
+	}
+
+	if request.Params.KeyID == "" {
+		return otel.ReportError(span, ErrHttpSignatureMissingKeyID)
+	}
+
+	if !request.Params.Expires.IsZero() && time.Now().After(request.Params.Expires) {
+		return otel.ReportError(span, fmt.Errorf("%w: at %s", ErrHttpSignatureExpired, request.Params.Expires))
+	}
+
+	key, err := source.Get(ctx, request.Params.KeyID)
+	if errors.Is(err, jwk.ErrKeyNotFound) {
+		return otel.ReportError(span, fmt.Errorf("%w: %w", jws.ErrInvalidSignature, err))
+	}
+
+	if err != nil {
+		return otel.ReportError(span, fmt.Errorf("get key: %w", err))
+	}
+
+	err = keyConfig.Policy.CheckAlg(key.Alg)
+	if err != nil {
+		return otel.ReportError(span, err)
+	}
+
+	if !slices.Contains(keyConfig.Algs(), key.Alg) {
+		return otel.ReportError(span, fmt.Errorf(
+			"%w: algorithm %s is not used by usage %s", jws.ErrInvalidSignature, key.Alg, request.Usage,
+		))
+	}
+
+	// Without an alg parameter, the algorithm comes from the key alone (RFC 9421, section 3.3.7).
+	if request.Params.Alg != "" && request.Params.Alg != HttpSignatureAlgs[key.Alg] {
+		return otel.ReportError(span, fmt.Errorf(
+			"%w: alg %s does not match key algorithm %s", jws.ErrInvalidSignature, request.Params.Alg, key.Alg,
+		))
+	}
+
+	base, err := HttpSignatureBase(request.Params, request.Values)
+	if err != nil {
+		return otel.ReportError(span, err)
+	}
+
+	err = jwsVerifyRaw(key, base, request.Signature)
+	if err != nil {
+		return otel.ReportError(span, err)
+	}
+
+	otel.ReportSuccessNoContent(span)
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// JwkAlgMigrationDaoSearch is the DAO search dependency of [JwkAlgMigration].
+type JwkAlgMigrationDaoSearch interface {
+	Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)
+}
+
+// JwkAlgMigrationServiceExtract is the service dependency of [JwkAlgMigration] for reading the
+// algorithm of stored keys.
+type JwkAlgMigrationServiceExtract interface {
+	Exec(ctx context.Context, request *JwkExtractRequest) (*Jwk, error)
+}
+
+// JwkAlgMigrationRequest holds the parameters for a [JwkAlgMigration.Exec] call.
+type JwkAlgMigrationRequest struct {
+	// Usage is the key usage to report on.
+	Usage string
+}
+
+// JwkAlgMigrationStatus reports the progress of a usage's move away from its previous algorithms.
+type JwkAlgMigrationStatus struct {
+	// Alg is the algorithm the usage signs with.
+	Alg jwa.Alg
+	// PreviousAlgs are the algorithms still accepted for verification.
+	PreviousAlgs []jwa.Alg
+	// LegacyKeys is the number of active keys that do not use Alg.
+	LegacyKeys int
+	// LegacyExpiresAt is when the last legacy key expires. Zero when there is none.
+	LegacyExpiresAt time.Time
+	// Complete is true once every active key uses Alg: no token signed with a previous algorithm
+	// can verify anymore, and PreviousAlgs may be dropped from the configuration.
+	Complete bool
+}
+
+// A JwkAlgMigration reports how far a usage is in migrating from its previous algorithms
+// to its current one. See [config.Jwk.PreviousAlgs].
+type JwkAlgMigration struct {
+	daoSearch      JwkAlgMigrationDaoSearch
+	serviceExtract JwkAlgMigrationServiceExtract
+	keysConfig     map[string]*config.Jwk
+}
+
+// NewJwkAlgMigration returns a new JwkAlgMigration service.
+func NewJwkAlgMigration(
+	daoSearch JwkAlgMigrationDaoSearch,
+	serviceExtract JwkAlgMigrationServiceExtract,
+	keysConfig map[string]*config.Jwk,
+) *JwkAlgMigration {
+	return &JwkAlgMigration{
+		daoSearch:      daoSearch,
+		serviceExtract: serviceExtract,
+		keysConfig:     keysConfig,
+	}
+}
+
+func (service *JwkAlgMigration) Exec(
+	ctx context.Context, request *JwkAlgMigrationRequest,
+) (*JwkAlgMigrationStatus, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.JwkAlgMigration")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("key.usage", request.Usage))
+
+	keyConfig, ok := service.keysConfig[request.Usage]
+	if !ok {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: %s", ErrConfigNotFound, request.Usage))
+	}
+
+	entities, err := service.daoSearch.Exec(ctx, &dao.JwkSearchRequest{Usage: request.Usage})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list keys: %w", err))
+	}
+
+	output := &JwkAlgMigrationStatus{
+		Alg:          keyConfig.Alg,
+		PreviousAlgs: keyConfig.PreviousAlgs,
+	}
+
+	for _, entity := range entities {
+		// The algorithm is only recorded in the key payload. The public half carries it as well,
+		// and does not need decrypting.
+		key, err := service.serviceExtract.Exec(ctx, &JwkExtractRequest{Jwk: entity})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("consume DAO entity (kid %s): %w", entity.ID, err))
+		}
+
+		if key.Alg == keyConfig.Alg {
+			continue
+		}
+
+		output.LegacyKeys++
+
+		if entity.ExpiresAt.After(output.LegacyExpiresAt) {
+			output.LegacyExpiresAt = entity.ExpiresAt
+		}
+	}
+
+	output.Complete = output.LegacyKeys == 0
+
+	span.SetAttributes(
+		attribute.Int("keys.legacy", output.LegacyKeys),
+		attribute.Bool("migration.complete", output.Complete),
+	)
+
+	return otel.ReportSuccess(span, output), nil
+}
@@ -0,0 +1,172 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestJwkAlgMigration(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	now := time.Now()
+
+	keys := []*dao.Jwk{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			PublicKey: lo.ToPtr("cHVibGljLWtleS0z"),
+			Usage:     "test-usage",
+			CreatedAt: now.Add(-time.Hour),
+			ExpiresAt: now.Add(23 * time.Hour),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			PublicKey: lo.ToPtr("cHVibGljLWtleS0y"),
+			Usage:     "test-usage",
+			CreatedAt: now.Add(-2 * time.Hour),
+			ExpiresAt: now.Add(22 * time.Hour),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			PublicKey: lo.ToPtr("cHVibGljLWtleS0x"),
+			Usage:     "test-usage",
+			CreatedAt: now.Add(-3 * time.Hour),
+			ExpiresAt: now.Add(21 * time.Hour),
+		},
+	}
+
+	keysConfig := map[string]*config.Jwk{
+		"test-usage": {Alg: jwa.EdDSA, PreviousAlgs: []jwa.Alg{jwa.ES256}},
+	}
+
+	type daoSearchMock struct {
+		resp []*dao.Jwk
+		err  error
+	}
+
+	type serviceExtractMock struct {
+		resp *core.Jwk
+		err  error
+	}
+
+	extracted := func(alg jwa.Alg) *serviceExtractMock {
+		return &serviceExtractMock{resp: &core.Jwk{JWKCommon: jwa.JWKCommon{Alg: alg}}}
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.JwkAlgMigrationRequest
+
+		daoSearchMock      *daoSearchMock
+		serviceExtractMock []*serviceExtractMock
+
+		expect    *core.JwkAlgMigrationStatus
+		expectErr error
+	}{
+		{
+			name: "Success/InProgress",
+
+			request: &core.JwkAlgMigrationRequest{Usage: "test-usage"},
+
+			daoSearchMock: &daoSearchMock{resp: keys},
+			serviceExtractMock: []*serviceExtractMock{
+				extracted(jwa.EdDSA),
+				extracted(jwa.ES256),
+				extracted(jwa.ES256),
+			},
+
+			expect: &core.JwkAlgMigrationStatus{
+				Alg:             jwa.EdDSA,
+				PreviousAlgs:    []jwa.Alg{jwa.ES256},
+				LegacyKeys:      2,
+				LegacyExpiresAt: keys[1].ExpiresAt,
+			},
+		},
+		{
+			name: "Success/Complete",
+
+			request: &core.JwkAlgMigrationRequest{Usage: "test-usage"},
+
+			daoSearchMock:      &daoSearchMock{resp: keys[:1]},
+			serviceExtractMock: []*serviceExtractMock{extracted(jwa.EdDSA)},
+
+			expect: &core.JwkAlgMigrationStatus{
+				Alg:          jwa.EdDSA,
+				PreviousAlgs: []jwa.Alg{jwa.ES256},
+				Complete:     true,
+			},
+		},
+		{
+			name: "Error/Extract",
+
+			request: &core.JwkAlgMigrationRequest{Usage: "test-usage"},
+
+			daoSearchMock:      &daoSearchMock{resp: keys[:1]},
+			serviceExtractMock: []*serviceExtractMock{{err: errFoo}},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Search",
+
+			request: &core.JwkAlgMigrationRequest{Usage: "test-usage"},
+
+			daoSearchMock: &daoSearchMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ConfigNotFound",
+
+			request: &core.JwkAlgMigrationRequest{Usage: "unknown-usage"},
+
+			expectErr: core.ErrConfigNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoSearch := coremocks.NewMockJwkAlgMigrationDaoSearch(t)
+			serviceExtract := coremocks.NewMockJwkAlgMigrationServiceExtract(t)
+
+			if testCase.daoSearchMock != nil {
+				daoSearch.EXPECT().
+					Exec(mock.Anything, &dao.JwkSearchRequest{Usage: testCase.request.Usage}).
+					Return(testCase.daoSearchMock.resp, testCase.daoSearchMock.err)
+			}
+
+			for i, extractMock := range testCase.serviceExtractMock {
+				serviceExtract.EXPECT().
+					Exec(mock.Anything, &core.JwkExtractRequest{Jwk: testCase.daoSearchMock.resp[i]}).
+					Return(extractMock.resp, extractMock.err).
+					Once()
+			}
+
+			service := core.NewJwkAlgMigration(daoSearch, serviceExtract, keysConfig)
+
+			res, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			daoSearch.AssertExpectations(t)
+			serviceExtract.AssertExpectations(t)
+		})
+	}
+}
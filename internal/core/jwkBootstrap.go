@@ -0,0 +1,133 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// JwkBootstrapDaoLock is the DAO lock dependency of [JwkBootstrap].
+type JwkBootstrapDaoLock interface {
+	Exec(ctx context.Context, request *dao.JwkLockRequest) error
+}
+
+// JwkBootstrapDaoSearch is the DAO search dependency of [JwkBootstrap].
+type JwkBootstrapDaoSearch interface {
+	Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)
+}
+
+// JwkBootstrapServiceGen is the per-usage generation dependency of [JwkBootstrap].
+type JwkBootstrapServiceGen interface {
+	Exec(ctx context.Context, request *JwkGenRequest) (*Jwk, error)
+}
+
+// JwkBootstrapRequest holds the parameters for a [JwkBootstrap.Exec] call. The set of usages
+// to bootstrap comes from configuration, so it is empty.
+type JwkBootstrapRequest struct{}
+
+// JwkBootstrapResponse reports the outcome of a [JwkBootstrap.Exec] call.
+type JwkBootstrapResponse struct {
+	// Generated lists, sorted, the usages that had no active key and got one.
+	Generated []string
+}
+
+// A JwkBootstrap generates a first key for every configured usage that has no active key, so
+// a fresh environment can sign without waiting for the rotation job. Usages that have a key are
+// left alone, whatever its age: rotating them is the job's business.
+//
+// Each usage is checked and generated in its own transaction, under the usage's lock: servers
+// bootstrapping at the same moment wait for each other, and the ones after the first find its
+// key instead of generating another. Usages are processed in order, so locks are always taken
+// in the same order.
+type JwkBootstrap struct {
+	daoLock    JwkBootstrapDaoLock
+	daoSearch  JwkBootstrapDaoSearch
+	serviceGen JwkBootstrapServiceGen
+	transactor transaction.Transactor
+	keysConfig map[string]*config.Jwk
+}
+
+// NewJwkBootstrap returns a new JwkBootstrap service.
+func NewJwkBootstrap(
+	daoLock JwkBootstrapDaoLock,
+	daoSearch JwkBootstrapDaoSearch,
+	serviceGen JwkBootstrapServiceGen,
+	transactor transaction.Transactor,
+	keysConfig map[string]*config.Jwk,
+) *JwkBootstrap {
+	return &JwkBootstrap{
+		daoLock:    daoLock,
+		daoSearch:  daoSearch,
+		serviceGen: serviceGen,
+		transactor: transactor,
+		keysConfig: keysConfig,
+	}
+}
+
+func (service *JwkBootstrap) Exec(ctx context.Context, _ *JwkBootstrapRequest) (*JwkBootstrapResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.JwkBootstrap")
+	defer span.End()
+
+	usages := lo.Keys(service.keysConfig)
+	slices.Sort(usages)
+
+	span.SetAttributes(attribute.Int("keys.usages", len(usages)))
+
+	output := &JwkBootstrapResponse{Generated: make([]string, 0)}
+
+	for _, usage := range usages {
+		generated, err := service.bootstrap(ctx, usage)
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("bootstrap usage %s: %w", usage, err))
+		}
+
+		if generated {
+			output.Generated = append(output.Generated, usage)
+		}
+	}
+
+	span.SetAttributes(attribute.StringSlice("keys.generated", output.Generated))
+
+	return otel.ReportSuccess(span, output), nil
+}
+
+// bootstrap generates a key for usage if it has none. It reports whether it did.
+func (service *JwkBootstrap) bootstrap(ctx context.Context, usage string) (bool, error) {
+	var generated bool
+
+	err := service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		err := service.daoLock.Exec(ctx, &dao.JwkLockRequest{Usage: usage})
+		if err != nil {
+			return fmt.Errorf("lock keys: %w", err)
+		}
+
+		keys, err := service.daoSearch.Exec(ctx, &dao.JwkSearchRequest{Usage: usage})
+		if err != nil {
+			return fmt.Errorf("list keys: %w", err)
+		}
+
+		if len(keys) > 0 {
+			return nil
+		}
+
+		_, err = service.serviceGen.Exec(ctx, &JwkGenRequest{Usage: usage})
+		if err != nil {
+			return fmt.Errorf("generate key: %w", err)
+		}
+
+		generated = true
+
+		return nil
+	})
+
+	return generated, err
+}
@@ -0,0 +1,184 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestJwkBootstrap(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	keysConfig := map[string]*config.Jwk{"auth": {}, "refresh": {}}
+
+	existingKeys := []*dao.Jwk{{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")}}
+
+	type daoSearchMock struct {
+		resp []*dao.Jwk
+		err  error
+	}
+
+	type serviceGenMock struct {
+		err error
+	}
+
+	testCases := []struct {
+		name string
+
+		daoLockErr      error
+		daoSearchMocks  map[string]*daoSearchMock
+		serviceGenMocks map[string]*serviceGenMock
+
+		expect    *core.JwkBootstrapResponse
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			daoSearchMocks: map[string]*daoSearchMock{
+				"auth":    {},
+				"refresh": {},
+			},
+			serviceGenMocks: map[string]*serviceGenMock{
+				"auth":    {},
+				"refresh": {},
+			},
+
+			expect: &core.JwkBootstrapResponse{Generated: []string{"auth", "refresh"}},
+		},
+		{
+			// Usages with a key are not rotated, however old it is.
+			name: "Success/ExistingKey",
+
+			daoSearchMocks: map[string]*daoSearchMock{
+				"auth":    {resp: existingKeys},
+				"refresh": {},
+			},
+			serviceGenMocks: map[string]*serviceGenMock{
+				"refresh": {},
+			},
+
+			expect: &core.JwkBootstrapResponse{Generated: []string{"refresh"}},
+		},
+		{
+			name: "Success/NothingMissing",
+
+			daoSearchMocks: map[string]*daoSearchMock{
+				"auth":    {resp: existingKeys},
+				"refresh": {resp: existingKeys},
+			},
+
+			expect: &core.JwkBootstrapResponse{Generated: []string{}},
+		},
+		{
+			// Keys are read only under the lock.
+			name: "Error/Lock",
+
+			daoLockErr: errFoo,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Search",
+
+			daoSearchMocks: map[string]*daoSearchMock{
+				"auth": {err: errFoo},
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Gen",
+
+			daoSearchMocks: map[string]*daoSearchMock{
+				"auth": {},
+			},
+			serviceGenMocks: map[string]*serviceGenMock{
+				"auth": {err: errFoo},
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoLock := coremocks.NewMockJwkBootstrapDaoLock(t)
+			daoSearch := coremocks.NewMockJwkBootstrapDaoSearch(t)
+			serviceGen := coremocks.NewMockJwkBootstrapServiceGen(t)
+
+			// Usages are processed in order: a failure stops at auth.
+			if testCase.daoLockErr != nil {
+				daoLock.EXPECT().
+					Exec(mock.Anything, &dao.JwkLockRequest{Usage: "auth"}).
+					Return(testCase.daoLockErr)
+			}
+
+			for usage, daoSearchMock := range testCase.daoSearchMocks {
+				daoLock.EXPECT().
+					Exec(mock.Anything, &dao.JwkLockRequest{Usage: usage}).
+					Return(nil)
+
+				daoSearch.EXPECT().
+					Exec(mock.Anything, &dao.JwkSearchRequest{Usage: usage}).
+					Return(daoSearchMock.resp, daoSearchMock.err)
+			}
+
+			for usage, serviceGenMock := range testCase.serviceGenMocks {
+				serviceGen.EXPECT().
+					Exec(mock.Anything, &core.JwkGenRequest{Usage: usage}).
+					Return(&core.Jwk{}, serviceGenMock.err)
+			}
+
+			transactor := transactiontest.NewTransactor()
+
+			service := core.NewJwkBootstrap(daoLock, daoSearch, serviceGen, transactor, keysConfig)
+
+			resp, err := service.Exec(t.Context(), &core.JwkBootstrapRequest{})
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoLock.AssertExpectations(t)
+			daoSearch.AssertExpectations(t)
+			serviceGen.AssertExpectations(t)
+		})
+	}
+}
+
+// TestJwkBootstrapTransactionPerUsage checks that every usage is bootstrapped in its own
+// transaction: the lock of a usage is released as soon as its key is in.
+func TestJwkBootstrapTransactionPerUsage(t *testing.T) {
+	t.Parallel()
+
+	daoLock := coremocks.NewMockJwkBootstrapDaoLock(t)
+	daoSearch := coremocks.NewMockJwkBootstrapDaoSearch(t)
+	serviceGen := coremocks.NewMockJwkBootstrapServiceGen(t)
+
+	daoLock.EXPECT().Exec(mock.Anything, mock.Anything).Return(nil)
+	daoSearch.EXPECT().Exec(mock.Anything, mock.Anything).Return(nil, nil)
+	serviceGen.EXPECT().Exec(mock.Anything, mock.Anything).Return(&core.Jwk{}, nil)
+
+	transactor := transactiontest.NewTransactor()
+
+	service := core.NewJwkBootstrap(
+		daoLock, daoSearch, serviceGen, transactor, map[string]*config.Jwk{"auth": {}, "refresh": {}},
+	)
+
+	_, err := service.Exec(t.Context(), &core.JwkBootstrapRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, transactor.Calls())
+}
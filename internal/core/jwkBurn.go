@@ -0,0 +1,226 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// JwkBurnDaoLock is the DAO lock dependency of [JwkBurn].
+type JwkBurnDaoLock interface {
+	Exec(ctx context.Context, request *dao.JwkLockRequest) error
+}
+
+// JwkBurnDaoSearch is the DAO search dependency of [JwkBurn].
+type JwkBurnDaoSearch interface {
+	Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)
+}
+
+// JwkBurnDaoDelete is the DAO delete dependency of [JwkBurn].
+type JwkBurnDaoDelete interface {
+	Exec(ctx context.Context, request *dao.JwkDeleteRequest) (*dao.Jwk, error)
+}
+
+// JwkBurnDaoInvalidate is the DAO dependency of [JwkBurn] that tells the servers to drop their
+// cached keys.
+type JwkBurnDaoInvalidate interface {
+	Exec(ctx context.Context, request *dao.JwkInvalidateRequest) error
+}
+
+// JwkBurnServiceGen is the generation dependency of [JwkBurn].
+type JwkBurnServiceGen interface {
+	Exec(ctx context.Context, request *JwkGenRequest) (*Jwk, error)
+}
+
+// JwkBurnRequest holds the parameters for a [JwkBurn.Exec] call.
+type JwkBurnRequest struct {
+	// Usage is the key usage to burn.
+	Usage string
+	// Comment is the reason of the burn, kept with every revoked key and in the audit trail.
+	// Required.
+	Comment string
+}
+
+// JwkBurnResponse reports the outcome of a [JwkBurn.Exec] call.
+type JwkBurnResponse struct {
+	// Key is the new main key of the usage, and its only active key.
+	Key *JwkMetadata
+	// Revoked lists the keys of the usage that were active before the burn, newest first.
+	Revoked []*JwkMetadata
+}
+
+// A JwkBurn replaces every key of a usage, when its signing key has been exfiltrated: it revokes
+// all the active keys of the usage, generates a new main key, and tells every server sharing the
+// database to drop its cached keys (see [JwkInvalidationSync]).
+//
+// It all happens in a single transaction, under the usage's lock, so the usage is never left
+// without a key. Tokens signed before the burn stop verifying once the servers, and the
+// consumers, refresh their keys.
+type JwkBurn struct {
+	daoLock        JwkBurnDaoLock
+	daoSearch      JwkBurnDaoSearch
+	daoDelete      JwkBurnDaoDelete
+	daoInvalidate  JwkBurnDaoInvalidate
+	serviceGen     JwkBurnServiceGen
+	serviceExtract JwkMetadataServiceExtract
+	transactor     transaction.Transactor
+	keysConfig     map[string]*config.Jwk
+}
+
+// NewJwkBurn returns a new JwkBurn service.
+func NewJwkBurn(
+	daoLock JwkBurnDaoLock,
+	daoSearch JwkBurnDaoSearch,
+	daoDelete JwkBurnDaoDelete,
+	daoInvalidate JwkBurnDaoInvalidate,
+	serviceGen JwkBurnServiceGen,
+	serviceExtract JwkMetadataServiceExtract,
+	transactor transaction.Transactor,
+	keysConfig map[string]*config.Jwk,
+) *JwkBurn {
+	return &JwkBurn{
+		daoLock:        daoLock,
+		daoSearch:      daoSearch,
+		daoDelete:      daoDelete,
+		daoInvalidate:  daoInvalidate,
+		serviceGen:     serviceGen,
+		serviceExtract: serviceExtract,
+		transactor:     transactor,
+		keysConfig:     keysConfig,
+	}
+}
+
+func (service *JwkBurn) Exec(ctx context.Context, request *JwkBurnRequest) (*JwkBurnResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.JwkBurn")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("key.usage", request.Usage))
+
+	output, err := service.burn(ctx, request)
+	if err != nil {
+		recordAudit(ctx, &AuditRecordRequest{Action: AuditActionJwkBurn, Usage: request.Usage, Err: err})
+
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(
+		attribute.String("key.id", output.Key.KID.String()),
+		attribute.Int("keys.revoked", len(output.Revoked)),
+	)
+
+	recordAudit(ctx, &AuditRecordRequest{
+		Action: AuditActionJwkBurn,
+		Usage:  request.Usage,
+		KID:    output.Key.KID.String(),
+		Detail: request.Comment,
+	})
+
+	return otel.ReportSuccess(span, output), nil
+}
+
+func (service *JwkBurn) burn(ctx context.Context, request *JwkBurnRequest) (*JwkBurnResponse, error) {
+	if request.Comment == "" {
+		return nil, ErrJwkRevokeNoComment
+	}
+
+	if _, ok := service.keysConfig[request.Usage]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrConfigNotFound, request.Usage)
+	}
+
+	output := &JwkBurnResponse{Revoked: make([]*JwkMetadata, 0)}
+
+	err := service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		err := service.daoLock.Exec(ctx, &dao.JwkLockRequest{Usage: request.Usage})
+		if err != nil {
+			return fmt.Errorf("lock usage: %w", err)
+		}
+
+		keys, err := service.daoSearch.Exec(ctx, &dao.JwkSearchRequest{Usage: request.Usage})
+		if err != nil {
+			return fmt.Errorf("list keys: %w", err)
+		}
+
+		now := time.Now()
+
+		for _, key := range keys {
+			revoked, err := service.revoke(ctx, key, now, request.Comment)
+			if err != nil {
+				return err
+			}
+
+			output.Revoked = append(output.Revoked, revoked)
+		}
+
+		_, err = service.serviceGen.Exec(ctx, &JwkGenRequest{Usage: request.Usage, Force: true})
+		if err != nil {
+			return fmt.Errorf("generate key: %w", err)
+		}
+
+		keys, err = service.daoSearch.Exec(ctx, &dao.JwkSearchRequest{Usage: request.Usage})
+		if err != nil {
+			return fmt.Errorf("list keys: %w", err)
+		}
+
+		if len(keys) == 0 {
+			return ErrJwkNotFound
+		}
+
+		output.Key, err = newJwkMetadata(ctx, service.serviceExtract, keys[0])
+		if err != nil {
+			return err
+		}
+
+		output.Key.Main = true
+
+		// Sent when the transaction commits.
+		err = service.daoInvalidate.Exec(ctx, &dao.JwkInvalidateRequest{Usage: request.Usage})
+		if err != nil {
+			return fmt.Errorf("invalidate keys: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("burn usage: %w", err)
+	}
+
+	return output, nil
+}
+
+// revoke revokes one of the burned keys, and records it in the audit trail.
+func (service *JwkBurn) revoke(
+	ctx context.Context, key *dao.Jwk, now time.Time, comment string,
+) (*JwkMetadata, error) {
+	entity, err := service.daoDelete.Exec(ctx, &dao.JwkDeleteRequest{ID: key.ID, Now: now, Comment: comment})
+	if err != nil && !errors.Is(err, dao.ErrJwkDeleteNotFound) {
+		return nil, fmt.Errorf("revoke key %s: %w", key.ID, err)
+	}
+
+	// The key expired between the search and the revocation: it is gone all the same.
+	if err != nil {
+		entity = key
+	}
+
+	revoked, err := newJwkMetadata(ctx, service.serviceExtract, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	recordAudit(ctx, &AuditRecordRequest{
+		Action: AuditActionJwkRevoke,
+		Usage:  revoked.Usage,
+		KID:    revoked.KID.String(),
+		Detail: comment,
+	})
+
+	return revoked, nil
+}
@@ -0,0 +1,287 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestJwkBurn(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	now := time.Now()
+
+	keysConfig := map[string]*config.Jwk{"auth": {}}
+
+	activeKey := func(id string, age time.Duration) *dao.Jwk {
+		return &dao.Jwk{
+			ID:        uuid.MustParse(id),
+			PublicKey: lo.ToPtr("cHVibGljLWtleQ"),
+			Usage:     "auth",
+			CreatedAt: now.Add(-age),
+			ExpiresAt: now.Add(time.Hour),
+		}
+	}
+
+	revokedKey := func(key *dao.Jwk) *dao.Jwk {
+		return &dao.Jwk{
+			ID:             key.ID,
+			PublicKey:      key.PublicKey,
+			Usage:          key.Usage,
+			CreatedAt:      key.CreatedAt,
+			ExpiresAt:      key.ExpiresAt,
+			DeletedAt:      lo.ToPtr(now),
+			DeletedComment: lo.ToPtr("leaked"),
+		}
+	}
+
+	metadata := func(key *dao.Jwk, main bool) *core.JwkMetadata {
+		return &core.JwkMetadata{
+			KID:            key.ID,
+			Usage:          key.Usage,
+			Alg:            jwa.EdDSA,
+			Main:           main,
+			CreatedAt:      key.CreatedAt,
+			ExpiresAt:      key.ExpiresAt,
+			RevokedAt:      key.DeletedAt,
+			RevokedComment: lo.FromPtr(key.DeletedComment),
+		}
+	}
+
+	mainKey := activeKey("00000000-0000-0000-0000-000000000002", time.Minute)
+	legacyKey := activeKey("00000000-0000-0000-0000-000000000001", time.Hour)
+	newKey := activeKey("00000000-0000-0000-0000-000000000003", 0)
+
+	type daoSearchMock struct {
+		resp []*dao.Jwk
+		err  error
+	}
+
+	type daoDeleteMock struct {
+		resp *dao.Jwk
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.JwkBurnRequest
+
+		daoLockErr         *error
+		daoSearchMock      *daoSearchMock
+		daoDeleteMocks     []*daoDeleteMock
+		serviceGenErr      *error
+		daoSearchAfterMock *daoSearchMock
+		daoInvalidateErr   *error
+
+		expect    *core.JwkBurnResponse
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.JwkBurnRequest{Usage: "auth", Comment: "leaked"},
+
+			daoLockErr:    new(error),
+			daoSearchMock: &daoSearchMock{resp: []*dao.Jwk{mainKey, legacyKey}},
+			daoDeleteMocks: []*daoDeleteMock{
+				{resp: revokedKey(mainKey)},
+				{resp: revokedKey(legacyKey)},
+			},
+			serviceGenErr:      new(error),
+			daoSearchAfterMock: &daoSearchMock{resp: []*dao.Jwk{newKey}},
+			daoInvalidateErr:   new(error),
+
+			expect: &core.JwkBurnResponse{
+				Key: metadata(newKey, true),
+				Revoked: []*core.JwkMetadata{
+					metadata(revokedKey(mainKey), false),
+					metadata(revokedKey(legacyKey), false),
+				},
+			},
+		},
+		{
+			// A key that expires during the burn is reported as is.
+			name: "Success/KeyExpired",
+
+			request: &core.JwkBurnRequest{Usage: "auth", Comment: "leaked"},
+
+			daoLockErr:         new(error),
+			daoSearchMock:      &daoSearchMock{resp: []*dao.Jwk{mainKey}},
+			daoDeleteMocks:     []*daoDeleteMock{{err: dao.ErrJwkDeleteNotFound}},
+			serviceGenErr:      new(error),
+			daoSearchAfterMock: &daoSearchMock{resp: []*dao.Jwk{newKey}},
+			daoInvalidateErr:   new(error),
+
+			expect: &core.JwkBurnResponse{
+				Key:     metadata(newKey, true),
+				Revoked: []*core.JwkMetadata{metadata(mainKey, false)},
+			},
+		},
+		{
+			name: "Success/NoKeys",
+
+			request: &core.JwkBurnRequest{Usage: "auth", Comment: "leaked"},
+
+			daoLockErr:         new(error),
+			daoSearchMock:      &daoSearchMock{},
+			serviceGenErr:      new(error),
+			daoSearchAfterMock: &daoSearchMock{resp: []*dao.Jwk{newKey}},
+			daoInvalidateErr:   new(error),
+
+			expect: &core.JwkBurnResponse{Key: metadata(newKey, true), Revoked: []*core.JwkMetadata{}},
+		},
+		{
+			name: "Error/NoComment",
+
+			request: &core.JwkBurnRequest{Usage: "auth"},
+
+			expectErr: core.ErrJwkRevokeNoComment,
+		},
+		{
+			name: "Error/ConfigNotFound",
+
+			request: &core.JwkBurnRequest{Usage: "unknown", Comment: "leaked"},
+
+			expectErr: core.ErrConfigNotFound,
+		},
+		{
+			name: "Error/Lock",
+
+			request: &core.JwkBurnRequest{Usage: "auth", Comment: "leaked"},
+
+			daoLockErr: &errFoo,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Delete",
+
+			request: &core.JwkBurnRequest{Usage: "auth", Comment: "leaked"},
+
+			daoLockErr:     new(error),
+			daoSearchMock:  &daoSearchMock{resp: []*dao.Jwk{mainKey}},
+			daoDeleteMocks: []*daoDeleteMock{{err: errFoo}},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Gen",
+
+			request: &core.JwkBurnRequest{Usage: "auth", Comment: "leaked"},
+
+			daoLockErr:     new(error),
+			daoSearchMock:  &daoSearchMock{resp: []*dao.Jwk{mainKey}},
+			daoDeleteMocks: []*daoDeleteMock{{resp: revokedKey(mainKey)}},
+			serviceGenErr:  &errFoo,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Invalidate",
+
+			request: &core.JwkBurnRequest{Usage: "auth", Comment: "leaked"},
+
+			daoLockErr:         new(error),
+			daoSearchMock:      &daoSearchMock{resp: []*dao.Jwk{mainKey}},
+			daoDeleteMocks:     []*daoDeleteMock{{resp: revokedKey(mainKey)}},
+			serviceGenErr:      new(error),
+			daoSearchAfterMock: &daoSearchMock{resp: []*dao.Jwk{newKey}},
+			daoInvalidateErr:   &errFoo,
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoLock := coremocks.NewMockJwkBurnDaoLock(t)
+			daoSearch := coremocks.NewMockJwkBurnDaoSearch(t)
+			daoDelete := coremocks.NewMockJwkBurnDaoDelete(t)
+			daoInvalidate := coremocks.NewMockJwkBurnDaoInvalidate(t)
+			serviceGen := coremocks.NewMockJwkBurnServiceGen(t)
+			serviceExtract := coremocks.NewMockJwkMetadataServiceExtract(t)
+
+			serviceExtract.EXPECT().
+				Exec(mock.Anything, mock.Anything).
+				Return(&core.Jwk{JWKCommon: jwa.JWKCommon{Alg: jwa.EdDSA}}, nil).
+				Maybe()
+
+			if testCase.daoLockErr != nil {
+				daoLock.EXPECT().
+					Exec(mock.Anything, &dao.JwkLockRequest{Usage: testCase.request.Usage}).
+					Return(*testCase.daoLockErr)
+			}
+
+			if testCase.daoSearchMock != nil {
+				daoSearch.EXPECT().
+					Exec(mock.Anything, &dao.JwkSearchRequest{Usage: testCase.request.Usage}).
+					Return(testCase.daoSearchMock.resp, testCase.daoSearchMock.err).
+					Once()
+
+				// Keys are revoked in order, at the same time.
+				for i, daoDeleteMock := range testCase.daoDeleteMocks {
+					daoDelete.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(request *dao.JwkDeleteRequest) bool {
+							return request.ID == testCase.daoSearchMock.resp[i].ID &&
+								request.Comment == testCase.request.Comment &&
+								!request.Now.IsZero()
+						})).
+						Return(daoDeleteMock.resp, daoDeleteMock.err).
+						Once()
+				}
+			}
+
+			if testCase.serviceGenErr != nil {
+				serviceGen.EXPECT().
+					Exec(mock.Anything, &core.JwkGenRequest{Usage: testCase.request.Usage, Force: true}).
+					Return(&core.Jwk{}, *testCase.serviceGenErr)
+			}
+
+			if testCase.daoSearchAfterMock != nil {
+				daoSearch.EXPECT().
+					Exec(mock.Anything, &dao.JwkSearchRequest{Usage: testCase.request.Usage}).
+					Return(testCase.daoSearchAfterMock.resp, testCase.daoSearchAfterMock.err).
+					Once()
+			}
+
+			if testCase.daoInvalidateErr != nil {
+				daoInvalidate.EXPECT().
+					Exec(mock.Anything, &dao.JwkInvalidateRequest{Usage: testCase.request.Usage}).
+					Return(*testCase.daoInvalidateErr)
+			}
+
+			transactor := transactiontest.NewTransactor()
+
+			service := core.NewJwkBurn(
+				daoLock, daoSearch, daoDelete, daoInvalidate, serviceGen, serviceExtract, transactor, keysConfig,
+			)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoLock.AssertExpectations(t)
+			daoSearch.AssertExpectations(t)
+			daoDelete.AssertExpectations(t)
+			daoInvalidate.AssertExpectations(t)
+			serviceGen.AssertExpectations(t)
+		})
+	}
+}
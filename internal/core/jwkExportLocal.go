@@ -18,6 +18,7 @@ type JwkExportLocalSource interface {
 // Server-side only; the public client package has its own gRPC-backed exporter.
 type JwkExportLocal struct {
 	service JwkExportLocalSource
+	public  bool
 }
 
 // NewJwkExportLocal returns a new JwkExportLocal service backed by the given search service.
@@ -25,12 +26,18 @@ func NewJwkExportLocal(service JwkExportLocalSource) *JwkExportLocal {
 	return &JwkExportLocal{service: service}
 }
 
+// NewJwkExportLocalPublic returns a JwkExportLocal serving public keys instead, for sources that
+// verify tokens locally. See [NewJwkPublicSource].
+func NewJwkExportLocalPublic(service JwkExportLocalSource) *JwkExportLocal {
+	return &JwkExportLocal{service: service, public: true}
+}
+
 func (source *JwkExportLocal) SearchKeys(ctx context.Context, usage string) ([]*jwa.JWK, error) {
 	ctx, span := otel.Tracer().Start(ctx, "core.JwkExportLocal.SearchKeys")
 	defer span.End()
 
 	return source.service.Exec(ctx, &JwkSearchRequest{
 		Usage:   usage,
-		Private: true,
+		Private: !source.public,
 	})
 }
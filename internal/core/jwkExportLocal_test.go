@@ -26,7 +26,8 @@ func TestJwkExportLocal(t *testing.T) {
 	testCases := []struct {
 		name string
 
-		usage string
+		usage  string
+		public bool
 
 		sourceMock *sourceMock
 
@@ -49,6 +50,17 @@ func TestJwkExportLocal(t *testing.T) {
 				{JWKCommon: jwa.JWKCommon{KID: "kid-2"}},
 			},
 		},
+		{
+			name:   "Success/Public",
+			usage:  "test-usage",
+			public: true,
+
+			sourceMock: &sourceMock{
+				resp: []*core.Jwk{{JWKCommon: jwa.JWKCommon{KID: "kid-1"}}},
+			},
+
+			expect: []*jwa.JWK{{JWKCommon: jwa.JWKCommon{KID: "kid-1"}}},
+		},
 		{
 			name:  "Error/Search",
 			usage: "test-usage",
@@ -71,12 +83,15 @@ func TestJwkExportLocal(t *testing.T) {
 				source.EXPECT().
 					Exec(mock.Anything, &core.JwkSearchRequest{
 						Usage:   testCase.usage,
-						Private: true,
+						Private: !testCase.public,
 					}).
 					Return(testCase.sourceMock.resp, testCase.sourceMock.err)
 			}
 
 			service := core.NewJwkExportLocal(source)
+			if testCase.public {
+				service = core.NewJwkExportLocalPublic(source)
+			}
 
 			result, err := service.SearchKeys(t.Context(), testCase.usage)
 			require.ErrorIs(t, err, testCase.expectErr)
@@ -42,13 +42,21 @@ type JwkGenServiceExtract interface {
 type JwkGenRequest struct {
 	// Usage identifies which key configuration to use for this rotation.
 	Usage string
+	// Force generates a new key even within the rotation window, for instance to replace a key
+	// suspected to be compromised.
+	Force bool
 }
 
 // A JwkGen generates new keys for a configured usage.
 //
 // Generation is conditional: it reads the usage's latest key and generates only once the
 // rotation window has elapsed. Within the window it returns that key and records the skip
-// on the trace span.
+// on the trace span, unless that key uses another algorithm than the one configured: after an
+// algorithm migration, a key for the new algorithm is generated straight away. A forced request
+// skips the check altogether.
+//
+// Generation is refused when the usage's algorithm, or the strength of the generated key, falls
+// outside the usage's [config.JwkPolicy]; nothing is inserted in that case.
 type JwkGen struct {
 	daoSearch      JwkGenDaoSearch
 	daoInsert      JwkGenDaoInsert
@@ -75,12 +83,33 @@ func (service *JwkGen) Exec(ctx context.Context, request *JwkGenRequest) (*Jwk,
 	ctx, span := otel.Tracer().Start(ctx, "core.JwkGen")
 	defer span.End()
 
-	span.SetAttributes(attribute.String("key.usage", request.Usage))
+	span.SetAttributes(
+		attribute.String("key.usage", request.Usage),
+		attribute.Bool("key.forced", request.Force),
+	)
+
+	key, generated, err := service.generate(ctx, span, request)
+	if err != nil {
+		recordAudit(ctx, &AuditRecordRequest{Action: AuditActionJwkRotate, Usage: request.Usage, Err: err})
+
+		return nil, err
+	}
+
+	// Skipped rotations are not audited: nothing changed.
+	if generated {
+		recordAudit(ctx, &AuditRecordRequest{Action: AuditActionJwkRotate, Usage: request.Usage, KID: key.KID})
+	}
 
+	return otel.ReportSuccess(span, key), nil
+}
+
+// generate runs the rotation. It reports whether a new key was generated, or the latest one
+// returned.
+func (service *JwkGen) generate(ctx context.Context, span trace.Span, request *JwkGenRequest) (*Jwk, bool, error) {
 	// The newest key for the usage decides whether the rotation window has elapsed.
 	keys, err := service.daoSearch.Exec(ctx, &dao.JwkSearchRequest{Usage: request.Usage})
 	if err != nil {
-		return nil, otel.ReportError(span, fmt.Errorf("list keys: %w", err))
+		return nil, false, otel.ReportError(span, fmt.Errorf("list keys: %w", err))
 	}
 
 	span.AddEvent("keys.retrieved", trace.WithAttributes(attribute.Int("keys.count", len(keys))))
@@ -92,7 +121,7 @@ func (service *JwkGen) Exec(ctx context.Context, request *JwkGenRequest) (*Jwk,
 
 	keyConfig, ok := service.keysConfig[request.Usage]
 	if !ok {
-		return nil, ErrConfigNotFound
+		return nil, false, ErrConfigNotFound
 	}
 
 	span.SetAttributes(
@@ -100,84 +129,116 @@ func (service *JwkGen) Exec(ctx context.Context, request *JwkGenRequest) (*Jwk,
 		attribute.Float64("key.rotation_interval", keyConfig.Key.Rotation.Seconds()),
 	)
 
-	var latestKey *dao.Jwk
-
-	if time.Since(lastCreated) >= keyConfig.Key.Rotation {
-		keyGenerator, ok := JwkGenerators[keyConfig.Alg]
-		if !ok {
-			return nil, otel.ReportError(span, fmt.Errorf("%w: %s", ErrJwkGenUnknownKeyUsage, request.Usage))
+	// A key of another algorithm forces a rotation whatever its age: it is what a usage looks like
+	// right after an algorithm migration, and signing must move to the new algorithm right away.
+	if !request.Force && len(keys) > 0 && time.Since(lastCreated) < keyConfig.Key.Rotation {
+		latestKey, err := service.serviceExtract.Exec(ctx, &JwkExtractRequest{
+			Jwk:     keys[0],
+			Private: true,
+		})
+		if err != nil {
+			return nil, false, otel.ReportError(span, err)
 		}
 
-		privateKey, publicKey, privateKID, publicKID, err := keyGenerator()
-		if err != nil {
-			return nil, otel.ReportError(span, fmt.Errorf("generate key: %w", err))
+		if latestKey.Alg == keyConfig.Alg {
+			span.AddEvent("skipped")
+
+			return latestKey, false, nil
 		}
 
-		span.AddEvent("key.generated", trace.WithAttributes(
-			attribute.String("key.private.kid", privateKID),
-			attribute.String("key.public.kid", publicKID),
+		span.AddEvent("key.alg_changed", trace.WithAttributes(
+			attribute.String("key.previous_alg", string(latestKey.Alg)),
 			attribute.String("key.alg", string(keyConfig.Alg)),
 		))
+	}
 
-		// Encrypt the private key with the master key, so a database dump does not expose it.
-		privateKeyEncrypted, err := lib.EncryptMasterKey(ctx, privateKey)
-		if err != nil {
-			return nil, otel.ReportError(span, fmt.Errorf("encrypt private key: %w", err))
-		}
+	err = keyConfig.Policy.CheckAlg(keyConfig.Alg)
+	if err != nil {
+		return nil, false, otel.ReportError(span, fmt.Errorf("check policy: %w", err))
+	}
 
-		span.AddEvent("key.private.encrypted")
+	keyGenerator, ok := JwkGenerators[keyConfig.Alg]
+	if !ok {
+		return nil, false, otel.ReportError(span, fmt.Errorf("%w: %s", ErrJwkGenUnknownKeyUsage, request.Usage))
+	}
 
-		privateKeyEncoded := base64.RawURLEncoding.EncodeToString(privateKeyEncrypted)
+	privateKey, publicKey, privateKID, publicKID, err := keyGenerator()
+	if err != nil {
+		return nil, false, otel.ReportError(span, fmt.Errorf("generate key: %w", err))
+	}
 
-		span.AddEvent("key.private.encoded")
+	// Measure the key actually generated rather than trusting the preset, so a weakened preset
+	// cannot slip past the policy.
+	keySize, err := JwkKeySize(publicKey)
+	if err != nil {
+		return nil, false, otel.ReportError(span, fmt.Errorf("measure key: %w", err))
+	}
 
-		// Both private and public keys share the same KID.
-		kid, err := uuid.Parse(privateKID)
-		if err != nil {
-			return nil, otel.ReportError(span, fmt.Errorf("parse KID: %w", err))
-		}
+	err = keyConfig.Policy.CheckKeySize(keyConfig.Alg, keySize)
+	if err != nil {
+		return nil, false, otel.ReportError(span, fmt.Errorf("check policy: %w", err))
+	}
 
-		var publicKeyEncoded *string
+	span.AddEvent("key.generated", trace.WithAttributes(
+		attribute.String("key.private.kid", privateKID),
+		attribute.String("key.public.kid", publicKID),
+		attribute.String("key.alg", string(keyConfig.Alg)),
+	))
 
-		if publicKey != nil {
-			publicKeySerialized, err := json.Marshal(publicKey)
-			if err != nil {
-				return nil, otel.ReportError(span, fmt.Errorf("serialize public key: %w", err))
-			}
+	// Encrypt the private key with the master key, so a database dump does not expose it.
+	privateKeyEncrypted, err := lib.EncryptMasterKey(ctx, privateKey)
+	if err != nil {
+		return nil, false, otel.ReportError(span, fmt.Errorf("encrypt private key: %w", err))
+	}
 
-			publicKeyEncoded = lo.ToPtr(base64.RawURLEncoding.EncodeToString(publicKeySerialized))
+	span.AddEvent("key.private.encrypted")
 
-			span.AddEvent("key.public.encoded")
-		}
+	privateKeyEncoded := base64.RawURLEncoding.EncodeToString(privateKeyEncrypted)
 
-		now := time.Now()
+	span.AddEvent("key.private.encoded")
 
-		latestKey, err = service.daoInsert.Exec(ctx, &dao.JwkInsertRequest{
-			ID:         kid,
-			PrivateKey: privateKeyEncoded,
-			PublicKey:  publicKeyEncoded,
-			Usage:      request.Usage,
-			Now:        now,
-			Expiration: now.Add(keyConfig.Key.TTL),
-		})
+	// Both private and public keys share the same KID.
+	kid, err := uuid.Parse(privateKID)
+	if err != nil {
+		return nil, false, otel.ReportError(span, fmt.Errorf("parse KID: %w", err))
+	}
+
+	var publicKeyEncoded *string
+
+	if publicKey != nil {
+		publicKeySerialized, err := json.Marshal(publicKey)
 		if err != nil {
-			return nil, otel.ReportError(span, fmt.Errorf("insert key: %w", err))
+			return nil, false, otel.ReportError(span, fmt.Errorf("serialize public key: %w", err))
 		}
 
-		span.AddEvent("key.inserted")
-	} else {
-		span.AddEvent("skipped")
+		publicKeyEncoded = lo.ToPtr(base64.RawURLEncoding.EncodeToString(publicKeySerialized))
 
-		latestKey = keys[0]
+		span.AddEvent("key.public.encoded")
 	}
 
+	now := time.Now()
+
+	latestKey, err := service.daoInsert.Exec(ctx, &dao.JwkInsertRequest{
+		ID:         kid,
+		PrivateKey: privateKeyEncoded,
+		PublicKey:  publicKeyEncoded,
+		Usage:      request.Usage,
+		Now:        now,
+		Expiration: now.Add(keyConfig.Key.TTL),
+	})
+	if err != nil {
+		return nil, false, otel.ReportError(span, fmt.Errorf("insert key: %w", err))
+	}
+
+	span.AddEvent("key.inserted")
+
 	output, err := service.serviceExtract.Exec(ctx, &JwkExtractRequest{
 		Jwk:     latestKey,
 		Private: true,
 	})
 	if err != nil {
-		return nil, otel.ReportError(span, err)
+		return nil, false, otel.ReportError(span, err)
 	}
 
-	return otel.ReportSuccess(span, output), nil
+	return output, true, nil
 }
@@ -95,7 +95,10 @@ func TestJwkGen(t *testing.T) {
 		daoSearchMock      *daoSearchMock
 		daoInsertMock      *daoInsertMock
 		serviceExtractMock *serviceExtractMock
-		keys               map[string]*config.Jwk
+		// latestExtractMock mocks the extraction of the latest key, when the test also expects a
+		// new key to be inserted and extracted.
+		latestExtractMock *serviceExtractMock
+		keys              map[string]*config.Jwk
 
 		expect    *core.Jwk
 		expectErr error
@@ -219,6 +222,146 @@ func TestJwkGen(t *testing.T) {
 				Payload: json.RawMessage(`{"message":"hello world"}`),
 			},
 		},
+		{
+			// A forced rotation ignores the rotation window.
+			name: "Success/Force",
+
+			request: &core.JwkGenRequest{
+				Usage: "test-usage",
+				Force: true,
+			},
+
+			keys: map[string]*config.Jwk{
+				"test-usage": {
+					Alg: jwa.EdDSA,
+					Key: config.JwkKey{
+						TTL:      24 * time.Hour,
+						Rotation: 12 * time.Hour,
+						Cache:    6 * time.Hour,
+					},
+				},
+			},
+
+			daoSearchMock: &daoSearchMock{
+				resp: []*dao.Jwk{
+					{
+						ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						PrivateKey: "cHJpdmF0ZS1rZXktMQ",
+						PublicKey:  lo.ToPtr("cHVibGljLWtleS0x"),
+						Usage:      "test-usage",
+						CreatedAt:  time.Now().Add(-time.Hour),
+						ExpiresAt:  time.Now().Add(23 * time.Hour),
+					},
+				},
+			},
+
+			daoInsertMock: &daoInsertMock{
+				resp: &dao.Jwk{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					PrivateKey: "cHJpdmF0ZS1rZXktMQ",
+					PublicKey:  lo.ToPtr("cHVibGljLWtleS0x"),
+					Usage:      "test-usage",
+					CreatedAt:  time.Now(),
+					ExpiresAt:  time.Now().Add(24 * time.Hour),
+				},
+			},
+
+			serviceExtractMock: &serviceExtractMock{
+				resp: &core.Jwk{
+					JWKCommon: jwa.JWKCommon{
+						KTY: "test-kty",
+						Use: "test-use",
+						Alg: jwa.EdDSA,
+						KID: "00000000-0000-0000-0000-000000000001",
+					},
+					Payload: json.RawMessage(`{"message":"hello world"}`),
+				},
+			},
+
+			expect: &core.Jwk{
+				JWKCommon: jwa.JWKCommon{
+					KTY: "test-kty",
+					Use: "test-use",
+					Alg: jwa.EdDSA,
+					KID: "00000000-0000-0000-0000-000000000001",
+				},
+				Payload: json.RawMessage(`{"message":"hello world"}`),
+			},
+		},
+		{
+			name: "Success/AlgChanged",
+
+			request: &core.JwkGenRequest{
+				Usage: "test-usage",
+			},
+
+			keys: map[string]*config.Jwk{
+				"test-usage": {
+					Alg:          jwa.EdDSA,
+					PreviousAlgs: []jwa.Alg{jwa.ES256},
+					Key: config.JwkKey{
+						TTL:      24 * time.Hour,
+						Rotation: 12 * time.Hour,
+						Cache:    6 * time.Hour,
+					},
+				},
+			},
+
+			daoSearchMock: &daoSearchMock{
+				resp: []*dao.Jwk{
+					{
+						ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						PrivateKey: "cHJpdmF0ZS1rZXktMQ",
+						PublicKey:  lo.ToPtr("cHVibGljLWtleS0x"),
+						Usage:      "test-usage",
+						CreatedAt:  time.Now().Add(-time.Hour),
+						ExpiresAt:  time.Now().Add(23 * time.Hour),
+					},
+				},
+			},
+
+			latestExtractMock: &serviceExtractMock{
+				resp: &core.Jwk{
+					JWKCommon: jwa.JWKCommon{
+						KTY: "EC",
+						Use: "sig",
+						Alg: jwa.ES256,
+						KID: "00000000-0000-0000-0000-000000000002",
+					},
+				},
+			},
+
+			daoInsertMock: &daoInsertMock{
+				resp: &dao.Jwk{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					PrivateKey: "cHJpdmF0ZS1rZXktMQ",
+					PublicKey:  lo.ToPtr("cHVibGljLWtleS0x"),
+					Usage:      "test-usage",
+					CreatedAt:  time.Now(),
+					ExpiresAt:  time.Now().Add(24 * time.Hour),
+				},
+			},
+
+			serviceExtractMock: &serviceExtractMock{
+				resp: &core.Jwk{
+					JWKCommon: jwa.JWKCommon{
+						KTY: "OKP",
+						Use: "sig",
+						Alg: jwa.EdDSA,
+						KID: "00000000-0000-0000-0000-000000000001",
+					},
+				},
+			},
+
+			expect: &core.Jwk{
+				JWKCommon: jwa.JWKCommon{
+					KTY: "OKP",
+					Use: "sig",
+					Alg: jwa.EdDSA,
+					KID: "00000000-0000-0000-0000-000000000001",
+				},
+			},
+		},
 		{
 			name: "Error/Extract",
 
@@ -343,6 +486,52 @@ func TestJwkGen(t *testing.T) {
 
 			expectErr: core.ErrConfigNotFound,
 		},
+		{
+			name: "Error/PolicyAlgNotAllowed",
+
+			request: &core.JwkGenRequest{
+				Usage: "test-usage",
+			},
+
+			keys: map[string]*config.Jwk{
+				"test-usage": {
+					Alg: jwa.RS256,
+					Key: config.JwkKey{
+						TTL:      24 * time.Hour,
+						Rotation: 12 * time.Hour,
+						Cache:    6 * time.Hour,
+					},
+					Policy: &config.JwkPolicy{Algs: []jwa.Alg{jwa.EdDSA}},
+				},
+			},
+
+			daoSearchMock: &daoSearchMock{},
+
+			expectErr: config.ErrJwkPolicyAlgNotAllowed,
+		},
+		{
+			name: "Error/PolicyKeyTooWeak",
+
+			request: &core.JwkGenRequest{
+				Usage: "test-usage",
+			},
+
+			keys: map[string]*config.Jwk{
+				"test-usage": {
+					Alg: jwa.ES256,
+					Key: config.JwkKey{
+						TTL:      24 * time.Hour,
+						Rotation: 12 * time.Hour,
+						Cache:    6 * time.Hour,
+					},
+					Policy: &config.JwkPolicy{MinKeySize: config.JwkPolicyKeySize{EC: 384}},
+				},
+			},
+
+			daoSearchMock: &daoSearchMock{},
+
+			expectErr: config.ErrJwkPolicyKeyTooWeak,
+		},
 	}
 
 	for _, alg := range []jwa.Alg{
@@ -479,6 +668,12 @@ func TestJwkGen(t *testing.T) {
 					Return(testCase.daoInsertMock.resp, testCase.daoInsertMock.err)
 			}
 
+			if testCase.latestExtractMock != nil {
+				serviceExtract.EXPECT().
+					Exec(mock.Anything, &core.JwkExtractRequest{Jwk: testCase.daoSearchMock.resp[0], Private: true}).
+					Return(testCase.latestExtractMock.resp, testCase.latestExtractMock.err)
+			}
+
 			if testCase.serviceExtractMock != nil {
 				var keyToExtract *dao.Jwk
 
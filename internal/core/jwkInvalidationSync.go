@@ -0,0 +1,74 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// JwkInvalidationSyncDao is the DAO dependency of [JwkInvalidationSync].
+type JwkInvalidationSyncDao interface {
+	Exec(ctx context.Context, request *dao.JwkListenInvalidationsRequest) (<-chan string, error)
+}
+
+// JwkInvalidationSyncCache is the key cache dependency of [JwkInvalidationSync]. It is
+// implemented by [JwkSourceCache].
+type JwkInvalidationSyncCache interface {
+	Invalidate(usage string)
+}
+
+// A JwkInvalidationSync applies the key invalidations sent by any process sharing the database,
+// such as [JwkBurn], to the key cache of this one.
+type JwkInvalidationSync struct {
+	dao   JwkInvalidationSyncDao
+	cache JwkInvalidationSyncCache
+}
+
+// NewJwkInvalidationSync returns a new JwkInvalidationSync service.
+func NewJwkInvalidationSync(dao JwkInvalidationSyncDao, cache JwkInvalidationSyncCache) *JwkInvalidationSync {
+	return &JwkInvalidationSync{dao: dao, cache: cache}
+}
+
+// Exec listens to invalidations, and applies them until ctx is done, or the listener stops.
+// Invalidations sent while no listener runs are lost: callers that restart it should drop their
+// whole cache.
+func (service *JwkInvalidationSync) Exec(ctx context.Context) error {
+	usages, err := service.listen(ctx)
+	if err != nil {
+		return err
+	}
+
+	for usage := range usages {
+		service.invalidate(ctx, usage)
+	}
+
+	return ctx.Err()
+}
+
+func (service *JwkInvalidationSync) listen(ctx context.Context) (<-chan string, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.JwkInvalidationSync(listen)")
+	defer span.End()
+
+	usages, err := service.dao.Exec(ctx, &dao.JwkListenInvalidationsRequest{})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("listen to invalidations: %w", err))
+	}
+
+	return otel.ReportSuccess(span, usages), nil
+}
+
+func (service *JwkInvalidationSync) invalidate(ctx context.Context, usage string) {
+	_, span := otel.Tracer().Start(ctx, "core.JwkInvalidationSync(invalidate)")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("key.usage", usage))
+
+	service.cache.Invalidate(usage)
+
+	otel.ReportSuccessNoContent(span)
+}
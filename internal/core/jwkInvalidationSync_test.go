@@ -0,0 +1,72 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestJwkInvalidationSync(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	testCases := []struct {
+		name string
+
+		invalidations []string
+		daoErr        error
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			invalidations: []string{"auth", "refresh", "auth"},
+		},
+		{
+			name: "Error/Listen",
+
+			daoErr: errFoo,
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoListen := coremocks.NewMockJwkInvalidationSyncDao(t)
+			cache := coremocks.NewMockJwkInvalidationSyncCache(t)
+
+			// The listener stops once it has delivered every invalidation.
+			usages := make(chan string, len(testCase.invalidations))
+			for _, usage := range testCase.invalidations {
+				usages <- usage
+			}
+
+			close(usages)
+
+			daoListen.EXPECT().
+				Exec(mock.Anything, &dao.JwkListenInvalidationsRequest{}).
+				Return(usages, testCase.daoErr)
+
+			for _, usage := range testCase.invalidations {
+				cache.EXPECT().Invalidate(usage).Return()
+			}
+
+			err := core.NewJwkInvalidationSync(daoListen, cache).Exec(t.Context())
+			require.ErrorIs(t, err, testCase.expectErr)
+
+			daoListen.AssertExpectations(t)
+			cache.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// JwkRotationStalledFactor is the number of rotation intervals after which a main key that has
+// not been replaced means the rotation job has stalled.
+const JwkRotationStalledFactor = 2
+
+// JwkLifecycleDaoSearch is the DAO search dependency of [JwkLifecycle].
+type JwkLifecycleDaoSearch interface {
+	Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)
+}
+
+// JwkLifecycleServiceExtract is the service dependency of [JwkLifecycle] for reading the algorithm
+// of stored keys.
+type JwkLifecycleServiceExtract interface {
+	Exec(ctx context.Context, request *JwkExtractRequest) (*Jwk, error)
+}
+
+// JwkLifecycleRequest holds the parameters for a [JwkLifecycle.Exec] call.
+type JwkLifecycleRequest struct {
+	// Usage is the key usage to report on.
+	Usage string
+}
+
+// JwkLifecycleStatus describes the active keys of a usage, against its rotation schedule.
+type JwkLifecycleStatus struct {
+	// ActiveKeys is the number of active keys: the main key and the legacy ones.
+	ActiveKeys int
+	// MainKID is the ID of the main key, the newest one. Empty when the usage has no active key.
+	MainKID string
+	// MainCreatedAt is when the main key was generated. Zero when the usage has no active key.
+	MainCreatedAt time.Time
+	// LegacyKeys is the number of active keys other than the main key, still used for verification.
+	LegacyKeys int
+	// SigningKeys is the number of active keys that use the algorithm of the usage (see
+	// [config.Jwk.Alg]), the only ones it signs with.
+	SigningKeys int
+	// NextExpiresAt is when the next active key expires. Zero when the usage has no active key.
+	NextExpiresAt time.Time
+	// Rotation is the configured rotation interval: the main key is overdue for rotation once
+	// it is older than this.
+	Rotation time.Duration
+	// RotationStalled is true when the main key is older than [JwkRotationStalledFactor] rotation
+	// intervals: the rotation job has missed several runs.
+	RotationStalled bool
+}
+
+// CanSign reports whether the usage has a key to sign with. In the middle of an algorithm
+// migration, until a key of the new algorithm is generated, the usage has active keys but none it
+// signs with (see [JwkPrivateSources.SigningKey]).
+func (status *JwkLifecycleStatus) CanSign() bool {
+	return status.SigningKeys > 0
+}
+
+// A JwkLifecycle reports where a usage's keys stand in their lifecycle, for monitoring.
+type JwkLifecycle struct {
+	daoSearch      JwkLifecycleDaoSearch
+	serviceExtract JwkLifecycleServiceExtract
+	keysConfig     map[string]*config.Jwk
+}
+
+// NewJwkLifecycle returns a new JwkLifecycle service.
+func NewJwkLifecycle(
+	daoSearch JwkLifecycleDaoSearch,
+	serviceExtract JwkLifecycleServiceExtract,
+	keysConfig map[string]*config.Jwk,
+) *JwkLifecycle {
+	return &JwkLifecycle{daoSearch: daoSearch, serviceExtract: serviceExtract, keysConfig: keysConfig}
+}
+
+func (service *JwkLifecycle) Exec(ctx context.Context, request *JwkLifecycleRequest) (*JwkLifecycleStatus, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.JwkLifecycle")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("key.usage", request.Usage))
+
+	keyConfig, ok := service.keysConfig[request.Usage]
+	if !ok {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: %s", ErrConfigNotFound, request.Usage))
+	}
+
+	entities, err := service.daoSearch.Exec(ctx, &dao.JwkSearchRequest{Usage: request.Usage})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list keys: %w", err))
+	}
+
+	output := &JwkLifecycleStatus{
+		ActiveKeys: len(entities),
+		Rotation:   keyConfig.Key.Rotation,
+	}
+
+	// Keys come newest first: the first one is the main key.
+	if len(entities) > 0 {
+		output.MainKID = entities[0].ID.String()
+		output.MainCreatedAt = entities[0].CreatedAt
+		output.LegacyKeys = len(entities) - 1
+		output.RotationStalled = keyConfig.Key.Rotation > 0 &&
+			time.Since(output.MainCreatedAt) > JwkRotationStalledFactor*keyConfig.Key.Rotation
+	}
+
+	for _, entity := range entities {
+		if output.NextExpiresAt.IsZero() || entity.ExpiresAt.Before(output.NextExpiresAt) {
+			output.NextExpiresAt = entity.ExpiresAt
+		}
+
+		// The public half carries the algorithm as well, and does not need decrypting. Symmetric
+		// keys have none.
+		key, err := service.serviceExtract.Exec(ctx, &JwkExtractRequest{Jwk: entity, Private: entity.PublicKey == nil})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("consume DAO entity (kid %s): %w", entity.ID, err))
+		}
+
+		if key.Alg == keyConfig.Alg {
+			output.SigningKeys++
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("keys.active", output.ActiveKeys),
+		attribute.Int("keys.signing", output.SigningKeys),
+		attribute.Bool("keys.rotation_stalled", output.RotationStalled),
+	)
+
+	return otel.ReportSuccess(span, output), nil
+}
@@ -0,0 +1,197 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestJwkLifecycle(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	now := time.Now()
+
+	keys := []*dao.Jwk{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			PublicKey: lo.ToPtr("cHVibGljLWtleS0y"),
+			Usage:     "test-usage",
+			CreatedAt: now.Add(-time.Hour),
+			ExpiresAt: now.Add(23 * time.Hour),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			PublicKey: lo.ToPtr("cHVibGljLWtleS0x"),
+			Usage:     "test-usage",
+			CreatedAt: now.Add(-2 * time.Hour),
+			ExpiresAt: now.Add(22 * time.Hour),
+		},
+	}
+
+	keysConfig := map[string]*config.Jwk{
+		"test-usage": {Alg: jwa.EdDSA, Key: config.JwkKey{Rotation: 12 * time.Hour}},
+		// The main key is an hour old: over twice this interval.
+		"stalled-usage": {Alg: jwa.EdDSA, Key: config.JwkKey{Rotation: 20 * time.Minute}},
+	}
+
+	type daoSearchMock struct {
+		resp []*dao.Jwk
+		err  error
+	}
+
+	type serviceExtractMock struct {
+		resp *core.Jwk
+		err  error
+	}
+
+	extracted := func(alg jwa.Alg) *serviceExtractMock {
+		return &serviceExtractMock{resp: &core.Jwk{JWKCommon: jwa.JWKCommon{Alg: alg}}}
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.JwkLifecycleRequest
+
+		daoSearchMock      *daoSearchMock
+		serviceExtractMock []*serviceExtractMock
+
+		expect    *core.JwkLifecycleStatus
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.JwkLifecycleRequest{Usage: "test-usage"},
+
+			daoSearchMock:      &daoSearchMock{resp: keys},
+			serviceExtractMock: []*serviceExtractMock{extracted(jwa.EdDSA), extracted(jwa.EdDSA)},
+
+			expect: &core.JwkLifecycleStatus{
+				ActiveKeys:    2,
+				MainKID:       keys[0].ID.String(),
+				MainCreatedAt: keys[0].CreatedAt,
+				LegacyKeys:    1,
+				SigningKeys:   2,
+				NextExpiresAt: keys[1].ExpiresAt,
+				Rotation:      12 * time.Hour,
+			},
+		},
+		{
+			// The usage moved to EdDSA, but the rotation has not generated an EdDSA key yet.
+			name: "Success/AlgMigration",
+
+			request: &core.JwkLifecycleRequest{Usage: "test-usage"},
+
+			daoSearchMock:      &daoSearchMock{resp: keys},
+			serviceExtractMock: []*serviceExtractMock{extracted(jwa.ES256), extracted(jwa.ES256)},
+
+			expect: &core.JwkLifecycleStatus{
+				ActiveKeys:    2,
+				MainKID:       keys[0].ID.String(),
+				MainCreatedAt: keys[0].CreatedAt,
+				LegacyKeys:    1,
+				NextExpiresAt: keys[1].ExpiresAt,
+				Rotation:      12 * time.Hour,
+			},
+		},
+		{
+			name: "Success/RotationStalled",
+
+			request: &core.JwkLifecycleRequest{Usage: "stalled-usage"},
+
+			daoSearchMock:      &daoSearchMock{resp: keys[:1]},
+			serviceExtractMock: []*serviceExtractMock{extracted(jwa.EdDSA)},
+
+			expect: &core.JwkLifecycleStatus{
+				ActiveKeys:      1,
+				MainKID:         keys[0].ID.String(),
+				MainCreatedAt:   keys[0].CreatedAt,
+				SigningKeys:     1,
+				NextExpiresAt:   keys[0].ExpiresAt,
+				Rotation:        20 * time.Minute,
+				RotationStalled: true,
+			},
+		},
+		{
+			name: "Success/NoKeys",
+
+			request: &core.JwkLifecycleRequest{Usage: "test-usage"},
+
+			daoSearchMock: &daoSearchMock{},
+
+			expect: &core.JwkLifecycleStatus{Rotation: 12 * time.Hour},
+		},
+		{
+			name: "Error/Extract",
+
+			request: &core.JwkLifecycleRequest{Usage: "test-usage"},
+
+			daoSearchMock:      &daoSearchMock{resp: keys[:1]},
+			serviceExtractMock: []*serviceExtractMock{{err: errFoo}},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Search",
+
+			request: &core.JwkLifecycleRequest{Usage: "test-usage"},
+
+			daoSearchMock: &daoSearchMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ConfigNotFound",
+
+			request: &core.JwkLifecycleRequest{Usage: "unknown-usage"},
+
+			expectErr: core.ErrConfigNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoSearch := coremocks.NewMockJwkLifecycleDaoSearch(t)
+			serviceExtract := coremocks.NewMockJwkLifecycleServiceExtract(t)
+
+			if testCase.daoSearchMock != nil {
+				daoSearch.EXPECT().
+					Exec(mock.Anything, &dao.JwkSearchRequest{Usage: testCase.request.Usage}).
+					Return(testCase.daoSearchMock.resp, testCase.daoSearchMock.err)
+			}
+
+			for i, extractMock := range testCase.serviceExtractMock {
+				serviceExtract.EXPECT().
+					Exec(mock.Anything, &core.JwkExtractRequest{Jwk: testCase.daoSearchMock.resp[i]}).
+					Return(extractMock.resp, extractMock.err).
+					Once()
+			}
+
+			service := core.NewJwkLifecycle(daoSearch, serviceExtract, keysConfig)
+
+			res, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			daoSearch.AssertExpectations(t)
+			serviceExtract.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// JwkMetadata describes a stored key for operators, without its key material.
+type JwkMetadata struct {
+	// KID is the ID of the key; it corresponds to the "kid" field in the JWT header.
+	KID uuid.UUID
+	// Usage is the key usage the key belongs to.
+	Usage string
+	// Alg is the algorithm of the key.
+	Alg jwa.Alg
+	// Main is true for the main key of the usage, which signs: its newest active key.
+	Main bool
+	// CreatedAt is when the key was generated.
+	CreatedAt time.Time
+	// ExpiresAt is when the key expires, and stops verifying tokens.
+	ExpiresAt time.Time
+	// RevokedAt is when the key was revoked before its expiry. Nil for active keys.
+	RevokedAt *time.Time
+	// RevokedComment is the reason given for the revocation. Empty for active keys.
+	RevokedComment string
+}
+
+// JwkMetadataServiceExtract is the service dependency of the JwkMetadata services for reading the
+// algorithm of stored keys.
+type JwkMetadataServiceExtract interface {
+	Exec(ctx context.Context, request *JwkExtractRequest) (*Jwk, error)
+}
+
+// newJwkMetadata describes entity. The algorithm is read from the public half of the key, which
+// does not need decrypting; symmetric keys have none, and are decrypted.
+func newJwkMetadata(
+	ctx context.Context, serviceExtract JwkMetadataServiceExtract, entity *dao.Jwk,
+) (*JwkMetadata, error) {
+	key, err := serviceExtract.Exec(ctx, &JwkExtractRequest{Jwk: entity, Private: entity.PublicKey == nil})
+	if err != nil {
+		return nil, fmt.Errorf("consume DAO entity (kid %s): %w", entity.ID, err)
+	}
+
+	return &JwkMetadata{
+		KID:            entity.ID,
+		Usage:          entity.Usage,
+		Alg:            key.Alg,
+		CreatedAt:      entity.CreatedAt,
+		ExpiresAt:      entity.ExpiresAt,
+		RevokedAt:      entity.DeletedAt,
+		RevokedComment: lo.FromPtr(entity.DeletedComment),
+	}, nil
+}
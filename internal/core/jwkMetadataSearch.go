@@ -0,0 +1,78 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// JwkMetadataSearchDao is the DAO dependency of [JwkMetadataSearch].
+type JwkMetadataSearchDao interface {
+	Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)
+}
+
+// JwkMetadataSearchRequest holds the parameters for a [JwkMetadataSearch.Exec] call.
+type JwkMetadataSearchRequest struct {
+	// Usage is the key usage to describe.
+	Usage string
+}
+
+// A JwkMetadataSearch describes the active keys of a usage, newest first: the first one is the
+// main key. See [JwkSearch] for the keys themselves.
+type JwkMetadataSearch struct {
+	dao            JwkMetadataSearchDao
+	serviceExtract JwkMetadataServiceExtract
+	keysConfig     map[string]*config.Jwk
+}
+
+// NewJwkMetadataSearch returns a new JwkMetadataSearch service.
+func NewJwkMetadataSearch(
+	dao JwkMetadataSearchDao,
+	serviceExtract JwkMetadataServiceExtract,
+	keysConfig map[string]*config.Jwk,
+) *JwkMetadataSearch {
+	return &JwkMetadataSearch{
+		dao:            dao,
+		serviceExtract: serviceExtract,
+		keysConfig:     keysConfig,
+	}
+}
+
+func (service *JwkMetadataSearch) Exec(ctx context.Context, request *JwkMetadataSearchRequest) ([]*JwkMetadata, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.JwkMetadataSearch")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("key.usage", request.Usage))
+
+	if _, ok := service.keysConfig[request.Usage]; !ok {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: %s", ErrConfigNotFound, request.Usage))
+	}
+
+	entities, err := service.dao.Exec(ctx, &dao.JwkSearchRequest{Usage: request.Usage})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list keys: %w", err))
+	}
+
+	output := make([]*JwkMetadata, len(entities))
+
+	for i, entity := range entities {
+		output[i], err = newJwkMetadata(ctx, service.serviceExtract, entity)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+	}
+
+	if len(output) > 0 {
+		output[0].Main = true
+	}
+
+	span.SetAttributes(attribute.Int("keys.count", len(output)))
+
+	return otel.ReportSuccess(span, output), nil
+}
@@ -0,0 +1,162 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestJwkMetadataSearch(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	now := time.Now()
+
+	keysConfig := map[string]*config.Jwk{"auth": {Alg: jwa.EdDSA}}
+
+	mainKey := &dao.Jwk{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		PublicKey: lo.ToPtr("cHVibGljLWtleS0x"),
+		Usage:     "auth",
+		CreatedAt: now.Add(-time.Hour),
+		ExpiresAt: now.Add(time.Hour),
+	}
+	// Symmetric keys have no public half.
+	legacyKey := &dao.Jwk{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Usage:     "auth",
+		CreatedAt: now.Add(-2 * time.Hour),
+		ExpiresAt: now.Add(time.Minute),
+	}
+
+	type daoSearchMock struct {
+		resp []*dao.Jwk
+		err  error
+	}
+
+	type serviceExtractMock struct {
+		resp *core.Jwk
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.JwkMetadataSearchRequest
+
+		daoSearchMock       *daoSearchMock
+		serviceExtractMocks []*serviceExtractMock
+
+		expect    []*core.JwkMetadata
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.JwkMetadataSearchRequest{Usage: "auth"},
+
+			daoSearchMock: &daoSearchMock{resp: []*dao.Jwk{mainKey, legacyKey}},
+			serviceExtractMocks: []*serviceExtractMock{
+				{resp: &core.Jwk{JWKCommon: jwa.JWKCommon{Alg: jwa.EdDSA}}},
+				{resp: &core.Jwk{JWKCommon: jwa.JWKCommon{Alg: jwa.HS256}}},
+			},
+
+			expect: []*core.JwkMetadata{
+				{
+					KID:       mainKey.ID,
+					Usage:     "auth",
+					Alg:       jwa.EdDSA,
+					Main:      true,
+					CreatedAt: mainKey.CreatedAt,
+					ExpiresAt: mainKey.ExpiresAt,
+				},
+				{
+					KID:       legacyKey.ID,
+					Usage:     "auth",
+					Alg:       jwa.HS256,
+					CreatedAt: legacyKey.CreatedAt,
+					ExpiresAt: legacyKey.ExpiresAt,
+				},
+			},
+		},
+		{
+			name: "Success/NoKeys",
+
+			request: &core.JwkMetadataSearchRequest{Usage: "auth"},
+
+			daoSearchMock: &daoSearchMock{},
+
+			expect: []*core.JwkMetadata{},
+		},
+		{
+			name: "Error/ConfigNotFound",
+
+			request: &core.JwkMetadataSearchRequest{Usage: "unknown"},
+
+			expectErr: core.ErrConfigNotFound,
+		},
+		{
+			name: "Error/Search",
+
+			request: &core.JwkMetadataSearchRequest{Usage: "auth"},
+
+			daoSearchMock: &daoSearchMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Extract",
+
+			request: &core.JwkMetadataSearchRequest{Usage: "auth"},
+
+			daoSearchMock:       &daoSearchMock{resp: []*dao.Jwk{mainKey}},
+			serviceExtractMocks: []*serviceExtractMock{{err: errFoo}},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoSearch := coremocks.NewMockJwkMetadataSearchDao(t)
+			serviceExtract := coremocks.NewMockJwkMetadataServiceExtract(t)
+
+			if testCase.daoSearchMock != nil {
+				daoSearch.EXPECT().
+					Exec(mock.Anything, &dao.JwkSearchRequest{Usage: testCase.request.Usage}).
+					Return(testCase.daoSearchMock.resp, testCase.daoSearchMock.err)
+			}
+
+			for i, serviceExtractMock := range testCase.serviceExtractMocks {
+				entity := testCase.daoSearchMock.resp[i]
+
+				serviceExtract.EXPECT().
+					Exec(mock.Anything, &core.JwkExtractRequest{Jwk: entity, Private: entity.PublicKey == nil}).
+					Return(serviceExtractMock.resp, serviceExtractMock.err)
+			}
+
+			service := core.NewJwkMetadataSearch(daoSearch, serviceExtract, keysConfig)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoSearch.AssertExpectations(t)
+			serviceExtract.AssertExpectations(t)
+		})
+	}
+}
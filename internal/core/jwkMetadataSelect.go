@@ -0,0 +1,82 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// JwkMetadataSelectDao is the DAO dependency of [JwkMetadataSelect].
+type JwkMetadataSelectDao interface {
+	Exec(ctx context.Context, request *dao.JwkSelectRequest) (*dao.Jwk, error)
+}
+
+// JwkMetadataSelectDaoSearch is the DAO search dependency of [JwkMetadataSelect], to tell whether
+// the key is the main key of its usage.
+type JwkMetadataSelectDaoSearch interface {
+	Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)
+}
+
+// JwkMetadataSelectRequest holds the parameters for a [JwkMetadataSelect.Exec] call.
+type JwkMetadataSelectRequest struct {
+	// ID is the key to describe.
+	ID uuid.UUID
+}
+
+// A JwkMetadataSelect describes an active key by its key ID. See [JwkSelect] for the key itself.
+type JwkMetadataSelect struct {
+	dao            JwkMetadataSelectDao
+	daoSearch      JwkMetadataSelectDaoSearch
+	serviceExtract JwkMetadataServiceExtract
+}
+
+// NewJwkMetadataSelect returns a new JwkMetadataSelect service.
+func NewJwkMetadataSelect(
+	dao JwkMetadataSelectDao,
+	daoSearch JwkMetadataSelectDaoSearch,
+	serviceExtract JwkMetadataServiceExtract,
+) *JwkMetadataSelect {
+	return &JwkMetadataSelect{
+		dao:            dao,
+		daoSearch:      daoSearch,
+		serviceExtract: serviceExtract,
+	}
+}
+
+func (service *JwkMetadataSelect) Exec(ctx context.Context, request *JwkMetadataSelectRequest) (*JwkMetadata, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.JwkMetadataSelect")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("key.id", request.ID.String()))
+
+	entity, err := service.dao.Exec(ctx, &dao.JwkSelectRequest{ID: request.ID})
+	if err != nil {
+		if errors.Is(err, dao.ErrJwkSelectNotFound) {
+			return nil, ErrJwkNotFound
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("select key: %w", err))
+	}
+
+	output, err := newJwkMetadata(ctx, service.serviceExtract, entity)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// Keys come newest first: the first one is the main key.
+	keys, err := service.daoSearch.Exec(ctx, &dao.JwkSearchRequest{Usage: entity.Usage})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list keys: %w", err))
+	}
+
+	output.Main = len(keys) > 0 && keys[0].ID == entity.ID
+
+	return otel.ReportSuccess(span, output), nil
+}
@@ -0,0 +1,159 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestJwkMetadataSelect(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	now := time.Now()
+
+	key := &dao.Jwk{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		PublicKey: lo.ToPtr("cHVibGljLWtleS0x"),
+		Usage:     "auth",
+		CreatedAt: now.Add(-time.Hour),
+		ExpiresAt: now.Add(time.Hour),
+	}
+	newerKey := &dao.Jwk{ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"), Usage: "auth"}
+
+	type daoSelectMock struct {
+		resp *dao.Jwk
+		err  error
+	}
+
+	type daoSearchMock struct {
+		resp []*dao.Jwk
+		err  error
+	}
+
+	type serviceExtractMock struct {
+		resp *core.Jwk
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		daoSelectMock      *daoSelectMock
+		serviceExtractMock *serviceExtractMock
+		daoSearchMock      *daoSearchMock
+
+		expect    *core.JwkMetadata
+		expectErr error
+	}{
+		{
+			name: "Success/Main",
+
+			daoSelectMock:      &daoSelectMock{resp: key},
+			serviceExtractMock: &serviceExtractMock{resp: &core.Jwk{JWKCommon: jwa.JWKCommon{Alg: jwa.EdDSA}}},
+			daoSearchMock:      &daoSearchMock{resp: []*dao.Jwk{key}},
+
+			expect: &core.JwkMetadata{
+				KID:       key.ID,
+				Usage:     "auth",
+				Alg:       jwa.EdDSA,
+				Main:      true,
+				CreatedAt: key.CreatedAt,
+				ExpiresAt: key.ExpiresAt,
+			},
+		},
+		{
+			name: "Success/Legacy",
+
+			daoSelectMock:      &daoSelectMock{resp: key},
+			serviceExtractMock: &serviceExtractMock{resp: &core.Jwk{JWKCommon: jwa.JWKCommon{Alg: jwa.EdDSA}}},
+			daoSearchMock:      &daoSearchMock{resp: []*dao.Jwk{newerKey, key}},
+
+			expect: &core.JwkMetadata{
+				KID:       key.ID,
+				Usage:     "auth",
+				Alg:       jwa.EdDSA,
+				CreatedAt: key.CreatedAt,
+				ExpiresAt: key.ExpiresAt,
+			},
+		},
+		{
+			name: "Error/NotFound",
+
+			daoSelectMock: &daoSelectMock{err: dao.ErrJwkSelectNotFound},
+
+			expectErr: core.ErrJwkNotFound,
+		},
+		{
+			name: "Error/Select",
+
+			daoSelectMock: &daoSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Extract",
+
+			daoSelectMock:      &daoSelectMock{resp: key},
+			serviceExtractMock: &serviceExtractMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Search",
+
+			daoSelectMock:      &daoSelectMock{resp: key},
+			serviceExtractMock: &serviceExtractMock{resp: &core.Jwk{JWKCommon: jwa.JWKCommon{Alg: jwa.EdDSA}}},
+			daoSearchMock:      &daoSearchMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoSelect := coremocks.NewMockJwkMetadataSelectDao(t)
+			daoSearch := coremocks.NewMockJwkMetadataSelectDaoSearch(t)
+			serviceExtract := coremocks.NewMockJwkMetadataServiceExtract(t)
+
+			daoSelect.EXPECT().
+				Exec(mock.Anything, &dao.JwkSelectRequest{ID: key.ID}).
+				Return(testCase.daoSelectMock.resp, testCase.daoSelectMock.err)
+
+			if testCase.serviceExtractMock != nil {
+				serviceExtract.EXPECT().
+					Exec(mock.Anything, &core.JwkExtractRequest{Jwk: key}).
+					Return(testCase.serviceExtractMock.resp, testCase.serviceExtractMock.err)
+			}
+
+			if testCase.daoSearchMock != nil {
+				daoSearch.EXPECT().
+					Exec(mock.Anything, &dao.JwkSearchRequest{Usage: "auth"}).
+					Return(testCase.daoSearchMock.resp, testCase.daoSearchMock.err)
+			}
+
+			service := core.NewJwkMetadataSelect(daoSelect, daoSearch, serviceExtract)
+
+			resp, err := service.Exec(t.Context(), &core.JwkMetadataSelectRequest{ID: key.ID})
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoSelect.AssertExpectations(t)
+			daoSearch.AssertExpectations(t)
+			serviceExtract.AssertExpectations(t)
+		})
+	}
+}
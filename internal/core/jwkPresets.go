@@ -2,8 +2,13 @@ package core
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/a-novel-kit/jwt/v2"
 	"github.com/a-novel-kit/jwt/v2/jwa"
@@ -20,6 +25,9 @@ var (
 	// with no registered key-source builder. Only asymmetric algorithms are supported: a
 	// symmetric secret has no public half to publish on the REST surface.
 	ErrJwkPresetUnknownAlgorithm = errors.New("unknown jwk algorithm")
+	// ErrJwkTokenType is returned when the "typ" header of a token names another kind of object
+	// than a JWT.
+	ErrJwkTokenType = errors.New("token type is not JWT")
 )
 
 // JwkPresetsEcdsa maps ECDSA algorithm identifiers to their JWK generation presets.
@@ -129,6 +137,22 @@ func JwkGeneratorRsa(alg jwa.Alg) func() (any, any, string, string, error) {
 	}
 }
 
+// JwkKeySize returns the strength, in bits, of a public key returned by one of the [JwkGenerators]:
+// the modulus size for RSA keys, the curve size for elliptic-curve keys.
+func JwkKeySize(publicKey any) (int, error) {
+	switch key := publicKey.(type) {
+	case *jwk.Key[ed25519.PublicKey]:
+		// Ed25519 keys have a single, fixed size.
+		return config.JwkAlgKeySizes[jwa.EdDSA], nil
+	case *jwk.Key[*ecdsa.PublicKey]:
+		return key.Key().Curve.Params().BitSize, nil
+	case *jwk.Key[*rsa.PublicKey]:
+		return key.Key().N.BitLen(), nil
+	default:
+		return 0, fmt.Errorf("%w: %T", ErrJwkPresetUnknown, publicKey)
+	}
+}
+
 // JwkPrivateSources holds typed, cached private-key sources for each supported algorithm family,
 // grouped by usage name, and is used to wire signing plugins for JWT production. Only asymmetric
 // algorithms are supported.
@@ -163,7 +187,7 @@ func NewJwkPrivateSource(
 		}
 
 		keySource := jwk.NewSource(jwk.SourceConfig{
-			CacheDuration: keyConfig.Key.Cache,
+			CacheDuration: jwkSourceCacheDuration(source, keyConfig),
 			Fetch:         fetch,
 		})
 
@@ -184,6 +208,44 @@ func NewJwkPrivateSource(
 	return output, nil
 }
 
+// Usage returns the key source of usage, whatever its algorithm family.
+func (sources *JwkPrivateSources) Usage(usage string) (*jwk.Source, bool) {
+	return jwkSourcesUsage(usage, sources.EdDSA, sources.ES, sources.RSA)
+}
+
+// SigningKey returns the private key usage signs with: its newest key of the configured algorithm,
+// checked against the usage's policy.
+//
+// During an algorithm migration, the newest key may still be a legacy one until the next rotation,
+// so the newest key overall is not enough.
+func (sources *JwkPrivateSources) SigningKey(
+	ctx context.Context, usage string, keyConfig *config.Jwk,
+) (*jwa.JWK, error) {
+	source, ok := sources.Usage(usage)
+	if !ok {
+		return nil, fmt.Errorf("%w: no key source for usage %s", ErrConfigNotFound, usage)
+	}
+
+	err := keyConfig.Policy.CheckAlg(keyConfig.Alg)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := source.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list keys: %w", err)
+	}
+
+	// Keys come newest first.
+	for _, key := range keys {
+		if key.Alg == keyConfig.Alg {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no %s key for usage %s", ErrJwkNotFound, keyConfig.Alg, usage)
+}
+
 // JwkPublicSources holds typed, cached public-key sources for each supported algorithm family,
 // grouped by usage name, and is used to wire verification plugins for JWT consumption. Only
 // asymmetric algorithms are supported.
@@ -218,7 +280,7 @@ func NewJwkPublicSource(
 		}
 
 		keySource := jwk.NewSource(jwk.SourceConfig{
-			CacheDuration: keyConfig.Key.Cache,
+			CacheDuration: jwkSourceCacheDuration(source, keyConfig),
 			Fetch:         fetch,
 			// The signer rotates to a key the instant it is published, but a verifier holds its
 			// cached set for CacheDuration — so a token signed with a just-rotated key names a kid
@@ -247,6 +309,34 @@ func NewJwkPublicSource(
 	return output, nil
 }
 
+// Usage returns the key source of usage, whatever its algorithm family.
+func (sources *JwkPublicSources) Usage(usage string) (*jwk.Source, bool) {
+	return jwkSourcesUsage(usage, sources.EdDSA, sources.ES, sources.RSA)
+}
+
+// jwkSourceCacheDuration returns how long the key source of a usage caches the keys fetched from
+// source: the usage's key.cache, shortened to the refresh interval of sources that cache keys
+// themselves (see [JwkSourceRefresher]).
+func jwkSourceCacheDuration(source any, keyConfig *config.Jwk) time.Duration {
+	refresher, ok := source.(JwkSourceRefresher)
+	if !ok {
+		return keyConfig.Key.Cache
+	}
+
+	return min(keyConfig.Key.Cache, refresher.RefreshInterval())
+}
+
+// jwkSourcesUsage looks usage up in each algorithm family bucket.
+func jwkSourcesUsage(usage string, families ...map[string]*jwk.Source) (*jwk.Source, bool) {
+	for _, family := range families {
+		if source, ok := family[usage]; ok {
+			return source, true
+		}
+	}
+
+	return nil, false
+}
+
 // JwkProducers maps each key usage to the set of JWT producer plugins used for signing tokens
 // under that usage. Use [NewJwkProducers] to build one from a [JwkPrivateSources].
 type JwkProducers map[string][]jwt.ProducerPlugin
@@ -286,32 +376,139 @@ func NewJwkProducers(
 // under that usage. Use [NewJwkRecipients] to build one from a [JwkPublicSources].
 type JwkRecipients map[string][]jwt.RecipientPlugin
 
-// NewJwkRecipients builds a JwkRecipients map from sources, wiring the appropriate verifier plugin
-// for each usage based on its algorithm. Returns an error if a usage has no matching verifier preset.
+// JwkPolicyRecipient is a recipient plugin that refuses tokens whose header algorithm falls outside
+// a [config.JwkPolicy]. It never consumes a token itself: an allowed algorithm yields
+// [jwt.ErrMismatchRecipientPlugin], so the following plugins verify it as usual.
+type JwkPolicyRecipient struct {
+	policy *config.JwkPolicy
+}
+
+var _ jwt.RecipientPlugin = (*JwkPolicyRecipient)(nil)
+
+// NewJwkPolicyRecipient returns a JwkPolicyRecipient enforcing policy.
+func NewJwkPolicyRecipient(policy *config.JwkPolicy) *JwkPolicyRecipient {
+	return &JwkPolicyRecipient{policy: policy}
+}
+
+func (recipient *JwkPolicyRecipient) Transform(_ context.Context, header *jwa.JWH, _ string) ([]byte, error) {
+	err := recipient.policy.CheckAlg(header.Alg)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, jwt.ErrMismatchRecipientPlugin
+}
+
+// JwkTypRecipient is a recipient plugin that refuses tokens whose "typ" header names another kind
+// of object than a JWT, such as detached payload signatures (see [JwsDetachedTyp]), which share the
+// keys of their usage. Tokens without a "typ" header pass: tokens signed before [ClaimsSign] typed
+// them carry none. Like [JwkPolicyRecipient], it never consumes a token itself.
+type JwkTypRecipient struct{}
+
+var _ jwt.RecipientPlugin = (*JwkTypRecipient)(nil)
+
+// NewJwkTypRecipient returns a JwkTypRecipient.
+func NewJwkTypRecipient() *JwkTypRecipient {
+	return &JwkTypRecipient{}
+}
+
+func (recipient *JwkTypRecipient) Transform(_ context.Context, header *jwa.JWH, _ string) ([]byte, error) {
+	err := checkTokenType(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, jwt.ErrMismatchRecipientPlugin
+}
+
+// checkTokenType returns an error wrapping [ErrJwkTokenType] when header types another kind of
+// object than a JWT. A header without a type passes.
+func checkTokenType(header *jwa.JWH) error {
+	// Media types compare case-insensitively (RFC 7515, section 4.1.9).
+	if header.Typ != "" && !strings.EqualFold(string(header.Typ), string(jwa.TypJWT)) {
+		return fmt.Errorf("%w: %q", ErrJwkTokenType, header.Typ)
+	}
+
+	return nil
+}
+
+// JwkAlgRecipient routes a token to a verifier plugin only when the token header names the
+// algorithm the plugin verifies, and yields [jwt.ErrMismatchRecipientPlugin] otherwise.
+//
+// Sourced verifiers of different algorithms can then share a single key source: without the
+// routing, a verifier of the wrong family reports an invalid signature for a key it cannot decode,
+// and the recipient stops there.
+type JwkAlgRecipient struct {
+	alg    jwa.Alg
+	plugin jwt.RecipientPlugin
+}
+
+var _ jwt.RecipientPlugin = (*JwkAlgRecipient)(nil)
+
+// NewJwkAlgRecipient returns a JwkAlgRecipient routing tokens signed with alg to plugin.
+func NewJwkAlgRecipient(alg jwa.Alg, plugin jwt.RecipientPlugin) *JwkAlgRecipient {
+	return &JwkAlgRecipient{alg: alg, plugin: plugin}
+}
+
+func (recipient *JwkAlgRecipient) Transform(ctx context.Context, header *jwa.JWH, token string) ([]byte, error) {
+	if header.Alg != recipient.alg {
+		return nil, fmt.Errorf("%w: algorithm %s, expected %s", jwt.ErrMismatchRecipientPlugin, header.Alg, recipient.alg)
+	}
+
+	return recipient.plugin.Transform(ctx, header, token)
+}
+
+// newJwkSourcedVerifier returns the sourced verifier plugin for alg.
+func newJwkSourcedVerifier(source *jwk.Source, alg jwa.Alg) (jwt.RecipientPlugin, error) {
+	if alg == jwa.EdDSA {
+		return jws.NewSourcedED25519Verifier(source), nil
+	}
+
+	if ecdsaPreset, ok := JwsPresetsEcdsa[alg]; ok {
+		return jws.NewSourcedECDSAVerifier(source, ecdsaPreset), nil
+	}
+
+	if rsaPreset, ok := JwsPresetsRsa[alg]; ok {
+		return jws.NewSourcedRSAVerifier(source, rsaPreset), nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrJwkPresetUnknown, alg)
+}
+
+// NewJwkRecipients builds a JwkRecipients map from sources, wiring one verifier plugin per
+// algorithm of each usage: its current algorithm, and any previous one still being migrated away
+// from (see [config.Jwk.PreviousAlgs]). All of them read the usage's single key source, and a
+// [JwkAlgRecipient] routes each token to the verifier of its header algorithm. Returns an error if
+// an algorithm has no matching verifier preset.
+//
+// Every usage gets a [JwkTypRecipient] ahead of its verifiers, and usages with a policy a
+// [JwkPolicyRecipient], so a token that is not a JWT, or whose header names a disallowed algorithm,
+// is refused outright rather than reported as unmatched.
 func NewJwkRecipients(
 	sources *JwkPublicSources,
 	keys map[string]*config.Jwk,
 ) (JwkRecipients, error) {
 	output := make(JwkRecipients)
 
-	for usage, usageConfig := range sources.EdDSA {
-		recipient := jws.NewSourcedED25519Verifier(usageConfig)
-		output[usage] = []jwt.RecipientPlugin{recipient}
-	}
+	for _, family := range []map[string]*jwk.Source{sources.EdDSA, sources.ES, sources.RSA} {
+		for usage, source := range family {
+			keyConfig := keys[usage]
 
-	for usage, usageConfig := range sources.ES {
-		recipient := jws.NewSourcedECDSAVerifier(usageConfig, JwsPresetsEcdsa[keys[usage].Alg])
-		output[usage] = append(output[usage], recipient)
-	}
+			output[usage] = append(output[usage], NewJwkTypRecipient())
 
-	for usage, usageConfig := range sources.RSA {
-		rsaPreset, ok := JwsPresetsRsa[keys[usage].Alg]
-		if !ok {
-			return nil, fmt.Errorf("%w (rsa) for usage: %s", ErrJwkPresetUnknown, usage)
-		}
+			if keyConfig.Policy != nil {
+				output[usage] = append(output[usage], NewJwkPolicyRecipient(keyConfig.Policy))
+			}
 
-		recipient := jws.NewSourcedRSAVerifier(usageConfig, rsaPreset)
-		output[usage] = append(output[usage], recipient)
+			for _, alg := range keyConfig.Algs() {
+				verifier, err := newJwkSourcedVerifier(source, alg)
+				if err != nil {
+					return nil, fmt.Errorf("usage %s: %w", usage, err)
+				}
+
+				output[usage] = append(output[usage], NewJwkAlgRecipient(alg, verifier))
+			}
+		}
 	}
 
 	return output, nil
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// ErrJwkRevokeNoComment is returned when a key is revoked without a reason.
+var ErrJwkRevokeNoComment = errors.New("a key revocation needs a comment")
+
+// JwkRevokeDao is the DAO dependency of [JwkRevoke].
+type JwkRevokeDao interface {
+	Exec(ctx context.Context, request *dao.JwkDeleteRequest) (*dao.Jwk, error)
+}
+
+// JwkRevokeDaoInvalidate is the DAO dependency of [JwkRevoke] that tells the servers to drop their
+// cached keys.
+type JwkRevokeDaoInvalidate interface {
+	Exec(ctx context.Context, request *dao.JwkInvalidateRequest) error
+}
+
+// JwkRevokeRequest holds the parameters for a [JwkRevoke.Exec] call.
+type JwkRevokeRequest struct {
+	// ID is the key to revoke.
+	ID uuid.UUID
+	// Comment is the reason of the revocation, kept with the key and in the audit trail. Required.
+	Comment string
+}
+
+// A JwkRevoke revokes a key before its expiry, for instance after a compromise. The key leaves
+// the active keys at once, and every server sharing the database drops its cached keys of the
+// usage (see [JwkInvalidationSync]): it stops verifying tokens there right away, and for other
+// consumers as soon as they refresh their cached keys.
+//
+// Revoking the main key of a usage makes its newest legacy key sign again, if any: rotate the
+// usage afterwards.
+type JwkRevoke struct {
+	dao            JwkRevokeDao
+	daoInvalidate  JwkRevokeDaoInvalidate
+	serviceExtract JwkMetadataServiceExtract
+}
+
+// NewJwkRevoke returns a new JwkRevoke service.
+func NewJwkRevoke(
+	dao JwkRevokeDao, daoInvalidate JwkRevokeDaoInvalidate, serviceExtract JwkMetadataServiceExtract,
+) *JwkRevoke {
+	return &JwkRevoke{dao: dao, daoInvalidate: daoInvalidate, serviceExtract: serviceExtract}
+}
+
+func (service *JwkRevoke) Exec(ctx context.Context, request *JwkRevokeRequest) (*JwkMetadata, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.JwkRevoke")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("key.id", request.ID.String()))
+
+	key, err := service.revoke(ctx, request)
+	if err != nil {
+		recordAudit(ctx, &AuditRecordRequest{
+			Action: AuditActionJwkRevoke,
+			KID:    request.ID.String(),
+			Err:    err,
+		})
+
+		return nil, otel.ReportError(span, err)
+	}
+
+	recordAudit(ctx, &AuditRecordRequest{
+		Action: AuditActionJwkRevoke,
+		Usage:  key.Usage,
+		KID:    key.KID.String(),
+		Detail: key.RevokedComment,
+	})
+
+	return otel.ReportSuccess(span, key), nil
+}
+
+func (service *JwkRevoke) revoke(ctx context.Context, request *JwkRevokeRequest) (*JwkMetadata, error) {
+	if request.Comment == "" {
+		return nil, ErrJwkRevokeNoComment
+	}
+
+	entity, err := service.dao.Exec(ctx, &dao.JwkDeleteRequest{
+		ID:      request.ID,
+		Now:     time.Now(),
+		Comment: request.Comment,
+	})
+	if err != nil {
+		if errors.Is(err, dao.ErrJwkDeleteNotFound) {
+			return nil, ErrJwkNotFound
+		}
+
+		return nil, fmt.Errorf("revoke key: %w", err)
+	}
+
+	// Within a transaction, sent when it commits.
+	err = service.daoInvalidate.Exec(ctx, &dao.JwkInvalidateRequest{Usage: entity.Usage})
+	if err != nil {
+		return nil, fmt.Errorf("invalidate keys: %w", err)
+	}
+
+	return newJwkMetadata(ctx, service.serviceExtract, entity)
+}
@@ -0,0 +1,165 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestJwkRevoke(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	now := time.Now()
+
+	revokedKey := &dao.Jwk{
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		PublicKey:      lo.ToPtr("cHVibGljLWtleS0x"),
+		Usage:          "auth",
+		CreatedAt:      now.Add(-time.Hour),
+		ExpiresAt:      now.Add(time.Hour),
+		DeletedAt:      lo.ToPtr(now),
+		DeletedComment: lo.ToPtr("leaked"),
+	}
+
+	type daoDeleteMock struct {
+		resp *dao.Jwk
+		err  error
+	}
+
+	type serviceExtractMock struct {
+		resp *core.Jwk
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.JwkRevokeRequest
+
+		daoDeleteMock      *daoDeleteMock
+		daoInvalidateErr   *error
+		serviceExtractMock *serviceExtractMock
+
+		expect    *core.JwkMetadata
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.JwkRevokeRequest{ID: revokedKey.ID, Comment: "leaked"},
+
+			daoDeleteMock:      &daoDeleteMock{resp: revokedKey},
+			daoInvalidateErr:   new(error),
+			serviceExtractMock: &serviceExtractMock{resp: &core.Jwk{JWKCommon: jwa.JWKCommon{Alg: jwa.EdDSA}}},
+
+			expect: &core.JwkMetadata{
+				KID:            revokedKey.ID,
+				Usage:          "auth",
+				Alg:            jwa.EdDSA,
+				CreatedAt:      revokedKey.CreatedAt,
+				ExpiresAt:      revokedKey.ExpiresAt,
+				RevokedAt:      lo.ToPtr(now),
+				RevokedComment: "leaked",
+			},
+		},
+		{
+			name: "Error/NoComment",
+
+			request: &core.JwkRevokeRequest{ID: revokedKey.ID},
+
+			expectErr: core.ErrJwkRevokeNoComment,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &core.JwkRevokeRequest{ID: revokedKey.ID, Comment: "leaked"},
+
+			daoDeleteMock: &daoDeleteMock{err: dao.ErrJwkDeleteNotFound},
+
+			expectErr: core.ErrJwkNotFound,
+		},
+		{
+			name: "Error/Delete",
+
+			request: &core.JwkRevokeRequest{ID: revokedKey.ID, Comment: "leaked"},
+
+			daoDeleteMock: &daoDeleteMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Extract",
+
+			request: &core.JwkRevokeRequest{ID: revokedKey.ID, Comment: "leaked"},
+
+			daoDeleteMock:      &daoDeleteMock{resp: revokedKey},
+			daoInvalidateErr:   new(error),
+			serviceExtractMock: &serviceExtractMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Invalidate",
+
+			request: &core.JwkRevokeRequest{ID: revokedKey.ID, Comment: "leaked"},
+
+			daoDeleteMock:    &daoDeleteMock{resp: revokedKey},
+			daoInvalidateErr: &errFoo,
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoDelete := coremocks.NewMockJwkRevokeDao(t)
+			daoInvalidate := coremocks.NewMockJwkRevokeDaoInvalidate(t)
+			serviceExtract := coremocks.NewMockJwkMetadataServiceExtract(t)
+
+			if testCase.daoDeleteMock != nil {
+				daoDelete.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(request *dao.JwkDeleteRequest) bool {
+						return request.ID == testCase.request.ID && request.Comment == testCase.request.Comment &&
+							!request.Now.IsZero()
+					})).
+					Return(testCase.daoDeleteMock.resp, testCase.daoDeleteMock.err)
+			}
+
+			if testCase.daoInvalidateErr != nil {
+				daoInvalidate.EXPECT().
+					Exec(mock.Anything, &dao.JwkInvalidateRequest{Usage: revokedKey.Usage}).
+					Return(*testCase.daoInvalidateErr)
+			}
+
+			if testCase.serviceExtractMock != nil {
+				serviceExtract.EXPECT().
+					Exec(mock.Anything, &core.JwkExtractRequest{Jwk: revokedKey}).
+					Return(testCase.serviceExtractMock.resp, testCase.serviceExtractMock.err)
+			}
+
+			service := core.NewJwkRevoke(daoDelete, daoInvalidate, serviceExtract)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoDelete.AssertExpectations(t)
+			daoInvalidate.AssertExpectations(t)
+			serviceExtract.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// JwkRotateDaoLock is the DAO lock dependency of [JwkRotate].
+type JwkRotateDaoLock interface {
+	Exec(ctx context.Context, request *dao.JwkLockRequest) error
+}
+
+// JwkRotateDaoInvalidate is the DAO dependency of [JwkRotate] that tells the servers to drop their
+// cached keys.
+type JwkRotateDaoInvalidate interface {
+	Exec(ctx context.Context, request *dao.JwkInvalidateRequest) error
+}
+
+// JwkRotateServiceSearch is the key listing dependency of [JwkRotate].
+type JwkRotateServiceSearch interface {
+	Exec(ctx context.Context, request *JwkMetadataSearchRequest) ([]*JwkMetadata, error)
+}
+
+// JwkRotateServiceGen is the generation dependency of [JwkRotate].
+type JwkRotateServiceGen interface {
+	Exec(ctx context.Context, request *JwkGenRequest) (*Jwk, error)
+}
+
+// JwkRotateServiceRevoke is the revocation dependency of [JwkRotate].
+type JwkRotateServiceRevoke interface {
+	Exec(ctx context.Context, request *JwkRevokeRequest) (*JwkMetadata, error)
+}
+
+// JwkRotateRequest holds the parameters for a [JwkRotate.Exec] call.
+type JwkRotateRequest struct {
+	// Usage is the key usage to rotate.
+	Usage string
+	// RevokePrevious revokes the previous main key of the usage along with the rotation, so it
+	// stops verifying tokens. Otherwise, it stays active until it expires.
+	RevokePrevious bool
+	// Comment is the reason of the revocation. Required with RevokePrevious.
+	Comment string
+}
+
+// JwkRotateResponse reports the outcome of a [JwkRotate.Exec] call.
+type JwkRotateResponse struct {
+	// Key is the new main key of the usage.
+	Key *JwkMetadata
+	// Previous is the main key of the usage before the rotation, nil if the usage had none. Its
+	// RevokedAt is set when it was revoked.
+	Previous *JwkMetadata
+}
+
+// A JwkRotate moves a usage to a fresh key at once, whatever the age of its current one, for
+// instance when the current key is suspected to have leaked. See [JwkRotateAll] for scheduled
+// rotations.
+//
+// The rotation and the revocation of the previous key run in a single transaction, under the
+// usage's lock: either both happen, or neither, and concurrent rotations, burns and scheduled
+// rotations of the usage wait for each other. When it commits, every server sharing the database
+// drops its cached keys (see [JwkInvalidationSync]), so they sign with the new key, and stop
+// trusting the revoked one, right away.
+type JwkRotate struct {
+	daoLock       JwkRotateDaoLock
+	daoInvalidate JwkRotateDaoInvalidate
+	serviceSearch JwkRotateServiceSearch
+	serviceGen    JwkRotateServiceGen
+	serviceRevoke JwkRotateServiceRevoke
+	transactor    transaction.Transactor
+}
+
+// NewJwkRotate returns a new JwkRotate service.
+func NewJwkRotate(
+	daoLock JwkRotateDaoLock,
+	daoInvalidate JwkRotateDaoInvalidate,
+	serviceSearch JwkRotateServiceSearch,
+	serviceGen JwkRotateServiceGen,
+	serviceRevoke JwkRotateServiceRevoke,
+	transactor transaction.Transactor,
+) *JwkRotate {
+	return &JwkRotate{
+		daoLock:       daoLock,
+		daoInvalidate: daoInvalidate,
+		serviceSearch: serviceSearch,
+		serviceGen:    serviceGen,
+		serviceRevoke: serviceRevoke,
+		transactor:    transactor,
+	}
+}
+
+func (service *JwkRotate) Exec(ctx context.Context, request *JwkRotateRequest) (*JwkRotateResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.JwkRotate")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("key.usage", request.Usage),
+		attribute.Bool("key.revoke_previous", request.RevokePrevious),
+	)
+
+	// Fail before generating anything.
+	if request.RevokePrevious && request.Comment == "" {
+		return nil, otel.ReportError(span, ErrJwkRevokeNoComment)
+	}
+
+	output := new(JwkRotateResponse)
+
+	err := service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		err := service.daoLock.Exec(ctx, &dao.JwkLockRequest{Usage: request.Usage})
+		if err != nil {
+			return fmt.Errorf("lock usage: %w", err)
+		}
+
+		previous, err := service.serviceSearch.Exec(ctx, &JwkMetadataSearchRequest{Usage: request.Usage})
+		if err != nil {
+			return fmt.Errorf("list keys: %w", err)
+		}
+
+		_, err = service.serviceGen.Exec(ctx, &JwkGenRequest{Usage: request.Usage, Force: true})
+		if err != nil {
+			return fmt.Errorf("generate key: %w", err)
+		}
+
+		if len(previous) > 0 {
+			output.Previous = previous[0]
+			output.Previous.Main = false
+		}
+
+		if output.Previous != nil && request.RevokePrevious {
+			output.Previous, err = service.serviceRevoke.Exec(ctx, &JwkRevokeRequest{
+				ID:      output.Previous.KID,
+				Comment: request.Comment,
+			})
+			if err != nil {
+				return fmt.Errorf("revoke previous key: %w", err)
+			}
+		}
+
+		current, err := service.serviceSearch.Exec(ctx, &JwkMetadataSearchRequest{Usage: request.Usage})
+		if err != nil {
+			return fmt.Errorf("list keys: %w", err)
+		}
+
+		// The generated key is the newest.
+		if len(current) == 0 {
+			return ErrJwkNotFound
+		}
+
+		output.Key = current[0]
+
+		// Sent when the transaction commits.
+		err = service.daoInvalidate.Exec(ctx, &dao.JwkInvalidateRequest{Usage: request.Usage})
+		if err != nil {
+			return fmt.Errorf("invalidate keys: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("rotate key: %w", err))
+	}
+
+	span.SetAttributes(attribute.String("key.id", output.Key.KID.String()))
+
+	if output.Previous != nil {
+		span.SetAttributes(attribute.String("key.previous_id", output.Previous.KID.String()))
+	}
+
+	return otel.ReportSuccess(span, output), nil
+}
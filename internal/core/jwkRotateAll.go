@@ -3,24 +3,36 @@ package core
 import (
 	"context"
 	"fmt"
+	"slices"
 
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel-kit/golib/otel"
 	"github.com/a-novel-kit/golib/transaction"
 
 	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
 )
 
+// JwkRotateAllDaoLock is the DAO lock dependency of [JwkRotateAll].
+type JwkRotateAllDaoLock interface {
+	Exec(ctx context.Context, request *dao.JwkLockRequest) error
+}
+
 // JwkRotateAllServiceGen is the per-usage generation dependency of [JwkRotateAll].
 type JwkRotateAllServiceGen interface {
 	Exec(ctx context.Context, request *JwkGenRequest) (*Jwk, error)
 }
 
-// JwkRotateAllRequest holds the parameters for a [JwkRotateAll.Exec] call. The set of usages
-// to rotate comes from configuration, so it is empty; it exists so the operation can gain a
-// parameter without breaking callers.
-type JwkRotateAllRequest struct{}
+// JwkRotateAllRequest holds the parameters for a [JwkRotateAll.Exec] call.
+type JwkRotateAllRequest struct {
+	// Usages restricts the rotation to these usages, which must all be configured. Every
+	// configured usage is rotated when empty.
+	Usages []string
+	// Force rotates the usages even within their rotation window. See [JwkGenRequest.Force].
+	Force bool
+}
 
 // JwkRotateAllResponse reports the outcome of a [JwkRotateAll.Exec] call.
 type JwkRotateAllResponse struct {
@@ -31,8 +43,10 @@ type JwkRotateAllResponse struct {
 
 // A JwkRotateAll ensures every configured usage has a current key, as a single unit of work:
 // the injected transactor wraps the whole rotation, so a failure partway through leaves none
-// of the usages rotated.
+// of the usages rotated. Usages are rotated in order, each under its lock, so a rotation never
+// races a [JwkRotate] or a [JwkBurn] of the same usage into generating two keys.
 type JwkRotateAll struct {
+	daoLock    JwkRotateAllDaoLock
 	serviceGen JwkRotateAllServiceGen
 	transactor transaction.Transactor
 	keysConfig map[string]*config.Jwk
@@ -40,26 +54,40 @@ type JwkRotateAll struct {
 
 // NewJwkRotateAll returns a JwkRotateAll rotating the usages declared in keysConfig.
 func NewJwkRotateAll(
+	daoLock JwkRotateAllDaoLock,
 	serviceGen JwkRotateAllServiceGen,
 	transactor transaction.Transactor,
 	keysConfig map[string]*config.Jwk,
 ) *JwkRotateAll {
-	return &JwkRotateAll{serviceGen: serviceGen, transactor: transactor, keysConfig: keysConfig}
+	return &JwkRotateAll{daoLock: daoLock, serviceGen: serviceGen, transactor: transactor, keysConfig: keysConfig}
 }
 
 func (service *JwkRotateAll) Exec(
-	ctx context.Context, _ *JwkRotateAllRequest,
+	ctx context.Context, request *JwkRotateAllRequest,
 ) (*JwkRotateAllResponse, error) {
 	ctx, span := otel.Tracer().Start(ctx, "core.JwkRotateAll")
 	defer span.End()
 
-	span.SetAttributes(attribute.Int("keys.usages", len(service.keysConfig)))
+	usages, err := jwkRotationUsages(service.keysConfig, request.Usages)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(
+		attribute.Int("keys.usages", len(usages)),
+		attribute.Bool("keys.forced", request.Force),
+	)
 
 	processed := 0
 
-	err := service.transactor.WithinTx(ctx, func(ctx context.Context) error {
-		for usage := range service.keysConfig {
-			_, err := service.serviceGen.Exec(ctx, &JwkGenRequest{Usage: usage})
+	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		for _, usage := range usages {
+			err := service.daoLock.Exec(ctx, &dao.JwkLockRequest{Usage: usage})
+			if err != nil {
+				return fmt.Errorf("lock usage %s: %w", usage, err)
+			}
+
+			_, err = service.serviceGen.Exec(ctx, &JwkGenRequest{Usage: usage, Force: request.Force})
 			if err != nil {
 				return fmt.Errorf("generate key for usage %s: %w", usage, err)
 			}
@@ -77,3 +105,22 @@ func (service *JwkRotateAll) Exec(
 
 	return otel.ReportSuccess(span, &JwkRotateAllResponse{Processed: processed}), nil
 }
+
+// jwkRotationUsages returns, sorted, the usages a rotation targets: the requested ones, or every
+// configured usage when none is requested.
+func jwkRotationUsages(keysConfig map[string]*config.Jwk, requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		requested = lo.Keys(keysConfig)
+	}
+
+	for _, usage := range requested {
+		if _, ok := keysConfig[usage]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrConfigNotFound, usage)
+		}
+	}
+
+	usages := lo.Uniq(requested)
+	slices.Sort(usages)
+
+	return usages, nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/a-novel/service-json-keys/v2/internal/config"
 	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
 )
 
 var errGenerate = errors.New("generate")
@@ -21,12 +22,14 @@ var errGenerate = errors.New("generate")
 type recordingGenerator struct {
 	failAfter int
 	usages    []string
+	forced    []bool
 }
 
 func (generator *recordingGenerator) Exec(
 	_ context.Context, request *core.JwkGenRequest,
 ) (*core.Jwk, error) {
 	generator.usages = append(generator.usages, request.Usage)
+	generator.forced = append(generator.forced, request.Force)
 
 	if generator.failAfter > 0 && len(generator.usages) >= generator.failAfter {
 		return nil, errGenerate
@@ -35,6 +38,17 @@ func (generator *recordingGenerator) Exec(
 	return &core.Jwk{}, nil
 }
 
+// recordingLock records the usages it was asked to lock.
+type recordingLock struct {
+	usages []string
+}
+
+func (lock *recordingLock) Exec(_ context.Context, request *dao.JwkLockRequest) error {
+	lock.usages = append(lock.usages, request.Usage)
+
+	return nil
+}
+
 func twoUsages() map[string]*config.Jwk {
 	return map[string]*config.Jwk{"auth": {}, "refresh": {}}
 }
@@ -43,17 +57,53 @@ func TestJwkRotateAllProcessesEveryUsage(t *testing.T) {
 	t.Parallel()
 
 	generator := &recordingGenerator{}
+	lock := &recordingLock{}
 	transactor := transactiontest.NewTransactor()
 
-	service := core.NewJwkRotateAll(generator, transactor, twoUsages())
+	service := core.NewJwkRotateAll(lock, generator, transactor, twoUsages())
 
 	resp, err := service.Exec(t.Context(), &core.JwkRotateAllRequest{})
 	require.NoError(t, err)
 	require.Equal(t, 2, resp.Processed)
 	require.ElementsMatch(t, []string{"auth", "refresh"}, generator.usages)
+	require.Equal(t, generator.usages, lock.usages, "every usage is locked before its rotation")
 	require.Equal(t, 1, transactor.Calls(), "every usage belongs to one unit of work, not one each")
 }
 
+func TestJwkRotateAllSelectsUsages(t *testing.T) {
+	t.Parallel()
+
+	generator := &recordingGenerator{}
+
+	keysConfig := map[string]*config.Jwk{"auth": {}, "refresh": {}, "share": {}}
+
+	service := core.NewJwkRotateAll(&recordingLock{}, generator, transactiontest.NewTransactor(), keysConfig)
+
+	resp, err := service.Exec(t.Context(), &core.JwkRotateAllRequest{
+		Usages: []string{"share", "auth", "share"},
+		Force:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, resp.Processed)
+	require.Equal(t, []string{"auth", "share"}, generator.usages)
+	require.Equal(t, []bool{true, true}, generator.forced)
+}
+
+// TestJwkRotateAllRejectsUnknownUsage checks that a usage missing from the configuration fails the
+// whole call before any rotation, rather than rotating the others.
+func TestJwkRotateAllRejectsUnknownUsage(t *testing.T) {
+	t.Parallel()
+
+	generator := &recordingGenerator{}
+
+	service := core.NewJwkRotateAll(&recordingLock{}, generator, transactiontest.NewTransactor(), twoUsages())
+
+	resp, err := service.Exec(t.Context(), &core.JwkRotateAllRequest{Usages: []string{"auth", "unknown"}})
+	require.ErrorIs(t, err, core.ErrConfigNotFound)
+	require.Nil(t, resp)
+	require.Empty(t, generator.usages)
+}
+
 // TestRotateKeysReportsNothingProcessedOnFailure covers the count's contract. A partial
 // number describes work that has been rolled back, so the count reports zero.
 func TestJwkRotateAllReportsNothingProcessedOnFailure(t *testing.T) {
@@ -61,7 +111,7 @@ func TestJwkRotateAllReportsNothingProcessedOnFailure(t *testing.T) {
 
 	generator := &recordingGenerator{failAfter: 1}
 
-	service := core.NewJwkRotateAll(generator, transactiontest.NewTransactor(), twoUsages())
+	service := core.NewJwkRotateAll(&recordingLock{}, generator, transactiontest.NewTransactor(), twoUsages())
 
 	resp, err := service.Exec(t.Context(), &core.JwkRotateAllRequest{})
 	require.ErrorIs(t, err, errGenerate)
@@ -82,7 +132,9 @@ func TestJwkRotateAllIsOneUnitOfWork(t *testing.T) {
 
 	generator := &recordingGenerator{}
 
-	service := core.NewJwkRotateAll(generator, transactiontest.NewFailingTransactor(errNoTransaction), twoUsages())
+	service := core.NewJwkRotateAll(
+		&recordingLock{}, generator, transactiontest.NewFailingTransactor(errNoTransaction), twoUsages(),
+	)
 
 	resp, err := service.Exec(t.Context(), &core.JwkRotateAllRequest{})
 	require.ErrorIs(t, err, errNoTransaction)
@@ -0,0 +1,239 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestJwkRotate(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	newKey := func() *core.JwkMetadata {
+		return &core.JwkMetadata{KID: uuid.MustParse("00000000-0000-0000-0000-000000000002"), Usage: "auth", Main: true}
+	}
+
+	previousKey := func() *core.JwkMetadata {
+		return &core.JwkMetadata{KID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Usage: "auth", Main: true}
+	}
+
+	// The previous key after the rotation: active, but no longer the main key.
+	formerKey := &core.JwkMetadata{KID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Usage: "auth"}
+
+	revokedKey := &core.JwkMetadata{
+		KID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Usage:          "auth",
+		RevokedAt:      lo.ToPtr(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)),
+		RevokedComment: "leak",
+	}
+
+	type serviceSearchMock struct {
+		resp []*core.JwkMetadata
+		err  error
+	}
+
+	type serviceRevokeMock struct {
+		resp *core.JwkMetadata
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.JwkRotateRequest
+
+		daoLockErr              *error
+		daoInvalidateErr        *error
+		serviceSearchBeforeMock *serviceSearchMock
+		serviceGenErr           *error
+		serviceRevokeMock       *serviceRevokeMock
+		serviceSearchAfterMock  *serviceSearchMock
+
+		expect    *core.JwkRotateResponse
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.JwkRotateRequest{Usage: "auth"},
+
+			daoLockErr:              new(error),
+			daoInvalidateErr:        new(error),
+			serviceSearchBeforeMock: &serviceSearchMock{resp: []*core.JwkMetadata{previousKey()}},
+			serviceGenErr:           new(error),
+			serviceSearchAfterMock:  &serviceSearchMock{resp: []*core.JwkMetadata{newKey(), previousKey()}},
+
+			expect: &core.JwkRotateResponse{Key: newKey(), Previous: formerKey},
+		},
+		{
+			name: "Success/RevokePrevious",
+
+			request: &core.JwkRotateRequest{Usage: "auth", RevokePrevious: true, Comment: "leak"},
+
+			daoLockErr:              new(error),
+			daoInvalidateErr:        new(error),
+			serviceSearchBeforeMock: &serviceSearchMock{resp: []*core.JwkMetadata{previousKey()}},
+			serviceGenErr:           new(error),
+			serviceRevokeMock:       &serviceRevokeMock{resp: revokedKey},
+			serviceSearchAfterMock:  &serviceSearchMock{resp: []*core.JwkMetadata{newKey()}},
+
+			expect: &core.JwkRotateResponse{Key: newKey(), Previous: revokedKey},
+		},
+		{
+			// Nothing to revoke.
+			name: "Success/NoPreviousKey",
+
+			request: &core.JwkRotateRequest{Usage: "auth", RevokePrevious: true, Comment: "leak"},
+
+			daoLockErr:              new(error),
+			daoInvalidateErr:        new(error),
+			serviceSearchBeforeMock: &serviceSearchMock{},
+			serviceGenErr:           new(error),
+			serviceSearchAfterMock:  &serviceSearchMock{resp: []*core.JwkMetadata{newKey()}},
+
+			expect: &core.JwkRotateResponse{Key: newKey()},
+		},
+		{
+			name: "Error/NoComment",
+
+			request: &core.JwkRotateRequest{Usage: "auth", RevokePrevious: true},
+
+			expectErr: core.ErrJwkRevokeNoComment,
+		},
+		{
+			name: "Error/ConfigNotFound",
+
+			request: &core.JwkRotateRequest{Usage: "auth"},
+
+			daoLockErr:              new(error),
+			serviceSearchBeforeMock: &serviceSearchMock{err: core.ErrConfigNotFound},
+
+			expectErr: core.ErrConfigNotFound,
+		},
+		{
+			name: "Error/Gen",
+
+			request: &core.JwkRotateRequest{Usage: "auth"},
+
+			daoLockErr:              new(error),
+			serviceSearchBeforeMock: &serviceSearchMock{resp: []*core.JwkMetadata{previousKey()}},
+			serviceGenErr:           &errFoo,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Revoke",
+
+			request: &core.JwkRotateRequest{Usage: "auth", RevokePrevious: true, Comment: "leak"},
+
+			daoLockErr:              new(error),
+			serviceSearchBeforeMock: &serviceSearchMock{resp: []*core.JwkMetadata{previousKey()}},
+			serviceGenErr:           new(error),
+			serviceRevokeMock:       &serviceRevokeMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Lock",
+
+			request: &core.JwkRotateRequest{Usage: "auth"},
+
+			daoLockErr: &errFoo,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Invalidate",
+
+			request: &core.JwkRotateRequest{Usage: "auth"},
+
+			daoLockErr:              new(error),
+			daoInvalidateErr:        &errFoo,
+			serviceSearchBeforeMock: &serviceSearchMock{resp: []*core.JwkMetadata{previousKey()}},
+			serviceGenErr:           new(error),
+			serviceSearchAfterMock:  &serviceSearchMock{resp: []*core.JwkMetadata{newKey(), previousKey()}},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoLock := coremocks.NewMockJwkRotateDaoLock(t)
+			daoInvalidate := coremocks.NewMockJwkRotateDaoInvalidate(t)
+			serviceSearch := coremocks.NewMockJwkRotateServiceSearch(t)
+			serviceGen := coremocks.NewMockJwkRotateServiceGen(t)
+			serviceRevoke := coremocks.NewMockJwkRotateServiceRevoke(t)
+
+			if testCase.daoLockErr != nil {
+				daoLock.EXPECT().
+					Exec(mock.Anything, &dao.JwkLockRequest{Usage: testCase.request.Usage}).
+					Return(*testCase.daoLockErr)
+			}
+
+			if testCase.daoInvalidateErr != nil {
+				daoInvalidate.EXPECT().
+					Exec(mock.Anything, &dao.JwkInvalidateRequest{Usage: testCase.request.Usage}).
+					Return(*testCase.daoInvalidateErr)
+			}
+
+			if testCase.serviceSearchBeforeMock != nil {
+				serviceSearch.EXPECT().
+					Exec(mock.Anything, &core.JwkMetadataSearchRequest{Usage: testCase.request.Usage}).
+					Return(testCase.serviceSearchBeforeMock.resp, testCase.serviceSearchBeforeMock.err).
+					Once()
+			}
+
+			if testCase.serviceGenErr != nil {
+				serviceGen.EXPECT().
+					Exec(mock.Anything, &core.JwkGenRequest{Usage: testCase.request.Usage, Force: true}).
+					Return(&core.Jwk{}, *testCase.serviceGenErr)
+			}
+
+			if testCase.serviceRevokeMock != nil {
+				serviceRevoke.EXPECT().
+					Exec(mock.Anything, &core.JwkRevokeRequest{
+						ID:      previousKey().KID,
+						Comment: testCase.request.Comment,
+					}).
+					Return(testCase.serviceRevokeMock.resp, testCase.serviceRevokeMock.err)
+			}
+
+			if testCase.serviceSearchAfterMock != nil {
+				serviceSearch.EXPECT().
+					Exec(mock.Anything, &core.JwkMetadataSearchRequest{Usage: testCase.request.Usage}).
+					Return(testCase.serviceSearchAfterMock.resp, testCase.serviceSearchAfterMock.err).
+					Once()
+			}
+
+			transactor := transactiontest.NewTransactor()
+
+			service := core.NewJwkRotate(daoLock, daoInvalidate, serviceSearch, serviceGen, serviceRevoke, transactor)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoLock.AssertExpectations(t)
+			daoInvalidate.AssertExpectations(t)
+			serviceSearch.AssertExpectations(t)
+			serviceGen.AssertExpectations(t)
+			serviceRevoke.AssertExpectations(t)
+		})
+	}
+}
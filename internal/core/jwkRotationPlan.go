@@ -0,0 +1,165 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// JwkRotationReason tells why a usage is rotated.
+type JwkRotationReason string
+
+const (
+	// JwkRotationReasonNoKey is given to usages without an active key.
+	JwkRotationReasonNoKey JwkRotationReason = "no_key"
+	// JwkRotationReasonElapsed is given to usages whose main key is older than the rotation interval.
+	JwkRotationReasonElapsed JwkRotationReason = "elapsed"
+	// JwkRotationReasonAlgChanged is given to usages whose main key uses another algorithm than
+	// the configured one.
+	JwkRotationReasonAlgChanged JwkRotationReason = "alg_changed"
+	// JwkRotationReasonForced is given to usages rotated by a forced request only.
+	JwkRotationReasonForced JwkRotationReason = "forced"
+)
+
+// JwkRotationPlanDaoSearch is the DAO search dependency of [JwkRotationPlan].
+type JwkRotationPlanDaoSearch interface {
+	Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)
+}
+
+// JwkRotationPlanServiceExtract is the service dependency of [JwkRotationPlan] for reading the
+// algorithm of the main key.
+type JwkRotationPlanServiceExtract interface {
+	Exec(ctx context.Context, request *JwkExtractRequest) (*Jwk, error)
+}
+
+// JwkRotationPlanRequest holds the parameters for a [JwkRotationPlan.Exec] call. It takes the
+// parameters of the [JwkRotateAllRequest] to plan.
+type JwkRotationPlanRequest struct {
+	// Usages restricts the plan to these usages. See [JwkRotateAllRequest.Usages].
+	Usages []string
+	// Force plans a forced rotation. See [JwkRotateAllRequest.Force].
+	Force bool
+}
+
+// JwkRotationPlanEntry describes what a rotation would do for a usage.
+type JwkRotationPlanEntry struct {
+	// Usage is the key usage.
+	Usage string
+	// Reason is why the usage would be rotated. Empty when it would not.
+	Reason JwkRotationReason
+	// NextDueAt is when the usage is next due for rotation, once the rotation is over: the end of
+	// the rotation window of the new key when it rotates, else of its current main key.
+	NextDueAt time.Time
+}
+
+// Rotate reports whether the usage would be rotated.
+func (entry *JwkRotationPlanEntry) Rotate() bool {
+	return entry.Reason != ""
+}
+
+// A JwkRotationPlan tells, without changing anything, what a [JwkRotateAll] call would do with
+// the same parameters. It follows the rules of [JwkGen]. Entries are sorted by usage.
+type JwkRotationPlan struct {
+	daoSearch      JwkRotationPlanDaoSearch
+	serviceExtract JwkRotationPlanServiceExtract
+	keysConfig     map[string]*config.Jwk
+}
+
+// NewJwkRotationPlan returns a new JwkRotationPlan service.
+func NewJwkRotationPlan(
+	daoSearch JwkRotationPlanDaoSearch,
+	serviceExtract JwkRotationPlanServiceExtract,
+	keysConfig map[string]*config.Jwk,
+) *JwkRotationPlan {
+	return &JwkRotationPlan{
+		daoSearch:      daoSearch,
+		serviceExtract: serviceExtract,
+		keysConfig:     keysConfig,
+	}
+}
+
+func (service *JwkRotationPlan) Exec(
+	ctx context.Context, request *JwkRotationPlanRequest,
+) ([]*JwkRotationPlanEntry, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.JwkRotationPlan")
+	defer span.End()
+
+	usages, err := jwkRotationUsages(service.keysConfig, request.Usages)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(
+		attribute.Int("keys.usages", len(usages)),
+		attribute.Bool("keys.forced", request.Force),
+	)
+
+	now := time.Now()
+	output := make([]*JwkRotationPlanEntry, 0, len(usages))
+
+	for _, usage := range usages {
+		entry, err := service.plan(ctx, usage, now)
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("plan usage %s: %w", usage, err))
+		}
+
+		if request.Force && !entry.Rotate() {
+			entry.Reason = JwkRotationReasonForced
+		}
+
+		// The new key starts a new window.
+		if entry.Rotate() {
+			entry.NextDueAt = now.Add(service.keysConfig[usage].Key.Rotation)
+		}
+
+		output = append(output, entry)
+	}
+
+	return otel.ReportSuccess(span, output), nil
+}
+
+// plan tells whether a usage is due for rotation, and when its main key is.
+func (service *JwkRotationPlan) plan(ctx context.Context, usage string, now time.Time) (*JwkRotationPlanEntry, error) {
+	keyConfig := service.keysConfig[usage]
+
+	keys, err := service.daoSearch.Exec(ctx, &dao.JwkSearchRequest{Usage: usage})
+	if err != nil {
+		return nil, fmt.Errorf("list keys: %w", err)
+	}
+
+	if len(keys) == 0 {
+		return &JwkRotationPlanEntry{Usage: usage, Reason: JwkRotationReasonNoKey}, nil
+	}
+
+	// Keys come newest first: the first one is the main key.
+	output := &JwkRotationPlanEntry{Usage: usage, NextDueAt: keys[0].CreatedAt.Add(keyConfig.Key.Rotation)}
+
+	if !now.Before(output.NextDueAt) {
+		output.Reason = JwkRotationReasonElapsed
+
+		return output, nil
+	}
+
+	// The public half carries the algorithm as well, and does not need decrypting. Symmetric keys
+	// have none.
+	latestKey, err := service.serviceExtract.Exec(ctx, &JwkExtractRequest{
+		Jwk:     keys[0],
+		Private: keys[0].PublicKey == nil,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("consume DAO entity (kid %s): %w", keys[0].ID, err)
+	}
+
+	if latestKey.Alg != keyConfig.Alg {
+		output.Reason = JwkRotationReasonAlgChanged
+	}
+
+	return output, nil
+}
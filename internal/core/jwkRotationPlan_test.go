@@ -0,0 +1,215 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestJwkRotationPlan(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	keysConfig := map[string]*config.Jwk{
+		"auth":    {Alg: jwa.EdDSA, Key: config.JwkKey{Rotation: 12 * time.Hour}},
+		"refresh": {Alg: jwa.EdDSA, Key: config.JwkKey{Rotation: 12 * time.Hour}},
+	}
+
+	recentKey := &dao.Jwk{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		PublicKey: lo.ToPtr("cHVibGljLWtleS0x"),
+		CreatedAt: time.Now().Add(-time.Hour),
+	}
+	oldKey := &dao.Jwk{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		PublicKey: lo.ToPtr("cHVibGljLWtleS0x"),
+		CreatedAt: time.Now().Add(-13 * time.Hour),
+	}
+
+	type daoSearchMock struct {
+		resp []*dao.Jwk
+		err  error
+	}
+
+	type serviceExtractMock struct {
+		resp *core.Jwk
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.JwkRotationPlanRequest
+
+		daoSearchMocks      map[string]*daoSearchMock
+		serviceExtractMocks map[string]*serviceExtractMock
+
+		// expectReasons maps each planned usage to its reason.
+		expectReasons map[string]core.JwkRotationReason
+		expectErr     error
+	}{
+		{
+			name: "Success",
+
+			request: &core.JwkRotationPlanRequest{},
+
+			daoSearchMocks: map[string]*daoSearchMock{
+				"auth":    {resp: []*dao.Jwk{recentKey}},
+				"refresh": {resp: []*dao.Jwk{oldKey}},
+			},
+			serviceExtractMocks: map[string]*serviceExtractMock{
+				"auth": {resp: &core.Jwk{JWKCommon: jwa.JWKCommon{Alg: jwa.EdDSA}}},
+			},
+
+			expectReasons: map[string]core.JwkRotationReason{
+				"auth":    "",
+				"refresh": core.JwkRotationReasonElapsed,
+			},
+		},
+		{
+			name: "Success/NoKey",
+
+			request: &core.JwkRotationPlanRequest{Usages: []string{"auth"}},
+
+			daoSearchMocks: map[string]*daoSearchMock{
+				"auth": {},
+			},
+
+			expectReasons: map[string]core.JwkRotationReason{
+				"auth": core.JwkRotationReasonNoKey,
+			},
+		},
+		{
+			name: "Success/AlgChanged",
+
+			request: &core.JwkRotationPlanRequest{Usages: []string{"auth"}},
+
+			daoSearchMocks: map[string]*daoSearchMock{
+				"auth": {resp: []*dao.Jwk{recentKey}},
+			},
+			serviceExtractMocks: map[string]*serviceExtractMock{
+				"auth": {resp: &core.Jwk{JWKCommon: jwa.JWKCommon{Alg: jwa.ES256}}},
+			},
+
+			expectReasons: map[string]core.JwkRotationReason{
+				"auth": core.JwkRotationReasonAlgChanged,
+			},
+		},
+		{
+			// Usages due anyway keep their own reason.
+			name: "Success/Force",
+
+			request: &core.JwkRotationPlanRequest{Force: true},
+
+			daoSearchMocks: map[string]*daoSearchMock{
+				"auth":    {resp: []*dao.Jwk{recentKey}},
+				"refresh": {resp: []*dao.Jwk{oldKey}},
+			},
+			serviceExtractMocks: map[string]*serviceExtractMock{
+				"auth": {resp: &core.Jwk{JWKCommon: jwa.JWKCommon{Alg: jwa.EdDSA}}},
+			},
+
+			expectReasons: map[string]core.JwkRotationReason{
+				"auth":    core.JwkRotationReasonForced,
+				"refresh": core.JwkRotationReasonElapsed,
+			},
+		},
+		{
+			name: "Error/UnknownUsage",
+
+			request: &core.JwkRotationPlanRequest{Usages: []string{"unknown"}},
+
+			expectErr: core.ErrConfigNotFound,
+		},
+		{
+			name: "Error/Search",
+
+			request: &core.JwkRotationPlanRequest{Usages: []string{"auth"}},
+
+			daoSearchMocks: map[string]*daoSearchMock{
+				"auth": {err: errFoo},
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Extract",
+
+			request: &core.JwkRotationPlanRequest{Usages: []string{"auth"}},
+
+			daoSearchMocks: map[string]*daoSearchMock{
+				"auth": {resp: []*dao.Jwk{recentKey}},
+			},
+			serviceExtractMocks: map[string]*serviceExtractMock{
+				"auth": {err: errFoo},
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoSearch := coremocks.NewMockJwkRotationPlanDaoSearch(t)
+			serviceExtract := coremocks.NewMockJwkRotationPlanServiceExtract(t)
+
+			for usage, daoSearchMock := range testCase.daoSearchMocks {
+				daoSearch.EXPECT().
+					Exec(mock.Anything, &dao.JwkSearchRequest{Usage: usage}).
+					Return(daoSearchMock.resp, daoSearchMock.err)
+			}
+
+			for usage, serviceExtractMock := range testCase.serviceExtractMocks {
+				serviceExtract.EXPECT().
+					Exec(mock.Anything, &core.JwkExtractRequest{Jwk: testCase.daoSearchMocks[usage].resp[0]}).
+					Return(serviceExtractMock.resp, serviceExtractMock.err)
+			}
+
+			service := core.NewJwkRotationPlan(daoSearch, serviceExtract, keysConfig)
+
+			start := time.Now()
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+
+			if testCase.expectErr == nil {
+				require.Len(t, resp, len(testCase.expectReasons))
+
+				for i, entry := range resp {
+					if i > 0 {
+						require.Less(t, resp[i-1].Usage, entry.Usage)
+					}
+
+					require.Equal(t, testCase.expectReasons[entry.Usage], entry.Reason, entry.Usage)
+
+					// The new key of a rotated usage is due a rotation interval from now; the others
+					// keep the window of their main key.
+					if entry.Rotate() {
+						require.WithinRange(t, entry.NextDueAt, start.Add(12*time.Hour), time.Now().Add(12*time.Hour))
+					} else {
+						mainKey := testCase.daoSearchMocks[entry.Usage].resp[0]
+						require.Equal(t, mainKey.CreatedAt.Add(12*time.Hour), entry.NextDueAt)
+					}
+				}
+			}
+
+			daoSearch.AssertExpectations(t)
+			serviceExtract.AssertExpectations(t)
+		})
+	}
+}
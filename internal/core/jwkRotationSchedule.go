@@ -0,0 +1,90 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// JwkRotationScheduleDaoSelect is the DAO lease select dependency of [JwkRotationSchedule].
+type JwkRotationScheduleDaoSelect interface {
+	Exec(ctx context.Context, request *dao.JobLeaseSelectRequest) (*dao.JobLease, error)
+}
+
+// JwkRotationScheduleRequest holds the parameters for a [JwkRotationSchedule.Exec] call. It is
+// empty: there is a single schedule.
+type JwkRotationScheduleRequest struct{}
+
+// JwkRotationScheduleStatus describes the key rotation scheduled inside the gRPC servers.
+type JwkRotationScheduleStatus struct {
+	// Interval is the time between two rotations.
+	Interval time.Duration
+	// Leader is true when the server reporting holds the rotation lease.
+	Leader bool
+	// LeaderElected is true when a server, this one or another, holds an unexpired lease.
+	LeaderElected bool
+	// LastRunAt is when the last rotation completed. Zero before the first.
+	LastRunAt time.Time
+	// LastRunFailed is true when the last rotation ended with an error.
+	LastRunFailed bool
+	// NextRunAt is when the next rotation is due. Zero before the first.
+	NextRunAt time.Time
+}
+
+// A JwkRotationSchedule reports the state of the key rotation scheduled by [JwkScheduledRotate],
+// as recorded on its lease, for monitoring.
+type JwkRotationSchedule struct {
+	daoSelect JwkRotationScheduleDaoSelect
+	holder    string
+	interval  time.Duration
+}
+
+// NewJwkRotationSchedule returns a new JwkRotationSchedule service. The holder and interval are
+// those of the server's [JwkScheduledRotate].
+func NewJwkRotationSchedule(
+	daoSelect JwkRotationScheduleDaoSelect, holder string, interval time.Duration,
+) *JwkRotationSchedule {
+	return &JwkRotationSchedule{daoSelect: daoSelect, holder: holder, interval: interval}
+}
+
+func (service *JwkRotationSchedule) Exec(
+	ctx context.Context, _ *JwkRotationScheduleRequest,
+) (*JwkRotationScheduleStatus, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.JwkRotationSchedule")
+	defer span.End()
+
+	output := &JwkRotationScheduleStatus{Interval: service.interval}
+
+	lease, err := service.daoSelect.Exec(ctx, &dao.JobLeaseSelectRequest{Name: JwkRotationLease})
+	if errors.Is(err, dao.ErrJobLeaseSelectNotFound) {
+		// No server has run the schedule yet.
+		return otel.ReportSuccess(span, output), nil
+	}
+
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select lease: %w", err))
+	}
+
+	output.LeaderElected = lease.ExpiresAt.After(time.Now())
+	output.Leader = output.LeaderElected && lease.Holder == service.holder
+	output.LastRunFailed = lease.LastRunError != nil
+
+	if lease.LastRunAt != nil {
+		output.LastRunAt = *lease.LastRunAt
+		output.NextRunAt = lease.LastRunAt.Add(service.interval)
+	}
+
+	span.SetAttributes(
+		attribute.Bool("lease.leader", output.Leader),
+		attribute.Bool("lease.leader_elected", output.LeaderElected),
+	)
+
+	return otel.ReportSuccess(span, output), nil
+}
@@ -0,0 +1,127 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestJwkRotationSchedule(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	const (
+		holder   = "server-1"
+		interval = time.Minute
+	)
+
+	now := time.Now()
+	lastRun := now.Add(-30 * time.Second)
+
+	type daoSelectMock struct {
+		resp *dao.JobLease
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		daoSelectMock *daoSelectMock
+
+		expect    *core.JwkRotationScheduleStatus
+		expectErr error
+	}{
+		{
+			name: "Success/Leader",
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.JobLease{Holder: holder, ExpiresAt: now.Add(time.Minute), LastRunAt: &lastRun},
+			},
+
+			expect: &core.JwkRotationScheduleStatus{
+				Interval:      interval,
+				Leader:        true,
+				LeaderElected: true,
+				LastRunAt:     lastRun,
+				NextRunAt:     lastRun.Add(interval),
+			},
+		},
+		{
+			name: "Success/OtherLeader",
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.JobLease{
+					Holder:       "server-2",
+					ExpiresAt:    now.Add(time.Minute),
+					LastRunAt:    &lastRun,
+					LastRunError: lo.ToPtr("foo"),
+				},
+			},
+
+			expect: &core.JwkRotationScheduleStatus{
+				Interval:      interval,
+				LeaderElected: true,
+				LastRunAt:     lastRun,
+				LastRunFailed: true,
+				NextRunAt:     lastRun.Add(interval),
+			},
+		},
+		{
+			// The holder of an expired lease no longer leads.
+			name: "Success/Expired",
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.JobLease{Holder: holder, ExpiresAt: now.Add(-time.Minute), LastRunAt: &lastRun},
+			},
+
+			expect: &core.JwkRotationScheduleStatus{
+				Interval:  interval,
+				LastRunAt: lastRun,
+				NextRunAt: lastRun.Add(interval),
+			},
+		},
+		{
+			name: "Success/NeverRun",
+
+			daoSelectMock: &daoSelectMock{err: dao.ErrJobLeaseSelectNotFound},
+
+			expect: &core.JwkRotationScheduleStatus{Interval: interval},
+		},
+		{
+			name: "Error/Select",
+
+			daoSelectMock: &daoSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoSelect := coremocks.NewMockJwkRotationScheduleDaoSelect(t)
+
+			daoSelect.EXPECT().
+				Exec(mock.Anything, &dao.JobLeaseSelectRequest{Name: core.JwkRotationLease}).
+				Return(testCase.daoSelectMock.resp, testCase.daoSelectMock.err)
+
+			service := core.NewJwkRotationSchedule(daoSelect, holder, interval)
+
+			resp, err := service.Exec(t.Context(), &core.JwkRotationScheduleRequest{})
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoSelect.AssertExpectations(t)
+		})
+	}
+}
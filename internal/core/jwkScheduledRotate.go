@@ -0,0 +1,136 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// JwkRotationLease names the job lease of the scheduled key rotation.
+const JwkRotationLease = "jwk.rotate"
+
+// JwkRotationLeaseFactor is the number of schedule intervals a rotation lease lasts. A leader
+// that stops renewing it is replaced after at most that many intervals.
+const JwkRotationLeaseFactor = 3
+
+// JwkScheduledRotateDaoAcquire is the DAO lease acquisition dependency of [JwkScheduledRotate].
+type JwkScheduledRotateDaoAcquire interface {
+	Exec(ctx context.Context, request *dao.JobLeaseAcquireRequest) (*dao.JobLease, error)
+}
+
+// JwkScheduledRotateDaoRecordRun is the DAO run recording dependency of [JwkScheduledRotate].
+type JwkScheduledRotateDaoRecordRun interface {
+	Exec(ctx context.Context, request *dao.JobLeaseRecordRunRequest) (*dao.JobLease, error)
+}
+
+// JwkScheduledRotateServiceRotateAll is the rotation dependency of [JwkScheduledRotate].
+type JwkScheduledRotateServiceRotateAll interface {
+	Exec(ctx context.Context, request *JwkRotateAllRequest) (*JwkRotateAllResponse, error)
+}
+
+// JwkScheduledRotateRequest holds the parameters for a [JwkScheduledRotate.Exec] call. It is
+// empty, like [JwkRotateAllRequest].
+type JwkScheduledRotateRequest struct{}
+
+// JwkScheduledRotateResponse reports the outcome of a [JwkScheduledRotate.Exec] call.
+type JwkScheduledRotateResponse struct {
+	// Leader is true when this server holds the rotation lease, and ran the rotation.
+	Leader bool
+	// Processed counts the usages the rotation ensured. See [JwkRotateAllResponse.Processed].
+	Processed int
+}
+
+// A JwkScheduledRotate runs one occurrence of the key rotation scheduled inside the gRPC
+// servers, in place of the rotate-keys job. Call it once every interval.
+//
+// Among the servers sharing the database, only the holder of the [JwkRotationLease] rotates.
+// Each call renews the lease of the holder, or takes it over once expired, then runs
+// [JwkRotateAll] and records the outcome on the lease. Other servers return at once.
+type JwkScheduledRotate struct {
+	daoAcquire       JwkScheduledRotateDaoAcquire
+	daoRecordRun     JwkScheduledRotateDaoRecordRun
+	serviceRotateAll JwkScheduledRotateServiceRotateAll
+	holder           string
+	interval         time.Duration
+}
+
+// NewJwkScheduledRotate returns a new JwkScheduledRotate service. The holder identifies this
+// server on the lease, and must be unique among the servers; the interval is the time between
+// two calls.
+func NewJwkScheduledRotate(
+	daoAcquire JwkScheduledRotateDaoAcquire,
+	daoRecordRun JwkScheduledRotateDaoRecordRun,
+	serviceRotateAll JwkScheduledRotateServiceRotateAll,
+	holder string,
+	interval time.Duration,
+) *JwkScheduledRotate {
+	return &JwkScheduledRotate{
+		daoAcquire:       daoAcquire,
+		daoRecordRun:     daoRecordRun,
+		serviceRotateAll: serviceRotateAll,
+		holder:           holder,
+		interval:         interval,
+	}
+}
+
+func (service *JwkScheduledRotate) Exec(
+	ctx context.Context, _ *JwkScheduledRotateRequest,
+) (*JwkScheduledRotateResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.JwkScheduledRotate")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("lease.holder", service.holder))
+
+	now := time.Now()
+
+	_, err := service.daoAcquire.Exec(ctx, &dao.JobLeaseAcquireRequest{
+		Name:       JwkRotationLease,
+		Holder:     service.holder,
+		Now:        now,
+		Expiration: now.Add(JwkRotationLeaseFactor * service.interval),
+	})
+	if errors.Is(err, dao.ErrJobLeaseHeld) {
+		span.SetAttributes(attribute.Bool("lease.leader", false))
+
+		return otel.ReportSuccess(span, &JwkScheduledRotateResponse{}), nil
+	}
+
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("acquire lease: %w", err))
+	}
+
+	span.SetAttributes(attribute.Bool("lease.leader", true))
+
+	rotated, rotateErr := service.serviceRotateAll.Exec(ctx, &JwkRotateAllRequest{})
+
+	var runErr *string
+	if rotateErr != nil {
+		runErr = lo.ToPtr(rotateErr.Error())
+	}
+
+	// The outcome is recorded even when the rotation failed, so the status reports it.
+	_, err = service.daoRecordRun.Exec(ctx, &dao.JobLeaseRecordRunRequest{
+		Name:   JwkRotationLease,
+		Holder: service.holder,
+		Now:    time.Now(),
+		Err:    runErr,
+	})
+	if err != nil {
+		err = fmt.Errorf("record run: %w", err)
+	}
+
+	err = errors.Join(rotateErr, err)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, &JwkScheduledRotateResponse{Leader: true, Processed: rotated.Processed}), nil
+}
@@ -0,0 +1,145 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestJwkScheduledRotate(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	const (
+		holder   = "server-1"
+		interval = time.Minute
+	)
+
+	type daoAcquireMock struct {
+		err error
+	}
+
+	type serviceRotateAllMock struct {
+		resp *core.JwkRotateAllResponse
+		err  error
+	}
+
+	type daoRecordRunMock struct {
+		err error
+	}
+
+	testCases := []struct {
+		name string
+
+		daoAcquireMock       *daoAcquireMock
+		serviceRotateAllMock *serviceRotateAllMock
+		daoRecordRunMock     *daoRecordRunMock
+
+		expectRunErr *string
+
+		expect    *core.JwkScheduledRotateResponse
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			daoAcquireMock:       &daoAcquireMock{},
+			serviceRotateAllMock: &serviceRotateAllMock{resp: &core.JwkRotateAllResponse{Processed: 2}},
+			daoRecordRunMock:     &daoRecordRunMock{},
+
+			expect: &core.JwkScheduledRotateResponse{Leader: true, Processed: 2},
+		},
+		{
+			// Another server leads: nothing runs.
+			name: "Success/NotLeader",
+
+			daoAcquireMock: &daoAcquireMock{err: dao.ErrJobLeaseHeld},
+
+			expect: &core.JwkScheduledRotateResponse{},
+		},
+		{
+			name: "Error/Acquire",
+
+			daoAcquireMock: &daoAcquireMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			// A failed rotation is recorded, for the status to report.
+			name: "Error/RotateAll",
+
+			daoAcquireMock:       &daoAcquireMock{},
+			serviceRotateAllMock: &serviceRotateAllMock{err: errFoo},
+			daoRecordRunMock:     &daoRecordRunMock{},
+
+			expectRunErr: lo.ToPtr(errFoo.Error()),
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/RecordRun",
+
+			daoAcquireMock:       &daoAcquireMock{},
+			serviceRotateAllMock: &serviceRotateAllMock{resp: &core.JwkRotateAllResponse{Processed: 2}},
+			daoRecordRunMock:     &daoRecordRunMock{err: dao.ErrJobLeaseHeld},
+
+			expectErr: dao.ErrJobLeaseHeld,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoAcquire := coremocks.NewMockJwkScheduledRotateDaoAcquire(t)
+			daoRecordRun := coremocks.NewMockJwkScheduledRotateDaoRecordRun(t)
+			serviceRotateAll := coremocks.NewMockJwkScheduledRotateServiceRotateAll(t)
+
+			if testCase.daoAcquireMock != nil {
+				daoAcquire.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(request *dao.JobLeaseAcquireRequest) bool {
+						return request.Name == core.JwkRotationLease &&
+							request.Holder == holder &&
+							request.Expiration.Sub(request.Now) == core.JwkRotationLeaseFactor*interval
+					})).
+					Return(&dao.JobLease{}, testCase.daoAcquireMock.err)
+			}
+
+			if testCase.serviceRotateAllMock != nil {
+				serviceRotateAll.EXPECT().
+					Exec(mock.Anything, &core.JwkRotateAllRequest{}).
+					Return(testCase.serviceRotateAllMock.resp, testCase.serviceRotateAllMock.err)
+			}
+
+			if testCase.daoRecordRunMock != nil {
+				daoRecordRun.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(request *dao.JobLeaseRecordRunRequest) bool {
+						return request.Name == core.JwkRotationLease &&
+							request.Holder == holder &&
+							lo.FromPtr(request.Err) == lo.FromPtr(testCase.expectRunErr) &&
+							(request.Err == nil) == (testCase.expectRunErr == nil)
+					})).
+					Return(&dao.JobLease{}, testCase.daoRecordRunMock.err)
+			}
+
+			service := core.NewJwkScheduledRotate(daoAcquire, daoRecordRun, serviceRotateAll, holder, interval)
+
+			resp, err := service.Exec(t.Context(), &core.JwkScheduledRotateRequest{})
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoAcquire.AssertExpectations(t)
+			daoRecordRun.AssertExpectations(t)
+			serviceRotateAll.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+)
+
+// JwkSourceRefreshInterval is how often the key sources built on a [JwkSourceCache] read it back,
+// and so how long they keep serving keys after an invalidation.
+const JwkSourceRefreshInterval = time.Second
+
+// JwkSourceCacheSource is the key source dependency of [JwkSourceCache].
+type JwkSourceCacheSource interface {
+	SearchKeys(ctx context.Context, usage string) ([]*jwa.JWK, error)
+}
+
+// JwkSourceRefresher is implemented by the key sources that cache keys themselves, such as
+// [JwkSourceCache]. The cached sources built on them by [NewJwkPrivateSource] and
+// [NewJwkPublicSource] hold keys for RefreshInterval at most, instead of the usage's key.cache,
+// so they drop the keys invalidated in the underlying cache within that delay.
+type JwkSourceRefresher interface {
+	RefreshInterval() time.Duration
+}
+
+type jwkSourceCacheEntry struct {
+	keys      []*jwa.JWK
+	fetchedAt time.Time
+}
+
+// A JwkSourceCache caches the keys of a source for the key.cache of their usage, and drops them
+// on demand. It stands between the database and the key sources of a server, so a revocation
+// reaches the server without waiting for its caches to expire: see [JwkInvalidationSync].
+//
+// It is safe for concurrent use.
+type JwkSourceCache struct {
+	source     JwkSourceCacheSource
+	keysConfig map[string]*config.Jwk
+
+	mu      sync.Mutex
+	entries map[string]*jwkSourceCacheEntry
+	// generations counts the invalidations of each usage, so a fetch that started before an
+	// invalidation does not store stale keys.
+	generations map[string]uint64
+}
+
+var _ JwkSourceRefresher = (*JwkSourceCache)(nil)
+
+// NewJwkSourceCache returns a new JwkSourceCache in front of source.
+func NewJwkSourceCache(source JwkSourceCacheSource, keysConfig map[string]*config.Jwk) *JwkSourceCache {
+	return &JwkSourceCache{
+		source:      source,
+		keysConfig:  keysConfig,
+		entries:     make(map[string]*jwkSourceCacheEntry),
+		generations: make(map[string]uint64),
+	}
+}
+
+// SearchKeys returns the keys of usage, from the cache while they are fresh.
+func (cache *JwkSourceCache) SearchKeys(ctx context.Context, usage string) ([]*jwa.JWK, error) {
+	keyConfig, ok := cache.keysConfig[usage]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrConfigNotFound, usage)
+	}
+
+	cache.mu.Lock()
+	entry, cached := cache.entries[usage]
+	generation := cache.generations[usage]
+	cache.mu.Unlock()
+
+	if cached && time.Since(entry.fetchedAt) < keyConfig.Key.Cache {
+		return slices.Clone(entry.keys), nil
+	}
+
+	keys, err := cache.source.SearchKeys(ctx, usage)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.generations[usage] == generation {
+		cache.entries[usage] = &jwkSourceCacheEntry{keys: slices.Clone(keys), fetchedAt: time.Now()}
+	}
+
+	return keys, nil
+}
+
+// Invalidate drops the cached keys of usage: the next search reads them from the source.
+func (cache *JwkSourceCache) Invalidate(usage string) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	delete(cache.entries, usage)
+	cache.generations[usage]++
+}
+
+// InvalidateAll drops the cached keys of every usage.
+func (cache *JwkSourceCache) InvalidateAll() {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	for usage := range cache.keysConfig {
+		delete(cache.entries, usage)
+		cache.generations[usage]++
+	}
+}
+
+// RefreshInterval implements [JwkSourceRefresher].
+func (cache *JwkSourceCache) RefreshInterval() time.Duration {
+	return JwkSourceRefreshInterval
+}
+
+// JwkSourceCaches groups the key caches of a server, such as its private and public key caches,
+// so a single [JwkInvalidationSync] drops the keys from all of them.
+type JwkSourceCaches []*JwkSourceCache
+
+// Invalidate drops the cached keys of usage from every cache.
+func (caches JwkSourceCaches) Invalidate(usage string) {
+	for _, cache := range caches {
+		cache.Invalidate(usage)
+	}
+}
+
+// InvalidateAll drops the cached keys of every usage from every cache.
+func (caches JwkSourceCaches) InvalidateAll() {
+	for _, cache := range caches {
+		cache.InvalidateAll()
+	}
+}
@@ -0,0 +1,177 @@
+package core_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+)
+
+// swappableKeySource serves a key set that tests replace, and counts its fetches.
+type swappableKeySource struct {
+	mu      sync.Mutex
+	keys    []*jwa.JWK
+	fetches int
+}
+
+func (source *swappableKeySource) SearchKeys(_ context.Context, _ string) ([]*jwa.JWK, error) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	source.fetches++
+
+	return source.keys, nil
+}
+
+func (source *swappableKeySource) set(keys ...*jwa.JWK) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	source.keys = keys
+}
+
+func (source *swappableKeySource) count() int {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	return source.fetches
+}
+
+func TestJwkSourceCache(t *testing.T) {
+	t.Parallel()
+
+	_, publicKeys := generateAuthTokenKeySet(t, 2)
+
+	keysConfig := map[string]*config.Jwk{
+		"test-usage":  {Alg: jwa.EdDSA, Key: config.JwkKey{Cache: time.Hour}},
+		"short-usage": {Alg: jwa.EdDSA, Key: config.JwkKey{Cache: time.Nanosecond}},
+	}
+
+	t.Run("Cached", func(t *testing.T) {
+		t.Parallel()
+
+		source := &swappableKeySource{keys: []*jwa.JWK{publicKeys[0].JWK}}
+		cache := core.NewJwkSourceCache(source, keysConfig)
+
+		for range 3 {
+			keys, err := cache.SearchKeys(t.Context(), "test-usage")
+			require.NoError(t, err)
+			require.Equal(t, []*jwa.JWK{publicKeys[0].JWK}, keys)
+		}
+
+		require.Equal(t, 1, source.count())
+
+		// Keys are refetched once their usage's cache expires.
+		for range 3 {
+			_, err := cache.SearchKeys(t.Context(), "short-usage")
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, 4, source.count())
+	})
+
+	t.Run("Invalidate", func(t *testing.T) {
+		t.Parallel()
+
+		source := &swappableKeySource{keys: []*jwa.JWK{publicKeys[0].JWK}}
+		cache := core.NewJwkSourceCache(source, keysConfig)
+
+		_, err := cache.SearchKeys(t.Context(), "test-usage")
+		require.NoError(t, err)
+
+		source.set(publicKeys[1].JWK)
+
+		cache.Invalidate("test-usage")
+
+		keys, err := cache.SearchKeys(t.Context(), "test-usage")
+		require.NoError(t, err)
+		require.Equal(t, []*jwa.JWK{publicKeys[1].JWK}, keys)
+
+		source.set(publicKeys[0].JWK)
+
+		cache.InvalidateAll()
+
+		keys, err = cache.SearchKeys(t.Context(), "test-usage")
+		require.NoError(t, err)
+		require.Equal(t, []*jwa.JWK{publicKeys[0].JWK}, keys)
+	})
+
+	t.Run("Caches", func(t *testing.T) {
+		t.Parallel()
+
+		source := &swappableKeySource{keys: []*jwa.JWK{publicKeys[0].JWK}}
+		caches := core.JwkSourceCaches{
+			core.NewJwkSourceCache(source, keysConfig), core.NewJwkSourceCache(source, keysConfig),
+		}
+
+		for _, cache := range caches {
+			_, err := cache.SearchKeys(t.Context(), "test-usage")
+			require.NoError(t, err)
+		}
+
+		source.set(publicKeys[1].JWK)
+
+		caches.Invalidate("test-usage")
+
+		for _, cache := range caches {
+			keys, err := cache.SearchKeys(t.Context(), "test-usage")
+			require.NoError(t, err)
+			require.Equal(t, []*jwa.JWK{publicKeys[1].JWK}, keys)
+		}
+
+		source.set(publicKeys[0].JWK)
+
+		caches.InvalidateAll()
+
+		for _, cache := range caches {
+			keys, err := cache.SearchKeys(t.Context(), "test-usage")
+			require.NoError(t, err)
+			require.Equal(t, []*jwa.JWK{publicKeys[0].JWK}, keys)
+		}
+	})
+
+	t.Run("Sources", func(t *testing.T) {
+		t.Parallel()
+
+		source := &swappableKeySource{keys: []*jwa.JWK{publicKeys[0].JWK}}
+		cache := core.NewJwkSourceCache(source, keysConfig)
+
+		sources, err := core.NewJwkPublicSource(cache, keysConfig)
+		require.NoError(t, err)
+
+		keySource, ok := sources.Usage("test-usage")
+		require.True(t, ok)
+
+		keys, err := keySource.List(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, []*jwa.JWK{publicKeys[0].JWK}, keys)
+
+		// The key sources built on the cache see an invalidation within the refresh interval, far
+		// before the usage's cache expires.
+		source.set(publicKeys[1].JWK)
+
+		cache.Invalidate("test-usage")
+
+		require.Eventually(t, func() bool {
+			keys, err := keySource.List(t.Context())
+
+			return err == nil && len(keys) == 1 && keys[0] == publicKeys[1].JWK
+		}, 3*core.JwkSourceRefreshInterval, 50*time.Millisecond)
+	})
+
+	t.Run("Error/ConfigNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		cache := core.NewJwkSourceCache(&swappableKeySource{}, keysConfig)
+
+		_, err := cache.SearchKeys(t.Context(), "unknown-usage")
+		require.ErrorIs(t, err, core.ErrConfigNotFound)
+	})
+}
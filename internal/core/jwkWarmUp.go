@@ -0,0 +1,64 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+)
+
+// JwkWarmUpSource is the private key source dependency of [JwkWarmUp]. It is implemented by
+// [JwkPrivateSources].
+type JwkWarmUpSource interface {
+	SigningKey(ctx context.Context, usage string, keyConfig *config.Jwk) (*jwa.JWK, error)
+}
+
+// A JwkWarmUp loads the signing key of every configured usage into its cached source, so the
+// first signatures after a start do not pay for the database fetch and decryption.
+//
+// It fails when a source cannot be loaded, or when a usage has no key to sign with; the latter
+// is reported as [ErrJwkNotFound], so callers can tell it apart from transient failures. Usages
+// that load are warmed either way.
+type JwkWarmUp struct {
+	source     JwkWarmUpSource
+	keysConfig map[string]*config.Jwk
+}
+
+// NewJwkWarmUp returns a new JwkWarmUp service.
+func NewJwkWarmUp(source JwkWarmUpSource, keysConfig map[string]*config.Jwk) *JwkWarmUp {
+	return &JwkWarmUp{source: source, keysConfig: keysConfig}
+}
+
+func (service *JwkWarmUp) Exec(ctx context.Context) error {
+	ctx, span := otel.Tracer().Start(ctx, "core.JwkWarmUp")
+	defer span.End()
+
+	var errs []error
+
+	for usage, keyConfig := range service.keysConfig {
+		_, err := service.source.SigningKey(ctx, usage, keyConfig)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("usage %s: %w", usage, err))
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("keys.usages", len(service.keysConfig)),
+		attribute.Int("keys.failed", len(errs)),
+	)
+
+	err := errors.Join(errs...)
+	if err != nil {
+		return otel.ReportError(span, err)
+	}
+
+	otel.ReportSuccessNoContent(span)
+
+	return nil
+}
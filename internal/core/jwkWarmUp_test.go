@@ -0,0 +1,90 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+)
+
+func TestJwkWarmUp(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	keysConfig := map[string]*config.Jwk{
+		"auth":    {Alg: jwa.EdDSA},
+		"refresh": {Alg: jwa.ES256},
+	}
+
+	type sourceMock struct {
+		resp *jwa.JWK
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		sourceMocks map[string]*sourceMock
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			sourceMocks: map[string]*sourceMock{
+				"auth":    {resp: &jwa.JWK{}},
+				"refresh": {resp: &jwa.JWK{}},
+			},
+		},
+		{
+			// Every usage is warmed, even after one failed.
+			name: "Error/NoSigningKey",
+
+			sourceMocks: map[string]*sourceMock{
+				"auth":    {err: core.ErrJwkNotFound},
+				"refresh": {resp: &jwa.JWK{}},
+			},
+
+			expectErr: core.ErrJwkNotFound,
+		},
+		{
+			name: "Error/Source",
+
+			sourceMocks: map[string]*sourceMock{
+				"auth":    {resp: &jwa.JWK{}},
+				"refresh": {err: errFoo},
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := coremocks.NewMockJwkWarmUpSource(t)
+
+			for usage, sourceMock := range testCase.sourceMocks {
+				source.EXPECT().
+					SigningKey(mock.Anything, usage, keysConfig[usage]).
+					Return(sourceMock.resp, sourceMock.err)
+			}
+
+			service := core.NewJwkWarmUp(source, keysConfig)
+
+			err := service.Exec(t.Context())
+			require.ErrorIs(t, err, testCase.expectErr)
+
+			source.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,415 @@
+package core
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/a-novel-kit/jwt/v2"
+	"github.com/a-novel-kit/jwt/v2/jwa"
+	"github.com/a-novel-kit/jwt/v2/jwk"
+	"github.com/a-novel-kit/jwt/v2/jws"
+)
+
+var (
+	// ErrJwsDetachedMalformed is returned when a detached JWS cannot be parsed.
+	ErrJwsDetachedMalformed = errors.New("malformed detached jws")
+	// ErrJwsDetachedUnsupportedCrit is returned when a detached JWS marks as critical a header
+	// parameter this service does not understand.
+	ErrJwsDetachedUnsupportedCrit = errors.New("unsupported critical header parameter")
+	// ErrJwsDetachedType is returned when the "typ" header of a detached JWS names another kind of
+	// object, such as a JWT.
+	ErrJwsDetachedType = errors.New("not a detached payload signature")
+)
+
+// JwsDetachedTyp is the "typ" header of detached payload signatures.
+//
+// Payload signatures share the keys of their usage with its tokens, and with the payload encoded,
+// their signing input has the very shape of a token's. The type, and the critical "b64" header
+// that claims verifiers do not understand, keep a payload signature from ever passing for a token:
+// see [JwkTypRecipient].
+const JwsDetachedTyp jwa.Typ = "payload+jose"
+
+const (
+	// jwsDetachedB64 is the RFC 7797 header parameter that turns payload encoding off.
+	jwsDetachedB64 = "b64"
+	// jwsEcdsaIntegers is the number of integers (R and S) an ECDSA signature is made of.
+	jwsEcdsaIntegers = 2
+	// jwsRsaMinKeyBits is the smallest RSA modulus the RS* and PS* algorithms accept (RFC 7518,
+	// sections 3.3 and 3.5), as enforced by the jwt library signers.
+	jwsRsaMinKeyBits = 2048
+)
+
+// JwsDetachedHeader is the protected header of a detached JWS.
+type JwsDetachedHeader struct {
+	// Alg is the signing algorithm.
+	Alg jwa.Alg `json:"alg"`
+	// KID identifies the signing key.
+	KID string `json:"kid,omitempty"`
+	// Typ is [JwsDetachedTyp]. Signatures of any other type, or without one, are refused.
+	Typ jwa.Typ `json:"typ,omitempty"`
+	// B64 is false when the payload is signed as is, rather than base64url-encoded first.
+	//
+	// See RFC 7797: https://datatracker.ietf.org/doc/html/rfc7797
+	B64 *bool `json:"b64,omitempty"`
+	// Crit lists the header parameters a verifier must understand. It names "b64" whenever B64 is
+	// set, which it always is on new signatures.
+	Crit []string `json:"crit,omitempty"`
+}
+
+// Encoded reports whether the payload is base64url-encoded in the signing input.
+func (header *JwsDetachedHeader) Encoded() bool {
+	return header.B64 == nil || *header.B64
+}
+
+// JwsDetached is a JWS whose payload travels separately from it: the compact serialization with
+// an empty payload segment.
+//
+// See RFC 7515, appendix F: https://datatracker.ietf.org/doc/html/rfc7515#appendix-F
+type JwsDetached struct {
+	// Header is the decoded protected header.
+	Header *JwsDetachedHeader
+	// Protected is the base64url-encoded protected header, as signed.
+	Protected string
+	// Signature is the decoded signature.
+	Signature []byte
+}
+
+// ParseJwsDetached reads a detached JWS ("header..signature").
+func ParseJwsDetached(token string) (*JwsDetached, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != jwsCompactSegments || parts[1] != "" {
+		return nil, fmt.Errorf("%w: expected header..signature", ErrJwsDetachedMalformed)
+	}
+
+	headerSerialized, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: decode header: %w", ErrJwsDetachedMalformed, err)
+	}
+
+	var header JwsDetachedHeader
+
+	err = json.Unmarshal(headerSerialized, &header)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unmarshal header: %w", ErrJwsDetachedMalformed, err)
+	}
+
+	// "b64" is the only extension understood here, and RFC 7797 requires it to be critical.
+	for _, param := range header.Crit {
+		if param != jwsDetachedB64 {
+			return nil, fmt.Errorf("%w: %s", ErrJwsDetachedUnsupportedCrit, param)
+		}
+	}
+
+	if header.B64 != nil && !slices.Contains(header.Crit, jwsDetachedB64) {
+		return nil, fmt.Errorf("%w: b64 is not marked critical", ErrJwsDetachedMalformed)
+	}
+
+	// Tokens carry no type, or "JWT": only the type tells them apart from payload signatures.
+	if header.Typ != JwsDetachedTyp {
+		return nil, fmt.Errorf("%w: type %q", ErrJwsDetachedType, header.Typ)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: decode signature: %w", ErrJwsDetachedMalformed, err)
+	}
+
+	return &JwsDetached{Header: &header, Protected: parts[0], Signature: signature}, nil
+}
+
+// NewJwsDetached signs payload with key, and returns the detached JWS. With encoded false, the
+// payload is signed as is (RFC 7797).
+func NewJwsDetached(ctx context.Context, key *jwa.JWK, payload []byte, encoded bool) (*JwsDetached, error) {
+	// "b64" is set even to its default, so verifiers that do not understand it refuse the signature.
+	header := &JwsDetachedHeader{
+		Alg:  key.Alg,
+		KID:  key.KID,
+		Typ:  JwsDetachedTyp,
+		B64:  &encoded,
+		Crit: []string{jwsDetachedB64},
+	}
+
+	headerSerialized, err := json.Marshal(header)
+	if err != nil {
+		return nil, fmt.Errorf("serialize header: %w", err)
+	}
+
+	output := &JwsDetached{
+		Header:    header,
+		Protected: base64.RawURLEncoding.EncodeToString(headerSerialized),
+	}
+
+	if encoded {
+		output.Signature, err = jwsSign(ctx, key, output.Protected, base64.RawURLEncoding.EncodeToString(payload))
+	} else {
+		output.Signature, err = jwsSignRaw(ctx, key, output.SigningInput(payload))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// SigningInput returns the bytes the signature covers, for the given payload.
+func (token *JwsDetached) SigningInput(payload []byte) []byte {
+	if token.Header.Encoded() {
+		return []byte(token.Protected + "." + base64.RawURLEncoding.EncodeToString(payload))
+	}
+
+	return append([]byte(token.Protected+"."), payload...)
+}
+
+// String returns the detached compact serialization.
+func (token *JwsDetached) String() string {
+	return token.Protected + ".." + base64.RawURLEncoding.EncodeToString(token.Signature)
+}
+
+// Verify checks the signature of the token over payload, using key. It returns an error wrapping
+// [jws.ErrInvalidSignature] when the signature does not match.
+func (token *JwsDetached) Verify(ctx context.Context, key *jwa.JWK, payload []byte) error {
+	if key.Alg != token.Header.Alg {
+		return fmt.Errorf("%w: key algorithm %s, token algorithm %s", jws.ErrInvalidSignature, key.Alg, token.Header.Alg)
+	}
+
+	if token.Header.Encoded() {
+		return jwsVerify(ctx, key, token.Protected, base64.RawURLEncoding.EncodeToString(payload), token.Signature)
+	}
+
+	return jwsVerifyRaw(key, token.SigningInput(payload), token.Signature)
+}
+
+// jwsSigner returns the jwt library signer of a private key, with the key it wraps.
+func jwsSigner(key *jwa.JWK) (jwt.ProducerPlugin, crypto.Signer, error) {
+	switch key.Alg {
+	case jwa.EdDSA:
+		privateKey, _, err := jwk.ConsumeED25519(key)
+		if err != nil || privateKey == nil {
+			return nil, nil, fmt.Errorf("consume ed25519 key: %w", errors.Join(err, ErrJwkPresetUnknown))
+		}
+
+		return jws.NewED25519Signer(privateKey.Key()), privateKey.Key(), nil
+	case jwa.ES256, jwa.ES384, jwa.ES512:
+		privateKey, _, err := jwk.ConsumeECDSA(key, JwkPresetsEcdsa[key.Alg])
+		if err != nil || privateKey == nil {
+			return nil, nil, fmt.Errorf("consume ecdsa key: %w", errors.Join(err, ErrJwkPresetUnknown))
+		}
+
+		return jws.NewECDSASigner(privateKey.Key(), JwsPresetsEcdsa[key.Alg]), privateKey.Key(), nil
+	case jwa.RS256, jwa.RS384, jwa.RS512, jwa.PS256, jwa.PS384, jwa.PS512:
+		privateKey, _, err := jwk.ConsumeRSA(key, JwkPresetsRsa[key.Alg])
+		if err != nil || privateKey == nil {
+			return nil, nil, fmt.Errorf("consume rsa key: %w", errors.Join(err, ErrJwkPresetUnknown))
+		}
+
+		return jws.NewRSASigner(privateKey.Key(), JwsPresetsRsa[key.Alg]), privateKey.Key(), nil
+	default:
+		return nil, nil, fmt.Errorf("%w: %s", ErrJwkPresetUnknownAlgorithm, key.Alg)
+	}
+}
+
+// jwsVerifier returns the jwt library verifier of a public key, with the key it wraps.
+func jwsVerifier(key *jwa.JWK) (jwt.RecipientPlugin, crypto.PublicKey, error) {
+	switch key.Alg {
+	case jwa.EdDSA:
+		_, publicKey, err := jwk.ConsumeED25519(key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("consume ed25519 key: %w", err)
+		}
+
+		return jws.NewED25519Verifier(publicKey.Key()), publicKey.Key(), nil
+	case jwa.ES256, jwa.ES384, jwa.ES512:
+		_, publicKey, err := jwk.ConsumeECDSA(key, JwkPresetsEcdsa[key.Alg])
+		if err != nil {
+			return nil, nil, fmt.Errorf("consume ecdsa key: %w", err)
+		}
+
+		return jws.NewECDSAVerifier(publicKey.Key(), JwsPresetsEcdsa[key.Alg]), publicKey.Key(), nil
+	case jwa.RS256, jwa.RS384, jwa.RS512, jwa.PS256, jwa.PS384, jwa.PS512:
+		_, publicKey, err := jwk.ConsumeRSA(key, JwkPresetsRsa[key.Alg])
+		if err != nil {
+			return nil, nil, fmt.Errorf("consume rsa key: %w", err)
+		}
+
+		return jws.NewRSAVerifier(publicKey.Key(), JwsPresetsRsa[key.Alg]), publicKey.Key(), nil
+	default:
+		return nil, nil, fmt.Errorf("%w: %s", ErrJwkPresetUnknownAlgorithm, key.Alg)
+	}
+}
+
+// jwsSign signs the JWS signing input "header.payload", both base64url-encoded, with the jwt
+// library signer of a private key, and returns the signature.
+func jwsSign(ctx context.Context, key *jwa.JWK, header, payload string) ([]byte, error) {
+	signer, _, err := jwsSigner(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Header runs the key checks of the signer: the curve of ECDSA keys, the size of RSA keys.
+	_, err = signer.Header(ctx, new(jwa.JWH))
+	if err != nil {
+		return nil, fmt.Errorf("check key: %w", err)
+	}
+
+	signed, err := signer.Transform(ctx, nil, header+"."+payload)
+	if err != nil {
+		return nil, fmt.Errorf("sign: %w", err)
+	}
+
+	token, err := jwt.DecodeToken(signed, &jwt.SignedTokenDecoder{})
+	if err != nil {
+		return nil, fmt.Errorf("split signed input: %w", err)
+	}
+
+	return base64.RawURLEncoding.DecodeString(token.Signature)
+}
+
+// jwsVerify checks signature over the JWS signing input "header.payload", both base64url-encoded,
+// with the jwt library verifier of a public key.
+func jwsVerify(ctx context.Context, key *jwa.JWK, header, payload string, signature []byte) error {
+	verifier, _, err := jwsVerifier(key)
+	if err != nil {
+		return err
+	}
+
+	_, err = verifier.Transform(
+		ctx,
+		&jwa.JWH{JWHCommon: jwa.JWHCommon{Alg: key.Alg}},
+		header+"."+payload+"."+base64.RawURLEncoding.EncodeToString(signature),
+	)
+
+	return err
+}
+
+// jwsSignRaw signs input as is with a private key, following the JWS encoding of its algorithm.
+//
+// The jwt library signers only take a JWS signing input, two base64url segments, which RFC 7797
+// payloads and HTTP signature bases are not. The key still goes through the checks of its library
+// signer first.
+func jwsSignRaw(ctx context.Context, key *jwa.JWK, input []byte) ([]byte, error) {
+	signer, privateKey, err := jwsSigner(key)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = signer.Header(ctx, new(jwa.JWH))
+	if err != nil {
+		return nil, fmt.Errorf("check key: %w", err)
+	}
+
+	switch privateKey := privateKey.(type) {
+	case ed25519.PrivateKey:
+		return ed25519.Sign(privateKey, input), nil
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, privateKey, jwsHash(JwsPresetsEcdsa[key.Alg].Hash, input))
+		if err != nil {
+			return nil, fmt.Errorf("sign: %w", err)
+		}
+
+		// JWS encodes an ECDSA signature as R || S, each left-padded to the curve coordinate size.
+		size := (privateKey.Params().BitSize + 7) / 8 //nolint:mnd // bits to bytes, rounded up.
+		signature := make([]byte, jwsEcdsaIntegers*size)
+		r.FillBytes(signature[:size])
+		s.FillBytes(signature[size:])
+
+		return signature, nil
+	case *rsa.PrivateKey:
+		preset := JwsPresetsRsa[key.Alg]
+		digest := jwsHash(preset.Hash, input)
+
+		if jwsIsPSS(key.Alg) {
+			return rsa.SignPSS(rand.Reader, privateKey, preset.Hash, digest, &rsa.PSSOptions{
+				SaltLength: rsa.PSSSaltLengthEqualsHash,
+			})
+		}
+
+		return rsa.SignPKCS1v15(rand.Reader, privateKey, preset.Hash, digest)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrJwkPresetUnknownAlgorithm, key.Alg)
+	}
+}
+
+// jwsVerifyRaw checks signature over input as is with a public key, following the JWS encoding of
+// its algorithm. See jwsSignRaw.
+func jwsVerifyRaw(key *jwa.JWK, input, signature []byte) error {
+	_, publicKey, err := jwsVerifier(key)
+	if err != nil {
+		return err
+	}
+
+	switch publicKey := publicKey.(type) {
+	case ed25519.PublicKey:
+		if !ed25519.Verify(publicKey, input, signature) {
+			return jws.ErrInvalidSignature
+		}
+
+		return nil
+	case *ecdsa.PublicKey:
+		// The same key checks as the library verifiers.
+		if publicKey.Curve != JwsPresetsEcdsa[key.Alg].Crv {
+			return fmt.Errorf("%w: curve %s does not match %s", jwt.ErrInvalidSecretKey, publicKey.Params().Name, key.Alg)
+		}
+
+		size := (publicKey.Params().BitSize + 7) / 8 //nolint:mnd // bits to bytes, rounded up.
+		if len(signature) != jwsEcdsaIntegers*size {
+			return jws.ErrInvalidSignature
+		}
+
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+
+		if !ecdsa.Verify(publicKey, jwsHash(JwsPresetsEcdsa[key.Alg].Hash, input), r, s) {
+			return jws.ErrInvalidSignature
+		}
+
+		return nil
+	case *rsa.PublicKey:
+		if publicKey.N.BitLen() < jwsRsaMinKeyBits {
+			return fmt.Errorf("%w: RSA key under %d bits", jwt.ErrInvalidSecretKey, jwsRsaMinKeyBits)
+		}
+
+		preset := JwsPresetsRsa[key.Alg]
+		digest := jwsHash(preset.Hash, input)
+
+		if jwsIsPSS(key.Alg) {
+			err = rsa.VerifyPSS(publicKey, preset.Hash, digest, signature, &rsa.PSSOptions{
+				SaltLength: rsa.PSSSaltLengthEqualsHash,
+			})
+		} else {
+			err = rsa.VerifyPKCS1v15(publicKey, preset.Hash, digest, signature)
+		}
+
+		if err != nil {
+			return fmt.Errorf("%w: %w", jws.ErrInvalidSignature, err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrJwkPresetUnknownAlgorithm, key.Alg)
+	}
+}
+
+// jwsHash returns the digest of input under hash.
+func jwsHash(hash crypto.Hash, input []byte) []byte {
+	hasher := hash.New()
+	hasher.Write(input)
+
+	return hasher.Sum(nil)
+}
+
+// jwsIsPSS reports whether alg selects RSASSA-PSS rather than RSASSA-PKCS1-v1_5.
+func jwsIsPSS(alg jwa.Alg) bool {
+	return alg == jwa.PS256 || alg == jwa.PS384 || alg == jwa.PS512
+}
@@ -0,0 +1,102 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrJwsJSONPayloadMismatch is returned when the tokens gathered into a [JwsJSON] do not share
+	// one payload.
+	ErrJwsJSONPayloadMismatch = errors.New("signatures do not share a payload")
+	// ErrJwsJSONMalformed is returned when a token is neither a compact JWS nor a JWS JSON
+	// serialization.
+	ErrJwsJSONMalformed = errors.New("malformed jws")
+)
+
+// jwsCompactSegments is the number of dot-separated segments of a compact JWS.
+const jwsCompactSegments = 3
+
+// JwsJSONSignature is one entry of a [JwsJSON] signatures array.
+type JwsJSONSignature struct {
+	// Protected is the base64url-encoded protected header of the signature.
+	Protected string `json:"protected"`
+	// Signature is the base64url-encoded signature.
+	Signature string `json:"signature"`
+}
+
+// JwsJSON is the general JWS JSON serialization of a token carrying several signatures over the
+// same payload.
+//
+// See RFC 7515, section 7.2.1: https://datatracker.ietf.org/doc/html/rfc7515#section-7.2.1
+type JwsJSON struct {
+	// Payload is the base64url-encoded payload every signature covers.
+	Payload string `json:"payload"`
+	// Signatures holds one entry per signing key.
+	Signatures []JwsJSONSignature `json:"signatures"`
+}
+
+// NewJwsJSON gathers compact tokens signed over the same payload into a single JwsJSON.
+func NewJwsJSON(tokens ...string) (*JwsJSON, error) {
+	output := &JwsJSON{Signatures: make([]JwsJSONSignature, len(tokens))}
+
+	for i, token := range tokens {
+		parts := strings.Split(token, ".")
+		if len(parts) != jwsCompactSegments {
+			return nil, fmt.Errorf("%w: expected %d segments", ErrJwsJSONMalformed, jwsCompactSegments)
+		}
+
+		if i > 0 && parts[1] != output.Payload {
+			return nil, ErrJwsJSONPayloadMismatch
+		}
+
+		output.Payload = parts[1]
+		output.Signatures[i] = JwsJSONSignature{Protected: parts[0], Signature: parts[2]}
+	}
+
+	return output, nil
+}
+
+// IsJwsJSON reports whether token uses the JWS JSON serialization rather than the compact one.
+func IsJwsJSON(token string) bool {
+	return strings.HasPrefix(strings.TrimSpace(token), "{")
+}
+
+// ParseJwsJSON reads a token in general JWS JSON serialization.
+func ParseJwsJSON(token string) (*JwsJSON, error) {
+	var output JwsJSON
+
+	err := json.Unmarshal([]byte(token), &output)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrJwsJSONMalformed, err)
+	}
+
+	if len(output.Signatures) == 0 {
+		return nil, fmt.Errorf("%w: no signatures", ErrJwsJSONMalformed)
+	}
+
+	return &output, nil
+}
+
+// Compact returns each signature as a standalone compact JWS, ready for a [jwt.Recipient].
+func (token *JwsJSON) Compact() []string {
+	output := make([]string, len(token.Signatures))
+
+	for i, signature := range token.Signatures {
+		output[i] = signature.Protected + "." + token.Payload + "." + signature.Signature
+	}
+
+	return output
+}
+
+// Serialize returns the JSON serialization of the token.
+func (token *JwsJSON) Serialize() (string, error) {
+	serialized, err := json.Marshal(token)
+	if err != nil {
+		return "", fmt.Errorf("marshal token: %w", err)
+	}
+
+	return string(serialized), nil
+}
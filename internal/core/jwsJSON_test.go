@@ -0,0 +1,104 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+)
+
+func TestJwsJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		tokens []string
+
+		expect    string
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			tokens: []string{"header1.payload.signature1", "header2.payload.signature2"},
+
+			expect: `{"payload":"payload","signatures":[` +
+				`{"protected":"header1","signature":"signature1"},` +
+				`{"protected":"header2","signature":"signature2"}]}`,
+		},
+		{
+			name: "Error/PayloadMismatch",
+
+			tokens: []string{"header1.payload1.signature1", "header2.payload2.signature2"},
+
+			expectErr: core.ErrJwsJSONPayloadMismatch,
+		},
+		{
+			name: "Error/Malformed",
+
+			tokens: []string{"header1.payload"},
+
+			expectErr: core.ErrJwsJSONMalformed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := core.NewJwsJSON(testCase.tokens...)
+			require.ErrorIs(t, err, testCase.expectErr)
+
+			if testCase.expectErr != nil {
+				return
+			}
+
+			serialized, err := token.Serialize()
+			require.NoError(t, err)
+			require.Equal(t, testCase.expect, serialized)
+			require.True(t, core.IsJwsJSON(serialized))
+
+			parsed, err := core.ParseJwsJSON(serialized)
+			require.NoError(t, err)
+			require.Equal(t, testCase.tokens, parsed.Compact())
+		})
+	}
+}
+
+func TestParseJwsJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		serialized string
+
+		expectErr error
+	}{
+		{
+			name: "Error/NoSignatures",
+
+			serialized: `{"payload":"payload","signatures":[]}`,
+
+			expectErr: core.ErrJwsJSONMalformed,
+		},
+		{
+			name: "Error/InvalidJSON",
+
+			serialized: `{"payload":`,
+
+			expectErr: core.ErrJwsJSONMalformed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := core.ParseJwsJSON(testCase.serialized)
+			require.ErrorIs(t, err, testCase.expectErr)
+		})
+	}
+}
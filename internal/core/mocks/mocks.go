@@ -6,20 +6,22 @@ package coremocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/a-novel-kit/jwt/v2/jwa"
+	"github.com/a-novel/service-json-keys/v2/internal/config"
 	"github.com/a-novel/service-json-keys/v2/internal/core"
 	"github.com/a-novel/service-json-keys/v2/internal/dao"
 	mock "github.com/stretchr/testify/mock"
 )
 
-// NewMockJwkExportLocalSource creates a new instance of MockJwkExportLocalSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockApiKeyAuthenticateDaoSelect creates a new instance of MockApiKeyAuthenticateDaoSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockJwkExportLocalSource(t interface {
+func NewMockApiKeyAuthenticateDaoSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockJwkExportLocalSource {
-	mock := &MockJwkExportLocalSource{}
+}) *MockApiKeyAuthenticateDaoSelect {
+	mock := &MockApiKeyAuthenticateDaoSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -27,40 +29,40 @@ func NewMockJwkExportLocalSource(t interface {
 	return mock
 }
 
-// MockJwkExportLocalSource is an autogenerated mock type for the JwkExportLocalSource type
-type MockJwkExportLocalSource struct {
+// MockApiKeyAuthenticateDaoSelect is an autogenerated mock type for the ApiKeyAuthenticateDaoSelect type
+type MockApiKeyAuthenticateDaoSelect struct {
 	mock.Mock
 }
 
-type MockJwkExportLocalSource_Expecter struct {
+type MockApiKeyAuthenticateDaoSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockJwkExportLocalSource) EXPECT() *MockJwkExportLocalSource_Expecter {
-	return &MockJwkExportLocalSource_Expecter{mock: &_m.Mock}
+func (_m *MockApiKeyAuthenticateDaoSelect) EXPECT() *MockApiKeyAuthenticateDaoSelect_Expecter {
+	return &MockApiKeyAuthenticateDaoSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockJwkExportLocalSource
-func (_mock *MockJwkExportLocalSource) Exec(ctx context.Context, request *core.JwkSearchRequest) ([]*core.Jwk, error) {
+// Exec provides a mock function for the type MockApiKeyAuthenticateDaoSelect
+func (_mock *MockApiKeyAuthenticateDaoSelect) Exec(ctx context.Context, request *dao.ApiKeySelectRequest) (*dao.ApiKey, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 []*core.Jwk
+	var r0 *dao.ApiKey
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkSearchRequest) ([]*core.Jwk, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ApiKeySelectRequest) (*dao.ApiKey, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkSearchRequest) []*core.Jwk); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ApiKeySelectRequest) *dao.ApiKey); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*core.Jwk)
+			r0 = ret.Get(0).(*dao.ApiKey)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkSearchRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ApiKeySelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -68,27 +70,27 @@ func (_mock *MockJwkExportLocalSource) Exec(ctx context.Context, request *core.J
 	return r0, r1
 }
 
-// MockJwkExportLocalSource_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockJwkExportLocalSource_Exec_Call struct {
+// MockApiKeyAuthenticateDaoSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockApiKeyAuthenticateDaoSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.JwkSearchRequest
-func (_e *MockJwkExportLocalSource_Expecter) Exec(ctx any, request any) *MockJwkExportLocalSource_Exec_Call {
-	return &MockJwkExportLocalSource_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ApiKeySelectRequest
+func (_e *MockApiKeyAuthenticateDaoSelect_Expecter) Exec(ctx any, request any) *MockApiKeyAuthenticateDaoSelect_Exec_Call {
+	return &MockApiKeyAuthenticateDaoSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockJwkExportLocalSource_Exec_Call) Run(run func(ctx context.Context, request *core.JwkSearchRequest)) *MockJwkExportLocalSource_Exec_Call {
+func (_c *MockApiKeyAuthenticateDaoSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ApiKeySelectRequest)) *MockApiKeyAuthenticateDaoSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.JwkSearchRequest
+		var arg1 *dao.ApiKeySelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.JwkSearchRequest)
+			arg1 = args[1].(*dao.ApiKeySelectRequest)
 		}
 		run(
 			arg0,
@@ -98,23 +100,23 @@ func (_c *MockJwkExportLocalSource_Exec_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockJwkExportLocalSource_Exec_Call) Return(vs []*core.Jwk, err error) *MockJwkExportLocalSource_Exec_Call {
-	_c.Call.Return(vs, err)
+func (_c *MockApiKeyAuthenticateDaoSelect_Exec_Call) Return(apiKey *dao.ApiKey, err error) *MockApiKeyAuthenticateDaoSelect_Exec_Call {
+	_c.Call.Return(apiKey, err)
 	return _c
 }
 
-func (_c *MockJwkExportLocalSource_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkSearchRequest) ([]*core.Jwk, error)) *MockJwkExportLocalSource_Exec_Call {
+func (_c *MockApiKeyAuthenticateDaoSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ApiKeySelectRequest) (*dao.ApiKey, error)) *MockApiKeyAuthenticateDaoSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockJwkGenDaoSearch creates a new instance of MockJwkGenDaoSearch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockApiKeyAuthenticateDaoTouch creates a new instance of MockApiKeyAuthenticateDaoTouch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockJwkGenDaoSearch(t interface {
+func NewMockApiKeyAuthenticateDaoTouch(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockJwkGenDaoSearch {
-	mock := &MockJwkGenDaoSearch{}
+}) *MockApiKeyAuthenticateDaoTouch {
+	mock := &MockApiKeyAuthenticateDaoTouch{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -122,68 +124,57 @@ func NewMockJwkGenDaoSearch(t interface {
 	return mock
 }
 
-// MockJwkGenDaoSearch is an autogenerated mock type for the JwkGenDaoSearch type
-type MockJwkGenDaoSearch struct {
+// MockApiKeyAuthenticateDaoTouch is an autogenerated mock type for the ApiKeyAuthenticateDaoTouch type
+type MockApiKeyAuthenticateDaoTouch struct {
 	mock.Mock
 }
 
-type MockJwkGenDaoSearch_Expecter struct {
+type MockApiKeyAuthenticateDaoTouch_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockJwkGenDaoSearch) EXPECT() *MockJwkGenDaoSearch_Expecter {
-	return &MockJwkGenDaoSearch_Expecter{mock: &_m.Mock}
+func (_m *MockApiKeyAuthenticateDaoTouch) EXPECT() *MockApiKeyAuthenticateDaoTouch_Expecter {
+	return &MockApiKeyAuthenticateDaoTouch_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockJwkGenDaoSearch
-func (_mock *MockJwkGenDaoSearch) Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error) {
+// Exec provides a mock function for the type MockApiKeyAuthenticateDaoTouch
+func (_mock *MockApiKeyAuthenticateDaoTouch) Exec(ctx context.Context, request *dao.ApiKeyTouchRequest) error {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 []*dao.Jwk
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) ([]*dao.Jwk, error)); ok {
-		return returnFunc(ctx, request)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) []*dao.Jwk); ok {
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ApiKeyTouchRequest) error); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.Jwk)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkSearchRequest) error); ok {
-		r1 = returnFunc(ctx, request)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockJwkGenDaoSearch_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockJwkGenDaoSearch_Exec_Call struct {
+// MockApiKeyAuthenticateDaoTouch_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockApiKeyAuthenticateDaoTouch_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.JwkSearchRequest
-func (_e *MockJwkGenDaoSearch_Expecter) Exec(ctx any, request any) *MockJwkGenDaoSearch_Exec_Call {
-	return &MockJwkGenDaoSearch_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ApiKeyTouchRequest
+func (_e *MockApiKeyAuthenticateDaoTouch_Expecter) Exec(ctx any, request any) *MockApiKeyAuthenticateDaoTouch_Exec_Call {
+	return &MockApiKeyAuthenticateDaoTouch_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockJwkGenDaoSearch_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkSearchRequest)) *MockJwkGenDaoSearch_Exec_Call {
+func (_c *MockApiKeyAuthenticateDaoTouch_Exec_Call) Run(run func(ctx context.Context, request *dao.ApiKeyTouchRequest)) *MockApiKeyAuthenticateDaoTouch_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.JwkSearchRequest
+		var arg1 *dao.ApiKeyTouchRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.JwkSearchRequest)
+			arg1 = args[1].(*dao.ApiKeyTouchRequest)
 		}
 		run(
 			arg0,
@@ -193,23 +184,23 @@ func (_c *MockJwkGenDaoSearch_Exec_Call) Run(run func(ctx context.Context, reque
 	return _c
 }
 
-func (_c *MockJwkGenDaoSearch_Exec_Call) Return(jwks []*dao.Jwk, err error) *MockJwkGenDaoSearch_Exec_Call {
-	_c.Call.Return(jwks, err)
+func (_c *MockApiKeyAuthenticateDaoTouch_Exec_Call) Return(err error) *MockApiKeyAuthenticateDaoTouch_Exec_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockJwkGenDaoSearch_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)) *MockJwkGenDaoSearch_Exec_Call {
+func (_c *MockApiKeyAuthenticateDaoTouch_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ApiKeyTouchRequest) error) *MockApiKeyAuthenticateDaoTouch_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockJwkGenDaoInsert creates a new instance of MockJwkGenDaoInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockApiKeyCreateDao creates a new instance of MockApiKeyCreateDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockJwkGenDaoInsert(t interface {
+func NewMockApiKeyCreateDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockJwkGenDaoInsert {
-	mock := &MockJwkGenDaoInsert{}
+}) *MockApiKeyCreateDao {
+	mock := &MockApiKeyCreateDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -217,40 +208,40 @@ func NewMockJwkGenDaoInsert(t interface {
 	return mock
 }
 
-// MockJwkGenDaoInsert is an autogenerated mock type for the JwkGenDaoInsert type
-type MockJwkGenDaoInsert struct {
+// MockApiKeyCreateDao is an autogenerated mock type for the ApiKeyCreateDao type
+type MockApiKeyCreateDao struct {
 	mock.Mock
 }
 
-type MockJwkGenDaoInsert_Expecter struct {
+type MockApiKeyCreateDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockJwkGenDaoInsert) EXPECT() *MockJwkGenDaoInsert_Expecter {
-	return &MockJwkGenDaoInsert_Expecter{mock: &_m.Mock}
+func (_m *MockApiKeyCreateDao) EXPECT() *MockApiKeyCreateDao_Expecter {
+	return &MockApiKeyCreateDao_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockJwkGenDaoInsert
-func (_mock *MockJwkGenDaoInsert) Exec(ctx context.Context, request *dao.JwkInsertRequest) (*dao.Jwk, error) {
+// Exec provides a mock function for the type MockApiKeyCreateDao
+func (_mock *MockApiKeyCreateDao) Exec(ctx context.Context, request *dao.ApiKeyInsertRequest) (*dao.ApiKey, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Jwk
+	var r0 *dao.ApiKey
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkInsertRequest) (*dao.Jwk, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ApiKeyInsertRequest) (*dao.ApiKey, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkInsertRequest) *dao.Jwk); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ApiKeyInsertRequest) *dao.ApiKey); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Jwk)
+			r0 = ret.Get(0).(*dao.ApiKey)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkInsertRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ApiKeyInsertRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -258,27 +249,27 @@ func (_mock *MockJwkGenDaoInsert) Exec(ctx context.Context, request *dao.JwkInse
 	return r0, r1
 }
 
-// MockJwkGenDaoInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockJwkGenDaoInsert_Exec_Call struct {
+// MockApiKeyCreateDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockApiKeyCreateDao_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.JwkInsertRequest
-func (_e *MockJwkGenDaoInsert_Expecter) Exec(ctx any, request any) *MockJwkGenDaoInsert_Exec_Call {
-	return &MockJwkGenDaoInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ApiKeyInsertRequest
+func (_e *MockApiKeyCreateDao_Expecter) Exec(ctx any, request any) *MockApiKeyCreateDao_Exec_Call {
+	return &MockApiKeyCreateDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockJwkGenDaoInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkInsertRequest)) *MockJwkGenDaoInsert_Exec_Call {
+func (_c *MockApiKeyCreateDao_Exec_Call) Run(run func(ctx context.Context, request *dao.ApiKeyInsertRequest)) *MockApiKeyCreateDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.JwkInsertRequest
+		var arg1 *dao.ApiKeyInsertRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.JwkInsertRequest)
+			arg1 = args[1].(*dao.ApiKeyInsertRequest)
 		}
 		run(
 			arg0,
@@ -288,23 +279,23 @@ func (_c *MockJwkGenDaoInsert_Exec_Call) Run(run func(ctx context.Context, reque
 	return _c
 }
 
-func (_c *MockJwkGenDaoInsert_Exec_Call) Return(jwk *dao.Jwk, err error) *MockJwkGenDaoInsert_Exec_Call {
-	_c.Call.Return(jwk, err)
+func (_c *MockApiKeyCreateDao_Exec_Call) Return(apiKey *dao.ApiKey, err error) *MockApiKeyCreateDao_Exec_Call {
+	_c.Call.Return(apiKey, err)
 	return _c
 }
 
-func (_c *MockJwkGenDaoInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkInsertRequest) (*dao.Jwk, error)) *MockJwkGenDaoInsert_Exec_Call {
+func (_c *MockApiKeyCreateDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ApiKeyInsertRequest) (*dao.ApiKey, error)) *MockApiKeyCreateDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockJwkGenServiceExtract creates a new instance of MockJwkGenServiceExtract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockApiKeyListDao creates a new instance of MockApiKeyListDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockJwkGenServiceExtract(t interface {
+func NewMockApiKeyListDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockJwkGenServiceExtract {
-	mock := &MockJwkGenServiceExtract{}
+}) *MockApiKeyListDao {
+	mock := &MockApiKeyListDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -312,40 +303,40 @@ func NewMockJwkGenServiceExtract(t interface {
 	return mock
 }
 
-// MockJwkGenServiceExtract is an autogenerated mock type for the JwkGenServiceExtract type
-type MockJwkGenServiceExtract struct {
+// MockApiKeyListDao is an autogenerated mock type for the ApiKeyListDao type
+type MockApiKeyListDao struct {
 	mock.Mock
 }
 
-type MockJwkGenServiceExtract_Expecter struct {
+type MockApiKeyListDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockJwkGenServiceExtract) EXPECT() *MockJwkGenServiceExtract_Expecter {
-	return &MockJwkGenServiceExtract_Expecter{mock: &_m.Mock}
+func (_m *MockApiKeyListDao) EXPECT() *MockApiKeyListDao_Expecter {
+	return &MockApiKeyListDao_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockJwkGenServiceExtract
-func (_mock *MockJwkGenServiceExtract) Exec(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error) {
+// Exec provides a mock function for the type MockApiKeyListDao
+func (_mock *MockApiKeyListDao) Exec(ctx context.Context, request *dao.ApiKeyListRequest) ([]*dao.ApiKey, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.Jwk
+	var r0 []*dao.ApiKey
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) (*core.Jwk, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ApiKeyListRequest) ([]*dao.ApiKey, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) *core.Jwk); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ApiKeyListRequest) []*dao.ApiKey); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.Jwk)
+			r0 = ret.Get(0).([]*dao.ApiKey)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkExtractRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ApiKeyListRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -353,27 +344,27 @@ func (_mock *MockJwkGenServiceExtract) Exec(ctx context.Context, request *core.J
 	return r0, r1
 }
 
-// MockJwkGenServiceExtract_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockJwkGenServiceExtract_Exec_Call struct {
+// MockApiKeyListDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockApiKeyListDao_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.JwkExtractRequest
-func (_e *MockJwkGenServiceExtract_Expecter) Exec(ctx any, request any) *MockJwkGenServiceExtract_Exec_Call {
-	return &MockJwkGenServiceExtract_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ApiKeyListRequest
+func (_e *MockApiKeyListDao_Expecter) Exec(ctx any, request any) *MockApiKeyListDao_Exec_Call {
+	return &MockApiKeyListDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockJwkGenServiceExtract_Exec_Call) Run(run func(ctx context.Context, request *core.JwkExtractRequest)) *MockJwkGenServiceExtract_Exec_Call {
+func (_c *MockApiKeyListDao_Exec_Call) Run(run func(ctx context.Context, request *dao.ApiKeyListRequest)) *MockApiKeyListDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.JwkExtractRequest
+		var arg1 *dao.ApiKeyListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.JwkExtractRequest)
+			arg1 = args[1].(*dao.ApiKeyListRequest)
 		}
 		run(
 			arg0,
@@ -383,23 +374,23 @@ func (_c *MockJwkGenServiceExtract_Exec_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockJwkGenServiceExtract_Exec_Call) Return(v *core.Jwk, err error) *MockJwkGenServiceExtract_Exec_Call {
-	_c.Call.Return(v, err)
+func (_c *MockApiKeyListDao_Exec_Call) Return(apiKeys []*dao.ApiKey, err error) *MockApiKeyListDao_Exec_Call {
+	_c.Call.Return(apiKeys, err)
 	return _c
 }
 
-func (_c *MockJwkGenServiceExtract_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error)) *MockJwkGenServiceExtract_Exec_Call {
+func (_c *MockApiKeyListDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ApiKeyListRequest) ([]*dao.ApiKey, error)) *MockApiKeyListDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockJwkPrivateSource creates a new instance of MockJwkPrivateSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockApiKeyRevokeDao creates a new instance of MockApiKeyRevokeDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockJwkPrivateSource(t interface {
+func NewMockApiKeyRevokeDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockJwkPrivateSource {
-	mock := &MockJwkPrivateSource{}
+}) *MockApiKeyRevokeDao {
+	mock := &MockApiKeyRevokeDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -407,68 +398,68 @@ func NewMockJwkPrivateSource(t interface {
 	return mock
 }
 
-// MockJwkPrivateSource is an autogenerated mock type for the JwkPrivateSource type
-type MockJwkPrivateSource struct {
+// MockApiKeyRevokeDao is an autogenerated mock type for the ApiKeyRevokeDao type
+type MockApiKeyRevokeDao struct {
 	mock.Mock
 }
 
-type MockJwkPrivateSource_Expecter struct {
+type MockApiKeyRevokeDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockJwkPrivateSource) EXPECT() *MockJwkPrivateSource_Expecter {
-	return &MockJwkPrivateSource_Expecter{mock: &_m.Mock}
+func (_m *MockApiKeyRevokeDao) EXPECT() *MockApiKeyRevokeDao_Expecter {
+	return &MockApiKeyRevokeDao_Expecter{mock: &_m.Mock}
 }
 
-// SearchKeys provides a mock function for the type MockJwkPrivateSource
-func (_mock *MockJwkPrivateSource) SearchKeys(ctx context.Context, usage string) ([]*jwa.JWK, error) {
-	ret := _mock.Called(ctx, usage)
+// Exec provides a mock function for the type MockApiKeyRevokeDao
+func (_mock *MockApiKeyRevokeDao) Exec(ctx context.Context, request *dao.ApiKeyRevokeRequest) (*dao.ApiKey, error) {
+	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SearchKeys")
+		panic("no return value specified for Exec")
 	}
 
-	var r0 []*jwa.JWK
+	var r0 *dao.ApiKey
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*jwa.JWK, error)); ok {
-		return returnFunc(ctx, usage)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ApiKeyRevokeRequest) (*dao.ApiKey, error)); ok {
+		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*jwa.JWK); ok {
-		r0 = returnFunc(ctx, usage)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ApiKeyRevokeRequest) *dao.ApiKey); ok {
+		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*jwa.JWK)
+			r0 = ret.Get(0).(*dao.ApiKey)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, usage)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ApiKeyRevokeRequest) error); ok {
+		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockJwkPrivateSource_SearchKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchKeys'
-type MockJwkPrivateSource_SearchKeys_Call struct {
+// MockApiKeyRevokeDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockApiKeyRevokeDao_Exec_Call struct {
 	*mock.Call
 }
 
-// SearchKeys is a helper method to define mock.On call
+// Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - usage string
-func (_e *MockJwkPrivateSource_Expecter) SearchKeys(ctx any, usage any) *MockJwkPrivateSource_SearchKeys_Call {
-	return &MockJwkPrivateSource_SearchKeys_Call{Call: _e.mock.On("SearchKeys", ctx, usage)}
+//   - request *dao.ApiKeyRevokeRequest
+func (_e *MockApiKeyRevokeDao_Expecter) Exec(ctx any, request any) *MockApiKeyRevokeDao_Exec_Call {
+	return &MockApiKeyRevokeDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockJwkPrivateSource_SearchKeys_Call) Run(run func(ctx context.Context, usage string)) *MockJwkPrivateSource_SearchKeys_Call {
+func (_c *MockApiKeyRevokeDao_Exec_Call) Run(run func(ctx context.Context, request *dao.ApiKeyRevokeRequest)) *MockApiKeyRevokeDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *dao.ApiKeyRevokeRequest
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(*dao.ApiKeyRevokeRequest)
 		}
 		run(
 			arg0,
@@ -478,23 +469,23 @@ func (_c *MockJwkPrivateSource_SearchKeys_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockJwkPrivateSource_SearchKeys_Call) Return(jWKs []*jwa.JWK, err error) *MockJwkPrivateSource_SearchKeys_Call {
-	_c.Call.Return(jWKs, err)
+func (_c *MockApiKeyRevokeDao_Exec_Call) Return(apiKey *dao.ApiKey, err error) *MockApiKeyRevokeDao_Exec_Call {
+	_c.Call.Return(apiKey, err)
 	return _c
 }
 
-func (_c *MockJwkPrivateSource_SearchKeys_Call) RunAndReturn(run func(ctx context.Context, usage string) ([]*jwa.JWK, error)) *MockJwkPrivateSource_SearchKeys_Call {
+func (_c *MockApiKeyRevokeDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ApiKeyRevokeRequest) (*dao.ApiKey, error)) *MockApiKeyRevokeDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockJwkPublicSource creates a new instance of MockJwkPublicSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAuditRecorder creates a new instance of MockAuditRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockJwkPublicSource(t interface {
+func NewMockAuditRecorder(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockJwkPublicSource {
-	mock := &MockJwkPublicSource{}
+}) *MockAuditRecorder {
+	mock := &MockAuditRecorder{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -502,68 +493,68 @@ func NewMockJwkPublicSource(t interface {
 	return mock
 }
 
-// MockJwkPublicSource is an autogenerated mock type for the JwkPublicSource type
-type MockJwkPublicSource struct {
+// MockAuditRecorder is an autogenerated mock type for the AuditRecorder type
+type MockAuditRecorder struct {
 	mock.Mock
 }
 
-type MockJwkPublicSource_Expecter struct {
+type MockAuditRecorder_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockJwkPublicSource) EXPECT() *MockJwkPublicSource_Expecter {
-	return &MockJwkPublicSource_Expecter{mock: &_m.Mock}
+func (_m *MockAuditRecorder) EXPECT() *MockAuditRecorder_Expecter {
+	return &MockAuditRecorder_Expecter{mock: &_m.Mock}
 }
 
-// SearchKeys provides a mock function for the type MockJwkPublicSource
-func (_mock *MockJwkPublicSource) SearchKeys(ctx context.Context, usage string) ([]*jwa.JWK, error) {
-	ret := _mock.Called(ctx, usage)
+// Exec provides a mock function for the type MockAuditRecorder
+func (_mock *MockAuditRecorder) Exec(ctx context.Context, request *core.AuditRecordRequest) (*core.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SearchKeys")
+		panic("no return value specified for Exec")
 	}
 
-	var r0 []*jwa.JWK
+	var r0 *core.AuditEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*jwa.JWK, error)); ok {
-		return returnFunc(ctx, usage)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.AuditRecordRequest) (*core.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*jwa.JWK); ok {
-		r0 = returnFunc(ctx, usage)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.AuditRecordRequest) *core.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*jwa.JWK)
+			r0 = ret.Get(0).(*core.AuditEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, usage)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.AuditRecordRequest) error); ok {
+		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockJwkPublicSource_SearchKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchKeys'
-type MockJwkPublicSource_SearchKeys_Call struct {
+// MockAuditRecorder_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockAuditRecorder_Exec_Call struct {
 	*mock.Call
 }
 
-// SearchKeys is a helper method to define mock.On call
+// Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - usage string
-func (_e *MockJwkPublicSource_Expecter) SearchKeys(ctx any, usage any) *MockJwkPublicSource_SearchKeys_Call {
-	return &MockJwkPublicSource_SearchKeys_Call{Call: _e.mock.On("SearchKeys", ctx, usage)}
+//   - request *core.AuditRecordRequest
+func (_e *MockAuditRecorder_Expecter) Exec(ctx any, request any) *MockAuditRecorder_Exec_Call {
+	return &MockAuditRecorder_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockJwkPublicSource_SearchKeys_Call) Run(run func(ctx context.Context, usage string)) *MockJwkPublicSource_SearchKeys_Call {
+func (_c *MockAuditRecorder_Exec_Call) Run(run func(ctx context.Context, request *core.AuditRecordRequest)) *MockAuditRecorder_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *core.AuditRecordRequest
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(*core.AuditRecordRequest)
 		}
 		run(
 			arg0,
@@ -573,23 +564,23 @@ func (_c *MockJwkPublicSource_SearchKeys_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockJwkPublicSource_SearchKeys_Call) Return(jWKs []*jwa.JWK, err error) *MockJwkPublicSource_SearchKeys_Call {
-	_c.Call.Return(jWKs, err)
+func (_c *MockAuditRecorder_Exec_Call) Return(auditEvent *core.AuditEvent, err error) *MockAuditRecorder_Exec_Call {
+	_c.Call.Return(auditEvent, err)
 	return _c
 }
 
-func (_c *MockJwkPublicSource_SearchKeys_Call) RunAndReturn(run func(ctx context.Context, usage string) ([]*jwa.JWK, error)) *MockJwkPublicSource_SearchKeys_Call {
+func (_c *MockAuditRecorder_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.AuditRecordRequest) (*core.AuditEvent, error)) *MockAuditRecorder_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockJwkSearchDao creates a new instance of MockJwkSearchDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAuditEventSearchDao creates a new instance of MockAuditEventSearchDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockJwkSearchDao(t interface {
+func NewMockAuditEventSearchDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockJwkSearchDao {
-	mock := &MockJwkSearchDao{}
+}) *MockAuditEventSearchDao {
+	mock := &MockAuditEventSearchDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -597,40 +588,40 @@ func NewMockJwkSearchDao(t interface {
 	return mock
 }
 
-// MockJwkSearchDao is an autogenerated mock type for the JwkSearchDao type
-type MockJwkSearchDao struct {
+// MockAuditEventSearchDao is an autogenerated mock type for the AuditEventSearchDao type
+type MockAuditEventSearchDao struct {
 	mock.Mock
 }
 
-type MockJwkSearchDao_Expecter struct {
+type MockAuditEventSearchDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockJwkSearchDao) EXPECT() *MockJwkSearchDao_Expecter {
-	return &MockJwkSearchDao_Expecter{mock: &_m.Mock}
+func (_m *MockAuditEventSearchDao) EXPECT() *MockAuditEventSearchDao_Expecter {
+	return &MockAuditEventSearchDao_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockJwkSearchDao
-func (_mock *MockJwkSearchDao) Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error) {
+// Exec provides a mock function for the type MockAuditEventSearchDao
+func (_mock *MockAuditEventSearchDao) Exec(ctx context.Context, request *dao.AuditEventSearchRequest) ([]*dao.AuditEvent, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 []*dao.Jwk
+	var r0 []*dao.AuditEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) ([]*dao.Jwk, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventSearchRequest) ([]*dao.AuditEvent, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) []*dao.Jwk); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventSearchRequest) []*dao.AuditEvent); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.Jwk)
+			r0 = ret.Get(0).([]*dao.AuditEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkSearchRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventSearchRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -638,27 +629,27 @@ func (_mock *MockJwkSearchDao) Exec(ctx context.Context, request *dao.JwkSearchR
 	return r0, r1
 }
 
-// MockJwkSearchDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockJwkSearchDao_Exec_Call struct {
+// MockAuditEventSearchDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockAuditEventSearchDao_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.JwkSearchRequest
-func (_e *MockJwkSearchDao_Expecter) Exec(ctx any, request any) *MockJwkSearchDao_Exec_Call {
-	return &MockJwkSearchDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.AuditEventSearchRequest
+func (_e *MockAuditEventSearchDao_Expecter) Exec(ctx any, request any) *MockAuditEventSearchDao_Exec_Call {
+	return &MockAuditEventSearchDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockJwkSearchDao_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkSearchRequest)) *MockJwkSearchDao_Exec_Call {
+func (_c *MockAuditEventSearchDao_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventSearchRequest)) *MockAuditEventSearchDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.JwkSearchRequest
+		var arg1 *dao.AuditEventSearchRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.JwkSearchRequest)
+			arg1 = args[1].(*dao.AuditEventSearchRequest)
 		}
 		run(
 			arg0,
@@ -668,23 +659,23 @@ func (_c *MockJwkSearchDao_Exec_Call) Run(run func(ctx context.Context, request
 	return _c
 }
 
-func (_c *MockJwkSearchDao_Exec_Call) Return(jwks []*dao.Jwk, err error) *MockJwkSearchDao_Exec_Call {
-	_c.Call.Return(jwks, err)
+func (_c *MockAuditEventSearchDao_Exec_Call) Return(auditEvents []*dao.AuditEvent, err error) *MockAuditEventSearchDao_Exec_Call {
+	_c.Call.Return(auditEvents, err)
 	return _c
 }
 
-func (_c *MockJwkSearchDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)) *MockJwkSearchDao_Exec_Call {
+func (_c *MockAuditEventSearchDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventSearchRequest) ([]*dao.AuditEvent, error)) *MockAuditEventSearchDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockJwkSearchServiceExtract creates a new instance of MockJwkSearchServiceExtract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAuditRecordDao creates a new instance of MockAuditRecordDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockJwkSearchServiceExtract(t interface {
+func NewMockAuditRecordDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockJwkSearchServiceExtract {
-	mock := &MockJwkSearchServiceExtract{}
+}) *MockAuditRecordDao {
+	mock := &MockAuditRecordDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -692,40 +683,40 @@ func NewMockJwkSearchServiceExtract(t interface {
 	return mock
 }
 
-// MockJwkSearchServiceExtract is an autogenerated mock type for the JwkSearchServiceExtract type
-type MockJwkSearchServiceExtract struct {
+// MockAuditRecordDao is an autogenerated mock type for the AuditRecordDao type
+type MockAuditRecordDao struct {
 	mock.Mock
 }
 
-type MockJwkSearchServiceExtract_Expecter struct {
+type MockAuditRecordDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockJwkSearchServiceExtract) EXPECT() *MockJwkSearchServiceExtract_Expecter {
-	return &MockJwkSearchServiceExtract_Expecter{mock: &_m.Mock}
+func (_m *MockAuditRecordDao) EXPECT() *MockAuditRecordDao_Expecter {
+	return &MockAuditRecordDao_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockJwkSearchServiceExtract
-func (_mock *MockJwkSearchServiceExtract) Exec(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error) {
+// Exec provides a mock function for the type MockAuditRecordDao
+func (_mock *MockAuditRecordDao) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.Jwk
+	var r0 *dao.AuditEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) (*core.Jwk, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) *core.Jwk); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.Jwk)
+			r0 = ret.Get(0).(*dao.AuditEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkExtractRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -733,27 +724,27 @@ func (_mock *MockJwkSearchServiceExtract) Exec(ctx context.Context, request *cor
 	return r0, r1
 }
 
-// MockJwkSearchServiceExtract_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockJwkSearchServiceExtract_Exec_Call struct {
+// MockAuditRecordDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockAuditRecordDao_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.JwkExtractRequest
-func (_e *MockJwkSearchServiceExtract_Expecter) Exec(ctx any, request any) *MockJwkSearchServiceExtract_Exec_Call {
-	return &MockJwkSearchServiceExtract_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockAuditRecordDao_Expecter) Exec(ctx any, request any) *MockAuditRecordDao_Exec_Call {
+	return &MockAuditRecordDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockJwkSearchServiceExtract_Exec_Call) Run(run func(ctx context.Context, request *core.JwkExtractRequest)) *MockJwkSearchServiceExtract_Exec_Call {
+func (_c *MockAuditRecordDao_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockAuditRecordDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.JwkExtractRequest
+		var arg1 *dao.AuditEventInsertRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.JwkExtractRequest)
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
 		}
 		run(
 			arg0,
@@ -763,23 +754,23 @@ func (_c *MockJwkSearchServiceExtract_Exec_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockJwkSearchServiceExtract_Exec_Call) Return(v *core.Jwk, err error) *MockJwkSearchServiceExtract_Exec_Call {
-	_c.Call.Return(v, err)
+func (_c *MockAuditRecordDao_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockAuditRecordDao_Exec_Call {
+	_c.Call.Return(auditEvent, err)
 	return _c
 }
 
-func (_c *MockJwkSearchServiceExtract_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error)) *MockJwkSearchServiceExtract_Exec_Call {
+func (_c *MockAuditRecordDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockAuditRecordDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockJwkSelectDao creates a new instance of MockJwkSelectDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockClaimsInspectSource creates a new instance of MockClaimsInspectSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockJwkSelectDao(t interface {
+func NewMockClaimsInspectSource(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockJwkSelectDao {
-	mock := &MockJwkSelectDao{}
+}) *MockClaimsInspectSource {
+	mock := &MockClaimsInspectSource{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -787,8 +778,4229 @@ func NewMockJwkSelectDao(t interface {
 	return mock
 }
 
-// MockJwkSelectDao is an autogenerated mock type for the JwkSelectDao type
-type MockJwkSelectDao struct {
+// MockClaimsInspectSource is an autogenerated mock type for the ClaimsInspectSource type
+type MockClaimsInspectSource struct {
+	mock.Mock
+}
+
+type MockClaimsInspectSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClaimsInspectSource) EXPECT() *MockClaimsInspectSource_Expecter {
+	return &MockClaimsInspectSource_Expecter{mock: &_m.Mock}
+}
+
+// SearchKeys provides a mock function for the type MockClaimsInspectSource
+func (_mock *MockClaimsInspectSource) SearchKeys(ctx context.Context, usage string) ([]*jwa.JWK, error) {
+	ret := _mock.Called(ctx, usage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchKeys")
+	}
+
+	var r0 []*jwa.JWK
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*jwa.JWK, error)); ok {
+		return returnFunc(ctx, usage)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*jwa.JWK); ok {
+		r0 = returnFunc(ctx, usage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*jwa.JWK)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, usage)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClaimsInspectSource_SearchKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchKeys'
+type MockClaimsInspectSource_SearchKeys_Call struct {
+	*mock.Call
+}
+
+// SearchKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usage string
+func (_e *MockClaimsInspectSource_Expecter) SearchKeys(ctx any, usage any) *MockClaimsInspectSource_SearchKeys_Call {
+	return &MockClaimsInspectSource_SearchKeys_Call{Call: _e.mock.On("SearchKeys", ctx, usage)}
+}
+
+func (_c *MockClaimsInspectSource_SearchKeys_Call) Run(run func(ctx context.Context, usage string)) *MockClaimsInspectSource_SearchKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClaimsInspectSource_SearchKeys_Call) Return(jWKs []*jwa.JWK, err error) *MockClaimsInspectSource_SearchKeys_Call {
+	_c.Call.Return(jWKs, err)
+	return _c
+}
+
+func (_c *MockClaimsInspectSource_SearchKeys_Call) RunAndReturn(run func(ctx context.Context, usage string) ([]*jwa.JWK, error)) *MockClaimsInspectSource_SearchKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockClaimsInspectServiceRevoked creates a new instance of MockClaimsInspectServiceRevoked. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClaimsInspectServiceRevoked(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClaimsInspectServiceRevoked {
+	mock := &MockClaimsInspectServiceRevoked{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockClaimsInspectServiceRevoked is an autogenerated mock type for the ClaimsInspectServiceRevoked type
+type MockClaimsInspectServiceRevoked struct {
+	mock.Mock
+}
+
+type MockClaimsInspectServiceRevoked_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClaimsInspectServiceRevoked) EXPECT() *MockClaimsInspectServiceRevoked_Expecter {
+	return &MockClaimsInspectServiceRevoked_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockClaimsInspectServiceRevoked
+func (_mock *MockClaimsInspectServiceRevoked) Exec(ctx context.Context, request *core.RevokedTokenCheckRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.RevokedTokenCheckRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClaimsInspectServiceRevoked_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockClaimsInspectServiceRevoked_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.RevokedTokenCheckRequest
+func (_e *MockClaimsInspectServiceRevoked_Expecter) Exec(ctx any, request any) *MockClaimsInspectServiceRevoked_Exec_Call {
+	return &MockClaimsInspectServiceRevoked_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockClaimsInspectServiceRevoked_Exec_Call) Run(run func(ctx context.Context, request *core.RevokedTokenCheckRequest)) *MockClaimsInspectServiceRevoked_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.RevokedTokenCheckRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.RevokedTokenCheckRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClaimsInspectServiceRevoked_Exec_Call) Return(err error) *MockClaimsInspectServiceRevoked_Exec_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClaimsInspectServiceRevoked_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.RevokedTokenCheckRequest) error) *MockClaimsInspectServiceRevoked_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockClaimsSignServiceFreeze creates a new instance of MockClaimsSignServiceFreeze. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClaimsSignServiceFreeze(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClaimsSignServiceFreeze {
+	mock := &MockClaimsSignServiceFreeze{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockClaimsSignServiceFreeze is an autogenerated mock type for the ClaimsSignServiceFreeze type
+type MockClaimsSignServiceFreeze struct {
+	mock.Mock
+}
+
+type MockClaimsSignServiceFreeze_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClaimsSignServiceFreeze) EXPECT() *MockClaimsSignServiceFreeze_Expecter {
+	return &MockClaimsSignServiceFreeze_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockClaimsSignServiceFreeze
+func (_mock *MockClaimsSignServiceFreeze) Exec(ctx context.Context, request *core.SigningFreezeCheckRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.SigningFreezeCheckRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClaimsSignServiceFreeze_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockClaimsSignServiceFreeze_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.SigningFreezeCheckRequest
+func (_e *MockClaimsSignServiceFreeze_Expecter) Exec(ctx any, request any) *MockClaimsSignServiceFreeze_Exec_Call {
+	return &MockClaimsSignServiceFreeze_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockClaimsSignServiceFreeze_Exec_Call) Run(run func(ctx context.Context, request *core.SigningFreezeCheckRequest)) *MockClaimsSignServiceFreeze_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.SigningFreezeCheckRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.SigningFreezeCheckRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClaimsSignServiceFreeze_Exec_Call) Return(err error) *MockClaimsSignServiceFreeze_Exec_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClaimsSignServiceFreeze_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.SigningFreezeCheckRequest) error) *MockClaimsSignServiceFreeze_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockClaimsVerifyServiceRevoked creates a new instance of MockClaimsVerifyServiceRevoked. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClaimsVerifyServiceRevoked(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClaimsVerifyServiceRevoked {
+	mock := &MockClaimsVerifyServiceRevoked{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockClaimsVerifyServiceRevoked is an autogenerated mock type for the ClaimsVerifyServiceRevoked type
+type MockClaimsVerifyServiceRevoked struct {
+	mock.Mock
+}
+
+type MockClaimsVerifyServiceRevoked_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClaimsVerifyServiceRevoked) EXPECT() *MockClaimsVerifyServiceRevoked_Expecter {
+	return &MockClaimsVerifyServiceRevoked_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockClaimsVerifyServiceRevoked
+func (_mock *MockClaimsVerifyServiceRevoked) Exec(ctx context.Context, request *core.RevokedTokenCheckRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.RevokedTokenCheckRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClaimsVerifyServiceRevoked_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockClaimsVerifyServiceRevoked_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.RevokedTokenCheckRequest
+func (_e *MockClaimsVerifyServiceRevoked_Expecter) Exec(ctx any, request any) *MockClaimsVerifyServiceRevoked_Exec_Call {
+	return &MockClaimsVerifyServiceRevoked_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockClaimsVerifyServiceRevoked_Exec_Call) Run(run func(ctx context.Context, request *core.RevokedTokenCheckRequest)) *MockClaimsVerifyServiceRevoked_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.RevokedTokenCheckRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.RevokedTokenCheckRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClaimsVerifyServiceRevoked_Exec_Call) Return(err error) *MockClaimsVerifyServiceRevoked_Exec_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClaimsVerifyServiceRevoked_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.RevokedTokenCheckRequest) error) *MockClaimsVerifyServiceRevoked_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockHttpSignatureSignServiceFreeze creates a new instance of MockHttpSignatureSignServiceFreeze. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHttpSignatureSignServiceFreeze(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHttpSignatureSignServiceFreeze {
+	mock := &MockHttpSignatureSignServiceFreeze{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockHttpSignatureSignServiceFreeze is an autogenerated mock type for the HttpSignatureSignServiceFreeze type
+type MockHttpSignatureSignServiceFreeze struct {
+	mock.Mock
+}
+
+type MockHttpSignatureSignServiceFreeze_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHttpSignatureSignServiceFreeze) EXPECT() *MockHttpSignatureSignServiceFreeze_Expecter {
+	return &MockHttpSignatureSignServiceFreeze_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockHttpSignatureSignServiceFreeze
+func (_mock *MockHttpSignatureSignServiceFreeze) Exec(ctx context.Context, request *core.SigningFreezeCheckRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.SigningFreezeCheckRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockHttpSignatureSignServiceFreeze_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockHttpSignatureSignServiceFreeze_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.SigningFreezeCheckRequest
+func (_e *MockHttpSignatureSignServiceFreeze_Expecter) Exec(ctx any, request any) *MockHttpSignatureSignServiceFreeze_Exec_Call {
+	return &MockHttpSignatureSignServiceFreeze_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockHttpSignatureSignServiceFreeze_Exec_Call) Run(run func(ctx context.Context, request *core.SigningFreezeCheckRequest)) *MockHttpSignatureSignServiceFreeze_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.SigningFreezeCheckRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.SigningFreezeCheckRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHttpSignatureSignServiceFreeze_Exec_Call) Return(err error) *MockHttpSignatureSignServiceFreeze_Exec_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockHttpSignatureSignServiceFreeze_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.SigningFreezeCheckRequest) error) *MockHttpSignatureSignServiceFreeze_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkAlgMigrationDaoSearch creates a new instance of MockJwkAlgMigrationDaoSearch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkAlgMigrationDaoSearch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkAlgMigrationDaoSearch {
+	mock := &MockJwkAlgMigrationDaoSearch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkAlgMigrationDaoSearch is an autogenerated mock type for the JwkAlgMigrationDaoSearch type
+type MockJwkAlgMigrationDaoSearch struct {
+	mock.Mock
+}
+
+type MockJwkAlgMigrationDaoSearch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkAlgMigrationDaoSearch) EXPECT() *MockJwkAlgMigrationDaoSearch_Expecter {
+	return &MockJwkAlgMigrationDaoSearch_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkAlgMigrationDaoSearch
+func (_mock *MockJwkAlgMigrationDaoSearch) Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) ([]*dao.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) []*dao.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkSearchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkAlgMigrationDaoSearch_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkAlgMigrationDaoSearch_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkSearchRequest
+func (_e *MockJwkAlgMigrationDaoSearch_Expecter) Exec(ctx any, request any) *MockJwkAlgMigrationDaoSearch_Exec_Call {
+	return &MockJwkAlgMigrationDaoSearch_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkAlgMigrationDaoSearch_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkSearchRequest)) *MockJwkAlgMigrationDaoSearch_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkSearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkAlgMigrationDaoSearch_Exec_Call) Return(jwks []*dao.Jwk, err error) *MockJwkAlgMigrationDaoSearch_Exec_Call {
+	_c.Call.Return(jwks, err)
+	return _c
+}
+
+func (_c *MockJwkAlgMigrationDaoSearch_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)) *MockJwkAlgMigrationDaoSearch_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkAlgMigrationServiceExtract creates a new instance of MockJwkAlgMigrationServiceExtract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkAlgMigrationServiceExtract(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkAlgMigrationServiceExtract {
+	mock := &MockJwkAlgMigrationServiceExtract{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkAlgMigrationServiceExtract is an autogenerated mock type for the JwkAlgMigrationServiceExtract type
+type MockJwkAlgMigrationServiceExtract struct {
+	mock.Mock
+}
+
+type MockJwkAlgMigrationServiceExtract_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkAlgMigrationServiceExtract) EXPECT() *MockJwkAlgMigrationServiceExtract_Expecter {
+	return &MockJwkAlgMigrationServiceExtract_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkAlgMigrationServiceExtract
+func (_mock *MockJwkAlgMigrationServiceExtract) Exec(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) (*core.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) *core.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkExtractRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkAlgMigrationServiceExtract_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkAlgMigrationServiceExtract_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkExtractRequest
+func (_e *MockJwkAlgMigrationServiceExtract_Expecter) Exec(ctx any, request any) *MockJwkAlgMigrationServiceExtract_Exec_Call {
+	return &MockJwkAlgMigrationServiceExtract_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkAlgMigrationServiceExtract_Exec_Call) Run(run func(ctx context.Context, request *core.JwkExtractRequest)) *MockJwkAlgMigrationServiceExtract_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkExtractRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkExtractRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkAlgMigrationServiceExtract_Exec_Call) Return(v *core.Jwk, err error) *MockJwkAlgMigrationServiceExtract_Exec_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockJwkAlgMigrationServiceExtract_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error)) *MockJwkAlgMigrationServiceExtract_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkBootstrapDaoLock creates a new instance of MockJwkBootstrapDaoLock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkBootstrapDaoLock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkBootstrapDaoLock {
+	mock := &MockJwkBootstrapDaoLock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkBootstrapDaoLock is an autogenerated mock type for the JwkBootstrapDaoLock type
+type MockJwkBootstrapDaoLock struct {
+	mock.Mock
+}
+
+type MockJwkBootstrapDaoLock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkBootstrapDaoLock) EXPECT() *MockJwkBootstrapDaoLock_Expecter {
+	return &MockJwkBootstrapDaoLock_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkBootstrapDaoLock
+func (_mock *MockJwkBootstrapDaoLock) Exec(ctx context.Context, request *dao.JwkLockRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkLockRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockJwkBootstrapDaoLock_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkBootstrapDaoLock_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkLockRequest
+func (_e *MockJwkBootstrapDaoLock_Expecter) Exec(ctx any, request any) *MockJwkBootstrapDaoLock_Exec_Call {
+	return &MockJwkBootstrapDaoLock_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkBootstrapDaoLock_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkLockRequest)) *MockJwkBootstrapDaoLock_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkLockRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkLockRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkBootstrapDaoLock_Exec_Call) Return(err error) *MockJwkBootstrapDaoLock_Exec_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockJwkBootstrapDaoLock_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkLockRequest) error) *MockJwkBootstrapDaoLock_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkBootstrapDaoSearch creates a new instance of MockJwkBootstrapDaoSearch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkBootstrapDaoSearch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkBootstrapDaoSearch {
+	mock := &MockJwkBootstrapDaoSearch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkBootstrapDaoSearch is an autogenerated mock type for the JwkBootstrapDaoSearch type
+type MockJwkBootstrapDaoSearch struct {
+	mock.Mock
+}
+
+type MockJwkBootstrapDaoSearch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkBootstrapDaoSearch) EXPECT() *MockJwkBootstrapDaoSearch_Expecter {
+	return &MockJwkBootstrapDaoSearch_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkBootstrapDaoSearch
+func (_mock *MockJwkBootstrapDaoSearch) Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) ([]*dao.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) []*dao.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkSearchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkBootstrapDaoSearch_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkBootstrapDaoSearch_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkSearchRequest
+func (_e *MockJwkBootstrapDaoSearch_Expecter) Exec(ctx any, request any) *MockJwkBootstrapDaoSearch_Exec_Call {
+	return &MockJwkBootstrapDaoSearch_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkBootstrapDaoSearch_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkSearchRequest)) *MockJwkBootstrapDaoSearch_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkSearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkBootstrapDaoSearch_Exec_Call) Return(jwks []*dao.Jwk, err error) *MockJwkBootstrapDaoSearch_Exec_Call {
+	_c.Call.Return(jwks, err)
+	return _c
+}
+
+func (_c *MockJwkBootstrapDaoSearch_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)) *MockJwkBootstrapDaoSearch_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkBootstrapServiceGen creates a new instance of MockJwkBootstrapServiceGen. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkBootstrapServiceGen(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkBootstrapServiceGen {
+	mock := &MockJwkBootstrapServiceGen{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkBootstrapServiceGen is an autogenerated mock type for the JwkBootstrapServiceGen type
+type MockJwkBootstrapServiceGen struct {
+	mock.Mock
+}
+
+type MockJwkBootstrapServiceGen_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkBootstrapServiceGen) EXPECT() *MockJwkBootstrapServiceGen_Expecter {
+	return &MockJwkBootstrapServiceGen_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkBootstrapServiceGen
+func (_mock *MockJwkBootstrapServiceGen) Exec(ctx context.Context, request *core.JwkGenRequest) (*core.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkGenRequest) (*core.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkGenRequest) *core.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkGenRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkBootstrapServiceGen_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkBootstrapServiceGen_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkGenRequest
+func (_e *MockJwkBootstrapServiceGen_Expecter) Exec(ctx any, request any) *MockJwkBootstrapServiceGen_Exec_Call {
+	return &MockJwkBootstrapServiceGen_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkBootstrapServiceGen_Exec_Call) Run(run func(ctx context.Context, request *core.JwkGenRequest)) *MockJwkBootstrapServiceGen_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkGenRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkGenRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkBootstrapServiceGen_Exec_Call) Return(v *core.Jwk, err error) *MockJwkBootstrapServiceGen_Exec_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockJwkBootstrapServiceGen_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkGenRequest) (*core.Jwk, error)) *MockJwkBootstrapServiceGen_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkBurnDaoLock creates a new instance of MockJwkBurnDaoLock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkBurnDaoLock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkBurnDaoLock {
+	mock := &MockJwkBurnDaoLock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkBurnDaoLock is an autogenerated mock type for the JwkBurnDaoLock type
+type MockJwkBurnDaoLock struct {
+	mock.Mock
+}
+
+type MockJwkBurnDaoLock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkBurnDaoLock) EXPECT() *MockJwkBurnDaoLock_Expecter {
+	return &MockJwkBurnDaoLock_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkBurnDaoLock
+func (_mock *MockJwkBurnDaoLock) Exec(ctx context.Context, request *dao.JwkLockRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkLockRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockJwkBurnDaoLock_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkBurnDaoLock_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkLockRequest
+func (_e *MockJwkBurnDaoLock_Expecter) Exec(ctx any, request any) *MockJwkBurnDaoLock_Exec_Call {
+	return &MockJwkBurnDaoLock_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkBurnDaoLock_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkLockRequest)) *MockJwkBurnDaoLock_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkLockRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkLockRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkBurnDaoLock_Exec_Call) Return(err error) *MockJwkBurnDaoLock_Exec_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockJwkBurnDaoLock_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkLockRequest) error) *MockJwkBurnDaoLock_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkBurnDaoSearch creates a new instance of MockJwkBurnDaoSearch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkBurnDaoSearch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkBurnDaoSearch {
+	mock := &MockJwkBurnDaoSearch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkBurnDaoSearch is an autogenerated mock type for the JwkBurnDaoSearch type
+type MockJwkBurnDaoSearch struct {
+	mock.Mock
+}
+
+type MockJwkBurnDaoSearch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkBurnDaoSearch) EXPECT() *MockJwkBurnDaoSearch_Expecter {
+	return &MockJwkBurnDaoSearch_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkBurnDaoSearch
+func (_mock *MockJwkBurnDaoSearch) Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) ([]*dao.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) []*dao.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkSearchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkBurnDaoSearch_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkBurnDaoSearch_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkSearchRequest
+func (_e *MockJwkBurnDaoSearch_Expecter) Exec(ctx any, request any) *MockJwkBurnDaoSearch_Exec_Call {
+	return &MockJwkBurnDaoSearch_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkBurnDaoSearch_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkSearchRequest)) *MockJwkBurnDaoSearch_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkSearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkBurnDaoSearch_Exec_Call) Return(jwks []*dao.Jwk, err error) *MockJwkBurnDaoSearch_Exec_Call {
+	_c.Call.Return(jwks, err)
+	return _c
+}
+
+func (_c *MockJwkBurnDaoSearch_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)) *MockJwkBurnDaoSearch_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkBurnDaoDelete creates a new instance of MockJwkBurnDaoDelete. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkBurnDaoDelete(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkBurnDaoDelete {
+	mock := &MockJwkBurnDaoDelete{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkBurnDaoDelete is an autogenerated mock type for the JwkBurnDaoDelete type
+type MockJwkBurnDaoDelete struct {
+	mock.Mock
+}
+
+type MockJwkBurnDaoDelete_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkBurnDaoDelete) EXPECT() *MockJwkBurnDaoDelete_Expecter {
+	return &MockJwkBurnDaoDelete_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkBurnDaoDelete
+func (_mock *MockJwkBurnDaoDelete) Exec(ctx context.Context, request *dao.JwkDeleteRequest) (*dao.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkDeleteRequest) (*dao.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkDeleteRequest) *dao.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkBurnDaoDelete_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkBurnDaoDelete_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkDeleteRequest
+func (_e *MockJwkBurnDaoDelete_Expecter) Exec(ctx any, request any) *MockJwkBurnDaoDelete_Exec_Call {
+	return &MockJwkBurnDaoDelete_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkBurnDaoDelete_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkDeleteRequest)) *MockJwkBurnDaoDelete_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkBurnDaoDelete_Exec_Call) Return(jwk *dao.Jwk, err error) *MockJwkBurnDaoDelete_Exec_Call {
+	_c.Call.Return(jwk, err)
+	return _c
+}
+
+func (_c *MockJwkBurnDaoDelete_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkDeleteRequest) (*dao.Jwk, error)) *MockJwkBurnDaoDelete_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkBurnDaoInvalidate creates a new instance of MockJwkBurnDaoInvalidate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkBurnDaoInvalidate(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkBurnDaoInvalidate {
+	mock := &MockJwkBurnDaoInvalidate{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkBurnDaoInvalidate is an autogenerated mock type for the JwkBurnDaoInvalidate type
+type MockJwkBurnDaoInvalidate struct {
+	mock.Mock
+}
+
+type MockJwkBurnDaoInvalidate_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkBurnDaoInvalidate) EXPECT() *MockJwkBurnDaoInvalidate_Expecter {
+	return &MockJwkBurnDaoInvalidate_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkBurnDaoInvalidate
+func (_mock *MockJwkBurnDaoInvalidate) Exec(ctx context.Context, request *dao.JwkInvalidateRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkInvalidateRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockJwkBurnDaoInvalidate_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkBurnDaoInvalidate_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkInvalidateRequest
+func (_e *MockJwkBurnDaoInvalidate_Expecter) Exec(ctx any, request any) *MockJwkBurnDaoInvalidate_Exec_Call {
+	return &MockJwkBurnDaoInvalidate_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkBurnDaoInvalidate_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkInvalidateRequest)) *MockJwkBurnDaoInvalidate_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkInvalidateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkInvalidateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkBurnDaoInvalidate_Exec_Call) Return(err error) *MockJwkBurnDaoInvalidate_Exec_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockJwkBurnDaoInvalidate_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkInvalidateRequest) error) *MockJwkBurnDaoInvalidate_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkBurnServiceGen creates a new instance of MockJwkBurnServiceGen. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkBurnServiceGen(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkBurnServiceGen {
+	mock := &MockJwkBurnServiceGen{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkBurnServiceGen is an autogenerated mock type for the JwkBurnServiceGen type
+type MockJwkBurnServiceGen struct {
+	mock.Mock
+}
+
+type MockJwkBurnServiceGen_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkBurnServiceGen) EXPECT() *MockJwkBurnServiceGen_Expecter {
+	return &MockJwkBurnServiceGen_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkBurnServiceGen
+func (_mock *MockJwkBurnServiceGen) Exec(ctx context.Context, request *core.JwkGenRequest) (*core.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkGenRequest) (*core.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkGenRequest) *core.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkGenRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkBurnServiceGen_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkBurnServiceGen_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkGenRequest
+func (_e *MockJwkBurnServiceGen_Expecter) Exec(ctx any, request any) *MockJwkBurnServiceGen_Exec_Call {
+	return &MockJwkBurnServiceGen_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkBurnServiceGen_Exec_Call) Run(run func(ctx context.Context, request *core.JwkGenRequest)) *MockJwkBurnServiceGen_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkGenRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkGenRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkBurnServiceGen_Exec_Call) Return(v *core.Jwk, err error) *MockJwkBurnServiceGen_Exec_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockJwkBurnServiceGen_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkGenRequest) (*core.Jwk, error)) *MockJwkBurnServiceGen_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkExportLocalSource creates a new instance of MockJwkExportLocalSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkExportLocalSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkExportLocalSource {
+	mock := &MockJwkExportLocalSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkExportLocalSource is an autogenerated mock type for the JwkExportLocalSource type
+type MockJwkExportLocalSource struct {
+	mock.Mock
+}
+
+type MockJwkExportLocalSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkExportLocalSource) EXPECT() *MockJwkExportLocalSource_Expecter {
+	return &MockJwkExportLocalSource_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkExportLocalSource
+func (_mock *MockJwkExportLocalSource) Exec(ctx context.Context, request *core.JwkSearchRequest) ([]*core.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*core.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkSearchRequest) ([]*core.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkSearchRequest) []*core.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*core.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkSearchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkExportLocalSource_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkExportLocalSource_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkSearchRequest
+func (_e *MockJwkExportLocalSource_Expecter) Exec(ctx any, request any) *MockJwkExportLocalSource_Exec_Call {
+	return &MockJwkExportLocalSource_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkExportLocalSource_Exec_Call) Run(run func(ctx context.Context, request *core.JwkSearchRequest)) *MockJwkExportLocalSource_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkSearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkExportLocalSource_Exec_Call) Return(vs []*core.Jwk, err error) *MockJwkExportLocalSource_Exec_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockJwkExportLocalSource_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkSearchRequest) ([]*core.Jwk, error)) *MockJwkExportLocalSource_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkGenDaoSearch creates a new instance of MockJwkGenDaoSearch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkGenDaoSearch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkGenDaoSearch {
+	mock := &MockJwkGenDaoSearch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkGenDaoSearch is an autogenerated mock type for the JwkGenDaoSearch type
+type MockJwkGenDaoSearch struct {
+	mock.Mock
+}
+
+type MockJwkGenDaoSearch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkGenDaoSearch) EXPECT() *MockJwkGenDaoSearch_Expecter {
+	return &MockJwkGenDaoSearch_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkGenDaoSearch
+func (_mock *MockJwkGenDaoSearch) Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) ([]*dao.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) []*dao.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkSearchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkGenDaoSearch_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkGenDaoSearch_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkSearchRequest
+func (_e *MockJwkGenDaoSearch_Expecter) Exec(ctx any, request any) *MockJwkGenDaoSearch_Exec_Call {
+	return &MockJwkGenDaoSearch_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkGenDaoSearch_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkSearchRequest)) *MockJwkGenDaoSearch_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkSearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkGenDaoSearch_Exec_Call) Return(jwks []*dao.Jwk, err error) *MockJwkGenDaoSearch_Exec_Call {
+	_c.Call.Return(jwks, err)
+	return _c
+}
+
+func (_c *MockJwkGenDaoSearch_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)) *MockJwkGenDaoSearch_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkGenDaoInsert creates a new instance of MockJwkGenDaoInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkGenDaoInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkGenDaoInsert {
+	mock := &MockJwkGenDaoInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkGenDaoInsert is an autogenerated mock type for the JwkGenDaoInsert type
+type MockJwkGenDaoInsert struct {
+	mock.Mock
+}
+
+type MockJwkGenDaoInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkGenDaoInsert) EXPECT() *MockJwkGenDaoInsert_Expecter {
+	return &MockJwkGenDaoInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkGenDaoInsert
+func (_mock *MockJwkGenDaoInsert) Exec(ctx context.Context, request *dao.JwkInsertRequest) (*dao.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkInsertRequest) (*dao.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkInsertRequest) *dao.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkGenDaoInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkGenDaoInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkInsertRequest
+func (_e *MockJwkGenDaoInsert_Expecter) Exec(ctx any, request any) *MockJwkGenDaoInsert_Exec_Call {
+	return &MockJwkGenDaoInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkGenDaoInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkInsertRequest)) *MockJwkGenDaoInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkGenDaoInsert_Exec_Call) Return(jwk *dao.Jwk, err error) *MockJwkGenDaoInsert_Exec_Call {
+	_c.Call.Return(jwk, err)
+	return _c
+}
+
+func (_c *MockJwkGenDaoInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkInsertRequest) (*dao.Jwk, error)) *MockJwkGenDaoInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkGenServiceExtract creates a new instance of MockJwkGenServiceExtract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkGenServiceExtract(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkGenServiceExtract {
+	mock := &MockJwkGenServiceExtract{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkGenServiceExtract is an autogenerated mock type for the JwkGenServiceExtract type
+type MockJwkGenServiceExtract struct {
+	mock.Mock
+}
+
+type MockJwkGenServiceExtract_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkGenServiceExtract) EXPECT() *MockJwkGenServiceExtract_Expecter {
+	return &MockJwkGenServiceExtract_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkGenServiceExtract
+func (_mock *MockJwkGenServiceExtract) Exec(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) (*core.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) *core.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkExtractRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkGenServiceExtract_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkGenServiceExtract_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkExtractRequest
+func (_e *MockJwkGenServiceExtract_Expecter) Exec(ctx any, request any) *MockJwkGenServiceExtract_Exec_Call {
+	return &MockJwkGenServiceExtract_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkGenServiceExtract_Exec_Call) Run(run func(ctx context.Context, request *core.JwkExtractRequest)) *MockJwkGenServiceExtract_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkExtractRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkExtractRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkGenServiceExtract_Exec_Call) Return(v *core.Jwk, err error) *MockJwkGenServiceExtract_Exec_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockJwkGenServiceExtract_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error)) *MockJwkGenServiceExtract_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkInvalidationSyncDao creates a new instance of MockJwkInvalidationSyncDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkInvalidationSyncDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkInvalidationSyncDao {
+	mock := &MockJwkInvalidationSyncDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkInvalidationSyncDao is an autogenerated mock type for the JwkInvalidationSyncDao type
+type MockJwkInvalidationSyncDao struct {
+	mock.Mock
+}
+
+type MockJwkInvalidationSyncDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkInvalidationSyncDao) EXPECT() *MockJwkInvalidationSyncDao_Expecter {
+	return &MockJwkInvalidationSyncDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkInvalidationSyncDao
+func (_mock *MockJwkInvalidationSyncDao) Exec(ctx context.Context, request *dao.JwkListenInvalidationsRequest) (<-chan string, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 <-chan string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkListenInvalidationsRequest) (<-chan string, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkListenInvalidationsRequest) <-chan string); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkListenInvalidationsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkInvalidationSyncDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkInvalidationSyncDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkListenInvalidationsRequest
+func (_e *MockJwkInvalidationSyncDao_Expecter) Exec(ctx any, request any) *MockJwkInvalidationSyncDao_Exec_Call {
+	return &MockJwkInvalidationSyncDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkInvalidationSyncDao_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkListenInvalidationsRequest)) *MockJwkInvalidationSyncDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkListenInvalidationsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkListenInvalidationsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkInvalidationSyncDao_Exec_Call) Return(stringCh <-chan string, err error) *MockJwkInvalidationSyncDao_Exec_Call {
+	_c.Call.Return(stringCh, err)
+	return _c
+}
+
+func (_c *MockJwkInvalidationSyncDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkListenInvalidationsRequest) (<-chan string, error)) *MockJwkInvalidationSyncDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkInvalidationSyncCache creates a new instance of MockJwkInvalidationSyncCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkInvalidationSyncCache(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkInvalidationSyncCache {
+	mock := &MockJwkInvalidationSyncCache{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkInvalidationSyncCache is an autogenerated mock type for the JwkInvalidationSyncCache type
+type MockJwkInvalidationSyncCache struct {
+	mock.Mock
+}
+
+type MockJwkInvalidationSyncCache_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkInvalidationSyncCache) EXPECT() *MockJwkInvalidationSyncCache_Expecter {
+	return &MockJwkInvalidationSyncCache_Expecter{mock: &_m.Mock}
+}
+
+// Invalidate provides a mock function for the type MockJwkInvalidationSyncCache
+func (_mock *MockJwkInvalidationSyncCache) Invalidate(usage string) {
+	_mock.Called(usage)
+	return
+}
+
+// MockJwkInvalidationSyncCache_Invalidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invalidate'
+type MockJwkInvalidationSyncCache_Invalidate_Call struct {
+	*mock.Call
+}
+
+// Invalidate is a helper method to define mock.On call
+//   - usage string
+func (_e *MockJwkInvalidationSyncCache_Expecter) Invalidate(usage any) *MockJwkInvalidationSyncCache_Invalidate_Call {
+	return &MockJwkInvalidationSyncCache_Invalidate_Call{Call: _e.mock.On("Invalidate", usage)}
+}
+
+func (_c *MockJwkInvalidationSyncCache_Invalidate_Call) Run(run func(usage string)) *MockJwkInvalidationSyncCache_Invalidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkInvalidationSyncCache_Invalidate_Call) Return() *MockJwkInvalidationSyncCache_Invalidate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockJwkInvalidationSyncCache_Invalidate_Call) RunAndReturn(run func(usage string)) *MockJwkInvalidationSyncCache_Invalidate_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockJwkLifecycleDaoSearch creates a new instance of MockJwkLifecycleDaoSearch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkLifecycleDaoSearch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkLifecycleDaoSearch {
+	mock := &MockJwkLifecycleDaoSearch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkLifecycleDaoSearch is an autogenerated mock type for the JwkLifecycleDaoSearch type
+type MockJwkLifecycleDaoSearch struct {
+	mock.Mock
+}
+
+type MockJwkLifecycleDaoSearch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkLifecycleDaoSearch) EXPECT() *MockJwkLifecycleDaoSearch_Expecter {
+	return &MockJwkLifecycleDaoSearch_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkLifecycleDaoSearch
+func (_mock *MockJwkLifecycleDaoSearch) Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) ([]*dao.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) []*dao.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkSearchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkLifecycleDaoSearch_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkLifecycleDaoSearch_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkSearchRequest
+func (_e *MockJwkLifecycleDaoSearch_Expecter) Exec(ctx any, request any) *MockJwkLifecycleDaoSearch_Exec_Call {
+	return &MockJwkLifecycleDaoSearch_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkLifecycleDaoSearch_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkSearchRequest)) *MockJwkLifecycleDaoSearch_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkSearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkLifecycleDaoSearch_Exec_Call) Return(jwks []*dao.Jwk, err error) *MockJwkLifecycleDaoSearch_Exec_Call {
+	_c.Call.Return(jwks, err)
+	return _c
+}
+
+func (_c *MockJwkLifecycleDaoSearch_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)) *MockJwkLifecycleDaoSearch_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkLifecycleServiceExtract creates a new instance of MockJwkLifecycleServiceExtract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkLifecycleServiceExtract(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkLifecycleServiceExtract {
+	mock := &MockJwkLifecycleServiceExtract{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkLifecycleServiceExtract is an autogenerated mock type for the JwkLifecycleServiceExtract type
+type MockJwkLifecycleServiceExtract struct {
+	mock.Mock
+}
+
+type MockJwkLifecycleServiceExtract_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkLifecycleServiceExtract) EXPECT() *MockJwkLifecycleServiceExtract_Expecter {
+	return &MockJwkLifecycleServiceExtract_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkLifecycleServiceExtract
+func (_mock *MockJwkLifecycleServiceExtract) Exec(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) (*core.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) *core.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkExtractRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkLifecycleServiceExtract_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkLifecycleServiceExtract_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkExtractRequest
+func (_e *MockJwkLifecycleServiceExtract_Expecter) Exec(ctx any, request any) *MockJwkLifecycleServiceExtract_Exec_Call {
+	return &MockJwkLifecycleServiceExtract_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkLifecycleServiceExtract_Exec_Call) Run(run func(ctx context.Context, request *core.JwkExtractRequest)) *MockJwkLifecycleServiceExtract_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkExtractRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkExtractRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkLifecycleServiceExtract_Exec_Call) Return(v *core.Jwk, err error) *MockJwkLifecycleServiceExtract_Exec_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockJwkLifecycleServiceExtract_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error)) *MockJwkLifecycleServiceExtract_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkMetadataServiceExtract creates a new instance of MockJwkMetadataServiceExtract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkMetadataServiceExtract(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkMetadataServiceExtract {
+	mock := &MockJwkMetadataServiceExtract{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkMetadataServiceExtract is an autogenerated mock type for the JwkMetadataServiceExtract type
+type MockJwkMetadataServiceExtract struct {
+	mock.Mock
+}
+
+type MockJwkMetadataServiceExtract_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkMetadataServiceExtract) EXPECT() *MockJwkMetadataServiceExtract_Expecter {
+	return &MockJwkMetadataServiceExtract_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkMetadataServiceExtract
+func (_mock *MockJwkMetadataServiceExtract) Exec(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) (*core.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) *core.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkExtractRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkMetadataServiceExtract_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkMetadataServiceExtract_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkExtractRequest
+func (_e *MockJwkMetadataServiceExtract_Expecter) Exec(ctx any, request any) *MockJwkMetadataServiceExtract_Exec_Call {
+	return &MockJwkMetadataServiceExtract_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkMetadataServiceExtract_Exec_Call) Run(run func(ctx context.Context, request *core.JwkExtractRequest)) *MockJwkMetadataServiceExtract_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkExtractRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkExtractRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkMetadataServiceExtract_Exec_Call) Return(v *core.Jwk, err error) *MockJwkMetadataServiceExtract_Exec_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockJwkMetadataServiceExtract_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error)) *MockJwkMetadataServiceExtract_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkMetadataSearchDao creates a new instance of MockJwkMetadataSearchDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkMetadataSearchDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkMetadataSearchDao {
+	mock := &MockJwkMetadataSearchDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkMetadataSearchDao is an autogenerated mock type for the JwkMetadataSearchDao type
+type MockJwkMetadataSearchDao struct {
+	mock.Mock
+}
+
+type MockJwkMetadataSearchDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkMetadataSearchDao) EXPECT() *MockJwkMetadataSearchDao_Expecter {
+	return &MockJwkMetadataSearchDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkMetadataSearchDao
+func (_mock *MockJwkMetadataSearchDao) Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) ([]*dao.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) []*dao.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkSearchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkMetadataSearchDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkMetadataSearchDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkSearchRequest
+func (_e *MockJwkMetadataSearchDao_Expecter) Exec(ctx any, request any) *MockJwkMetadataSearchDao_Exec_Call {
+	return &MockJwkMetadataSearchDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkMetadataSearchDao_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkSearchRequest)) *MockJwkMetadataSearchDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkSearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkMetadataSearchDao_Exec_Call) Return(jwks []*dao.Jwk, err error) *MockJwkMetadataSearchDao_Exec_Call {
+	_c.Call.Return(jwks, err)
+	return _c
+}
+
+func (_c *MockJwkMetadataSearchDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)) *MockJwkMetadataSearchDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkMetadataSelectDao creates a new instance of MockJwkMetadataSelectDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkMetadataSelectDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkMetadataSelectDao {
+	mock := &MockJwkMetadataSelectDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkMetadataSelectDao is an autogenerated mock type for the JwkMetadataSelectDao type
+type MockJwkMetadataSelectDao struct {
+	mock.Mock
+}
+
+type MockJwkMetadataSelectDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkMetadataSelectDao) EXPECT() *MockJwkMetadataSelectDao_Expecter {
+	return &MockJwkMetadataSelectDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkMetadataSelectDao
+func (_mock *MockJwkMetadataSelectDao) Exec(ctx context.Context, request *dao.JwkSelectRequest) (*dao.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSelectRequest) (*dao.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSelectRequest) *dao.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkMetadataSelectDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkMetadataSelectDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkSelectRequest
+func (_e *MockJwkMetadataSelectDao_Expecter) Exec(ctx any, request any) *MockJwkMetadataSelectDao_Exec_Call {
+	return &MockJwkMetadataSelectDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkMetadataSelectDao_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkSelectRequest)) *MockJwkMetadataSelectDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkMetadataSelectDao_Exec_Call) Return(jwk *dao.Jwk, err error) *MockJwkMetadataSelectDao_Exec_Call {
+	_c.Call.Return(jwk, err)
+	return _c
+}
+
+func (_c *MockJwkMetadataSelectDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkSelectRequest) (*dao.Jwk, error)) *MockJwkMetadataSelectDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkMetadataSelectDaoSearch creates a new instance of MockJwkMetadataSelectDaoSearch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkMetadataSelectDaoSearch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkMetadataSelectDaoSearch {
+	mock := &MockJwkMetadataSelectDaoSearch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkMetadataSelectDaoSearch is an autogenerated mock type for the JwkMetadataSelectDaoSearch type
+type MockJwkMetadataSelectDaoSearch struct {
+	mock.Mock
+}
+
+type MockJwkMetadataSelectDaoSearch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkMetadataSelectDaoSearch) EXPECT() *MockJwkMetadataSelectDaoSearch_Expecter {
+	return &MockJwkMetadataSelectDaoSearch_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkMetadataSelectDaoSearch
+func (_mock *MockJwkMetadataSelectDaoSearch) Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) ([]*dao.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) []*dao.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkSearchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkMetadataSelectDaoSearch_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkMetadataSelectDaoSearch_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkSearchRequest
+func (_e *MockJwkMetadataSelectDaoSearch_Expecter) Exec(ctx any, request any) *MockJwkMetadataSelectDaoSearch_Exec_Call {
+	return &MockJwkMetadataSelectDaoSearch_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkMetadataSelectDaoSearch_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkSearchRequest)) *MockJwkMetadataSelectDaoSearch_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkSearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkMetadataSelectDaoSearch_Exec_Call) Return(jwks []*dao.Jwk, err error) *MockJwkMetadataSelectDaoSearch_Exec_Call {
+	_c.Call.Return(jwks, err)
+	return _c
+}
+
+func (_c *MockJwkMetadataSelectDaoSearch_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)) *MockJwkMetadataSelectDaoSearch_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkPrivateSource creates a new instance of MockJwkPrivateSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkPrivateSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkPrivateSource {
+	mock := &MockJwkPrivateSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkPrivateSource is an autogenerated mock type for the JwkPrivateSource type
+type MockJwkPrivateSource struct {
+	mock.Mock
+}
+
+type MockJwkPrivateSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkPrivateSource) EXPECT() *MockJwkPrivateSource_Expecter {
+	return &MockJwkPrivateSource_Expecter{mock: &_m.Mock}
+}
+
+// SearchKeys provides a mock function for the type MockJwkPrivateSource
+func (_mock *MockJwkPrivateSource) SearchKeys(ctx context.Context, usage string) ([]*jwa.JWK, error) {
+	ret := _mock.Called(ctx, usage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchKeys")
+	}
+
+	var r0 []*jwa.JWK
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*jwa.JWK, error)); ok {
+		return returnFunc(ctx, usage)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*jwa.JWK); ok {
+		r0 = returnFunc(ctx, usage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*jwa.JWK)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, usage)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkPrivateSource_SearchKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchKeys'
+type MockJwkPrivateSource_SearchKeys_Call struct {
+	*mock.Call
+}
+
+// SearchKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usage string
+func (_e *MockJwkPrivateSource_Expecter) SearchKeys(ctx any, usage any) *MockJwkPrivateSource_SearchKeys_Call {
+	return &MockJwkPrivateSource_SearchKeys_Call{Call: _e.mock.On("SearchKeys", ctx, usage)}
+}
+
+func (_c *MockJwkPrivateSource_SearchKeys_Call) Run(run func(ctx context.Context, usage string)) *MockJwkPrivateSource_SearchKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkPrivateSource_SearchKeys_Call) Return(jWKs []*jwa.JWK, err error) *MockJwkPrivateSource_SearchKeys_Call {
+	_c.Call.Return(jWKs, err)
+	return _c
+}
+
+func (_c *MockJwkPrivateSource_SearchKeys_Call) RunAndReturn(run func(ctx context.Context, usage string) ([]*jwa.JWK, error)) *MockJwkPrivateSource_SearchKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkPublicSource creates a new instance of MockJwkPublicSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkPublicSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkPublicSource {
+	mock := &MockJwkPublicSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkPublicSource is an autogenerated mock type for the JwkPublicSource type
+type MockJwkPublicSource struct {
+	mock.Mock
+}
+
+type MockJwkPublicSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkPublicSource) EXPECT() *MockJwkPublicSource_Expecter {
+	return &MockJwkPublicSource_Expecter{mock: &_m.Mock}
+}
+
+// SearchKeys provides a mock function for the type MockJwkPublicSource
+func (_mock *MockJwkPublicSource) SearchKeys(ctx context.Context, usage string) ([]*jwa.JWK, error) {
+	ret := _mock.Called(ctx, usage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchKeys")
+	}
+
+	var r0 []*jwa.JWK
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*jwa.JWK, error)); ok {
+		return returnFunc(ctx, usage)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*jwa.JWK); ok {
+		r0 = returnFunc(ctx, usage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*jwa.JWK)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, usage)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkPublicSource_SearchKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchKeys'
+type MockJwkPublicSource_SearchKeys_Call struct {
+	*mock.Call
+}
+
+// SearchKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usage string
+func (_e *MockJwkPublicSource_Expecter) SearchKeys(ctx any, usage any) *MockJwkPublicSource_SearchKeys_Call {
+	return &MockJwkPublicSource_SearchKeys_Call{Call: _e.mock.On("SearchKeys", ctx, usage)}
+}
+
+func (_c *MockJwkPublicSource_SearchKeys_Call) Run(run func(ctx context.Context, usage string)) *MockJwkPublicSource_SearchKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkPublicSource_SearchKeys_Call) Return(jWKs []*jwa.JWK, err error) *MockJwkPublicSource_SearchKeys_Call {
+	_c.Call.Return(jWKs, err)
+	return _c
+}
+
+func (_c *MockJwkPublicSource_SearchKeys_Call) RunAndReturn(run func(ctx context.Context, usage string) ([]*jwa.JWK, error)) *MockJwkPublicSource_SearchKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkRevokeDao creates a new instance of MockJwkRevokeDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkRevokeDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkRevokeDao {
+	mock := &MockJwkRevokeDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkRevokeDao is an autogenerated mock type for the JwkRevokeDao type
+type MockJwkRevokeDao struct {
+	mock.Mock
+}
+
+type MockJwkRevokeDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkRevokeDao) EXPECT() *MockJwkRevokeDao_Expecter {
+	return &MockJwkRevokeDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkRevokeDao
+func (_mock *MockJwkRevokeDao) Exec(ctx context.Context, request *dao.JwkDeleteRequest) (*dao.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkDeleteRequest) (*dao.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkDeleteRequest) *dao.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkRevokeDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkRevokeDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkDeleteRequest
+func (_e *MockJwkRevokeDao_Expecter) Exec(ctx any, request any) *MockJwkRevokeDao_Exec_Call {
+	return &MockJwkRevokeDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkRevokeDao_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkDeleteRequest)) *MockJwkRevokeDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkRevokeDao_Exec_Call) Return(jwk *dao.Jwk, err error) *MockJwkRevokeDao_Exec_Call {
+	_c.Call.Return(jwk, err)
+	return _c
+}
+
+func (_c *MockJwkRevokeDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkDeleteRequest) (*dao.Jwk, error)) *MockJwkRevokeDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkRevokeDaoInvalidate creates a new instance of MockJwkRevokeDaoInvalidate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkRevokeDaoInvalidate(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkRevokeDaoInvalidate {
+	mock := &MockJwkRevokeDaoInvalidate{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkRevokeDaoInvalidate is an autogenerated mock type for the JwkRevokeDaoInvalidate type
+type MockJwkRevokeDaoInvalidate struct {
+	mock.Mock
+}
+
+type MockJwkRevokeDaoInvalidate_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkRevokeDaoInvalidate) EXPECT() *MockJwkRevokeDaoInvalidate_Expecter {
+	return &MockJwkRevokeDaoInvalidate_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkRevokeDaoInvalidate
+func (_mock *MockJwkRevokeDaoInvalidate) Exec(ctx context.Context, request *dao.JwkInvalidateRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkInvalidateRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockJwkRevokeDaoInvalidate_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkRevokeDaoInvalidate_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkInvalidateRequest
+func (_e *MockJwkRevokeDaoInvalidate_Expecter) Exec(ctx any, request any) *MockJwkRevokeDaoInvalidate_Exec_Call {
+	return &MockJwkRevokeDaoInvalidate_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkRevokeDaoInvalidate_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkInvalidateRequest)) *MockJwkRevokeDaoInvalidate_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkInvalidateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkInvalidateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkRevokeDaoInvalidate_Exec_Call) Return(err error) *MockJwkRevokeDaoInvalidate_Exec_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockJwkRevokeDaoInvalidate_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkInvalidateRequest) error) *MockJwkRevokeDaoInvalidate_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkRotateDaoLock creates a new instance of MockJwkRotateDaoLock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkRotateDaoLock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkRotateDaoLock {
+	mock := &MockJwkRotateDaoLock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkRotateDaoLock is an autogenerated mock type for the JwkRotateDaoLock type
+type MockJwkRotateDaoLock struct {
+	mock.Mock
+}
+
+type MockJwkRotateDaoLock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkRotateDaoLock) EXPECT() *MockJwkRotateDaoLock_Expecter {
+	return &MockJwkRotateDaoLock_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkRotateDaoLock
+func (_mock *MockJwkRotateDaoLock) Exec(ctx context.Context, request *dao.JwkLockRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkLockRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockJwkRotateDaoLock_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkRotateDaoLock_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkLockRequest
+func (_e *MockJwkRotateDaoLock_Expecter) Exec(ctx any, request any) *MockJwkRotateDaoLock_Exec_Call {
+	return &MockJwkRotateDaoLock_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkRotateDaoLock_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkLockRequest)) *MockJwkRotateDaoLock_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkLockRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkLockRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkRotateDaoLock_Exec_Call) Return(err error) *MockJwkRotateDaoLock_Exec_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockJwkRotateDaoLock_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkLockRequest) error) *MockJwkRotateDaoLock_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkRotateDaoInvalidate creates a new instance of MockJwkRotateDaoInvalidate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkRotateDaoInvalidate(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkRotateDaoInvalidate {
+	mock := &MockJwkRotateDaoInvalidate{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkRotateDaoInvalidate is an autogenerated mock type for the JwkRotateDaoInvalidate type
+type MockJwkRotateDaoInvalidate struct {
+	mock.Mock
+}
+
+type MockJwkRotateDaoInvalidate_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkRotateDaoInvalidate) EXPECT() *MockJwkRotateDaoInvalidate_Expecter {
+	return &MockJwkRotateDaoInvalidate_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkRotateDaoInvalidate
+func (_mock *MockJwkRotateDaoInvalidate) Exec(ctx context.Context, request *dao.JwkInvalidateRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkInvalidateRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockJwkRotateDaoInvalidate_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkRotateDaoInvalidate_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkInvalidateRequest
+func (_e *MockJwkRotateDaoInvalidate_Expecter) Exec(ctx any, request any) *MockJwkRotateDaoInvalidate_Exec_Call {
+	return &MockJwkRotateDaoInvalidate_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkRotateDaoInvalidate_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkInvalidateRequest)) *MockJwkRotateDaoInvalidate_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkInvalidateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkInvalidateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkRotateDaoInvalidate_Exec_Call) Return(err error) *MockJwkRotateDaoInvalidate_Exec_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockJwkRotateDaoInvalidate_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkInvalidateRequest) error) *MockJwkRotateDaoInvalidate_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkRotateServiceSearch creates a new instance of MockJwkRotateServiceSearch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkRotateServiceSearch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkRotateServiceSearch {
+	mock := &MockJwkRotateServiceSearch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkRotateServiceSearch is an autogenerated mock type for the JwkRotateServiceSearch type
+type MockJwkRotateServiceSearch struct {
+	mock.Mock
+}
+
+type MockJwkRotateServiceSearch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkRotateServiceSearch) EXPECT() *MockJwkRotateServiceSearch_Expecter {
+	return &MockJwkRotateServiceSearch_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkRotateServiceSearch
+func (_mock *MockJwkRotateServiceSearch) Exec(ctx context.Context, request *core.JwkMetadataSearchRequest) ([]*core.JwkMetadata, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*core.JwkMetadata
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkMetadataSearchRequest) ([]*core.JwkMetadata, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkMetadataSearchRequest) []*core.JwkMetadata); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*core.JwkMetadata)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkMetadataSearchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkRotateServiceSearch_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkRotateServiceSearch_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkMetadataSearchRequest
+func (_e *MockJwkRotateServiceSearch_Expecter) Exec(ctx any, request any) *MockJwkRotateServiceSearch_Exec_Call {
+	return &MockJwkRotateServiceSearch_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkRotateServiceSearch_Exec_Call) Run(run func(ctx context.Context, request *core.JwkMetadataSearchRequest)) *MockJwkRotateServiceSearch_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkMetadataSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkMetadataSearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkRotateServiceSearch_Exec_Call) Return(jwkMetadatas []*core.JwkMetadata, err error) *MockJwkRotateServiceSearch_Exec_Call {
+	_c.Call.Return(jwkMetadatas, err)
+	return _c
+}
+
+func (_c *MockJwkRotateServiceSearch_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkMetadataSearchRequest) ([]*core.JwkMetadata, error)) *MockJwkRotateServiceSearch_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkRotateServiceGen creates a new instance of MockJwkRotateServiceGen. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkRotateServiceGen(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkRotateServiceGen {
+	mock := &MockJwkRotateServiceGen{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkRotateServiceGen is an autogenerated mock type for the JwkRotateServiceGen type
+type MockJwkRotateServiceGen struct {
+	mock.Mock
+}
+
+type MockJwkRotateServiceGen_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkRotateServiceGen) EXPECT() *MockJwkRotateServiceGen_Expecter {
+	return &MockJwkRotateServiceGen_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkRotateServiceGen
+func (_mock *MockJwkRotateServiceGen) Exec(ctx context.Context, request *core.JwkGenRequest) (*core.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkGenRequest) (*core.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkGenRequest) *core.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkGenRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkRotateServiceGen_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkRotateServiceGen_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkGenRequest
+func (_e *MockJwkRotateServiceGen_Expecter) Exec(ctx any, request any) *MockJwkRotateServiceGen_Exec_Call {
+	return &MockJwkRotateServiceGen_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkRotateServiceGen_Exec_Call) Run(run func(ctx context.Context, request *core.JwkGenRequest)) *MockJwkRotateServiceGen_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkGenRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkGenRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkRotateServiceGen_Exec_Call) Return(v *core.Jwk, err error) *MockJwkRotateServiceGen_Exec_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockJwkRotateServiceGen_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkGenRequest) (*core.Jwk, error)) *MockJwkRotateServiceGen_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkRotateServiceRevoke creates a new instance of MockJwkRotateServiceRevoke. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkRotateServiceRevoke(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkRotateServiceRevoke {
+	mock := &MockJwkRotateServiceRevoke{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkRotateServiceRevoke is an autogenerated mock type for the JwkRotateServiceRevoke type
+type MockJwkRotateServiceRevoke struct {
+	mock.Mock
+}
+
+type MockJwkRotateServiceRevoke_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkRotateServiceRevoke) EXPECT() *MockJwkRotateServiceRevoke_Expecter {
+	return &MockJwkRotateServiceRevoke_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkRotateServiceRevoke
+func (_mock *MockJwkRotateServiceRevoke) Exec(ctx context.Context, request *core.JwkRevokeRequest) (*core.JwkMetadata, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.JwkMetadata
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkRevokeRequest) (*core.JwkMetadata, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkRevokeRequest) *core.JwkMetadata); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.JwkMetadata)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkRevokeRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkRotateServiceRevoke_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkRotateServiceRevoke_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkRevokeRequest
+func (_e *MockJwkRotateServiceRevoke_Expecter) Exec(ctx any, request any) *MockJwkRotateServiceRevoke_Exec_Call {
+	return &MockJwkRotateServiceRevoke_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkRotateServiceRevoke_Exec_Call) Run(run func(ctx context.Context, request *core.JwkRevokeRequest)) *MockJwkRotateServiceRevoke_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkRevokeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkRevokeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkRotateServiceRevoke_Exec_Call) Return(jwkMetadata *core.JwkMetadata, err error) *MockJwkRotateServiceRevoke_Exec_Call {
+	_c.Call.Return(jwkMetadata, err)
+	return _c
+}
+
+func (_c *MockJwkRotateServiceRevoke_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkRevokeRequest) (*core.JwkMetadata, error)) *MockJwkRotateServiceRevoke_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkRotateAllDaoLock creates a new instance of MockJwkRotateAllDaoLock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkRotateAllDaoLock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkRotateAllDaoLock {
+	mock := &MockJwkRotateAllDaoLock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkRotateAllDaoLock is an autogenerated mock type for the JwkRotateAllDaoLock type
+type MockJwkRotateAllDaoLock struct {
+	mock.Mock
+}
+
+type MockJwkRotateAllDaoLock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkRotateAllDaoLock) EXPECT() *MockJwkRotateAllDaoLock_Expecter {
+	return &MockJwkRotateAllDaoLock_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkRotateAllDaoLock
+func (_mock *MockJwkRotateAllDaoLock) Exec(ctx context.Context, request *dao.JwkLockRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkLockRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockJwkRotateAllDaoLock_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkRotateAllDaoLock_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkLockRequest
+func (_e *MockJwkRotateAllDaoLock_Expecter) Exec(ctx any, request any) *MockJwkRotateAllDaoLock_Exec_Call {
+	return &MockJwkRotateAllDaoLock_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkRotateAllDaoLock_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkLockRequest)) *MockJwkRotateAllDaoLock_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkLockRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkLockRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkRotateAllDaoLock_Exec_Call) Return(err error) *MockJwkRotateAllDaoLock_Exec_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockJwkRotateAllDaoLock_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkLockRequest) error) *MockJwkRotateAllDaoLock_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkRotateAllServiceGen creates a new instance of MockJwkRotateAllServiceGen. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkRotateAllServiceGen(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkRotateAllServiceGen {
+	mock := &MockJwkRotateAllServiceGen{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkRotateAllServiceGen is an autogenerated mock type for the JwkRotateAllServiceGen type
+type MockJwkRotateAllServiceGen struct {
+	mock.Mock
+}
+
+type MockJwkRotateAllServiceGen_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkRotateAllServiceGen) EXPECT() *MockJwkRotateAllServiceGen_Expecter {
+	return &MockJwkRotateAllServiceGen_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkRotateAllServiceGen
+func (_mock *MockJwkRotateAllServiceGen) Exec(ctx context.Context, request *core.JwkGenRequest) (*core.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkGenRequest) (*core.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkGenRequest) *core.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkGenRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkRotateAllServiceGen_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkRotateAllServiceGen_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkGenRequest
+func (_e *MockJwkRotateAllServiceGen_Expecter) Exec(ctx any, request any) *MockJwkRotateAllServiceGen_Exec_Call {
+	return &MockJwkRotateAllServiceGen_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkRotateAllServiceGen_Exec_Call) Run(run func(ctx context.Context, request *core.JwkGenRequest)) *MockJwkRotateAllServiceGen_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkGenRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkGenRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkRotateAllServiceGen_Exec_Call) Return(v *core.Jwk, err error) *MockJwkRotateAllServiceGen_Exec_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockJwkRotateAllServiceGen_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkGenRequest) (*core.Jwk, error)) *MockJwkRotateAllServiceGen_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkRotationPlanDaoSearch creates a new instance of MockJwkRotationPlanDaoSearch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkRotationPlanDaoSearch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkRotationPlanDaoSearch {
+	mock := &MockJwkRotationPlanDaoSearch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkRotationPlanDaoSearch is an autogenerated mock type for the JwkRotationPlanDaoSearch type
+type MockJwkRotationPlanDaoSearch struct {
+	mock.Mock
+}
+
+type MockJwkRotationPlanDaoSearch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkRotationPlanDaoSearch) EXPECT() *MockJwkRotationPlanDaoSearch_Expecter {
+	return &MockJwkRotationPlanDaoSearch_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkRotationPlanDaoSearch
+func (_mock *MockJwkRotationPlanDaoSearch) Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) ([]*dao.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) []*dao.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkSearchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkRotationPlanDaoSearch_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkRotationPlanDaoSearch_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkSearchRequest
+func (_e *MockJwkRotationPlanDaoSearch_Expecter) Exec(ctx any, request any) *MockJwkRotationPlanDaoSearch_Exec_Call {
+	return &MockJwkRotationPlanDaoSearch_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkRotationPlanDaoSearch_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkSearchRequest)) *MockJwkRotationPlanDaoSearch_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkSearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkRotationPlanDaoSearch_Exec_Call) Return(jwks []*dao.Jwk, err error) *MockJwkRotationPlanDaoSearch_Exec_Call {
+	_c.Call.Return(jwks, err)
+	return _c
+}
+
+func (_c *MockJwkRotationPlanDaoSearch_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)) *MockJwkRotationPlanDaoSearch_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkRotationPlanServiceExtract creates a new instance of MockJwkRotationPlanServiceExtract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkRotationPlanServiceExtract(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkRotationPlanServiceExtract {
+	mock := &MockJwkRotationPlanServiceExtract{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkRotationPlanServiceExtract is an autogenerated mock type for the JwkRotationPlanServiceExtract type
+type MockJwkRotationPlanServiceExtract struct {
+	mock.Mock
+}
+
+type MockJwkRotationPlanServiceExtract_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkRotationPlanServiceExtract) EXPECT() *MockJwkRotationPlanServiceExtract_Expecter {
+	return &MockJwkRotationPlanServiceExtract_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkRotationPlanServiceExtract
+func (_mock *MockJwkRotationPlanServiceExtract) Exec(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) (*core.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) *core.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkExtractRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkRotationPlanServiceExtract_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkRotationPlanServiceExtract_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkExtractRequest
+func (_e *MockJwkRotationPlanServiceExtract_Expecter) Exec(ctx any, request any) *MockJwkRotationPlanServiceExtract_Exec_Call {
+	return &MockJwkRotationPlanServiceExtract_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkRotationPlanServiceExtract_Exec_Call) Run(run func(ctx context.Context, request *core.JwkExtractRequest)) *MockJwkRotationPlanServiceExtract_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkExtractRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkExtractRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkRotationPlanServiceExtract_Exec_Call) Return(v *core.Jwk, err error) *MockJwkRotationPlanServiceExtract_Exec_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockJwkRotationPlanServiceExtract_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error)) *MockJwkRotationPlanServiceExtract_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkRotationScheduleDaoSelect creates a new instance of MockJwkRotationScheduleDaoSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkRotationScheduleDaoSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkRotationScheduleDaoSelect {
+	mock := &MockJwkRotationScheduleDaoSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkRotationScheduleDaoSelect is an autogenerated mock type for the JwkRotationScheduleDaoSelect type
+type MockJwkRotationScheduleDaoSelect struct {
+	mock.Mock
+}
+
+type MockJwkRotationScheduleDaoSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkRotationScheduleDaoSelect) EXPECT() *MockJwkRotationScheduleDaoSelect_Expecter {
+	return &MockJwkRotationScheduleDaoSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkRotationScheduleDaoSelect
+func (_mock *MockJwkRotationScheduleDaoSelect) Exec(ctx context.Context, request *dao.JobLeaseSelectRequest) (*dao.JobLease, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.JobLease
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JobLeaseSelectRequest) (*dao.JobLease, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JobLeaseSelectRequest) *dao.JobLease); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.JobLease)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JobLeaseSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkRotationScheduleDaoSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkRotationScheduleDaoSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JobLeaseSelectRequest
+func (_e *MockJwkRotationScheduleDaoSelect_Expecter) Exec(ctx any, request any) *MockJwkRotationScheduleDaoSelect_Exec_Call {
+	return &MockJwkRotationScheduleDaoSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkRotationScheduleDaoSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.JobLeaseSelectRequest)) *MockJwkRotationScheduleDaoSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JobLeaseSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JobLeaseSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkRotationScheduleDaoSelect_Exec_Call) Return(jobLease *dao.JobLease, err error) *MockJwkRotationScheduleDaoSelect_Exec_Call {
+	_c.Call.Return(jobLease, err)
+	return _c
+}
+
+func (_c *MockJwkRotationScheduleDaoSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JobLeaseSelectRequest) (*dao.JobLease, error)) *MockJwkRotationScheduleDaoSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkScheduledRotateDaoAcquire creates a new instance of MockJwkScheduledRotateDaoAcquire. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkScheduledRotateDaoAcquire(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkScheduledRotateDaoAcquire {
+	mock := &MockJwkScheduledRotateDaoAcquire{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkScheduledRotateDaoAcquire is an autogenerated mock type for the JwkScheduledRotateDaoAcquire type
+type MockJwkScheduledRotateDaoAcquire struct {
+	mock.Mock
+}
+
+type MockJwkScheduledRotateDaoAcquire_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkScheduledRotateDaoAcquire) EXPECT() *MockJwkScheduledRotateDaoAcquire_Expecter {
+	return &MockJwkScheduledRotateDaoAcquire_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkScheduledRotateDaoAcquire
+func (_mock *MockJwkScheduledRotateDaoAcquire) Exec(ctx context.Context, request *dao.JobLeaseAcquireRequest) (*dao.JobLease, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.JobLease
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JobLeaseAcquireRequest) (*dao.JobLease, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JobLeaseAcquireRequest) *dao.JobLease); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.JobLease)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JobLeaseAcquireRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkScheduledRotateDaoAcquire_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkScheduledRotateDaoAcquire_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JobLeaseAcquireRequest
+func (_e *MockJwkScheduledRotateDaoAcquire_Expecter) Exec(ctx any, request any) *MockJwkScheduledRotateDaoAcquire_Exec_Call {
+	return &MockJwkScheduledRotateDaoAcquire_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkScheduledRotateDaoAcquire_Exec_Call) Run(run func(ctx context.Context, request *dao.JobLeaseAcquireRequest)) *MockJwkScheduledRotateDaoAcquire_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JobLeaseAcquireRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JobLeaseAcquireRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkScheduledRotateDaoAcquire_Exec_Call) Return(jobLease *dao.JobLease, err error) *MockJwkScheduledRotateDaoAcquire_Exec_Call {
+	_c.Call.Return(jobLease, err)
+	return _c
+}
+
+func (_c *MockJwkScheduledRotateDaoAcquire_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JobLeaseAcquireRequest) (*dao.JobLease, error)) *MockJwkScheduledRotateDaoAcquire_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkScheduledRotateDaoRecordRun creates a new instance of MockJwkScheduledRotateDaoRecordRun. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkScheduledRotateDaoRecordRun(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkScheduledRotateDaoRecordRun {
+	mock := &MockJwkScheduledRotateDaoRecordRun{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkScheduledRotateDaoRecordRun is an autogenerated mock type for the JwkScheduledRotateDaoRecordRun type
+type MockJwkScheduledRotateDaoRecordRun struct {
+	mock.Mock
+}
+
+type MockJwkScheduledRotateDaoRecordRun_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkScheduledRotateDaoRecordRun) EXPECT() *MockJwkScheduledRotateDaoRecordRun_Expecter {
+	return &MockJwkScheduledRotateDaoRecordRun_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkScheduledRotateDaoRecordRun
+func (_mock *MockJwkScheduledRotateDaoRecordRun) Exec(ctx context.Context, request *dao.JobLeaseRecordRunRequest) (*dao.JobLease, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.JobLease
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JobLeaseRecordRunRequest) (*dao.JobLease, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JobLeaseRecordRunRequest) *dao.JobLease); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.JobLease)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JobLeaseRecordRunRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkScheduledRotateDaoRecordRun_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkScheduledRotateDaoRecordRun_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JobLeaseRecordRunRequest
+func (_e *MockJwkScheduledRotateDaoRecordRun_Expecter) Exec(ctx any, request any) *MockJwkScheduledRotateDaoRecordRun_Exec_Call {
+	return &MockJwkScheduledRotateDaoRecordRun_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkScheduledRotateDaoRecordRun_Exec_Call) Run(run func(ctx context.Context, request *dao.JobLeaseRecordRunRequest)) *MockJwkScheduledRotateDaoRecordRun_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JobLeaseRecordRunRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JobLeaseRecordRunRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkScheduledRotateDaoRecordRun_Exec_Call) Return(jobLease *dao.JobLease, err error) *MockJwkScheduledRotateDaoRecordRun_Exec_Call {
+	_c.Call.Return(jobLease, err)
+	return _c
+}
+
+func (_c *MockJwkScheduledRotateDaoRecordRun_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JobLeaseRecordRunRequest) (*dao.JobLease, error)) *MockJwkScheduledRotateDaoRecordRun_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkScheduledRotateServiceRotateAll creates a new instance of MockJwkScheduledRotateServiceRotateAll. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkScheduledRotateServiceRotateAll(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkScheduledRotateServiceRotateAll {
+	mock := &MockJwkScheduledRotateServiceRotateAll{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkScheduledRotateServiceRotateAll is an autogenerated mock type for the JwkScheduledRotateServiceRotateAll type
+type MockJwkScheduledRotateServiceRotateAll struct {
+	mock.Mock
+}
+
+type MockJwkScheduledRotateServiceRotateAll_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkScheduledRotateServiceRotateAll) EXPECT() *MockJwkScheduledRotateServiceRotateAll_Expecter {
+	return &MockJwkScheduledRotateServiceRotateAll_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkScheduledRotateServiceRotateAll
+func (_mock *MockJwkScheduledRotateServiceRotateAll) Exec(ctx context.Context, request *core.JwkRotateAllRequest) (*core.JwkRotateAllResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.JwkRotateAllResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkRotateAllRequest) (*core.JwkRotateAllResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkRotateAllRequest) *core.JwkRotateAllResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.JwkRotateAllResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkRotateAllRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkScheduledRotateServiceRotateAll_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkScheduledRotateServiceRotateAll_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkRotateAllRequest
+func (_e *MockJwkScheduledRotateServiceRotateAll_Expecter) Exec(ctx any, request any) *MockJwkScheduledRotateServiceRotateAll_Exec_Call {
+	return &MockJwkScheduledRotateServiceRotateAll_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkScheduledRotateServiceRotateAll_Exec_Call) Run(run func(ctx context.Context, request *core.JwkRotateAllRequest)) *MockJwkScheduledRotateServiceRotateAll_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkRotateAllRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkRotateAllRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkScheduledRotateServiceRotateAll_Exec_Call) Return(jwkRotateAllResponse *core.JwkRotateAllResponse, err error) *MockJwkScheduledRotateServiceRotateAll_Exec_Call {
+	_c.Call.Return(jwkRotateAllResponse, err)
+	return _c
+}
+
+func (_c *MockJwkScheduledRotateServiceRotateAll_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkRotateAllRequest) (*core.JwkRotateAllResponse, error)) *MockJwkScheduledRotateServiceRotateAll_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkSearchDao creates a new instance of MockJwkSearchDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkSearchDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkSearchDao {
+	mock := &MockJwkSearchDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkSearchDao is an autogenerated mock type for the JwkSearchDao type
+type MockJwkSearchDao struct {
+	mock.Mock
+}
+
+type MockJwkSearchDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkSearchDao) EXPECT() *MockJwkSearchDao_Expecter {
+	return &MockJwkSearchDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkSearchDao
+func (_mock *MockJwkSearchDao) Exec(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) ([]*dao.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSearchRequest) []*dao.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkSearchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkSearchDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkSearchDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkSearchRequest
+func (_e *MockJwkSearchDao_Expecter) Exec(ctx any, request any) *MockJwkSearchDao_Exec_Call {
+	return &MockJwkSearchDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkSearchDao_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkSearchRequest)) *MockJwkSearchDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkSearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkSearchDao_Exec_Call) Return(jwks []*dao.Jwk, err error) *MockJwkSearchDao_Exec_Call {
+	_c.Call.Return(jwks, err)
+	return _c
+}
+
+func (_c *MockJwkSearchDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkSearchRequest) ([]*dao.Jwk, error)) *MockJwkSearchDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkSearchServiceExtract creates a new instance of MockJwkSearchServiceExtract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkSearchServiceExtract(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkSearchServiceExtract {
+	mock := &MockJwkSearchServiceExtract{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkSearchServiceExtract is an autogenerated mock type for the JwkSearchServiceExtract type
+type MockJwkSearchServiceExtract struct {
+	mock.Mock
+}
+
+type MockJwkSearchServiceExtract_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkSearchServiceExtract) EXPECT() *MockJwkSearchServiceExtract_Expecter {
+	return &MockJwkSearchServiceExtract_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkSearchServiceExtract
+func (_mock *MockJwkSearchServiceExtract) Exec(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) (*core.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) *core.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkExtractRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkSearchServiceExtract_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkSearchServiceExtract_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkExtractRequest
+func (_e *MockJwkSearchServiceExtract_Expecter) Exec(ctx any, request any) *MockJwkSearchServiceExtract_Exec_Call {
+	return &MockJwkSearchServiceExtract_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkSearchServiceExtract_Exec_Call) Run(run func(ctx context.Context, request *core.JwkExtractRequest)) *MockJwkSearchServiceExtract_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkExtractRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkExtractRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkSearchServiceExtract_Exec_Call) Return(v *core.Jwk, err error) *MockJwkSearchServiceExtract_Exec_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockJwkSearchServiceExtract_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error)) *MockJwkSearchServiceExtract_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkSelectDao creates a new instance of MockJwkSelectDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkSelectDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkSelectDao {
+	mock := &MockJwkSelectDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkSelectDao is an autogenerated mock type for the JwkSelectDao type
+type MockJwkSelectDao struct {
 	mock.Mock
 }
 
@@ -796,31 +5008,936 @@ type MockJwkSelectDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockJwkSelectDao) EXPECT() *MockJwkSelectDao_Expecter {
-	return &MockJwkSelectDao_Expecter{mock: &_m.Mock}
+func (_m *MockJwkSelectDao) EXPECT() *MockJwkSelectDao_Expecter {
+	return &MockJwkSelectDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkSelectDao
+func (_mock *MockJwkSelectDao) Exec(ctx context.Context, request *dao.JwkSelectRequest) (*dao.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSelectRequest) (*dao.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSelectRequest) *dao.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkSelectDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkSelectDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.JwkSelectRequest
+func (_e *MockJwkSelectDao_Expecter) Exec(ctx any, request any) *MockJwkSelectDao_Exec_Call {
+	return &MockJwkSelectDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkSelectDao_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkSelectRequest)) *MockJwkSelectDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.JwkSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.JwkSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkSelectDao_Exec_Call) Return(jwk *dao.Jwk, err error) *MockJwkSelectDao_Exec_Call {
+	_c.Call.Return(jwk, err)
+	return _c
+}
+
+func (_c *MockJwkSelectDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkSelectRequest) (*dao.Jwk, error)) *MockJwkSelectDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkSelectServiceExtract creates a new instance of MockJwkSelectServiceExtract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkSelectServiceExtract(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkSelectServiceExtract {
+	mock := &MockJwkSelectServiceExtract{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkSelectServiceExtract is an autogenerated mock type for the JwkSelectServiceExtract type
+type MockJwkSelectServiceExtract struct {
+	mock.Mock
+}
+
+type MockJwkSelectServiceExtract_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkSelectServiceExtract) EXPECT() *MockJwkSelectServiceExtract_Expecter {
+	return &MockJwkSelectServiceExtract_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockJwkSelectServiceExtract
+func (_mock *MockJwkSelectServiceExtract) Exec(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) (*core.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) *core.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkExtractRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkSelectServiceExtract_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockJwkSelectServiceExtract_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkExtractRequest
+func (_e *MockJwkSelectServiceExtract_Expecter) Exec(ctx any, request any) *MockJwkSelectServiceExtract_Exec_Call {
+	return &MockJwkSelectServiceExtract_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockJwkSelectServiceExtract_Exec_Call) Run(run func(ctx context.Context, request *core.JwkExtractRequest)) *MockJwkSelectServiceExtract_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkExtractRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkExtractRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkSelectServiceExtract_Exec_Call) Return(v *core.Jwk, err error) *MockJwkSelectServiceExtract_Exec_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockJwkSelectServiceExtract_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error)) *MockJwkSelectServiceExtract_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkSourceCacheSource creates a new instance of MockJwkSourceCacheSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkSourceCacheSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkSourceCacheSource {
+	mock := &MockJwkSourceCacheSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkSourceCacheSource is an autogenerated mock type for the JwkSourceCacheSource type
+type MockJwkSourceCacheSource struct {
+	mock.Mock
+}
+
+type MockJwkSourceCacheSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkSourceCacheSource) EXPECT() *MockJwkSourceCacheSource_Expecter {
+	return &MockJwkSourceCacheSource_Expecter{mock: &_m.Mock}
+}
+
+// SearchKeys provides a mock function for the type MockJwkSourceCacheSource
+func (_mock *MockJwkSourceCacheSource) SearchKeys(ctx context.Context, usage string) ([]*jwa.JWK, error) {
+	ret := _mock.Called(ctx, usage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchKeys")
+	}
+
+	var r0 []*jwa.JWK
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*jwa.JWK, error)); ok {
+		return returnFunc(ctx, usage)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*jwa.JWK); ok {
+		r0 = returnFunc(ctx, usage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*jwa.JWK)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, usage)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkSourceCacheSource_SearchKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchKeys'
+type MockJwkSourceCacheSource_SearchKeys_Call struct {
+	*mock.Call
+}
+
+// SearchKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usage string
+func (_e *MockJwkSourceCacheSource_Expecter) SearchKeys(ctx any, usage any) *MockJwkSourceCacheSource_SearchKeys_Call {
+	return &MockJwkSourceCacheSource_SearchKeys_Call{Call: _e.mock.On("SearchKeys", ctx, usage)}
+}
+
+func (_c *MockJwkSourceCacheSource_SearchKeys_Call) Run(run func(ctx context.Context, usage string)) *MockJwkSourceCacheSource_SearchKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkSourceCacheSource_SearchKeys_Call) Return(jWKs []*jwa.JWK, err error) *MockJwkSourceCacheSource_SearchKeys_Call {
+	_c.Call.Return(jWKs, err)
+	return _c
+}
+
+func (_c *MockJwkSourceCacheSource_SearchKeys_Call) RunAndReturn(run func(ctx context.Context, usage string) ([]*jwa.JWK, error)) *MockJwkSourceCacheSource_SearchKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkSourceRefresher creates a new instance of MockJwkSourceRefresher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkSourceRefresher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkSourceRefresher {
+	mock := &MockJwkSourceRefresher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkSourceRefresher is an autogenerated mock type for the JwkSourceRefresher type
+type MockJwkSourceRefresher struct {
+	mock.Mock
+}
+
+type MockJwkSourceRefresher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkSourceRefresher) EXPECT() *MockJwkSourceRefresher_Expecter {
+	return &MockJwkSourceRefresher_Expecter{mock: &_m.Mock}
+}
+
+// RefreshInterval provides a mock function for the type MockJwkSourceRefresher
+func (_mock *MockJwkSourceRefresher) RefreshInterval() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshInterval")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockJwkSourceRefresher_RefreshInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshInterval'
+type MockJwkSourceRefresher_RefreshInterval_Call struct {
+	*mock.Call
+}
+
+// RefreshInterval is a helper method to define mock.On call
+func (_e *MockJwkSourceRefresher_Expecter) RefreshInterval() *MockJwkSourceRefresher_RefreshInterval_Call {
+	return &MockJwkSourceRefresher_RefreshInterval_Call{Call: _e.mock.On("RefreshInterval")}
+}
+
+func (_c *MockJwkSourceRefresher_RefreshInterval_Call) Run(run func()) *MockJwkSourceRefresher_RefreshInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockJwkSourceRefresher_RefreshInterval_Call) Return(duration time.Duration) *MockJwkSourceRefresher_RefreshInterval_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockJwkSourceRefresher_RefreshInterval_Call) RunAndReturn(run func() time.Duration) *MockJwkSourceRefresher_RefreshInterval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJwkWarmUpSource creates a new instance of MockJwkWarmUpSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJwkWarmUpSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJwkWarmUpSource {
+	mock := &MockJwkWarmUpSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJwkWarmUpSource is an autogenerated mock type for the JwkWarmUpSource type
+type MockJwkWarmUpSource struct {
+	mock.Mock
+}
+
+type MockJwkWarmUpSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJwkWarmUpSource) EXPECT() *MockJwkWarmUpSource_Expecter {
+	return &MockJwkWarmUpSource_Expecter{mock: &_m.Mock}
+}
+
+// SigningKey provides a mock function for the type MockJwkWarmUpSource
+func (_mock *MockJwkWarmUpSource) SigningKey(ctx context.Context, usage string, keyConfig *config.Jwk) (*jwa.JWK, error) {
+	ret := _mock.Called(ctx, usage, keyConfig)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SigningKey")
+	}
+
+	var r0 *jwa.JWK
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *config.Jwk) (*jwa.JWK, error)); ok {
+		return returnFunc(ctx, usage, keyConfig)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *config.Jwk) *jwa.JWK); ok {
+		r0 = returnFunc(ctx, usage, keyConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jwa.JWK)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *config.Jwk) error); ok {
+		r1 = returnFunc(ctx, usage, keyConfig)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJwkWarmUpSource_SigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SigningKey'
+type MockJwkWarmUpSource_SigningKey_Call struct {
+	*mock.Call
+}
+
+// SigningKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usage string
+//   - keyConfig *config.Jwk
+func (_e *MockJwkWarmUpSource_Expecter) SigningKey(ctx any, usage any, keyConfig any) *MockJwkWarmUpSource_SigningKey_Call {
+	return &MockJwkWarmUpSource_SigningKey_Call{Call: _e.mock.On("SigningKey", ctx, usage, keyConfig)}
+}
+
+func (_c *MockJwkWarmUpSource_SigningKey_Call) Run(run func(ctx context.Context, usage string, keyConfig *config.Jwk)) *MockJwkWarmUpSource_SigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *config.Jwk
+		if args[2] != nil {
+			arg2 = args[2].(*config.Jwk)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJwkWarmUpSource_SigningKey_Call) Return(jWK *jwa.JWK, err error) *MockJwkWarmUpSource_SigningKey_Call {
+	_c.Call.Return(jWK, err)
+	return _c
+}
+
+func (_c *MockJwkWarmUpSource_SigningKey_Call) RunAndReturn(run func(ctx context.Context, usage string, keyConfig *config.Jwk) (*jwa.JWK, error)) *MockJwkWarmUpSource_SigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPayloadSignServiceFreeze creates a new instance of MockPayloadSignServiceFreeze. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPayloadSignServiceFreeze(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPayloadSignServiceFreeze {
+	mock := &MockPayloadSignServiceFreeze{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPayloadSignServiceFreeze is an autogenerated mock type for the PayloadSignServiceFreeze type
+type MockPayloadSignServiceFreeze struct {
+	mock.Mock
+}
+
+type MockPayloadSignServiceFreeze_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPayloadSignServiceFreeze) EXPECT() *MockPayloadSignServiceFreeze_Expecter {
+	return &MockPayloadSignServiceFreeze_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockPayloadSignServiceFreeze
+func (_mock *MockPayloadSignServiceFreeze) Exec(ctx context.Context, request *core.SigningFreezeCheckRequest) error {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.SigningFreezeCheckRequest) error); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPayloadSignServiceFreeze_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockPayloadSignServiceFreeze_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.SigningFreezeCheckRequest
+func (_e *MockPayloadSignServiceFreeze_Expecter) Exec(ctx any, request any) *MockPayloadSignServiceFreeze_Exec_Call {
+	return &MockPayloadSignServiceFreeze_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockPayloadSignServiceFreeze_Exec_Call) Run(run func(ctx context.Context, request *core.SigningFreezeCheckRequest)) *MockPayloadSignServiceFreeze_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.SigningFreezeCheckRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.SigningFreezeCheckRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPayloadSignServiceFreeze_Exec_Call) Return(err error) *MockPayloadSignServiceFreeze_Exec_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPayloadSignServiceFreeze_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.SigningFreezeCheckRequest) error) *MockPayloadSignServiceFreeze_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRevokedTokenCheckService creates a new instance of MockRevokedTokenCheckService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRevokedTokenCheckService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRevokedTokenCheckService {
+	mock := &MockRevokedTokenCheckService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRevokedTokenCheckService is an autogenerated mock type for the RevokedTokenCheckService type
+type MockRevokedTokenCheckService struct {
+	mock.Mock
+}
+
+type MockRevokedTokenCheckService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRevokedTokenCheckService) EXPECT() *MockRevokedTokenCheckService_Expecter {
+	return &MockRevokedTokenCheckService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRevokedTokenCheckService
+func (_mock *MockRevokedTokenCheckService) Exec(ctx context.Context, request *core.RevokedTokenSyncRequest) (*core.RevokedTokenSyncResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.RevokedTokenSyncResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.RevokedTokenSyncRequest) (*core.RevokedTokenSyncResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.RevokedTokenSyncRequest) *core.RevokedTokenSyncResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.RevokedTokenSyncResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.RevokedTokenSyncRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenCheckService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRevokedTokenCheckService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.RevokedTokenSyncRequest
+func (_e *MockRevokedTokenCheckService_Expecter) Exec(ctx any, request any) *MockRevokedTokenCheckService_Exec_Call {
+	return &MockRevokedTokenCheckService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRevokedTokenCheckService_Exec_Call) Run(run func(ctx context.Context, request *core.RevokedTokenSyncRequest)) *MockRevokedTokenCheckService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.RevokedTokenSyncRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.RevokedTokenSyncRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenCheckService_Exec_Call) Return(revokedTokenSyncResult *core.RevokedTokenSyncResult, err error) *MockRevokedTokenCheckService_Exec_Call {
+	_c.Call.Return(revokedTokenSyncResult, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenCheckService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.RevokedTokenSyncRequest) (*core.RevokedTokenSyncResult, error)) *MockRevokedTokenCheckService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRevokedTokenSyncDao creates a new instance of MockRevokedTokenSyncDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRevokedTokenSyncDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRevokedTokenSyncDao {
+	mock := &MockRevokedTokenSyncDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRevokedTokenSyncDao is an autogenerated mock type for the RevokedTokenSyncDao type
+type MockRevokedTokenSyncDao struct {
+	mock.Mock
+}
+
+type MockRevokedTokenSyncDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRevokedTokenSyncDao) EXPECT() *MockRevokedTokenSyncDao_Expecter {
+	return &MockRevokedTokenSyncDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRevokedTokenSyncDao
+func (_mock *MockRevokedTokenSyncDao) Exec(ctx context.Context, request *dao.RevokedTokenListRequest) ([]*dao.RevokedToken, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.RevokedToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RevokedTokenListRequest) ([]*dao.RevokedToken, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RevokedTokenListRequest) []*dao.RevokedToken); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.RevokedToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.RevokedTokenListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenSyncDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRevokedTokenSyncDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.RevokedTokenListRequest
+func (_e *MockRevokedTokenSyncDao_Expecter) Exec(ctx any, request any) *MockRevokedTokenSyncDao_Exec_Call {
+	return &MockRevokedTokenSyncDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRevokedTokenSyncDao_Exec_Call) Run(run func(ctx context.Context, request *dao.RevokedTokenListRequest)) *MockRevokedTokenSyncDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.RevokedTokenListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.RevokedTokenListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenSyncDao_Exec_Call) Return(revokedTokens []*dao.RevokedToken, err error) *MockRevokedTokenSyncDao_Exec_Call {
+	_c.Call.Return(revokedTokens, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenSyncDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.RevokedTokenListRequest) ([]*dao.RevokedToken, error)) *MockRevokedTokenSyncDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSignatureRecorder creates a new instance of MockSignatureRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSignatureRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSignatureRecorder {
+	mock := &MockSignatureRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSignatureRecorder is an autogenerated mock type for the SignatureRecorder type
+type MockSignatureRecorder struct {
+	mock.Mock
+}
+
+type MockSignatureRecorder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSignatureRecorder) EXPECT() *MockSignatureRecorder_Expecter {
+	return &MockSignatureRecorder_Expecter{mock: &_m.Mock}
+}
+
+// RecordSignature provides a mock function for the type MockSignatureRecorder
+func (_mock *MockSignatureRecorder) RecordSignature(operation core.SignatureOperation, usage string, kid string) {
+	_mock.Called(operation, usage, kid)
+	return
+}
+
+// MockSignatureRecorder_RecordSignature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSignature'
+type MockSignatureRecorder_RecordSignature_Call struct {
+	*mock.Call
+}
+
+// RecordSignature is a helper method to define mock.On call
+//   - operation core.SignatureOperation
+//   - usage string
+//   - kid string
+func (_e *MockSignatureRecorder_Expecter) RecordSignature(operation any, usage any, kid any) *MockSignatureRecorder_RecordSignature_Call {
+	return &MockSignatureRecorder_RecordSignature_Call{Call: _e.mock.On("RecordSignature", operation, usage, kid)}
+}
+
+func (_c *MockSignatureRecorder_RecordSignature_Call) Run(run func(operation core.SignatureOperation, usage string, kid string)) *MockSignatureRecorder_RecordSignature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 core.SignatureOperation
+		if args[0] != nil {
+			arg0 = args[0].(core.SignatureOperation)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSignatureRecorder_RecordSignature_Call) Return() *MockSignatureRecorder_RecordSignature_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockSignatureRecorder_RecordSignature_Call) RunAndReturn(run func(operation core.SignatureOperation, usage string, kid string)) *MockSignatureRecorder_RecordSignature_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockSigningFreezeCheckService creates a new instance of MockSigningFreezeCheckService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSigningFreezeCheckService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSigningFreezeCheckService {
+	mock := &MockSigningFreezeCheckService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSigningFreezeCheckService is an autogenerated mock type for the SigningFreezeCheckService type
+type MockSigningFreezeCheckService struct {
+	mock.Mock
+}
+
+type MockSigningFreezeCheckService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSigningFreezeCheckService) EXPECT() *MockSigningFreezeCheckService_Expecter {
+	return &MockSigningFreezeCheckService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSigningFreezeCheckService
+func (_mock *MockSigningFreezeCheckService) Exec(ctx context.Context, request *core.SigningFreezeListRequest) ([]*core.SigningFreeze, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*core.SigningFreeze
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.SigningFreezeListRequest) ([]*core.SigningFreeze, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.SigningFreezeListRequest) []*core.SigningFreeze); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*core.SigningFreeze)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.SigningFreezeListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningFreezeCheckService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSigningFreezeCheckService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.SigningFreezeListRequest
+func (_e *MockSigningFreezeCheckService_Expecter) Exec(ctx any, request any) *MockSigningFreezeCheckService_Exec_Call {
+	return &MockSigningFreezeCheckService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSigningFreezeCheckService_Exec_Call) Run(run func(ctx context.Context, request *core.SigningFreezeListRequest)) *MockSigningFreezeCheckService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.SigningFreezeListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.SigningFreezeListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSigningFreezeCheckService_Exec_Call) Return(signingFreezes []*core.SigningFreeze, err error) *MockSigningFreezeCheckService_Exec_Call {
+	_c.Call.Return(signingFreezes, err)
+	return _c
+}
+
+func (_c *MockSigningFreezeCheckService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.SigningFreezeListRequest) ([]*core.SigningFreeze, error)) *MockSigningFreezeCheckService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSigningFreezeListDao creates a new instance of MockSigningFreezeListDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSigningFreezeListDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSigningFreezeListDao {
+	mock := &MockSigningFreezeListDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSigningFreezeListDao is an autogenerated mock type for the SigningFreezeListDao type
+type MockSigningFreezeListDao struct {
+	mock.Mock
+}
+
+type MockSigningFreezeListDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSigningFreezeListDao) EXPECT() *MockSigningFreezeListDao_Expecter {
+	return &MockSigningFreezeListDao_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockJwkSelectDao
-func (_mock *MockJwkSelectDao) Exec(ctx context.Context, request *dao.JwkSelectRequest) (*dao.Jwk, error) {
+// Exec provides a mock function for the type MockSigningFreezeListDao
+func (_mock *MockSigningFreezeListDao) Exec(ctx context.Context, request *dao.SigningFreezeListRequest) ([]*dao.SigningFreeze, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Jwk
+	var r0 []*dao.SigningFreeze
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSelectRequest) (*dao.Jwk, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SigningFreezeListRequest) ([]*dao.SigningFreeze, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.JwkSelectRequest) *dao.Jwk); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SigningFreezeListRequest) []*dao.SigningFreeze); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Jwk)
+			r0 = ret.Get(0).([]*dao.SigningFreeze)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.JwkSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SigningFreezeListRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -828,27 +5945,27 @@ func (_mock *MockJwkSelectDao) Exec(ctx context.Context, request *dao.JwkSelectR
 	return r0, r1
 }
 
-// MockJwkSelectDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockJwkSelectDao_Exec_Call struct {
+// MockSigningFreezeListDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSigningFreezeListDao_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.JwkSelectRequest
-func (_e *MockJwkSelectDao_Expecter) Exec(ctx any, request any) *MockJwkSelectDao_Exec_Call {
-	return &MockJwkSelectDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.SigningFreezeListRequest
+func (_e *MockSigningFreezeListDao_Expecter) Exec(ctx any, request any) *MockSigningFreezeListDao_Exec_Call {
+	return &MockSigningFreezeListDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockJwkSelectDao_Exec_Call) Run(run func(ctx context.Context, request *dao.JwkSelectRequest)) *MockJwkSelectDao_Exec_Call {
+func (_c *MockSigningFreezeListDao_Exec_Call) Run(run func(ctx context.Context, request *dao.SigningFreezeListRequest)) *MockSigningFreezeListDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.JwkSelectRequest
+		var arg1 *dao.SigningFreezeListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.JwkSelectRequest)
+			arg1 = args[1].(*dao.SigningFreezeListRequest)
 		}
 		run(
 			arg0,
@@ -858,23 +5975,23 @@ func (_c *MockJwkSelectDao_Exec_Call) Run(run func(ctx context.Context, request
 	return _c
 }
 
-func (_c *MockJwkSelectDao_Exec_Call) Return(jwk *dao.Jwk, err error) *MockJwkSelectDao_Exec_Call {
-	_c.Call.Return(jwk, err)
+func (_c *MockSigningFreezeListDao_Exec_Call) Return(signingFreezes []*dao.SigningFreeze, err error) *MockSigningFreezeListDao_Exec_Call {
+	_c.Call.Return(signingFreezes, err)
 	return _c
 }
 
-func (_c *MockJwkSelectDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.JwkSelectRequest) (*dao.Jwk, error)) *MockJwkSelectDao_Exec_Call {
+func (_c *MockSigningFreezeListDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SigningFreezeListRequest) ([]*dao.SigningFreeze, error)) *MockSigningFreezeListDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockJwkSelectServiceExtract creates a new instance of MockJwkSelectServiceExtract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSigningFreezeSetDaoInsert creates a new instance of MockSigningFreezeSetDaoInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockJwkSelectServiceExtract(t interface {
+func NewMockSigningFreezeSetDaoInsert(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockJwkSelectServiceExtract {
-	mock := &MockJwkSelectServiceExtract{}
+}) *MockSigningFreezeSetDaoInsert {
+	mock := &MockSigningFreezeSetDaoInsert{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -882,40 +5999,40 @@ func NewMockJwkSelectServiceExtract(t interface {
 	return mock
 }
 
-// MockJwkSelectServiceExtract is an autogenerated mock type for the JwkSelectServiceExtract type
-type MockJwkSelectServiceExtract struct {
+// MockSigningFreezeSetDaoInsert is an autogenerated mock type for the SigningFreezeSetDaoInsert type
+type MockSigningFreezeSetDaoInsert struct {
 	mock.Mock
 }
 
-type MockJwkSelectServiceExtract_Expecter struct {
+type MockSigningFreezeSetDaoInsert_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockJwkSelectServiceExtract) EXPECT() *MockJwkSelectServiceExtract_Expecter {
-	return &MockJwkSelectServiceExtract_Expecter{mock: &_m.Mock}
+func (_m *MockSigningFreezeSetDaoInsert) EXPECT() *MockSigningFreezeSetDaoInsert_Expecter {
+	return &MockSigningFreezeSetDaoInsert_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockJwkSelectServiceExtract
-func (_mock *MockJwkSelectServiceExtract) Exec(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error) {
+// Exec provides a mock function for the type MockSigningFreezeSetDaoInsert
+func (_mock *MockSigningFreezeSetDaoInsert) Exec(ctx context.Context, request *dao.SigningFreezeInsertRequest) (*dao.SigningFreeze, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.Jwk
+	var r0 *dao.SigningFreeze
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) (*core.Jwk, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SigningFreezeInsertRequest) (*dao.SigningFreeze, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkExtractRequest) *core.Jwk); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SigningFreezeInsertRequest) *dao.SigningFreeze); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.Jwk)
+			r0 = ret.Get(0).(*dao.SigningFreeze)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkExtractRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SigningFreezeInsertRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -923,27 +6040,27 @@ func (_mock *MockJwkSelectServiceExtract) Exec(ctx context.Context, request *cor
 	return r0, r1
 }
 
-// MockJwkSelectServiceExtract_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockJwkSelectServiceExtract_Exec_Call struct {
+// MockSigningFreezeSetDaoInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSigningFreezeSetDaoInsert_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.JwkExtractRequest
-func (_e *MockJwkSelectServiceExtract_Expecter) Exec(ctx any, request any) *MockJwkSelectServiceExtract_Exec_Call {
-	return &MockJwkSelectServiceExtract_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.SigningFreezeInsertRequest
+func (_e *MockSigningFreezeSetDaoInsert_Expecter) Exec(ctx any, request any) *MockSigningFreezeSetDaoInsert_Exec_Call {
+	return &MockSigningFreezeSetDaoInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockJwkSelectServiceExtract_Exec_Call) Run(run func(ctx context.Context, request *core.JwkExtractRequest)) *MockJwkSelectServiceExtract_Exec_Call {
+func (_c *MockSigningFreezeSetDaoInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SigningFreezeInsertRequest)) *MockSigningFreezeSetDaoInsert_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.JwkExtractRequest
+		var arg1 *dao.SigningFreezeInsertRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.JwkExtractRequest)
+			arg1 = args[1].(*dao.SigningFreezeInsertRequest)
 		}
 		run(
 			arg0,
@@ -953,12 +6070,297 @@ func (_c *MockJwkSelectServiceExtract_Exec_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockJwkSelectServiceExtract_Exec_Call) Return(v *core.Jwk, err error) *MockJwkSelectServiceExtract_Exec_Call {
-	_c.Call.Return(v, err)
+func (_c *MockSigningFreezeSetDaoInsert_Exec_Call) Return(signingFreeze *dao.SigningFreeze, err error) *MockSigningFreezeSetDaoInsert_Exec_Call {
+	_c.Call.Return(signingFreeze, err)
 	return _c
 }
 
-func (_c *MockJwkSelectServiceExtract_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkExtractRequest) (*core.Jwk, error)) *MockJwkSelectServiceExtract_Exec_Call {
+func (_c *MockSigningFreezeSetDaoInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SigningFreezeInsertRequest) (*dao.SigningFreeze, error)) *MockSigningFreezeSetDaoInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSigningFreezeSetDaoDelete creates a new instance of MockSigningFreezeSetDaoDelete. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSigningFreezeSetDaoDelete(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSigningFreezeSetDaoDelete {
+	mock := &MockSigningFreezeSetDaoDelete{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSigningFreezeSetDaoDelete is an autogenerated mock type for the SigningFreezeSetDaoDelete type
+type MockSigningFreezeSetDaoDelete struct {
+	mock.Mock
+}
+
+type MockSigningFreezeSetDaoDelete_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSigningFreezeSetDaoDelete) EXPECT() *MockSigningFreezeSetDaoDelete_Expecter {
+	return &MockSigningFreezeSetDaoDelete_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSigningFreezeSetDaoDelete
+func (_mock *MockSigningFreezeSetDaoDelete) Exec(ctx context.Context, request *dao.SigningFreezeDeleteRequest) (*dao.SigningFreeze, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.SigningFreeze
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SigningFreezeDeleteRequest) (*dao.SigningFreeze, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SigningFreezeDeleteRequest) *dao.SigningFreeze); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.SigningFreeze)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SigningFreezeDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningFreezeSetDaoDelete_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSigningFreezeSetDaoDelete_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SigningFreezeDeleteRequest
+func (_e *MockSigningFreezeSetDaoDelete_Expecter) Exec(ctx any, request any) *MockSigningFreezeSetDaoDelete_Exec_Call {
+	return &MockSigningFreezeSetDaoDelete_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSigningFreezeSetDaoDelete_Exec_Call) Run(run func(ctx context.Context, request *dao.SigningFreezeDeleteRequest)) *MockSigningFreezeSetDaoDelete_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SigningFreezeDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SigningFreezeDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSigningFreezeSetDaoDelete_Exec_Call) Return(signingFreeze *dao.SigningFreeze, err error) *MockSigningFreezeSetDaoDelete_Exec_Call {
+	_c.Call.Return(signingFreeze, err)
+	return _c
+}
+
+func (_c *MockSigningFreezeSetDaoDelete_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SigningFreezeDeleteRequest) (*dao.SigningFreeze, error)) *MockSigningFreezeSetDaoDelete_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTokenRevokeDao creates a new instance of MockTokenRevokeDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenRevokeDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenRevokeDao {
+	mock := &MockTokenRevokeDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTokenRevokeDao is an autogenerated mock type for the TokenRevokeDao type
+type MockTokenRevokeDao struct {
+	mock.Mock
+}
+
+type MockTokenRevokeDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenRevokeDao) EXPECT() *MockTokenRevokeDao_Expecter {
+	return &MockTokenRevokeDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockTokenRevokeDao
+func (_mock *MockTokenRevokeDao) Exec(ctx context.Context, request *dao.RevokedTokenInsertRequest) (*dao.RevokedToken, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.RevokedToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RevokedTokenInsertRequest) (*dao.RevokedToken, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RevokedTokenInsertRequest) *dao.RevokedToken); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.RevokedToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.RevokedTokenInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenRevokeDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockTokenRevokeDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.RevokedTokenInsertRequest
+func (_e *MockTokenRevokeDao_Expecter) Exec(ctx any, request any) *MockTokenRevokeDao_Exec_Call {
+	return &MockTokenRevokeDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockTokenRevokeDao_Exec_Call) Run(run func(ctx context.Context, request *dao.RevokedTokenInsertRequest)) *MockTokenRevokeDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.RevokedTokenInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.RevokedTokenInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTokenRevokeDao_Exec_Call) Return(revokedToken *dao.RevokedToken, err error) *MockTokenRevokeDao_Exec_Call {
+	_c.Call.Return(revokedToken, err)
+	return _c
+}
+
+func (_c *MockTokenRevokeDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.RevokedTokenInsertRequest) (*dao.RevokedToken, error)) *MockTokenRevokeDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTokenRevokeServiceInspect creates a new instance of MockTokenRevokeServiceInspect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenRevokeServiceInspect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenRevokeServiceInspect {
+	mock := &MockTokenRevokeServiceInspect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTokenRevokeServiceInspect is an autogenerated mock type for the TokenRevokeServiceInspect type
+type MockTokenRevokeServiceInspect struct {
+	mock.Mock
+}
+
+type MockTokenRevokeServiceInspect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenRevokeServiceInspect) EXPECT() *MockTokenRevokeServiceInspect_Expecter {
+	return &MockTokenRevokeServiceInspect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockTokenRevokeServiceInspect
+func (_mock *MockTokenRevokeServiceInspect) Exec(ctx context.Context, request *core.ClaimsInspectRequest) (*core.ClaimsInspectResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ClaimsInspectResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ClaimsInspectRequest) (*core.ClaimsInspectResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ClaimsInspectRequest) *core.ClaimsInspectResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ClaimsInspectResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ClaimsInspectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenRevokeServiceInspect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockTokenRevokeServiceInspect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ClaimsInspectRequest
+func (_e *MockTokenRevokeServiceInspect_Expecter) Exec(ctx any, request any) *MockTokenRevokeServiceInspect_Exec_Call {
+	return &MockTokenRevokeServiceInspect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockTokenRevokeServiceInspect_Exec_Call) Run(run func(ctx context.Context, request *core.ClaimsInspectRequest)) *MockTokenRevokeServiceInspect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ClaimsInspectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ClaimsInspectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTokenRevokeServiceInspect_Exec_Call) Return(claimsInspectResult *core.ClaimsInspectResult, err error) *MockTokenRevokeServiceInspect_Exec_Call {
+	_c.Call.Return(claimsInspectResult, err)
+	return _c
+}
+
+func (_c *MockTokenRevokeServiceInspect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ClaimsInspectRequest) (*core.ClaimsInspectResult, error)) *MockTokenRevokeServiceInspect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
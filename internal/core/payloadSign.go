@@ -0,0 +1,87 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+)
+
+// PayloadSignServiceFreeze is the signing freeze dependency of [PayloadSign].
+type PayloadSignServiceFreeze interface {
+	Exec(ctx context.Context, request *SigningFreezeCheckRequest) error
+}
+
+// PayloadSignRequest holds the parameters for a [PayloadSign.Exec] call.
+type PayloadSignRequest struct {
+	// Payload is the content to sign. It is not included in the output: the caller sends it
+	// alongside the signature.
+	Payload []byte
+	// Usage identifies the key to sign with. See [config.Jwk].
+	Usage string
+	// Unencoded signs the payload as is rather than its base64url encoding (RFC 7797, "b64": false).
+	// Verifiers must then be handed the exact payload bytes.
+	Unencoded bool
+}
+
+// A PayloadSign signs an arbitrary payload and returns a detached JWS (see [JwsDetached]): unlike
+// [ClaimsSign], no JWT claims are attached, and the payload is left out of the token.
+//
+// Like tokens, no payload is signed while the signing of the usage is frozen: see
+// [SigningFreezeSet].
+type PayloadSign struct {
+	sources       *JwkPrivateSources
+	serviceFreeze PayloadSignServiceFreeze
+	keysConfig    map[string]*config.Jwk
+}
+
+// NewPayloadSign creates a PayloadSign service. Sources provide the per-usage private keys (see
+// [NewJwkPrivateSource]); serviceFreeze tells the frozen usages apart, and is nil when signing
+// cannot be frozen.
+func NewPayloadSign(
+	sources *JwkPrivateSources, serviceFreeze PayloadSignServiceFreeze, keysConfig map[string]*config.Jwk,
+) *PayloadSign {
+	return &PayloadSign{sources: sources, serviceFreeze: serviceFreeze, keysConfig: keysConfig}
+}
+
+func (service *PayloadSign) Exec(ctx context.Context, request *PayloadSignRequest) (string, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.PayloadSign")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("key.usage", request.Usage),
+		attribute.Bool("payload.unencoded", request.Unencoded),
+	)
+
+	keyConfig, ok := service.keysConfig[request.Usage]
+	if !ok {
+		return "", otel.ReportError(span, fmt.Errorf("%w: %s", ErrConfigNotFound, request.Usage))
+	}
+
+	if service.serviceFreeze != nil {
+		err := service.serviceFreeze.Exec(ctx, &SigningFreezeCheckRequest{Usage: request.Usage})
+		if err != nil {
+			return "", otel.ReportError(span, fmt.Errorf("check freeze: %w", err))
+		}
+	}
+
+	key, err := service.sources.SigningKey(ctx, request.Usage, keyConfig)
+	if err != nil {
+		return "", otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(attribute.String("key.kid", key.KID))
+
+	token, err := NewJwsDetached(ctx, key, request.Payload, !request.Unencoded)
+	if err != nil {
+		return "", otel.ReportError(span, fmt.Errorf("sign payload: %w", err))
+	}
+
+	recordSignature(ctx, SignatureOperationPayload, request.Usage, key.KID)
+
+	return otel.ReportSuccess(span, token.String()), nil
+}
@@ -0,0 +1,128 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/jwt/v2/jwa"
+	"github.com/a-novel-kit/jwt/v2/jwk"
+	"github.com/a-novel-kit/jwt/v2/jws"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+)
+
+// PayloadVerifyRequest holds the parameters for a [PayloadVerify.Exec] call.
+type PayloadVerifyRequest struct {
+	// Signature is the detached JWS returned by [PayloadSign].
+	Signature string
+	// Payload is the content the signature was issued for.
+	Payload []byte
+	// Usage identifies the keys to verify with. See [config.Jwk].
+	Usage string
+}
+
+// A PayloadVerify checks a detached JWS issued by [PayloadSign] against its payload, using the
+// public keys of the usage.
+//
+// The signature must name an algorithm of the usage, current or previous (see
+// [config.Jwk.PreviousAlgs]), allowed by its policy. It returns an error wrapping
+// [jws.ErrInvalidSignature] when no key of the usage verifies it.
+type PayloadVerify struct {
+	sources    *JwkPublicSources
+	keysConfig map[string]*config.Jwk
+}
+
+// NewPayloadVerify creates a PayloadVerify service. Sources provide the per-usage public keys (see
+// [NewJwkPublicSource]).
+func NewPayloadVerify(sources *JwkPublicSources, keysConfig map[string]*config.Jwk) *PayloadVerify {
+	return &PayloadVerify{sources: sources, keysConfig: keysConfig}
+}
+
+func (service *PayloadVerify) Exec(ctx context.Context, request *PayloadVerifyRequest) error {
+	ctx, span := otel.Tracer().Start(ctx, "core.PayloadVerify")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("key.usage", request.Usage))
+
+	keyConfig, ok := service.keysConfig[request.Usage]
+	if !ok {
+		return otel.ReportError(span, fmt.Errorf("%w: %s", ErrConfigNotFound, request.Usage))
+	}
+
+	source, ok := service.sources.Usage(request.Usage)
+	if !ok {
+		return otel.ReportError(span, fmt.Errorf("%w: no key source for usage %s", ErrConfigNotFound, request.Usage))
+	}
+
+	token, err := ParseJwsDetached(request.Signature)
+	if err != nil {
+		return otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(
+		attribute.String("token.alg", string(token.Header.Alg)),
+		attribute.String("key.kid", token.Header.KID),
+	)
+
+	err = keyConfig.Policy.CheckAlg(token.Header.Alg)
+	if err != nil {
+		return otel.ReportError(span, err)
+	}
+
+	if !slices.Contains(keyConfig.Algs(), token.Header.Alg) {
+		return otel.ReportError(span, fmt.Errorf(
+			"%w: algorithm %s is not used by usage %s", jws.ErrInvalidSignature, token.Header.Alg, request.Usage,
+		))
+	}
+
+	keys, err := service.candidateKeys(ctx, source, token.Header)
+	if err != nil {
+		return otel.ReportError(span, err)
+	}
+
+	err = jws.ErrInvalidSignature
+
+	for _, key := range keys {
+		err = token.Verify(ctx, key, request.Payload)
+		if err == nil {
+			otel.ReportSuccessNoContent(span)
+
+			return nil
+		}
+	}
+
+	return otel.ReportError(span, err)
+}
+
+// candidateKeys returns the keys that may have issued a signature with header: the key it names,
+// or every key of its algorithm when it names none.
+func (service *PayloadVerify) candidateKeys(
+	ctx context.Context, source *jwk.Source, header *JwsDetachedHeader,
+) ([]*jwa.JWK, error) {
+	if header.KID != "" {
+		key, err := source.Get(ctx, header.KID)
+		if errors.Is(err, jwk.ErrKeyNotFound) {
+			return nil, fmt.Errorf("%w: %w", jws.ErrInvalidSignature, err)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("get key: %w", err)
+		}
+
+		return []*jwa.JWK{key}, nil
+	}
+
+	keys, err := source.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list keys: %w", err)
+	}
+
+	return slices.DeleteFunc(slices.Clone(keys), func(key *jwa.JWK) bool {
+		return key.Alg != header.Alg
+	}), nil
+}
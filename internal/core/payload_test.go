@@ -0,0 +1,419 @@
+package core_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/jwt/v2"
+	"github.com/a-novel-kit/jwt/v2/jwa"
+	"github.com/a-novel-kit/jwt/v2/jwk"
+	"github.com/a-novel-kit/jwt/v2/jws"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+)
+
+// generatePayloadKey returns a key pair for alg, as raw JWKs.
+func generatePayloadKey(t *testing.T, alg jwa.Alg) (*jwa.JWK, *jwa.JWK) {
+	t.Helper()
+
+	privateKey, publicKey, _, _, err := core.JwkGenerators[alg]()
+	require.NoError(t, err)
+
+	switch key := privateKey.(type) {
+	case *jwk.Key[ed25519.PrivateKey]:
+		return key.JWK, publicKey.(*jwk.Key[ed25519.PublicKey]).JWK
+	case *jwk.Key[*ecdsa.PrivateKey]:
+		return key.JWK, publicKey.(*jwk.Key[*ecdsa.PublicKey]).JWK
+	case *jwk.Key[*rsa.PrivateKey]:
+		return key.JWK, publicKey.(*jwk.Key[*rsa.PublicKey]).JWK
+	default:
+		require.FailNowf(t, "unexpected key type", "%T", privateKey)
+
+		return nil, nil
+	}
+}
+
+// newPayloadSources returns private and public sources serving keys under a single usage.
+func newPayloadSources(
+	privateKeys, publicKeys []*jwa.JWK, keysConfig map[string]*config.Jwk,
+) (*core.JwkPrivateSources, *core.JwkPublicSources, error) {
+	privateSources, err := core.NewJwkPrivateSource(staticKeySource(privateKeys), keysConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicSources, err := core.NewJwkPublicSource(staticKeySource(publicKeys), keysConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return privateSources, publicSources, nil
+}
+
+type staticKeySource []*jwa.JWK
+
+func (source staticKeySource) SearchKeys(_ context.Context, _ string) ([]*jwa.JWK, error) {
+	return source, nil
+}
+
+func TestPayloadSignAndVerify(t *testing.T) {
+	t.Parallel()
+
+	algs := []jwa.Alg{
+		jwa.EdDSA,
+		jwa.ES256, jwa.ES384, jwa.ES512,
+		jwa.RS256, jwa.RS384, jwa.RS512,
+		jwa.PS256, jwa.PS384, jwa.PS512,
+	}
+
+	// A payload holding dots cannot travel inside a compact JWS when unencoded, so it exercises the
+	// detached signing input.
+	payload := []byte(`{"foo":"bar.baz"}`)
+
+	for _, alg := range algs {
+		for _, unencoded := range []bool{false, true} {
+			name := string(alg)
+			if unencoded {
+				name += "/Unencoded"
+			}
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				privateKey, publicKey := generatePayloadKey(t, alg)
+
+				keysConfig := map[string]*config.Jwk{"test-usage": {Alg: alg}}
+
+				privateSources, publicSources, err := newPayloadSources(
+					[]*jwa.JWK{privateKey}, []*jwa.JWK{publicKey}, keysConfig,
+				)
+				require.NoError(t, err)
+
+				signature, err := core.NewPayloadSign(privateSources, nil, keysConfig).Exec(
+					t.Context(), &core.PayloadSignRequest{Payload: payload, Usage: "test-usage", Unencoded: unencoded},
+				)
+				require.NoError(t, err)
+
+				parts := strings.Split(signature, ".")
+				require.Len(t, parts, 3)
+				require.Empty(t, parts[1], "payload must be detached")
+
+				verifier := core.NewPayloadVerify(publicSources, keysConfig)
+
+				require.NoError(t, verifier.Exec(t.Context(), &core.PayloadVerifyRequest{
+					Signature: signature,
+					Payload:   payload,
+					Usage:     "test-usage",
+				}))
+
+				require.ErrorIs(t, verifier.Exec(t.Context(), &core.PayloadVerifyRequest{
+					Signature: signature,
+					Payload:   []byte(`{"foo":"bar.qux"}`),
+					Usage:     "test-usage",
+				}), jws.ErrInvalidSignature)
+
+				if unencoded {
+					return
+				}
+
+				// With the payload attached back, an encoded signature has the shape of a token, signed
+				// by a key of the usage. Claims verifiers must refuse it.
+				recipients, err := core.NewJwkRecipients(publicSources, keysConfig)
+				require.NoError(t, err)
+
+				attached := parts[0] + "." + base64.RawURLEncoding.EncodeToString(payload) + "." + parts[2]
+
+				var decoded map[string]string
+
+				err = jwt.NewRecipient(jwt.RecipientConfig{Plugins: recipients["test-usage"]}).
+					Consume(t.Context(), attached, &decoded)
+				require.ErrorIs(t, err, jwt.ErrUnsupportedCritHeader)
+
+				// Even from a verifier that understands "b64", the type gives it away.
+				err = jwt.NewRecipient(jwt.RecipientConfig{
+					Plugins:         recipients["test-usage"],
+					CriticalHeaders: []string{"b64"},
+				}).Consume(t.Context(), attached, &decoded)
+				require.ErrorIs(t, err, core.ErrJwkTokenType)
+			})
+		}
+	}
+}
+
+func TestPayloadVerify(t *testing.T) {
+	t.Parallel()
+
+	privateKey, publicKey := generatePayloadKey(t, jwa.EdDSA)
+	otherPrivateKey, _ := generatePayloadKey(t, jwa.EdDSA)
+
+	keysConfig := map[string]*config.Jwk{
+		"test-usage": {Alg: jwa.EdDSA, Key: config.JwkKey{Cache: time.Minute}},
+	}
+
+	payload := []byte("hello world")
+
+	sign := func(key *jwa.JWK, encoded bool) string {
+		token, err := core.NewJwsDetached(t.Context(), key, payload, encoded)
+		require.NoError(t, err)
+
+		return token.String()
+	}
+
+	header := func(value string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(value))
+	}
+
+	signature := sign(privateKey, true)
+	signatureParts := strings.Split(signature, ".")
+
+	testCases := []struct {
+		name string
+
+		signature  string
+		usage      string
+		keysConfig map[string]*config.Jwk
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			signature: signature,
+			usage:     "test-usage",
+
+			keysConfig: keysConfig,
+		},
+		{
+			name: "Success/Unencoded",
+
+			signature: sign(privateKey, false),
+			usage:     "test-usage",
+
+			keysConfig: keysConfig,
+		},
+		{
+			name: "Error/UnknownUsage",
+
+			signature: signature,
+			usage:     "other-usage",
+
+			keysConfig: keysConfig,
+
+			expectErr: core.ErrConfigNotFound,
+		},
+		{
+			name: "Error/UnknownKey",
+
+			signature: sign(otherPrivateKey, true),
+			usage:     "test-usage",
+
+			keysConfig: keysConfig,
+
+			expectErr: jws.ErrInvalidSignature,
+		},
+		{
+			name: "Error/AttachedPayload",
+
+			signature: signatureParts[0] + "." + base64.RawURLEncoding.EncodeToString(payload) + "." + signatureParts[2],
+			usage:     "test-usage",
+
+			keysConfig: keysConfig,
+
+			expectErr: core.ErrJwsDetachedMalformed,
+		},
+		{
+			name: "Error/UnsupportedCrit",
+
+			signature: header(`{"alg":"EdDSA","crit":["exp"],"exp":0}`) + ".." + signatureParts[2],
+			usage:     "test-usage",
+
+			keysConfig: keysConfig,
+
+			expectErr: core.ErrJwsDetachedUnsupportedCrit,
+		},
+		{
+			// A token's signature does not pass for a payload signature either.
+			name: "Error/TokenType",
+
+			signature: header(`{"alg":"EdDSA","typ":"JWT"}`) + ".." + signatureParts[2],
+			usage:     "test-usage",
+
+			keysConfig: keysConfig,
+
+			expectErr: core.ErrJwsDetachedType,
+		},
+		{
+			// Tokens signed before they were typed carry no type: neither may a payload signature.
+			name: "Error/NoType",
+
+			signature: header(`{"alg":"EdDSA"}`) + ".." + signatureParts[2],
+			usage:     "test-usage",
+
+			keysConfig: keysConfig,
+
+			expectErr: core.ErrJwsDetachedType,
+		},
+		{
+			name: "Error/B64NotCritical",
+
+			signature: header(`{"alg":"EdDSA","b64":false}`) + ".." + signatureParts[2],
+			usage:     "test-usage",
+
+			keysConfig: keysConfig,
+
+			expectErr: core.ErrJwsDetachedMalformed,
+		},
+		{
+			name: "Error/AlgNotUsed",
+
+			signature: header(`{"alg":"ES256","typ":"payload+jose"}`) + ".." + signatureParts[2],
+			usage:     "test-usage",
+
+			keysConfig: keysConfig,
+
+			expectErr: jws.ErrInvalidSignature,
+		},
+		{
+			name: "Error/AlgNotAllowed",
+
+			signature: signature,
+			usage:     "test-usage",
+
+			keysConfig: map[string]*config.Jwk{
+				"test-usage": {Alg: jwa.EdDSA, Policy: &config.JwkPolicy{Algs: []jwa.Alg{jwa.ES256}}},
+			},
+
+			expectErr: config.ErrJwkPolicyAlgNotAllowed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, publicSources, err := newPayloadSources(nil, []*jwa.JWK{publicKey}, testCase.keysConfig)
+			require.NoError(t, err)
+
+			err = core.NewPayloadVerify(publicSources, testCase.keysConfig).Exec(t.Context(), &core.PayloadVerifyRequest{
+				Signature: testCase.signature,
+				Payload:   payload,
+				Usage:     testCase.usage,
+			})
+			require.ErrorIs(t, err, testCase.expectErr)
+		})
+	}
+}
+
+func TestPayloadSign(t *testing.T) {
+	t.Parallel()
+
+	legacyPrivateKey, _ := generatePayloadKey(t, jwa.EdDSA)
+	privateKey, publicKey := generatePayloadKey(t, jwa.ES256)
+
+	testCases := []struct {
+		name string
+
+		privateKeys []*jwa.JWK
+		usage       string
+		keysConfig  map[string]*config.Jwk
+		freezeErr   error
+
+		expectKID string
+		expectErr error
+	}{
+		{
+			name: "Success/SkipsLegacyKey",
+
+			// The newest key still uses the previous algorithm: only the key of the configured one signs.
+			privateKeys: []*jwa.JWK{legacyPrivateKey, privateKey},
+			usage:       "test-usage",
+			keysConfig: map[string]*config.Jwk{
+				"test-usage": {Alg: jwa.ES256, PreviousAlgs: []jwa.Alg{jwa.EdDSA}},
+			},
+
+			expectKID: privateKey.KID,
+		},
+		{
+			name: "Error/UnknownUsage",
+
+			privateKeys: []*jwa.JWK{privateKey},
+			usage:       "other-usage",
+			keysConfig:  map[string]*config.Jwk{"test-usage": {Alg: jwa.ES256}},
+
+			expectErr: core.ErrConfigNotFound,
+		},
+		{
+			name: "Error/NoKey",
+
+			privateKeys: []*jwa.JWK{legacyPrivateKey},
+			usage:       "test-usage",
+			keysConfig:  map[string]*config.Jwk{"test-usage": {Alg: jwa.ES256}},
+
+			expectErr: core.ErrJwkNotFound,
+		},
+		{
+			name: "Error/Frozen",
+
+			privateKeys: []*jwa.JWK{privateKey},
+			usage:       "test-usage",
+			keysConfig:  map[string]*config.Jwk{"test-usage": {Alg: jwa.ES256}},
+			freezeErr:   core.ErrSigningFrozen,
+
+			expectErr: core.ErrSigningFrozen,
+		},
+		{
+			name: "Error/AlgNotAllowed",
+
+			privateKeys: []*jwa.JWK{privateKey},
+			usage:       "test-usage",
+			keysConfig: map[string]*config.Jwk{
+				"test-usage": {Alg: jwa.ES256, Policy: &config.JwkPolicy{Algs: []jwa.Alg{jwa.EdDSA}}},
+			},
+
+			expectErr: config.ErrJwkPolicyAlgNotAllowed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			privateSources, _, err := newPayloadSources(
+				testCase.privateKeys, []*jwa.JWK{publicKey}, testCase.keysConfig,
+			)
+			require.NoError(t, err)
+
+			serviceFreeze := coremocks.NewMockPayloadSignServiceFreeze(t)
+
+			if _, ok := testCase.keysConfig[testCase.usage]; ok {
+				serviceFreeze.EXPECT().
+					Exec(mock.Anything, &core.SigningFreezeCheckRequest{Usage: testCase.usage}).
+					Return(testCase.freezeErr)
+			}
+
+			signature, err := core.NewPayloadSign(privateSources, serviceFreeze, testCase.keysConfig).Exec(
+				t.Context(), &core.PayloadSignRequest{Payload: []byte("hello"), Usage: testCase.usage},
+			)
+			require.ErrorIs(t, err, testCase.expectErr)
+
+			if testCase.expectErr != nil {
+				return
+			}
+
+			token, err := core.ParseJwsDetached(signature)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectKID, token.Header.KID)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package core
+
+import (
+	"errors"
+	"time"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// ErrTokenRevoked is returned when a token was revoked. See [TokenRevoke].
+var ErrTokenRevoked = errors.New("token is revoked")
+
+// ErrTokenRevokeNoID is returned when a revocation names no token ID: the request carries
+// neither a token nor an ID, or the token has no "jti" claim.
+var ErrTokenRevokeNoID = errors.New("no token id to revoke")
+
+// ErrTokenRevokeInvalid is returned when the token to revoke does not verify for its usage.
+var ErrTokenRevokeInvalid = errors.New("token to revoke does not verify")
+
+// RevokedToken describes the revocation of a single token.
+type RevokedToken struct {
+	// TokenID is the "jti" claim of the revoked token.
+	TokenID string
+	// Usage is the key usage the token was signed for.
+	Usage string
+	// ExpiresAt is when the token stops verifying anyway, leeway included. The revocation is
+	// forgotten past this time.
+	ExpiresAt time.Time
+	// RevokedAt is when the token was revoked.
+	RevokedAt time.Time
+}
+
+func newRevokedToken(entity *dao.RevokedToken) *RevokedToken {
+	return &RevokedToken{
+		TokenID:   entity.JTI,
+		Usage:     entity.Usage,
+		ExpiresAt: entity.ExpiresAt,
+		RevokedAt: entity.RevokedAt,
+	}
+}
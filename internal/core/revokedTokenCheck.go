@@ -0,0 +1,168 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+)
+
+// RevokedTokenRefreshInterval is how often a server-side [RevokedTokenCheck] pulls new
+// revocations, and so how long a server keeps accepting a token after it is revoked.
+const RevokedTokenRefreshInterval = time.Second
+
+// revokedTokenSyncTimeout bounds a background sync of a [RevokedTokenCheck].
+const revokedTokenSyncTimeout = 10 * time.Second
+
+// RevokedTokenCheckService is the sync dependency of [RevokedTokenCheck]. See [RevokedTokenSync].
+type RevokedTokenCheckService interface {
+	Exec(ctx context.Context, request *RevokedTokenSyncRequest) (*RevokedTokenSyncResult, error)
+}
+
+// RevokedTokenCheckRequest holds the parameters for a [RevokedTokenCheck.Exec] call.
+type RevokedTokenCheckRequest struct {
+	// Usage is the key usage the token verified under. A revocation only applies under the usage it
+	// was made for.
+	Usage string
+	// TokenID is the "jti" claim of the token to check.
+	TokenID string
+}
+
+// revokedTokenKey identifies a revoked token: a token ID is only unique within its usage.
+type revokedTokenKey struct {
+	usage string
+	jti   string
+}
+
+// A RevokedTokenCheck tells whether a token was revoked. See [TokenRevoke].
+//
+// It keeps a copy of the denylist in memory: every refresh interval, it pulls the revocations
+// made since its last sync, and forgets the ones whose token expired. Syncs run in the
+// background, so checks never wait for them, and a failed sync leaves the copy as it was: an
+// outage neither lifts a revocation, nor stops verification. The copy is empty until the first
+// sync succeeds: [RevokedTokenCheck.Sync] pulls it ahead of the first check.
+//
+// It is safe for concurrent use.
+type RevokedTokenCheck struct {
+	service         RevokedTokenCheckService
+	refreshInterval time.Duration
+
+	mu        sync.Mutex
+	revoked   map[revokedTokenKey]time.Time
+	cursor    time.Time
+	fetchedAt time.Time
+	syncing   bool
+}
+
+// NewRevokedTokenCheck returns a new RevokedTokenCheck service, that syncs every refresh
+// interval. Servers use [RevokedTokenRefreshInterval].
+func NewRevokedTokenCheck(service RevokedTokenCheckService, refreshInterval time.Duration) *RevokedTokenCheck {
+	return &RevokedTokenCheck{service: service, refreshInterval: refreshInterval}
+}
+
+// Exec returns [ErrTokenRevoked] when the token was revoked. A token without an ID cannot be
+// revoked, and always passes.
+func (service *RevokedTokenCheck) Exec(ctx context.Context, request *RevokedTokenCheckRequest) error {
+	ctx, span := otel.Tracer().Start(ctx, "core.RevokedTokenCheck")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("key.usage", request.Usage),
+		attribute.String("token.jti", request.TokenID),
+	)
+
+	if request.TokenID == "" {
+		otel.ReportSuccessNoContent(span)
+
+		return nil
+	}
+
+	if service.isRevoked(ctx, revokedTokenKey{usage: request.Usage, jti: request.TokenID}) {
+		return otel.ReportError(span, fmt.Errorf("%w: %s", ErrTokenRevoked, request.TokenID))
+	}
+
+	otel.ReportSuccessNoContent(span)
+
+	return nil
+}
+
+// Sync pulls the revocations made since the last sync, and waits for them to apply. Checks sync on
+// their own; Sync fills the copy before the first one.
+func (service *RevokedTokenCheck) Sync(ctx context.Context) error {
+	service.mu.Lock()
+	cursor := service.cursor
+	service.mu.Unlock()
+
+	// The lock is not held during the call, so checks go on with the current copy.
+	result, err := service.service.Exec(ctx, &RevokedTokenSyncRequest{Since: cursor})
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	now := time.Now()
+
+	// A failed sync is retried at the next refresh, not at the next check.
+	service.fetchedAt = now
+
+	if err != nil {
+		return fmt.Errorf("sync revoked tokens: %w", err)
+	}
+
+	if service.revoked == nil {
+		service.revoked = make(map[revokedTokenKey]time.Time, len(result.Tokens))
+	}
+
+	for _, token := range result.Tokens {
+		service.revoked[revokedTokenKey{usage: token.Usage, jti: token.TokenID}] = token.ExpiresAt
+	}
+
+	// Expired tokens fail verification anyway.
+	maps.DeleteFunc(service.revoked, func(_ revokedTokenKey, expiresAt time.Time) bool {
+		return !expiresAt.After(now)
+	})
+
+	// Syncs may overlap: the cursor never goes back.
+	if result.Cursor.After(service.cursor) {
+		service.cursor = result.Cursor
+	}
+
+	return nil
+}
+
+// isRevoked answers from the current copy, and starts a sync in the background when the copy is
+// due.
+func (service *RevokedTokenCheck) isRevoked(ctx context.Context, key revokedTokenKey) bool {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if !service.syncing && time.Since(service.fetchedAt) >= service.refreshInterval {
+		service.syncing = true
+
+		// The sync outlives the check that started it, but keeps the dependencies of its context.
+		go service.refresh(context.WithoutCancel(ctx))
+	}
+
+	expiresAt, ok := service.revoked[key]
+
+	return ok && expiresAt.After(time.Now())
+}
+
+// refresh runs a background sync.
+func (service *RevokedTokenCheck) refresh(ctx context.Context) {
+	defer func() {
+		service.mu.Lock()
+		service.syncing = false
+		service.mu.Unlock()
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, revokedTokenSyncTimeout)
+	defer cancel()
+
+	// The copy is kept on failure; the error is on the span of the sync service.
+	_ = service.Sync(ctx)
+}
@@ -0,0 +1,173 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+)
+
+func TestRevokedTokenCheck(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	const refreshInterval = 10 * time.Millisecond
+
+	hourAgo := time.Now().Add(-time.Hour)
+	hourLater := time.Now().Add(time.Hour)
+
+	first := &core.RevokedTokenSyncResult{
+		Tokens: []*core.RevokedToken{
+			{TokenID: "revoked-token", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: hourAgo},
+			{TokenID: "expired-token", Usage: "test-usage", ExpiresAt: hourAgo, RevokedAt: hourAgo},
+		},
+		Cursor: hourAgo,
+	}
+
+	check := func(t *testing.T, service *core.RevokedTokenCheck, tokenID string) error {
+		t.Helper()
+
+		return service.Exec(t.Context(), &core.RevokedTokenCheckRequest{Usage: "test-usage", TokenID: tokenID})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		sync := coremocks.NewMockRevokedTokenCheckService(t)
+		sync.EXPECT().Exec(mock.Anything, &core.RevokedTokenSyncRequest{}).Return(first, nil).Once()
+
+		service := core.NewRevokedTokenCheck(sync, time.Hour)
+		require.NoError(t, service.Sync(t.Context()))
+
+		require.NoError(t, check(t, service, "test-token"))
+		require.NoError(t, check(t, service, "expired-token"))
+		require.ErrorIs(t, check(t, service, "revoked-token"), core.ErrTokenRevoked)
+
+		// A token ID is only revoked under its usage.
+		require.NoError(
+			t, service.Exec(t.Context(), &core.RevokedTokenCheckRequest{Usage: "other-usage", TokenID: "revoked-token"}),
+		)
+
+		// Every check was answered by a single sync.
+		sync.AssertExpectations(t)
+	})
+
+	t.Run("Success/NoTokenID", func(t *testing.T) {
+		t.Parallel()
+
+		sync := coremocks.NewMockRevokedTokenCheckService(t)
+
+		service := core.NewRevokedTokenCheck(sync, time.Hour)
+
+		// A token without an ID cannot be revoked: the denylist is not even pulled.
+		require.NoError(t, service.Exec(t.Context(), &core.RevokedTokenCheckRequest{}))
+
+		sync.AssertExpectations(t)
+	})
+
+	t.Run("Success/Background", func(t *testing.T) {
+		t.Parallel()
+
+		release := make(chan struct{})
+
+		sync := coremocks.NewMockRevokedTokenCheckService(t)
+		sync.EXPECT().
+			Exec(mock.Anything, &core.RevokedTokenSyncRequest{}).
+			RunAndReturn(func(context.Context, *core.RevokedTokenSyncRequest) (*core.RevokedTokenSyncResult, error) {
+				<-release
+
+				return first, nil
+			}).
+			Once()
+
+		service := core.NewRevokedTokenCheck(sync, time.Hour)
+
+		// Checks do not wait for the sync they start: the copy is still empty.
+		require.NoError(t, check(t, service, "revoked-token"))
+		require.NoError(t, check(t, service, "revoked-token"))
+
+		close(release)
+
+		require.Eventually(t, func() bool {
+			err := check(t, service, "revoked-token")
+
+			return errors.Is(err, core.ErrTokenRevoked)
+		}, time.Second, time.Millisecond)
+
+		// Checks made during the sync did not start another one.
+		sync.AssertExpectations(t)
+	})
+
+	t.Run("Success/Incremental", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+
+		sync := coremocks.NewMockRevokedTokenCheckService(t)
+		sync.EXPECT().Exec(mock.Anything, &core.RevokedTokenSyncRequest{}).Return(first, nil).Once()
+		sync.EXPECT().
+			Exec(mock.Anything, &core.RevokedTokenSyncRequest{Since: hourAgo}).
+			Return(&core.RevokedTokenSyncResult{
+				Tokens: []*core.RevokedToken{
+					{TokenID: "test-token", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: now},
+				},
+				Cursor: now,
+			}, nil).
+			Once()
+
+		service := core.NewRevokedTokenCheck(sync, refreshInterval)
+		require.NoError(t, service.Sync(t.Context()))
+
+		require.NoError(t, check(t, service, "test-token"))
+
+		time.Sleep(refreshInterval)
+
+		// The new revocation adds up to the ones already pulled.
+		require.Eventually(t, func() bool {
+			err := check(t, service, "test-token")
+
+			return errors.Is(err, core.ErrTokenRevoked)
+		}, time.Second, time.Millisecond)
+		require.ErrorIs(t, check(t, service, "revoked-token"), core.ErrTokenRevoked)
+	})
+
+	t.Run("Success/StaleOnError", func(t *testing.T) {
+		t.Parallel()
+
+		sync := coremocks.NewMockRevokedTokenCheckService(t)
+		sync.EXPECT().Exec(mock.Anything, &core.RevokedTokenSyncRequest{}).Return(first, nil).Once()
+		sync.EXPECT().Exec(mock.Anything, &core.RevokedTokenSyncRequest{Since: hourAgo}).Return(nil, errFoo).Once()
+
+		service := core.NewRevokedTokenCheck(sync, time.Hour)
+		require.NoError(t, service.Sync(t.Context()))
+		require.ErrorIs(t, service.Sync(t.Context()), errFoo)
+
+		// The revocation outlives the failed sync.
+		require.ErrorIs(t, check(t, service, "revoked-token"), core.ErrTokenRevoked)
+
+		sync.AssertExpectations(t)
+	})
+
+	t.Run("Error/Sync", func(t *testing.T) {
+		t.Parallel()
+
+		sync := coremocks.NewMockRevokedTokenCheckService(t)
+		sync.EXPECT().Exec(mock.Anything, mock.Anything).Return(nil, errFoo).Once()
+
+		service := core.NewRevokedTokenCheck(sync, time.Hour)
+
+		require.ErrorIs(t, service.Sync(t.Context()), errFoo)
+
+		// Verification goes on without the denylist.
+		require.NoError(t, check(t, service, "test-token"))
+
+		sync.AssertExpectations(t)
+	})
+}
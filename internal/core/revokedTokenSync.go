@@ -0,0 +1,89 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// RevokedTokenSyncOverlap is how far back before its cursor a [RevokedTokenSync] looks. A
+// revocation is timed by the server that made it, and may commit after a later one: the overlap
+// covers clock skew and slow commits, at the cost of returning some revocations twice.
+const RevokedTokenSyncOverlap = time.Minute
+
+// RevokedTokenSyncDao is the DAO dependency of [RevokedTokenSync].
+type RevokedTokenSyncDao interface {
+	Exec(ctx context.Context, request *dao.RevokedTokenListRequest) ([]*dao.RevokedToken, error)
+}
+
+// RevokedTokenSyncRequest holds the parameters for a [RevokedTokenSync.Exec] call.
+type RevokedTokenSyncRequest struct {
+	// Since is the cursor returned by the previous sync. The zero value returns every
+	// revocation.
+	Since time.Time
+}
+
+// RevokedTokenSyncResult holds the outcome of a [RevokedTokenSync.Exec] call.
+type RevokedTokenSyncResult struct {
+	// Tokens lists the revocations made since the cursor, oldest first. Revocations of expired
+	// tokens are left out.
+	Tokens []*RevokedToken
+	// Cursor is the value of [RevokedTokenSyncRequest.Since] for the next sync.
+	Cursor time.Time
+}
+
+// A RevokedTokenSync returns the revocations of unexpired tokens made since a cursor, so a
+// verifier keeps a copy of the denylist with incremental pulls. See [RevokedTokenCheck].
+//
+// Pulls overlap by [RevokedTokenSyncOverlap]: a verifier may receive a revocation more than
+// once.
+type RevokedTokenSync struct {
+	dao RevokedTokenSyncDao
+}
+
+// NewRevokedTokenSync returns a new RevokedTokenSync service.
+func NewRevokedTokenSync(dao RevokedTokenSyncDao) *RevokedTokenSync {
+	return &RevokedTokenSync{dao: dao}
+}
+
+func (service *RevokedTokenSync) Exec(
+	ctx context.Context, request *RevokedTokenSyncRequest,
+) (*RevokedTokenSyncResult, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.RevokedTokenSync")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int64("tokens.since", request.Since.Unix()))
+
+	since := request.Since
+	if !since.IsZero() {
+		since = since.Add(-RevokedTokenSyncOverlap)
+	}
+
+	entities, err := service.dao.Exec(ctx, &dao.RevokedTokenListRequest{Since: since, Now: time.Now()})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list revoked tokens: %w", err))
+	}
+
+	output := &RevokedTokenSyncResult{
+		Tokens: make([]*RevokedToken, len(entities)),
+		Cursor: request.Since,
+	}
+
+	for i, entity := range entities {
+		output.Tokens[i] = newRevokedToken(entity)
+
+		if entity.RevokedAt.After(output.Cursor) {
+			output.Cursor = entity.RevokedAt
+		}
+	}
+
+	span.SetAttributes(attribute.Int("tokens.count", len(output.Tokens)))
+
+	return otel.ReportSuccess(span, output), nil
+}
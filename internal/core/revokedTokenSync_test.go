@@ -0,0 +1,135 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestRevokedTokenSync(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	hourAgo := time.Now().Add(-time.Hour)
+	minuteAgo := time.Now().Add(-time.Minute)
+	now := time.Now()
+	hourLater := time.Now().Add(time.Hour)
+
+	type daoMock struct {
+		since time.Time
+
+		resp []*dao.RevokedToken
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.RevokedTokenSyncRequest
+
+		daoMock *daoMock
+
+		expect    *core.RevokedTokenSyncResult
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.RevokedTokenSyncRequest{Since: hourAgo},
+
+			daoMock: &daoMock{
+				// Pulls overlap, for the revocations committed late.
+				since: hourAgo.Add(-core.RevokedTokenSyncOverlap),
+				resp: []*dao.RevokedToken{
+					{JTI: "token-a", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: minuteAgo},
+					{JTI: "token-b", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: now},
+				},
+			},
+
+			expect: &core.RevokedTokenSyncResult{
+				Tokens: []*core.RevokedToken{
+					{TokenID: "token-a", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: minuteAgo},
+					{TokenID: "token-b", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: now},
+				},
+				Cursor: now,
+			},
+		},
+		{
+			name: "Success/FirstSync",
+
+			request: &core.RevokedTokenSyncRequest{},
+
+			daoMock: &daoMock{
+				resp: []*dao.RevokedToken{
+					{JTI: "token-a", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: minuteAgo},
+				},
+			},
+
+			expect: &core.RevokedTokenSyncResult{
+				Tokens: []*core.RevokedToken{
+					{TokenID: "token-a", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: minuteAgo},
+				},
+				Cursor: minuteAgo,
+			},
+		},
+		{
+			// Revocations already pulled do not move the cursor back.
+			name: "Success/NoRevocation",
+
+			request: &core.RevokedTokenSyncRequest{Since: now},
+
+			daoMock: &daoMock{
+				since: now.Add(-core.RevokedTokenSyncOverlap),
+				resp: []*dao.RevokedToken{
+					{JTI: "token-a", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: minuteAgo},
+				},
+			},
+
+			expect: &core.RevokedTokenSyncResult{
+				Tokens: []*core.RevokedToken{
+					{TokenID: "token-a", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: minuteAgo},
+				},
+				Cursor: now,
+			},
+		},
+		{
+			name: "Error/Dao",
+
+			request: &core.RevokedTokenSyncRequest{},
+
+			daoMock: &daoMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoList := coremocks.NewMockRevokedTokenSyncDao(t)
+
+			daoList.EXPECT().
+				Exec(mock.Anything, mock.MatchedBy(func(request *dao.RevokedTokenListRequest) bool {
+					return request.Since.Equal(testCase.daoMock.since) && !request.Now.IsZero()
+				})).
+				Return(testCase.daoMock.resp, testCase.daoMock.err)
+
+			service := core.NewRevokedTokenSync(daoList)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoList.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package core
+
+import "context"
+
+// SignatureOperation names the kind of signature made by a signing service.
+type SignatureOperation string
+
+const (
+	// SignatureOperationClaims is a JWT, signed by [ClaimsSign]. Multi-signature tokens count once
+	// per signature.
+	SignatureOperationClaims SignatureOperation = "claims"
+	// SignatureOperationPayload is a detached JWS, signed by [PayloadSign].
+	SignatureOperationPayload SignatureOperation = "payload"
+	// SignatureOperationHttp is an HTTP message signature, signed by [HttpSignatureSign].
+	SignatureOperationHttp SignatureOperation = "http_signature"
+)
+
+// A SignatureRecorder is told of every signature made by the signing services, for example to
+// count them.
+type SignatureRecorder interface {
+	RecordSignature(operation SignatureOperation, usage, kid string)
+}
+
+// signatureRecorderContext is the context key used to store the recorder of signatures.
+type signatureRecorderContext struct{}
+
+// NewSignatureRecorderContext returns a copy of ctx that reports the signatures made with it to
+// recorder.
+func NewSignatureRecorderContext(ctx context.Context, recorder SignatureRecorder) context.Context {
+	return context.WithValue(ctx, signatureRecorderContext{}, recorder)
+}
+
+// TransferSignatureRecorderContext copies the recorder held by baseCtx onto a context derived
+// from destCtx. When baseCtx holds no recorder, destCtx is returned unchanged.
+func TransferSignatureRecorderContext(baseCtx, destCtx context.Context) context.Context {
+	recorder, ok := baseCtx.Value(signatureRecorderContext{}).(SignatureRecorder)
+	if !ok {
+		return destCtx
+	}
+
+	return NewSignatureRecorderContext(destCtx, recorder)
+}
+
+// recordSignature reports a signature to the recorder of ctx, if any.
+func recordSignature(ctx context.Context, operation SignatureOperation, usage, kid string) {
+	recorder, ok := ctx.Value(signatureRecorderContext{}).(SignatureRecorder)
+	if !ok {
+		return
+	}
+
+	recorder.RecordSignature(operation, usage, kid)
+}
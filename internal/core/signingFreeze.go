@@ -0,0 +1,33 @@
+package core
+
+import (
+	"errors"
+	"time"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// ErrSigningFrozen is returned when a token is requested for a usage whose signing is frozen.
+// See [SigningFreezeSet].
+var ErrSigningFrozen = errors.New("signing is frozen for the requested usage")
+
+// ErrSigningFreezeNoComment is returned when a usage is frozen without a reason.
+var ErrSigningFreezeNoComment = errors.New("a signing freeze needs a comment")
+
+// SigningFreeze describes the signing freeze of a usage.
+type SigningFreeze struct {
+	// Usage is the frozen key usage.
+	Usage string
+	// Comment is the reason of the freeze.
+	Comment string
+	// FrozenAt is when the usage was frozen.
+	FrozenAt time.Time
+}
+
+func newSigningFreeze(entity *dao.SigningFreeze) *SigningFreeze {
+	return &SigningFreeze{
+		Usage:    entity.Usage,
+		Comment:  entity.Comment,
+		FrozenAt: entity.FrozenAt,
+	}
+}
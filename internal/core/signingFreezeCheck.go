@@ -0,0 +1,99 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+)
+
+// SigningFreezeRefreshInterval is how long a [SigningFreezeCheck] caches the freezes, and so how
+// long a server keeps signing after its usage is frozen.
+const SigningFreezeRefreshInterval = time.Second
+
+// SigningFreezeCheckService is the freeze list dependency of [SigningFreezeCheck].
+type SigningFreezeCheckService interface {
+	Exec(ctx context.Context, request *SigningFreezeListRequest) ([]*SigningFreeze, error)
+}
+
+// SigningFreezeCheckRequest holds the parameters for a [SigningFreezeCheck.Exec] call.
+type SigningFreezeCheckRequest struct {
+	// Usage is the key usage about to sign.
+	Usage string
+}
+
+// A SigningFreezeCheck tells whether the signing of a usage is frozen. See [SigningFreezeSet].
+//
+// The freezes are read for every usage at once, and cached for [SigningFreezeRefreshInterval],
+// so signing does not query the database on every request. When they cannot be read, the last
+// freezes read keep applying: a database outage neither lifts a freeze, nor stops signing.
+//
+// It is safe for concurrent use.
+type SigningFreezeCheck struct {
+	service SigningFreezeCheckService
+
+	mu        sync.Mutex
+	frozen    map[string]bool
+	fetchedAt time.Time
+}
+
+// NewSigningFreezeCheck returns a new SigningFreezeCheck service.
+func NewSigningFreezeCheck(service SigningFreezeCheckService) *SigningFreezeCheck {
+	return &SigningFreezeCheck{service: service}
+}
+
+// Exec returns [ErrSigningFrozen] when the usage is frozen.
+func (service *SigningFreezeCheck) Exec(ctx context.Context, request *SigningFreezeCheckRequest) error {
+	ctx, span := otel.Tracer().Start(ctx, "core.SigningFreezeCheck")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("key.usage", request.Usage))
+
+	frozen, err := service.load(ctx)
+	if err != nil {
+		return otel.ReportError(span, err)
+	}
+
+	if frozen[request.Usage] {
+		return otel.ReportError(span, fmt.Errorf("%w: %s", ErrSigningFrozen, request.Usage))
+	}
+
+	otel.ReportSuccessNoContent(span)
+
+	return nil
+}
+
+func (service *SigningFreezeCheck) load(ctx context.Context) (map[string]bool, error) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if service.frozen != nil && time.Since(service.fetchedAt) < SigningFreezeRefreshInterval {
+		return service.frozen, nil
+	}
+
+	freezes, err := service.service.Exec(ctx, &SigningFreezeListRequest{})
+	if err != nil {
+		if service.frozen != nil {
+			// Keep the last freezes read until the next refresh; the error is on the span of the
+			// list service.
+			service.fetchedAt = time.Now()
+
+			return service.frozen, nil
+		}
+
+		return nil, fmt.Errorf("list freezes: %w", err)
+	}
+
+	service.frozen = make(map[string]bool, len(freezes))
+	for _, freeze := range freezes {
+		service.frozen[freeze.Usage] = true
+	}
+
+	service.fetchedAt = time.Now()
+
+	return service.frozen, nil
+}
@@ -0,0 +1,94 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+)
+
+func TestSigningFreezeCheck(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	freezes := []*core.SigningFreeze{{Usage: "frozen-usage", Comment: "incident", FrozenAt: time.Now()}}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		list := coremocks.NewMockSigningFreezeCheckService(t)
+		list.EXPECT().Exec(mock.Anything, &core.SigningFreezeListRequest{}).Return(freezes, nil).Once()
+
+		service := core.NewSigningFreezeCheck(list)
+
+		require.NoError(t, service.Exec(t.Context(), &core.SigningFreezeCheckRequest{Usage: "test-usage"}))
+		require.ErrorIs(
+			t, service.Exec(t.Context(), &core.SigningFreezeCheckRequest{Usage: "frozen-usage"}), core.ErrSigningFrozen,
+		)
+
+		// Both checks were answered by a single read.
+		list.AssertExpectations(t)
+	})
+
+	t.Run("Success/Refresh", func(t *testing.T) {
+		t.Parallel()
+
+		list := coremocks.NewMockSigningFreezeCheckService(t)
+		list.EXPECT().Exec(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		list.EXPECT().Exec(mock.Anything, mock.Anything).Return(freezes, nil).Once()
+
+		service := core.NewSigningFreezeCheck(list)
+
+		require.NoError(t, service.Exec(t.Context(), &core.SigningFreezeCheckRequest{Usage: "frozen-usage"}))
+
+		time.Sleep(core.SigningFreezeRefreshInterval)
+
+		require.ErrorIs(
+			t, service.Exec(t.Context(), &core.SigningFreezeCheckRequest{Usage: "frozen-usage"}), core.ErrSigningFrozen,
+		)
+
+		list.AssertExpectations(t)
+	})
+
+	t.Run("Success/StaleOnError", func(t *testing.T) {
+		t.Parallel()
+
+		list := coremocks.NewMockSigningFreezeCheckService(t)
+		list.EXPECT().Exec(mock.Anything, mock.Anything).Return(freezes, nil).Once()
+		list.EXPECT().Exec(mock.Anything, mock.Anything).Return(nil, errFoo).Once()
+
+		service := core.NewSigningFreezeCheck(list)
+
+		require.ErrorIs(
+			t, service.Exec(t.Context(), &core.SigningFreezeCheckRequest{Usage: "frozen-usage"}), core.ErrSigningFrozen,
+		)
+
+		time.Sleep(core.SigningFreezeRefreshInterval)
+
+		// The freeze outlives the failed read.
+		require.ErrorIs(
+			t, service.Exec(t.Context(), &core.SigningFreezeCheckRequest{Usage: "frozen-usage"}), core.ErrSigningFrozen,
+		)
+
+		list.AssertExpectations(t)
+	})
+
+	t.Run("Error/List", func(t *testing.T) {
+		t.Parallel()
+
+		list := coremocks.NewMockSigningFreezeCheckService(t)
+		list.EXPECT().Exec(mock.Anything, mock.Anything).Return(nil, errFoo).Once()
+
+		service := core.NewSigningFreezeCheck(list)
+
+		require.ErrorIs(t, service.Exec(t.Context(), &core.SigningFreezeCheckRequest{Usage: "test-usage"}), errFoo)
+
+		list.AssertExpectations(t)
+	})
+}
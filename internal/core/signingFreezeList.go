@@ -0,0 +1,50 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// SigningFreezeListDao is the DAO dependency of [SigningFreezeList].
+type SigningFreezeListDao interface {
+	Exec(ctx context.Context, request *dao.SigningFreezeListRequest) ([]*dao.SigningFreeze, error)
+}
+
+// SigningFreezeListRequest holds the parameters for a [SigningFreezeList.Exec] call. It is
+// empty: every freeze is listed.
+type SigningFreezeListRequest struct{}
+
+// A SigningFreezeList lists the usages whose signing is frozen, by usage. See [SigningFreezeSet].
+type SigningFreezeList struct {
+	dao SigningFreezeListDao
+}
+
+// NewSigningFreezeList returns a new SigningFreezeList service.
+func NewSigningFreezeList(dao SigningFreezeListDao) *SigningFreezeList {
+	return &SigningFreezeList{dao: dao}
+}
+
+func (service *SigningFreezeList) Exec(ctx context.Context, _ *SigningFreezeListRequest) ([]*SigningFreeze, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.SigningFreezeList")
+	defer span.End()
+
+	entities, err := service.dao.Exec(ctx, &dao.SigningFreezeListRequest{})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list freezes: %w", err))
+	}
+
+	output := make([]*SigningFreeze, len(entities))
+	for i, entity := range entities {
+		output[i] = newSigningFreeze(entity)
+	}
+
+	span.SetAttributes(attribute.Int("freezes.count", len(output)))
+
+	return otel.ReportSuccess(span, output), nil
+}
@@ -0,0 +1,79 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestSigningFreezeList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	now := time.Now()
+
+	type daoMock struct {
+		resp []*dao.SigningFreeze
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		daoMock *daoMock
+
+		expect    []*core.SigningFreeze
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			daoMock: &daoMock{
+				resp: []*dao.SigningFreeze{{Usage: "test-usage", Comment: "incident", FrozenAt: now}},
+			},
+
+			expect: []*core.SigningFreeze{{Usage: "test-usage", Comment: "incident", FrozenAt: now}},
+		},
+		{
+			name: "Success/NoFreeze",
+
+			daoMock: &daoMock{},
+
+			expect: []*core.SigningFreeze{},
+		},
+		{
+			name: "Error/Dao",
+
+			daoMock: &daoMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			dao := coremocks.NewMockSigningFreezeListDao(t)
+
+			dao.EXPECT().
+				Exec(mock.Anything, mock.Anything).
+				Return(testCase.daoMock.resp, testCase.daoMock.err)
+
+			service := core.NewSigningFreezeList(dao)
+
+			resp, err := service.Exec(t.Context(), &core.SigningFreezeListRequest{})
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			dao.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestSigningFreezeSet(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	now := time.Now()
+
+	keysConfig := map[string]*config.Jwk{"test-usage": {}}
+
+	type daoInsertMock struct {
+		resp *dao.SigningFreeze
+		err  error
+	}
+
+	type daoDeleteMock struct {
+		err error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.SigningFreezeSetRequest
+
+		daoInsertMock *daoInsertMock
+		daoDeleteMock *daoDeleteMock
+
+		expect    *core.SigningFreeze
+		expectErr error
+	}{
+		{
+			name: "Success/Freeze",
+
+			request: &core.SigningFreezeSetRequest{Usage: "test-usage", Frozen: true, Comment: "incident"},
+
+			daoInsertMock: &daoInsertMock{
+				resp: &dao.SigningFreeze{Usage: "test-usage", Comment: "incident", FrozenAt: now},
+			},
+
+			expect: &core.SigningFreeze{Usage: "test-usage", Comment: "incident", FrozenAt: now},
+		},
+		{
+			name: "Success/Unfreeze",
+
+			request: &core.SigningFreezeSetRequest{Usage: "test-usage"},
+
+			daoDeleteMock: &daoDeleteMock{},
+		},
+		{
+			name: "Success/UnfreezeNotFrozen",
+
+			request: &core.SigningFreezeSetRequest{Usage: "test-usage"},
+
+			daoDeleteMock: &daoDeleteMock{err: dao.ErrSigningFreezeDeleteNotFound},
+		},
+		{
+			name: "Error/ConfigNotFound",
+
+			request: &core.SigningFreezeSetRequest{Usage: "unknown-usage", Frozen: true, Comment: "incident"},
+
+			expectErr: core.ErrConfigNotFound,
+		},
+		{
+			name: "Error/NoComment",
+
+			request: &core.SigningFreezeSetRequest{Usage: "test-usage", Frozen: true},
+
+			expectErr: core.ErrSigningFreezeNoComment,
+		},
+		{
+			name: "Error/Insert",
+
+			request: &core.SigningFreezeSetRequest{Usage: "test-usage", Frozen: true, Comment: "incident"},
+
+			daoInsertMock: &daoInsertMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Delete",
+
+			request: &core.SigningFreezeSetRequest{Usage: "test-usage"},
+
+			daoDeleteMock: &daoDeleteMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoInsert := coremocks.NewMockSigningFreezeSetDaoInsert(t)
+			daoDelete := coremocks.NewMockSigningFreezeSetDaoDelete(t)
+
+			if testCase.daoInsertMock != nil {
+				daoInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(request *dao.SigningFreezeInsertRequest) bool {
+						return request.Usage == testCase.request.Usage && request.Comment == testCase.request.Comment &&
+							!request.Now.IsZero()
+					})).
+					Return(testCase.daoInsertMock.resp, testCase.daoInsertMock.err)
+			}
+
+			if testCase.daoDeleteMock != nil {
+				daoDelete.EXPECT().
+					Exec(mock.Anything, &dao.SigningFreezeDeleteRequest{Usage: testCase.request.Usage}).
+					Return(nil, testCase.daoDeleteMock.err)
+			}
+
+			service := core.NewSigningFreezeSet(daoInsert, daoDelete, keysConfig)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoInsert.AssertExpectations(t)
+			daoDelete.AssertExpectations(t)
+		})
+	}
+}
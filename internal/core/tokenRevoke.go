@@ -0,0 +1,165 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+// TokenRevokeDao is the DAO dependency of [TokenRevoke].
+type TokenRevokeDao interface {
+	Exec(ctx context.Context, request *dao.RevokedTokenInsertRequest) (*dao.RevokedToken, error)
+}
+
+// TokenRevokeServiceInspect is the verification dependency of [TokenRevoke]. See [ClaimsInspect].
+type TokenRevokeServiceInspect interface {
+	Exec(ctx context.Context, request *ClaimsInspectRequest) (*ClaimsInspectResult, error)
+}
+
+// TokenRevokeRequest holds the parameters for a [TokenRevoke.Exec] call. Either Token or TokenID
+// must be set.
+type TokenRevokeRequest struct {
+	// Usage is the key usage the token was signed for.
+	Usage string
+	// Token is the token to revoke, compact or in JWS JSON serialization. It must verify for the
+	// usage, expired or not. When set, TokenID is ignored.
+	Token string
+	// TokenID is the "jti" claim of the token to revoke, for callers that no longer hold the
+	// token.
+	TokenID string
+}
+
+// A TokenRevoke revokes a single token, by its usage and its "jti" claim: verifiers refuse it
+// from then on, while the key that signed it keeps verifying other tokens. To stop every token of a usage,
+// burn its keys instead, see [JwkBurn].
+//
+// The revocation is kept until the token expires, leeway included. Given the token, its "exp"
+// claim tells when; given its ID only, the revocation lasts the usage's token TTL from now,
+// which outlives any token issued so far. Revoking a token twice keeps the original revocation.
+//
+// Servers apply a revocation within [RevokedTokenRefreshInterval]; client verifiers within
+// their sync interval. See [RevokedTokenCheck].
+type TokenRevoke struct {
+	dao            TokenRevokeDao
+	serviceInspect TokenRevokeServiceInspect
+	keysConfig     map[string]*config.Jwk
+}
+
+// NewTokenRevoke returns a new TokenRevoke service.
+func NewTokenRevoke(
+	dao TokenRevokeDao, serviceInspect TokenRevokeServiceInspect, keysConfig map[string]*config.Jwk,
+) *TokenRevoke {
+	return &TokenRevoke{dao: dao, serviceInspect: serviceInspect, keysConfig: keysConfig}
+}
+
+func (service *TokenRevoke) Exec(ctx context.Context, request *TokenRevokeRequest) (*RevokedToken, error) {
+	ctx, span := otel.Tracer().Start(ctx, "core.TokenRevoke")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("key.usage", request.Usage))
+
+	revoked, err := service.revoke(ctx, request)
+
+	audit := &AuditRecordRequest{
+		Action: AuditActionTokenRevoke,
+		Usage:  request.Usage,
+		Token:  request.Token,
+		Err:    err,
+	}
+	if request.Token == "" {
+		audit.TokenID = request.TokenID
+	}
+
+	recordAudit(ctx, audit)
+
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(attribute.String("token.jti", revoked.TokenID))
+
+	return otel.ReportSuccess(span, revoked), nil
+}
+
+func (service *TokenRevoke) revoke(ctx context.Context, request *TokenRevokeRequest) (*RevokedToken, error) {
+	keyConfig, ok := service.keysConfig[request.Usage]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrConfigNotFound, request.Usage)
+	}
+
+	now := time.Now()
+	jti := request.TokenID
+	// Without the token, no token of the usage issued so far outlives this.
+	expiresAt := now.Add(keyConfig.Token.TTL + keyConfig.Token.Leeway)
+
+	if request.Token != "" {
+		claims, err := service.readToken(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		jti = claims.Jti
+		expiresAt = time.Unix(claims.Exp, 0).Add(keyConfig.Token.Leeway)
+	}
+
+	if jti == "" {
+		return nil, ErrTokenRevokeNoID
+	}
+
+	entity, err := service.dao.Exec(ctx, &dao.RevokedTokenInsertRequest{
+		JTI:       jti,
+		Usage:     request.Usage,
+		ExpiresAt: expiresAt,
+		Now:       now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("revoke token: %w", err)
+	}
+
+	return newRevokedToken(entity), nil
+}
+
+// readToken verifies the token to revoke, expired or not, and returns its claims. Only a token
+// signed for the usage may be revoked under it.
+func (service *TokenRevoke) readToken(ctx context.Context, request *TokenRevokeRequest) (*jwa.Claims, error) {
+	result, err := service.serviceInspect.Exec(ctx, &ClaimsInspectRequest{
+		Token:         request.Token,
+		Usage:         request.Usage,
+		IgnoreExpired: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("inspect token: %w", err)
+	}
+
+	// Revoking a token again is not an error.
+	failures := lo.Reject(result.Failures, func(item *TokenCheckFailure, _ int) bool {
+		return item.Check == TokenCheckRevoked
+	})
+	if len(failures) > 0 {
+		return nil, fmt.Errorf("%w: %s: %s", ErrTokenRevokeInvalid, failures[0].Check, failures[0].Message)
+	}
+
+	var claims jwa.Claims
+
+	err = json.Unmarshal(result.Claims, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrTokenRevokeInvalid, err)
+	}
+
+	// A token without an expiration date never verifies: there is nothing to revoke.
+	if claims.Exp <= 0 {
+		return nil, fmt.Errorf("%w: missing expiration date", ErrTokenRevokeInvalid)
+	}
+
+	return &claims, nil
+}
@@ -0,0 +1,235 @@
+package core_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	coremocks "github.com/a-novel/service-json-keys/v2/internal/core/mocks"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+)
+
+func TestTokenRevoke(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	now := time.Now().Round(time.Second)
+	exp := now.Add(time.Hour)
+
+	keysConfig := map[string]*config.Jwk{
+		"test-usage": {Token: config.JwkToken{TTL: 2 * time.Hour, Leeway: 5 * time.Minute}},
+	}
+
+	claims := json.RawMessage(`{"jti":"jti-1","exp":` + strconv.FormatInt(exp.Unix(), 10) + `}`)
+
+	type serviceInspectMock struct {
+		resp *core.ClaimsInspectResult
+		err  error
+	}
+
+	type daoInsertMock struct {
+		jti string
+		// expiresAt is the expected expiry of the revocation; zero when it derives from the token
+		// TTL.
+		expiresAt time.Time
+
+		resp *dao.RevokedToken
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.TokenRevokeRequest
+
+		serviceInspectMock *serviceInspectMock
+		daoInsertMock      *daoInsertMock
+
+		expect    *core.RevokedToken
+		expectErr error
+	}{
+		{
+			name: "Success/Token",
+
+			request: &core.TokenRevokeRequest{Usage: "test-usage", Token: "token"},
+
+			serviceInspectMock: &serviceInspectMock{
+				resp: &core.ClaimsInspectResult{Claims: claims, Signer: "test-usage"},
+			},
+			daoInsertMock: &daoInsertMock{
+				jti: "jti-1",
+				// The revocation outlives the token by the leeway, as verifiers accept it until then.
+				expiresAt: exp.Add(5 * time.Minute),
+				resp: &dao.RevokedToken{
+					JTI: "jti-1", Usage: "test-usage", ExpiresAt: exp.Add(5 * time.Minute), RevokedAt: now,
+				},
+			},
+
+			expect: &core.RevokedToken{
+				TokenID: "jti-1", Usage: "test-usage", ExpiresAt: exp.Add(5 * time.Minute), RevokedAt: now,
+			},
+		},
+		{
+			name: "Success/TokenAlreadyRevoked",
+
+			request: &core.TokenRevokeRequest{Usage: "test-usage", Token: "token"},
+
+			serviceInspectMock: &serviceInspectMock{
+				resp: &core.ClaimsInspectResult{
+					Claims:   claims,
+					Signer:   "test-usage",
+					Failures: []*core.TokenCheckFailure{{Check: core.TokenCheckRevoked, Message: "revoked"}},
+				},
+			},
+			daoInsertMock: &daoInsertMock{
+				jti:       "jti-1",
+				expiresAt: exp.Add(5 * time.Minute),
+				resp: &dao.RevokedToken{
+					JTI: "jti-1", Usage: "test-usage", ExpiresAt: exp.Add(5 * time.Minute), RevokedAt: now,
+				},
+			},
+
+			expect: &core.RevokedToken{
+				TokenID: "jti-1", Usage: "test-usage", ExpiresAt: exp.Add(5 * time.Minute), RevokedAt: now,
+			},
+		},
+		{
+			name: "Success/TokenID",
+
+			request: &core.TokenRevokeRequest{Usage: "test-usage", TokenID: "jti-1"},
+
+			daoInsertMock: &daoInsertMock{
+				jti: "jti-1",
+				resp: &dao.RevokedToken{
+					JTI: "jti-1", Usage: "test-usage", ExpiresAt: exp, RevokedAt: now,
+				},
+			},
+
+			expect: &core.RevokedToken{TokenID: "jti-1", Usage: "test-usage", ExpiresAt: exp, RevokedAt: now},
+		},
+		{
+			name: "Error/ConfigNotFound",
+
+			request: &core.TokenRevokeRequest{Usage: "unknown-usage", TokenID: "jti-1"},
+
+			expectErr: core.ErrConfigNotFound,
+		},
+		{
+			name: "Error/NoID",
+
+			request: &core.TokenRevokeRequest{Usage: "test-usage"},
+
+			expectErr: core.ErrTokenRevokeNoID,
+		},
+		{
+			name: "Error/TokenWithoutID",
+
+			request: &core.TokenRevokeRequest{Usage: "test-usage", Token: "token"},
+
+			serviceInspectMock: &serviceInspectMock{
+				resp: &core.ClaimsInspectResult{
+					Claims: json.RawMessage(`{"exp":` + strconv.FormatInt(exp.Unix(), 10) + `}`),
+					Signer: "test-usage",
+				},
+			},
+
+			expectErr: core.ErrTokenRevokeNoID,
+		},
+		{
+			name: "Error/TokenWithoutExpiration",
+
+			request: &core.TokenRevokeRequest{Usage: "test-usage", Token: "token"},
+
+			serviceInspectMock: &serviceInspectMock{
+				resp: &core.ClaimsInspectResult{Claims: json.RawMessage(`{"jti":"jti-1"}`), Signer: "test-usage"},
+			},
+
+			expectErr: core.ErrTokenRevokeInvalid,
+		},
+		{
+			// Only a token that verifies may be revoked: anyone could forge a token with any ID.
+			name: "Error/InvalidToken",
+
+			request: &core.TokenRevokeRequest{Usage: "test-usage", Token: "token"},
+
+			serviceInspectMock: &serviceInspectMock{
+				resp: &core.ClaimsInspectResult{
+					Claims:   claims,
+					Failures: []*core.TokenCheckFailure{{Check: core.TokenCheckSignature, Message: "bad signature"}},
+				},
+			},
+
+			expectErr: core.ErrTokenRevokeInvalid,
+		},
+		{
+			name: "Error/Inspect",
+
+			request: &core.TokenRevokeRequest{Usage: "test-usage", Token: "token"},
+
+			serviceInspectMock: &serviceInspectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Insert",
+
+			request: &core.TokenRevokeRequest{Usage: "test-usage", TokenID: "jti-1"},
+
+			daoInsertMock: &daoInsertMock{jti: "jti-1", err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoInsert := coremocks.NewMockTokenRevokeDao(t)
+			serviceInspect := coremocks.NewMockTokenRevokeServiceInspect(t)
+
+			if testCase.serviceInspectMock != nil {
+				serviceInspect.EXPECT().
+					Exec(mock.Anything, &core.ClaimsInspectRequest{
+						Token:         testCase.request.Token,
+						Usage:         testCase.request.Usage,
+						IgnoreExpired: true,
+					}).
+					Return(testCase.serviceInspectMock.resp, testCase.serviceInspectMock.err)
+			}
+
+			if testCase.daoInsertMock != nil {
+				daoInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(request *dao.RevokedTokenInsertRequest) bool {
+						expiresAt := testCase.daoInsertMock.expiresAt
+						if expiresAt.IsZero() {
+							expiresAt = request.Now.Add(2*time.Hour + 5*time.Minute)
+						}
+
+						return request.JTI == testCase.daoInsertMock.jti &&
+							request.Usage == testCase.request.Usage &&
+							request.ExpiresAt.Equal(expiresAt) &&
+							!request.Now.IsZero()
+					})).
+					Return(testCase.daoInsertMock.resp, testCase.daoInsertMock.err)
+			}
+
+			service := core.NewTokenRevoke(daoInsert, serviceInspect, keysConfig)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoInsert.AssertExpectations(t)
+			serviceInspect.AssertExpectations(t)
+		})
+	}
+}
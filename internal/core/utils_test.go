@@ -5,6 +5,7 @@ import (
 	"crypto/ed25519"
 	"encoding/base64"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -60,3 +61,24 @@ func generateAuthTokenKeySet(t *testing.T, size int) ([]*jwk.Key[ed25519.Private
 
 	return privateKeys, publicKeys
 }
+
+// untypeToken removes the "typ" header of a compact token, and signs it again with key, like the
+// tokens issued before [core.ClaimsSign] typed them.
+func untypeToken(t *testing.T, token string, key *jwk.Key[ed25519.PrivateKey]) string {
+	t.Helper()
+
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+
+	rawHeader, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.NoError(t, err)
+
+	var header map[string]any
+
+	require.NoError(t, json.Unmarshal(rawHeader, &header))
+	delete(header, "typ")
+
+	signingInput := mustSerializeBase64Value(t, header) + "." + parts[1]
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(ed25519.Sign(key.Key(), []byte(signingInput)))
+}
@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// An ApiKey represents a service API key stored in the database.
+//
+// The secret handed out to the caller is never stored: the row keeps its public
+// [ApiKey.Prefix], used to find it, and a digest of the secret part, used to check it.
+//
+// Revoked rows stay in the table for auditing; DAOs that authenticate callers ignore them.
+type ApiKey struct {
+	bun.BaseModel `bun:"table:api_keys"`
+
+	// ID is the key's unique identifier.
+	ID uuid.UUID `bun:"id,pk,type:uuid"`
+	// Name is a human-readable label, used to tell keys apart when listing them.
+	Name string `bun:"name"`
+
+	// Prefix is the public part of the key. It is embedded in the secret, so a presented key
+	// can be looked up without scanning the table. It is unique.
+	Prefix string `bun:"prefix"`
+	// SecretHash is the hex-encoded SHA-256 digest of the secret part of the key.
+	SecretHash string `bun:"secret_hash"`
+
+	// Usages lists the key usages the key grants access to.
+	Usages []string `bun:"usages,array"`
+	// Operations lists the operations the key grants on those usages.
+	Operations []string `bun:"operations,array"`
+
+	// CreatedAt is when the key was issued.
+	CreatedAt time.Time `bun:"created_at"`
+	// LastUsedAt is when the key last authenticated a request. It is nil for keys never used.
+	LastUsedAt *time.Time `bun:"last_used_at"`
+	// RevokedAt is set when the key is revoked. A revoked key no longer authenticates requests.
+	RevokedAt *time.Time `bun:"revoked_at"`
+}
@@ -0,0 +1,85 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.apiKeyInsert.sql
+var apiKeyInsertQuery string
+
+// ApiKeyInsertRequest holds the parameters for a [PgApiKeyInsert.Exec] call.
+type ApiKeyInsertRequest struct {
+	// ID is the key's unique identifier.
+	ID uuid.UUID
+	// Name is the human-readable label of the key. See [ApiKey.Name].
+	Name string
+
+	// Prefix is the public part of the key; it must not collide with any existing key.
+	Prefix string
+	// SecretHash is the digest of the secret part of the key. See [ApiKey.SecretHash].
+	SecretHash string
+
+	// Usages lists the key usages the key grants access to.
+	Usages []string
+	// Operations lists the operations the key grants on those usages.
+	Operations []string
+
+	// Now is the timestamp recorded as the key's creation time.
+	Now time.Time
+}
+
+// A PgApiKeyInsert stores a new API key.
+type PgApiKeyInsert struct{}
+
+// NewPgApiKeyInsert returns a new PgApiKeyInsert dao.
+func NewPgApiKeyInsert() *PgApiKeyInsert {
+	return &PgApiKeyInsert{}
+}
+
+func (dao *PgApiKeyInsert) Exec(ctx context.Context, request *ApiKeyInsertRequest) (*ApiKey, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgApiKeyInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("api_key.id", request.ID.String()),
+		attribute.String("api_key.name", request.Name),
+		attribute.String("api_key.prefix", request.Prefix),
+		attribute.StringSlice("api_key.usages", request.Usages),
+		attribute.StringSlice("api_key.operations", request.Operations),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(ApiKey)
+
+	err = tx.
+		NewRaw(
+			apiKeyInsertQuery,
+			request.ID,
+			request.Name,
+			request.Prefix,
+			request.SecretHash,
+			pgdialect.Array(request.Usages),
+			pgdialect.Array(request.Operations),
+			request.Now,
+		).
+		Scan(ctx, entity)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,14 @@
+INSERT INTO
+  api_keys (
+    id,
+    name,
+    prefix,
+    secret_hash,
+    usages,
+    operations,
+    created_at
+  )
+VALUES
+  (?0, ?1, ?2, ?3, ?4, ?5, ?6)
+RETURNING
+  *;
@@ -0,0 +1,118 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgApiKeyInsert(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Round(time.Second)
+
+	testCases := []struct {
+		name string
+
+		request  *dao.ApiKeyInsertRequest
+		fixtures []*dao.ApiKey
+
+		expect    *dao.ApiKey
+		expectErr bool
+	}{
+		{
+			name: "Success",
+
+			request: &dao.ApiKeyInsertRequest{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Name:       "service-authentication",
+				Prefix:     "prefix01",
+				SecretHash: "secret-hash-1",
+				Usages:     []string{"auth", "refresh"},
+				Operations: []string{"sign", "list"},
+				Now:        now,
+			},
+
+			expect: &dao.ApiKey{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Name:       "service-authentication",
+				Prefix:     "prefix01",
+				SecretHash: "secret-hash-1",
+				Usages:     []string{"auth", "refresh"},
+				Operations: []string{"sign", "list"},
+				CreatedAt:  now,
+			},
+		},
+		{
+			name: "Error/PrefixTaken",
+
+			request: &dao.ApiKeyInsertRequest{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Name:       "service-authentication",
+				Prefix:     "prefix01",
+				SecretHash: "secret-hash-2",
+				Usages:     []string{"auth"},
+				Operations: []string{"sign"},
+				Now:        now,
+			},
+
+			fixtures: []*dao.ApiKey{
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Name:       "service-other",
+					Prefix:     "prefix01",
+					SecretHash: "secret-hash-1",
+					Usages:     []string{"auth"},
+					Operations: []string{"list"},
+					CreatedAt:  now,
+				},
+			},
+
+			expectErr: true,
+		},
+	}
+
+	dao := dao.NewPgApiKeyInsert()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					db, err := postgres.GetContext(ctx)
+					require.NoError(t, err)
+
+					if len(testCase.fixtures) > 0 {
+						_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+						require.NoError(t, err)
+					}
+
+					key, err := dao.Exec(ctx, testCase.request)
+					if testCase.expectErr {
+						require.Error(t, err)
+
+						return
+					}
+
+					require.NoError(t, err)
+					require.Equal(t, testCase.expect, key)
+				},
+			)
+		})
+	}
+}
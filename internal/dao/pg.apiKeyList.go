@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.apiKeyList.sql
+var apiKeyListQuery string
+
+// ApiKeyListRequest holds the parameters for a [PgApiKeyList.Exec] call.
+type ApiKeyListRequest struct {
+	// IncludeRevoked also returns revoked keys.
+	IncludeRevoked bool
+}
+
+// A PgApiKeyList lists API keys, newest first.
+//
+// There is no pagination for this query: keys are issued by operators, one per calling service,
+// so the table stays small.
+type PgApiKeyList struct{}
+
+// NewPgApiKeyList returns a new PgApiKeyList dao.
+func NewPgApiKeyList() *PgApiKeyList {
+	return &PgApiKeyList{}
+}
+
+func (dao *PgApiKeyList) Exec(ctx context.Context, request *ApiKeyListRequest) ([]*ApiKey, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgApiKeyList")
+	defer span.End()
+
+	span.SetAttributes(attribute.Bool("api_keys.include_revoked", request.IncludeRevoked))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var entities []*ApiKey
+
+	err = tx.NewRaw(apiKeyListQuery, request.IncludeRevoked).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("api_keys.count", len(entities)))
+
+	return otel.ReportSuccess(span, entities), nil
+}
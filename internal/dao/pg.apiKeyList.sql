@@ -0,0 +1,9 @@
+SELECT
+  *
+FROM
+  api_keys
+WHERE
+  ?0
+  OR revoked_at IS NULL
+ORDER BY
+  created_at DESC;
@@ -0,0 +1,106 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgApiKeyList(t *testing.T) {
+	t.Parallel()
+
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+	now := time.Now().UTC().Round(time.Second)
+
+	fixtures := []*dao.ApiKey{
+		{
+			ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Name:       "service-old",
+			Prefix:     "prefix01",
+			SecretHash: "secret-hash-1",
+			Usages:     []string{"auth"},
+			Operations: []string{"sign"},
+			CreatedAt:  hourAgo,
+		},
+		{
+			ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Name:       "service-new",
+			Prefix:     "prefix02",
+			SecretHash: "secret-hash-2",
+			Usages:     []string{"auth"},
+			Operations: []string{"list"},
+			CreatedAt:  now,
+		},
+		{
+			ID:         uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			Name:       "service-revoked",
+			Prefix:     "prefix03",
+			SecretHash: "secret-hash-3",
+			Usages:     []string{"auth"},
+			Operations: []string{"admin"},
+			CreatedAt:  hourAgo,
+			RevokedAt:  lo.ToPtr(now),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.ApiKeyListRequest
+
+		expect []*dao.ApiKey
+	}{
+		{
+			name: "Success",
+
+			request: &dao.ApiKeyListRequest{},
+
+			expect: []*dao.ApiKey{fixtures[1], fixtures[0]},
+		},
+		{
+			name: "Success/IncludeRevoked",
+
+			request: &dao.ApiKeyListRequest{IncludeRevoked: true},
+
+			expect: []*dao.ApiKey{fixtures[1], fixtures[0], fixtures[2]},
+		},
+	}
+
+	dao := dao.NewPgApiKeyList()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					db, err := postgres.GetContext(ctx)
+					require.NoError(t, err)
+
+					_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+					require.NoError(t, err)
+
+					keys, err := dao.Exec(ctx, testCase.request)
+					require.NoError(t, err)
+					require.ElementsMatch(t, testCase.expect, keys)
+					require.Equal(t, testCase.expect[0], keys[0])
+				},
+			)
+		})
+	}
+}
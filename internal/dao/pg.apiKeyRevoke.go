@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.apiKeyRevoke.sql
+var apiKeyRevokeQuery string
+
+// ErrApiKeyRevokeNotFound is returned when no unrevoked API key matches the revoke request.
+var ErrApiKeyRevokeNotFound = errors.New("api key not found")
+
+// ApiKeyRevokeRequest holds the parameters for a [PgApiKeyRevoke.Exec] call.
+type ApiKeyRevokeRequest struct {
+	// ID is the identifier of the key to revoke.
+	ID uuid.UUID
+	// Now is the timestamp recorded as the revocation time.
+	Now time.Time
+}
+
+// A PgApiKeyRevoke revokes an API key: it stops authenticating requests at once, while the row
+// is retained for auditing.
+//
+// An already revoked key yields [ErrApiKeyRevokeNotFound].
+type PgApiKeyRevoke struct{}
+
+// NewPgApiKeyRevoke returns a new PgApiKeyRevoke dao.
+func NewPgApiKeyRevoke() *PgApiKeyRevoke {
+	return &PgApiKeyRevoke{}
+}
+
+func (dao *PgApiKeyRevoke) Exec(ctx context.Context, request *ApiKeyRevokeRequest) (*ApiKey, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgApiKeyRevoke")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("api_key.id", request.ID.String()),
+		attribute.Int64("api_key.revoked_at", request.Now.Unix()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(ApiKey)
+
+	err = tx.NewRaw(apiKeyRevokeQuery, request.Now, request.ID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrApiKeyRevokeNotFound
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,9 @@
+UPDATE api_keys
+SET
+  revoked_at = ?0
+WHERE
+  id = ?1
+  -- Keep the original revocation time.
+  AND revoked_at IS NULL
+RETURNING
+  *;
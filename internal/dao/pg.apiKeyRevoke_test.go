@@ -0,0 +1,128 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgApiKeyRevoke(t *testing.T) {
+	t.Parallel()
+
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+	now := time.Now().UTC().Round(time.Second)
+
+	testCases := []struct {
+		name string
+
+		request  *dao.ApiKeyRevokeRequest
+		fixtures []*dao.ApiKey
+
+		expect    *dao.ApiKey
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.ApiKeyRevokeRequest{
+				ID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Now: now,
+			},
+
+			fixtures: []*dao.ApiKey{
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Name:       "service-authentication",
+					Prefix:     "prefix01",
+					SecretHash: "secret-hash-1",
+					Usages:     []string{"auth"},
+					Operations: []string{"sign"},
+					CreatedAt:  hourAgo,
+				},
+			},
+
+			expect: &dao.ApiKey{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Name:       "service-authentication",
+				Prefix:     "prefix01",
+				SecretHash: "secret-hash-1",
+				Usages:     []string{"auth"},
+				Operations: []string{"sign"},
+				CreatedAt:  hourAgo,
+				RevokedAt:  &now,
+			},
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &dao.ApiKeyRevokeRequest{
+				ID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Now: now,
+			},
+
+			expectErr: dao.ErrApiKeyRevokeNotFound,
+		},
+		{
+			name: "Error/AlreadyRevoked",
+
+			request: &dao.ApiKeyRevokeRequest{
+				ID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Now: now,
+			},
+
+			fixtures: []*dao.ApiKey{
+				{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Name:       "service-authentication",
+					Prefix:     "prefix01",
+					SecretHash: "secret-hash-1",
+					Usages:     []string{"auth"},
+					Operations: []string{"sign"},
+					CreatedAt:  hourAgo,
+					RevokedAt:  lo.ToPtr(hourAgo),
+				},
+			},
+
+			expectErr: dao.ErrApiKeyRevokeNotFound,
+		},
+	}
+
+	dao := dao.NewPgApiKeyRevoke()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					db, err := postgres.GetContext(ctx)
+					require.NoError(t, err)
+
+					if len(testCase.fixtures) > 0 {
+						_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+						require.NoError(t, err)
+					}
+
+					key, err := dao.Exec(ctx, testCase.request)
+					require.ErrorIs(t, err, testCase.expectErr)
+					require.Equal(t, testCase.expect, key)
+				},
+			)
+		})
+	}
+}
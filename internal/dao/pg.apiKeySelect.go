@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.apiKeySelect.sql
+var apiKeySelectQuery string
+
+// ErrApiKeySelectNotFound is returned when no unrevoked API key matches the requested prefix.
+var ErrApiKeySelectNotFound = errors.New("api key not found")
+
+// ApiKeySelectRequest holds the parameters for a [PgApiKeySelect.Exec] call.
+type ApiKeySelectRequest struct {
+	// Prefix is the public part of the key to retrieve. See [ApiKey.Prefix].
+	Prefix string
+}
+
+// A PgApiKeySelect retrieves a single unrevoked API key by its prefix.
+type PgApiKeySelect struct{}
+
+// NewPgApiKeySelect returns a new PgApiKeySelect dao.
+func NewPgApiKeySelect() *PgApiKeySelect {
+	return &PgApiKeySelect{}
+}
+
+func (dao *PgApiKeySelect) Exec(ctx context.Context, request *ApiKeySelectRequest) (*ApiKey, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgApiKeySelect")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("api_key.prefix", request.Prefix))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(ApiKey)
+
+	err = tx.NewRaw(apiKeySelectQuery, request.Prefix).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrApiKeySelectNotFound
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,8 @@
+SELECT
+  *
+FROM
+  api_keys
+WHERE
+  prefix = ?0
+  -- Revoked keys no longer authenticate.
+  AND revoked_at IS NULL;
@@ -0,0 +1,103 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgApiKeySelect(t *testing.T) {
+	t.Parallel()
+
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+
+	fixtures := []*dao.ApiKey{
+		{
+			ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Name:       "service-authentication",
+			Prefix:     "prefix01",
+			SecretHash: "secret-hash-1",
+			Usages:     []string{"auth"},
+			Operations: []string{"sign"},
+			CreatedAt:  hourAgo,
+		},
+		{
+			ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Name:       "service-revoked",
+			Prefix:     "prefix02",
+			SecretHash: "secret-hash-2",
+			Usages:     []string{"auth"},
+			Operations: []string{"sign"},
+			CreatedAt:  hourAgo,
+			RevokedAt:  lo.ToPtr(hourAgo),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.ApiKeySelectRequest
+
+		expect    *dao.ApiKey
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.ApiKeySelectRequest{Prefix: "prefix01"},
+
+			expect: fixtures[0],
+		},
+		{
+			name: "Error/Revoked",
+
+			request: &dao.ApiKeySelectRequest{Prefix: "prefix02"},
+
+			expectErr: dao.ErrApiKeySelectNotFound,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &dao.ApiKeySelectRequest{Prefix: "prefix03"},
+
+			expectErr: dao.ErrApiKeySelectNotFound,
+		},
+	}
+
+	dao := dao.NewPgApiKeySelect()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					db, err := postgres.GetContext(ctx)
+					require.NoError(t, err)
+
+					_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+					require.NoError(t, err)
+
+					key, err := dao.Exec(ctx, testCase.request)
+					require.ErrorIs(t, err, testCase.expectErr)
+					require.Equal(t, testCase.expect, key)
+				},
+			)
+		})
+	}
+}
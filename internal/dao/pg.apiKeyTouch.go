@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.apiKeyTouch.sql
+var apiKeyTouchQuery string
+
+// ApiKeyTouchRequest holds the parameters for a [PgApiKeyTouch.Exec] call.
+type ApiKeyTouchRequest struct {
+	// ID is the identifier of the key that was used.
+	ID uuid.UUID
+	// Now is the timestamp recorded as the last use of the key.
+	Now time.Time
+}
+
+// A PgApiKeyTouch records the last use of an API key. See [ApiKey.LastUsedAt].
+type PgApiKeyTouch struct{}
+
+// NewPgApiKeyTouch returns a new PgApiKeyTouch dao.
+func NewPgApiKeyTouch() *PgApiKeyTouch {
+	return &PgApiKeyTouch{}
+}
+
+func (dao *PgApiKeyTouch) Exec(ctx context.Context, request *ApiKeyTouchRequest) error {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgApiKeyTouch")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("api_key.id", request.ID.String()),
+		attribute.Int64("api_key.last_used_at", request.Now.Unix()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	_, err = tx.NewRaw(apiKeyTouchQuery, request.Now, request.ID).Exec(ctx)
+	if err != nil {
+		return otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	otel.ReportSuccessNoContent(span)
+
+	return nil
+}
@@ -0,0 +1,10 @@
+UPDATE api_keys
+SET
+  last_used_at = ?0
+WHERE
+  id = ?1
+  -- Concurrent requests may finish out of order; never move the timestamp back.
+  AND (
+    last_used_at IS NULL
+    OR last_used_at < ?0
+  );
@@ -0,0 +1,106 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgApiKeyTouch(t *testing.T) {
+	t.Parallel()
+
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+	now := time.Now().UTC().Round(time.Second)
+
+	testCases := []struct {
+		name string
+
+		request    *dao.ApiKeyTouchRequest
+		lastUsedAt *time.Time
+
+		expectLastUsedAt *time.Time
+	}{
+		{
+			name: "Success/FirstUse",
+
+			request: &dao.ApiKeyTouchRequest{
+				ID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Now: now,
+			},
+
+			expectLastUsedAt: &now,
+		},
+		{
+			name: "Success/LaterUse",
+
+			request: &dao.ApiKeyTouchRequest{
+				ID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Now: now,
+			},
+			lastUsedAt: lo.ToPtr(hourAgo),
+
+			expectLastUsedAt: &now,
+		},
+		{
+			name: "Success/OutOfOrder",
+
+			request: &dao.ApiKeyTouchRequest{
+				ID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Now: hourAgo,
+			},
+			lastUsedAt: lo.ToPtr(now),
+
+			expectLastUsedAt: &now,
+		},
+	}
+
+	daoTouch := dao.NewPgApiKeyTouch()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					db, err := postgres.GetContext(ctx)
+					require.NoError(t, err)
+
+					fixture := &dao.ApiKey{
+						ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Name:       "service-authentication",
+						Prefix:     "prefix01",
+						SecretHash: "secret-hash-1",
+						Usages:     []string{"auth"},
+						Operations: []string{"sign"},
+						CreatedAt:  hourAgo,
+						LastUsedAt: testCase.lastUsedAt,
+					}
+
+					_, err = db.NewInsert().Model(fixture).Exec(ctx)
+					require.NoError(t, err)
+
+					require.NoError(t, daoTouch.Exec(ctx, testCase.request))
+
+					stored := new(dao.ApiKey)
+					require.NoError(t, db.NewSelect().Model(stored).Where("id = ?", fixture.ID).Scan(ctx))
+					require.Equal(t, testCase.expectLastUsedAt, stored.LastUsedAt)
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// An AuditEvent records a signing or key-management operation, whether it succeeded or not.
+//
+// The audit trail is append-only: events are inserted and read, never updated nor deleted.
+// Tokens are never stored, only their "jti" claim and their digest.
+type AuditEvent struct {
+	bun.BaseModel `bun:"table:audit_events"`
+
+	// ID is the event's unique identifier.
+	ID uuid.UUID `bun:"id,pk,type:uuid"`
+	// OccurredAt is when the operation completed.
+	OccurredAt time.Time `bun:"occurred_at"`
+
+	// Action names the operation, such as "claims.sign".
+	Action string `bun:"action"`
+	// Outcome is "success" or "failure".
+	Outcome string `bun:"outcome"`
+	// Caller identifies who asked for the operation; nil when unknown.
+	Caller *string `bun:"caller"`
+
+	// Usage is the key usage the operation applied to, if any.
+	Usage *string `bun:"usage"`
+	// KID is the ID of the key used or produced by the operation, if any.
+	KID *string `bun:"kid"`
+	// TokenID is the "jti" claim of the token issued by the operation, if any.
+	TokenID *string `bun:"token_id"`
+	// TokenHash is the hex-encoded SHA-256 digest of the token issued by the operation, if any.
+	TokenHash *string `bun:"token_hash"`
+
+	// Detail holds free-form context, such as the reason of a failure.
+	Detail *string `bun:"detail"`
+}
@@ -0,0 +1,82 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.auditEventInsert.sql
+var auditEventInsertQuery string
+
+// AuditEventInsertRequest holds the parameters for a [PgAuditEventInsert.Exec] call. Optional
+// fields are nil when they do not apply. See [AuditEvent].
+type AuditEventInsertRequest struct {
+	ID  uuid.UUID
+	Now time.Time
+
+	Action  string
+	Outcome string
+	Caller  *string
+
+	Usage     *string
+	KID       *string
+	TokenID   *string
+	TokenHash *string
+
+	Detail *string
+}
+
+// A PgAuditEventInsert appends an event to the audit trail.
+type PgAuditEventInsert struct{}
+
+// NewPgAuditEventInsert returns a new PgAuditEventInsert dao.
+func NewPgAuditEventInsert() *PgAuditEventInsert {
+	return &PgAuditEventInsert{}
+}
+
+func (dao *PgAuditEventInsert) Exec(ctx context.Context, request *AuditEventInsertRequest) (*AuditEvent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgAuditEventInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("audit.id", request.ID.String()),
+		attribute.String("audit.action", request.Action),
+		attribute.String("audit.outcome", request.Outcome),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(AuditEvent)
+
+	err = tx.
+		NewRaw(
+			auditEventInsertQuery,
+			request.ID,
+			request.Now,
+			request.Action,
+			request.Outcome,
+			request.Caller,
+			request.Usage,
+			request.KID,
+			request.TokenID,
+			request.TokenHash,
+			request.Detail,
+		).
+		Scan(ctx, entity)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,17 @@
+INSERT INTO
+  audit_events (
+    id,
+    occurred_at,
+    action,
+    outcome,
+    caller,
+    usage,
+    kid,
+    token_id,
+    token_hash,
+    detail
+  )
+VALUES
+  (?0, ?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9)
+RETURNING
+  *;
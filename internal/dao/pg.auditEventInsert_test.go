@@ -0,0 +1,118 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgAuditEventInsert(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Round(time.Second)
+
+	testCases := []struct {
+		name string
+
+		request *dao.AuditEventInsertRequest
+
+		expect    *dao.AuditEvent
+		expectErr bool
+	}{
+		{
+			name: "Success",
+
+			request: &dao.AuditEventInsertRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Now:       now,
+				Action:    "claims.sign",
+				Outcome:   "success",
+				Caller:    lo.ToPtr("api-key:service-authentication"),
+				Usage:     lo.ToPtr("auth"),
+				KID:       lo.ToPtr("00000000-0000-0000-0000-000000000010"),
+				TokenID:   lo.ToPtr("token-id"),
+				TokenHash: lo.ToPtr("token-hash"),
+			},
+
+			expect: &dao.AuditEvent{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				OccurredAt: now,
+				Action:     "claims.sign",
+				Outcome:    "success",
+				Caller:     lo.ToPtr("api-key:service-authentication"),
+				Usage:      lo.ToPtr("auth"),
+				KID:        lo.ToPtr("00000000-0000-0000-0000-000000000010"),
+				TokenID:    lo.ToPtr("token-id"),
+				TokenHash:  lo.ToPtr("token-hash"),
+			},
+		},
+		{
+			name: "Success/Minimal",
+
+			request: &dao.AuditEventInsertRequest{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Now:     now,
+				Action:  "jwk.rotate",
+				Outcome: "failure",
+				Detail:  lo.ToPtr("uh oh"),
+			},
+
+			expect: &dao.AuditEvent{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				OccurredAt: now,
+				Action:     "jwk.rotate",
+				Outcome:    "failure",
+				Detail:     lo.ToPtr("uh oh"),
+			},
+		},
+		{
+			name: "Error/UnknownOutcome",
+
+			request: &dao.AuditEventInsertRequest{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Now:     now,
+				Action:  "claims.sign",
+				Outcome: "maybe",
+			},
+
+			expectErr: true,
+		},
+	}
+
+	dao := dao.NewPgAuditEventInsert()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					event, err := dao.Exec(ctx, testCase.request)
+					if testCase.expectErr {
+						require.Error(t, err)
+
+						return
+					}
+
+					require.NoError(t, err)
+					require.Equal(t, testCase.expect, event)
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.auditEventSearch.sql
+var auditEventSearchQuery string
+
+// AuditEventsMaxBatchSize is the maximum number of events returned by a single search. Callers
+// page through longer ranges by moving the end of the range.
+const AuditEventsMaxBatchSize = 1000
+
+// AuditEventSearchRequest holds the parameters for a [PgAuditEventSearch.Exec] call.
+type AuditEventSearchRequest struct {
+	// Usage only returns events of this key usage. All events are returned when empty.
+	Usage string
+	// From is the start of the time range, inclusive.
+	From time.Time
+	// To is the end of the time range, exclusive.
+	To time.Time
+	// Limit is the maximum number of events to return. It is capped to
+	// [AuditEventsMaxBatchSize], which is also used when it is not positive.
+	Limit int
+}
+
+// A PgAuditEventSearch lists the audit events of a time range, newest first.
+type PgAuditEventSearch struct{}
+
+// NewPgAuditEventSearch returns a new PgAuditEventSearch dao.
+func NewPgAuditEventSearch() *PgAuditEventSearch {
+	return &PgAuditEventSearch{}
+}
+
+func (dao *PgAuditEventSearch) Exec(ctx context.Context, request *AuditEventSearchRequest) ([]*AuditEvent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgAuditEventSearch")
+	defer span.End()
+
+	limit := request.Limit
+	if limit <= 0 || limit > AuditEventsMaxBatchSize {
+		limit = AuditEventsMaxBatchSize
+	}
+
+	span.SetAttributes(
+		attribute.String("audit.usage", request.Usage),
+		attribute.Int64("audit.from", request.From.Unix()),
+		attribute.Int64("audit.to", request.To.Unix()),
+		attribute.Int("audit.limit", limit),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var entities []*AuditEvent
+
+	err = tx.NewRaw(auditEventSearchQuery, request.Usage, request.From, request.To, limit).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("audit.count", len(entities)))
+
+	return otel.ReportSuccess(span, entities), nil
+}
@@ -0,0 +1,15 @@
+SELECT
+  *
+FROM
+  audit_events
+WHERE
+  (
+    ?0 = ''
+    OR usage = ?0
+  )
+  AND occurred_at >= ?1
+  AND occurred_at < ?2
+ORDER BY
+  occurred_at DESC
+LIMIT
+  ?3;
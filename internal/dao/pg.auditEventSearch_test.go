@@ -0,0 +1,142 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgAuditEventSearch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Round(time.Second)
+
+	fixtures := []*dao.AuditEvent{
+		{
+			ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			OccurredAt: now.Add(-2 * time.Hour),
+			Action:     "claims.sign",
+			Outcome:    "success",
+			Usage:      lo.ToPtr("auth"),
+		},
+		{
+			ID:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			OccurredAt: now.Add(-time.Hour),
+			Action:     "claims.sign",
+			Outcome:    "failure",
+			Usage:      lo.ToPtr("refresh"),
+		},
+		{
+			ID:         uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			OccurredAt: now.Add(-30 * time.Minute),
+			Action:     "jwk.rotate",
+			Outcome:    "success",
+			Usage:      lo.ToPtr("auth"),
+		},
+		{
+			ID:         uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			OccurredAt: now.Add(-10 * time.Minute),
+			Action:     "api_key.create",
+			Outcome:    "success",
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.AuditEventSearchRequest
+
+		expect []*dao.AuditEvent
+	}{
+		{
+			name: "Success",
+
+			request: &dao.AuditEventSearchRequest{
+				From: now.Add(-24 * time.Hour),
+				To:   now,
+			},
+
+			expect: []*dao.AuditEvent{fixtures[3], fixtures[2], fixtures[1], fixtures[0]},
+		},
+		{
+			name: "Success/Usage",
+
+			request: &dao.AuditEventSearchRequest{
+				Usage: "auth",
+				From:  now.Add(-24 * time.Hour),
+				To:    now,
+			},
+
+			expect: []*dao.AuditEvent{fixtures[2], fixtures[0]},
+		},
+		{
+			name: "Success/TimeRange",
+
+			request: &dao.AuditEventSearchRequest{
+				From: now.Add(-time.Hour),
+				To:   now.Add(-10 * time.Minute),
+			},
+
+			expect: []*dao.AuditEvent{fixtures[2], fixtures[1]},
+		},
+		{
+			name: "Success/Limit",
+
+			request: &dao.AuditEventSearchRequest{
+				From:  now.Add(-24 * time.Hour),
+				To:    now,
+				Limit: 1,
+			},
+
+			expect: []*dao.AuditEvent{fixtures[3]},
+		},
+		{
+			name: "Success/NoResults",
+
+			request: &dao.AuditEventSearchRequest{
+				Usage: "unknown",
+				From:  now.Add(-24 * time.Hour),
+				To:    now,
+			},
+
+			expect: nil,
+		},
+	}
+
+	dao := dao.NewPgAuditEventSearch()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					db, err := postgres.GetContext(ctx)
+					require.NoError(t, err)
+
+					_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+					require.NoError(t, err)
+
+					events, err := dao.Exec(ctx, testCase.request)
+					require.NoError(t, err)
+					require.Equal(t, testCase.expect, events)
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// ErrJobLeaseHeld is returned when a lease is held by another server.
+var ErrJobLeaseHeld = errors.New("lease held by another holder")
+
+// A JobLease elects the server that runs a background job, among the replicas sharing the
+// database. A lease that has expired is free for any server to take.
+type JobLease struct {
+	bun.BaseModel `bun:"table:job_leases"`
+
+	// Name identifies the job the lease is for.
+	Name string `bun:"name,pk"`
+	// Holder identifies the server holding the lease.
+	Holder string `bun:"holder"`
+	// ExpiresAt is when the lease is released, unless its holder renews it.
+	ExpiresAt time.Time `bun:"expires_at"`
+
+	// LastRunAt is when a holder last completed the job, whatever its outcome. It is kept when
+	// the lease changes hands, and nil before the first run.
+	LastRunAt *time.Time `bun:"last_run_at"`
+	// LastRunError is the error the last run ended with. It is nil when it succeeded.
+	LastRunError *string `bun:"last_run_error"`
+}
@@ -0,0 +1,73 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.jobLeaseAcquire.sql
+var jobLeaseAcquireQuery string
+
+// JobLeaseAcquireRequest holds the parameters for a [PgJobLeaseAcquire.Exec] call.
+type JobLeaseAcquireRequest struct {
+	// Name identifies the job to lease.
+	Name string
+	// Holder identifies the server asking for the lease.
+	Holder string
+	// Now is the time against which the current lease expiry is checked.
+	Now time.Time
+	// Expiration is when the lease is released, unless renewed.
+	Expiration time.Time
+}
+
+// A PgJobLeaseAcquire takes a job lease, or renews it when the caller already holds it. The
+// lease is created on first use.
+//
+// It fails with [ErrJobLeaseHeld] while another server holds an unexpired lease. The check and
+// the update are one statement, so two servers cannot both take an expired lease.
+type PgJobLeaseAcquire struct{}
+
+// NewPgJobLeaseAcquire returns a new PgJobLeaseAcquire dao.
+func NewPgJobLeaseAcquire() *PgJobLeaseAcquire {
+	return &PgJobLeaseAcquire{}
+}
+
+func (dao *PgJobLeaseAcquire) Exec(ctx context.Context, request *JobLeaseAcquireRequest) (*JobLease, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgJobLeaseAcquire")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("lease.name", request.Name),
+		attribute.String("lease.holder", request.Holder),
+		attribute.Int64("lease.expires_at", request.Expiration.Unix()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(JobLease)
+
+	err = tx.NewRaw(
+		jobLeaseAcquireQuery, request.Name, request.Holder, request.Now, request.Expiration,
+	).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrJobLeaseHeld
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,14 @@
+INSERT INTO
+  job_leases (name, holder, expires_at)
+VALUES
+  (?0, ?1, ?3)
+ON CONFLICT (name) DO UPDATE
+SET
+  holder = excluded.holder,
+  expires_at = excluded.expires_at
+WHERE
+  -- The holder renews its lease; anyone takes an expired one.
+  job_leases.holder = excluded.holder
+  OR job_leases.expires_at <= ?2
+RETURNING
+  *;
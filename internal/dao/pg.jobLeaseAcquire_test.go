@@ -0,0 +1,124 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgJobLeaseAcquire(t *testing.T) {
+	t.Parallel()
+
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+	now := time.Now().UTC().Round(time.Second)
+	hourLater := time.Now().Add(time.Hour).UTC().Round(time.Second)
+
+	request := &dao.JobLeaseAcquireRequest{
+		Name:       "test-job",
+		Holder:     "server-1",
+		Now:        now,
+		Expiration: hourLater,
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.JobLease
+
+		expect    *dao.JobLease
+		expectErr error
+	}{
+		{
+			name: "Success/Create",
+
+			expect: &dao.JobLease{Name: "test-job", Holder: "server-1", ExpiresAt: hourLater},
+		},
+		{
+			// Renewing keeps the run records.
+			name: "Success/Renew",
+
+			fixtures: []*dao.JobLease{
+				{Name: "test-job", Holder: "server-1", ExpiresAt: now.Add(time.Minute), LastRunAt: &hourAgo},
+			},
+
+			expect: &dao.JobLease{Name: "test-job", Holder: "server-1", ExpiresAt: hourLater, LastRunAt: &hourAgo},
+		},
+		{
+			name: "Success/TakeOverExpired",
+
+			fixtures: []*dao.JobLease{
+				{
+					Name:         "test-job",
+					Holder:       "server-2",
+					ExpiresAt:    hourAgo,
+					LastRunAt:    &hourAgo,
+					LastRunError: lo.ToPtr("foo"),
+				},
+			},
+
+			expect: &dao.JobLease{
+				Name:         "test-job",
+				Holder:       "server-1",
+				ExpiresAt:    hourLater,
+				LastRunAt:    &hourAgo,
+				LastRunError: lo.ToPtr("foo"),
+			},
+		},
+		{
+			name: "Success/OtherJob",
+
+			fixtures: []*dao.JobLease{
+				{Name: "other-job", Holder: "server-2", ExpiresAt: hourLater},
+			},
+
+			expect: &dao.JobLease{Name: "test-job", Holder: "server-1", ExpiresAt: hourLater},
+		},
+		{
+			name: "Error/Held",
+
+			fixtures: []*dao.JobLease{
+				{Name: "test-job", Holder: "server-2", ExpiresAt: now.Add(time.Minute)},
+			},
+
+			expectErr: dao.ErrJobLeaseHeld,
+		},
+	}
+
+	dao := dao.NewPgJobLeaseAcquire()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					db, err := postgres.GetContext(ctx)
+					require.NoError(t, err)
+
+					if len(testCase.fixtures) > 0 {
+						_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+						require.NoError(t, err)
+					}
+
+					lease, err := dao.Exec(ctx, request)
+					require.ErrorIs(t, err, testCase.expectErr)
+					require.Equal(t, testCase.expect, lease)
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.jobLeaseRecordRun.sql
+var jobLeaseRecordRunQuery string
+
+// JobLeaseRecordRunRequest holds the parameters for a [PgJobLeaseRecordRun.Exec] call.
+type JobLeaseRecordRunRequest struct {
+	// Name identifies the job that ran.
+	Name string
+	// Holder identifies the server that ran it.
+	Holder string
+	// Now is the timestamp recorded as the end of the run.
+	Now time.Time
+	// Err is the error the run ended with. Nil when it succeeded.
+	Err *string
+}
+
+// A PgJobLeaseRecordRun records the outcome of a job run on its lease. See [JobLease.LastRunAt].
+//
+// It fails with [ErrJobLeaseHeld] when the caller no longer holds the lease.
+type PgJobLeaseRecordRun struct{}
+
+// NewPgJobLeaseRecordRun returns a new PgJobLeaseRecordRun dao.
+func NewPgJobLeaseRecordRun() *PgJobLeaseRecordRun {
+	return &PgJobLeaseRecordRun{}
+}
+
+func (dao *PgJobLeaseRecordRun) Exec(ctx context.Context, request *JobLeaseRecordRunRequest) (*JobLease, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgJobLeaseRecordRun")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("lease.name", request.Name),
+		attribute.String("lease.holder", request.Holder),
+		attribute.Int64("lease.last_run_at", request.Now.Unix()),
+		attribute.Bool("lease.last_run_failed", request.Err != nil),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(JobLease)
+
+	err = tx.NewRaw(jobLeaseRecordRunQuery, request.Name, request.Holder, request.Now, request.Err).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrJobLeaseHeld
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
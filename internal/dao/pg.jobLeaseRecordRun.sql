@@ -0,0 +1,10 @@
+UPDATE job_leases
+SET
+  last_run_at = ?2,
+  last_run_error = ?3
+WHERE
+  name = ?0
+  -- A server that lost its lease mid-run does not overwrite its successor's records.
+  AND holder = ?1
+RETURNING
+  *;
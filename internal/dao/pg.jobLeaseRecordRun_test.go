@@ -0,0 +1,119 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgJobLeaseRecordRun(t *testing.T) {
+	t.Parallel()
+
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+	now := time.Now().UTC().Round(time.Second)
+	hourLater := time.Now().Add(time.Hour).UTC().Round(time.Second)
+
+	testCases := []struct {
+		name string
+
+		request  *dao.JobLeaseRecordRunRequest
+		fixtures []*dao.JobLease
+
+		expect    *dao.JobLease
+		expectErr error
+	}{
+		{
+			// A success clears the previous error.
+			name: "Success",
+
+			request: &dao.JobLeaseRecordRunRequest{Name: "test-job", Holder: "server-1", Now: now},
+
+			fixtures: []*dao.JobLease{
+				{
+					Name:         "test-job",
+					Holder:       "server-1",
+					ExpiresAt:    hourLater,
+					LastRunAt:    &hourAgo,
+					LastRunError: lo.ToPtr("foo"),
+				},
+			},
+
+			expect: &dao.JobLease{Name: "test-job", Holder: "server-1", ExpiresAt: hourLater, LastRunAt: &now},
+		},
+		{
+			name: "Success/Failure",
+
+			request: &dao.JobLeaseRecordRunRequest{
+				Name: "test-job", Holder: "server-1", Now: now, Err: lo.ToPtr("bar"),
+			},
+
+			fixtures: []*dao.JobLease{
+				{Name: "test-job", Holder: "server-1", ExpiresAt: hourLater},
+			},
+
+			expect: &dao.JobLease{
+				Name:         "test-job",
+				Holder:       "server-1",
+				ExpiresAt:    hourLater,
+				LastRunAt:    &now,
+				LastRunError: lo.ToPtr("bar"),
+			},
+		},
+		{
+			name: "Error/LeaseLost",
+
+			request: &dao.JobLeaseRecordRunRequest{Name: "test-job", Holder: "server-1", Now: now},
+
+			fixtures: []*dao.JobLease{
+				{Name: "test-job", Holder: "server-2", ExpiresAt: hourLater},
+			},
+
+			expectErr: dao.ErrJobLeaseHeld,
+		},
+		{
+			name: "Error/NeverLeased",
+
+			request: &dao.JobLeaseRecordRunRequest{Name: "test-job", Holder: "server-1", Now: now},
+
+			expectErr: dao.ErrJobLeaseHeld,
+		},
+	}
+
+	dao := dao.NewPgJobLeaseRecordRun()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					db, err := postgres.GetContext(ctx)
+					require.NoError(t, err)
+
+					if len(testCase.fixtures) > 0 {
+						_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+						require.NoError(t, err)
+					}
+
+					lease, err := dao.Exec(ctx, testCase.request)
+					require.ErrorIs(t, err, testCase.expectErr)
+					require.Equal(t, testCase.expect, lease)
+				},
+			)
+		})
+	}
+}
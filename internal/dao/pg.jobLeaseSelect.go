@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.jobLeaseSelect.sql
+var jobLeaseSelectQuery string
+
+// ErrJobLeaseSelectNotFound is returned when a job has never been leased.
+var ErrJobLeaseSelectNotFound = errors.New("job lease not found")
+
+// JobLeaseSelectRequest holds the parameters for a [PgJobLeaseSelect.Exec] call.
+type JobLeaseSelectRequest struct {
+	// Name identifies the job.
+	Name string
+}
+
+// A PgJobLeaseSelect reads the lease of a job, expired or not.
+type PgJobLeaseSelect struct{}
+
+// NewPgJobLeaseSelect returns a new PgJobLeaseSelect dao.
+func NewPgJobLeaseSelect() *PgJobLeaseSelect {
+	return &PgJobLeaseSelect{}
+}
+
+func (dao *PgJobLeaseSelect) Exec(ctx context.Context, request *JobLeaseSelectRequest) (*JobLease, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgJobLeaseSelect")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("lease.name", request.Name))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(JobLease)
+
+	err = tx.NewRaw(jobLeaseSelectQuery, request.Name).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrJobLeaseSelectNotFound
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
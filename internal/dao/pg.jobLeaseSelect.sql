@@ -0,0 +1,6 @@
+SELECT
+  *
+FROM
+  job_leases
+WHERE
+  name = ?0;
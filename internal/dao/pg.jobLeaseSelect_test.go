@@ -0,0 +1,78 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgJobLeaseSelect(t *testing.T) {
+	t.Parallel()
+
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+
+	// Expired leases are still read: they keep the run records.
+	fixtures := []*dao.JobLease{
+		{Name: "test-job", Holder: "server-1", ExpiresAt: hourAgo, LastRunAt: &hourAgo},
+		{Name: "other-job", Holder: "server-2", ExpiresAt: hourAgo},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.JobLeaseSelectRequest
+
+		expect    *dao.JobLease
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.JobLeaseSelectRequest{Name: "test-job"},
+
+			expect: fixtures[0],
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &dao.JobLeaseSelectRequest{Name: "missing-job"},
+
+			expectErr: dao.ErrJobLeaseSelectNotFound,
+		},
+	}
+
+	dao := dao.NewPgJobLeaseSelect()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					db, err := postgres.GetContext(ctx)
+					require.NoError(t, err)
+
+					_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+					require.NoError(t, err)
+
+					lease, err := dao.Exec(ctx, testCase.request)
+					require.ErrorIs(t, err, testCase.expectErr)
+					require.Equal(t, testCase.expect, lease)
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.jwkInvalidate.sql
+var jwkInvalidateQuery string
+
+// JwkInvalidationChannel is the PostgreSQL notification channel that carries key invalidations.
+// The payload of a notification is the key usage to invalidate.
+const JwkInvalidationChannel = "jwk_invalidate"
+
+// JwkInvalidateRequest holds the parameters for a [PgJwkInvalidate.Exec] call.
+type JwkInvalidateRequest struct {
+	// Usage is the key usage whose cached keys must be dropped. See [Jwk.Usage].
+	Usage string
+}
+
+// A PgJwkInvalidate tells every process listening with [PgJwkListenInvalidations] to drop the
+// keys it cached for a usage, for instance after they were revoked.
+//
+// Within a transaction, the notification is only sent when the transaction commits, so listeners
+// never refetch before the change is visible.
+type PgJwkInvalidate struct{}
+
+// NewPgJwkInvalidate returns a new PgJwkInvalidate dao.
+func NewPgJwkInvalidate() *PgJwkInvalidate {
+	return &PgJwkInvalidate{}
+}
+
+func (dao *PgJwkInvalidate) Exec(ctx context.Context, request *JwkInvalidateRequest) error {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgJwkInvalidate")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("key.usage", request.Usage))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	_, err = tx.NewRaw(jwkInvalidateQuery, JwkInvalidationChannel, request.Usage).Exec(ctx)
+	if err != nil {
+		return otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	otel.ReportSuccessNoContent(span)
+
+	return nil
+}
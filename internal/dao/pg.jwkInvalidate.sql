@@ -0,0 +1,3 @@
+-- Delivered to the listeners when the surrounding transaction commits, and dropped if it rolls back.
+SELECT
+  pg_notify(?0, ?1);
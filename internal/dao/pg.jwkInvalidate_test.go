@@ -0,0 +1,77 @@
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgJwkInvalidate(t *testing.T) {
+	t.Parallel()
+
+	invalidate := dao.NewPgJwkInvalidate()
+	listen := dao.NewPgJwkListenInvalidations()
+
+	errRollback := errors.New("rollback")
+
+	postgres.RunDBTest(
+		t,
+		configtest.PostgresPreset,
+		migrations.Migrations,
+		func(ctx context.Context, t *testing.T) {
+			t.Helper()
+
+			listenCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			usages, err := listen.Exec(listenCtx, &dao.JwkListenInvalidationsRequest{})
+			require.NoError(t, err)
+
+			// A rolled back invalidation is never sent.
+			err = postgres.WithinTx(ctx, nil, func(ctx context.Context) error {
+				require.NoError(t, invalidate.Exec(ctx, &dao.JwkInvalidateRequest{Usage: "rolled-back"}))
+
+				return errRollback
+			})
+			require.ErrorIs(t, err, errRollback)
+
+			err = postgres.WithinTx(ctx, nil, func(ctx context.Context) error {
+				return invalidate.Exec(ctx, &dao.JwkInvalidateRequest{Usage: "test-usage"})
+			})
+			require.NoError(t, err)
+
+			select {
+			case usage := <-usages:
+				require.Equal(t, "test-usage", usage)
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "invalidation not received")
+			}
+
+			// The channel closes with the context.
+			cancel()
+
+			require.Eventually(t, func() bool {
+				_, open := <-usages
+
+				return !open
+			}, 5*time.Second, 10*time.Millisecond)
+
+			// A transaction cannot listen.
+			err = postgres.WithinTx(ctx, nil, func(ctx context.Context) error {
+				_, err := listen.Exec(ctx, &dao.JwkListenInvalidationsRequest{})
+
+				return err
+			})
+			require.ErrorIs(t, err, dao.ErrJwkListenInvalidationsTransaction)
+		},
+	)
+}
@@ -0,0 +1,90 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+// ErrJwkListenInvalidationsTransaction is returned when [PgJwkListenInvalidations] is called
+// within a transaction, which cannot hold a listening connection.
+var ErrJwkListenInvalidationsTransaction = errors.New("jwk invalidations cannot be listened to in a transaction")
+
+// JwkListenInvalidationsRequest holds the parameters for a [PgJwkListenInvalidations.Exec] call.
+// Every invalidation is listened to, so it is empty.
+type JwkListenInvalidationsRequest struct{}
+
+// A PgJwkListenInvalidations receives the invalidations sent by [PgJwkInvalidate], from every
+// process sharing the database.
+//
+// The listener holds a connection of its own, outside the pool, and reconnects when it is lost.
+// Notifications sent while it is disconnected are lost.
+type PgJwkListenInvalidations struct{}
+
+// NewPgJwkListenInvalidations returns a new PgJwkListenInvalidations dao.
+func NewPgJwkListenInvalidations() *PgJwkListenInvalidations {
+	return &PgJwkListenInvalidations{}
+}
+
+// Exec starts listening, and returns the channel that receives the invalidated usages. The
+// channel is closed, and the connection released, once ctx is done.
+func (dao *PgJwkListenInvalidations) Exec(
+	ctx context.Context, _ *JwkListenInvalidationsRequest,
+) (<-chan string, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgJwkListenInvalidations")
+	defer span.End()
+
+	pg, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get database: %w", err))
+	}
+
+	db, ok := pg.(*bun.DB)
+	if !ok {
+		return nil, otel.ReportError(span, ErrJwkListenInvalidationsTransaction)
+	}
+
+	listener := pgdriver.NewListener(db)
+
+	err = listener.Listen(ctx, JwkInvalidationChannel)
+	if err != nil {
+		_ = listener.Close()
+
+		return nil, otel.ReportError(span, fmt.Errorf("listen: %w", err))
+	}
+
+	notifications := listener.Channel()
+	output := make(chan string)
+
+	go func() {
+		defer close(output)
+		defer listener.Close() //nolint:errcheck // Nothing to do on a failed close.
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case notification, ok := <-notifications:
+				if !ok {
+					return
+				}
+
+				select {
+				case output <- notification.Payload:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	otel.ReportSuccessNoContent(span)
+
+	return output, nil
+}
@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.jwkLock.sql
+var jwkLockQuery string
+
+// ErrJwkLockNoTransaction is returned when [PgJwkLock] is called outside a transaction, where
+// the lock would be released as soon as it is taken.
+var ErrJwkLockNoTransaction = errors.New("jwk lock requires a transaction")
+
+// JwkLockRequest holds the parameters for a [PgJwkLock.Exec] call.
+type JwkLockRequest struct {
+	// Usage is the key usage to lock. See [Jwk.Usage].
+	Usage string
+}
+
+// A PgJwkLock serializes the writers of a usage's keys across every process sharing the
+// database. It takes a PostgreSQL advisory lock, and waits for other holders to release it.
+//
+// The lock is held until the surrounding transaction commits or rolls back: a writer that
+// locks, reads the usage's keys, then inserts one, sees the keys inserted by the writers that
+// held the lock before it.
+type PgJwkLock struct{}
+
+// NewPgJwkLock returns a new PgJwkLock dao.
+func NewPgJwkLock() *PgJwkLock {
+	return &PgJwkLock{}
+}
+
+func (dao *PgJwkLock) Exec(ctx context.Context, request *JwkLockRequest) error {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgJwkLock")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("key.usage", request.Usage))
+
+	if !postgres.InTx(ctx) {
+		return otel.ReportError(span, ErrJwkLockNoTransaction)
+	}
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	_, err = tx.NewRaw(jwkLockQuery, request.Usage).Exec(ctx)
+	if err != nil {
+		return otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	otel.ReportSuccessNoContent(span)
+
+	return nil
+}
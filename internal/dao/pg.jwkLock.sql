@@ -0,0 +1,4 @@
+-- Held until the transaction ends. Namespaced, so other advisory locks on the database cannot
+-- collide with a usage name.
+SELECT
+  pg_advisory_xact_lock(hashtextextended('keys:' || ?0, 0));
@@ -0,0 +1,62 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgJwkLock(t *testing.T) {
+	t.Parallel()
+
+	lock := dao.NewPgJwkLock()
+
+	postgres.RunDBTest(
+		t,
+		configtest.PostgresPreset,
+		migrations.Migrations,
+		func(ctx context.Context, t *testing.T) {
+			t.Helper()
+
+			db, err := postgres.GetContext(ctx)
+			require.NoError(t, err)
+
+			// tryLock reports whether another connection could take the lock of a usage. Run outside
+			// a transaction, the lock it takes is released with the statement.
+			tryLock := func(usage string) bool {
+				var locked bool
+
+				err := db.NewRaw(
+					"SELECT pg_try_advisory_xact_lock(hashtextextended('keys:' || ?, 0))", usage,
+				).Scan(ctx, &locked)
+				require.NoError(t, err)
+
+				return locked
+			}
+
+			err = postgres.WithinTx(ctx, nil, func(ctx context.Context) error {
+				err := lock.Exec(ctx, &dao.JwkLockRequest{Usage: "test-usage"})
+				require.NoError(t, err)
+
+				require.False(t, tryLock("test-usage"), "the lock is not held")
+				require.True(t, tryLock("other-usage"), "the lock covers other usages")
+
+				return nil
+			})
+			require.NoError(t, err)
+
+			require.True(t, tryLock("test-usage"), "the lock outlived its transaction")
+
+			// Outside a transaction, the lock would be released straight away.
+			err = lock.Exec(ctx, &dao.JwkLockRequest{Usage: "test-usage"})
+			require.ErrorIs(t, err, dao.ErrJwkLockNoTransaction)
+		},
+	)
+}
@@ -0,0 +1,23 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// A RevokedToken denies a single token, by its usage and its "jti" claim: verifiers refuse it,
+// while the key that signed it keeps verifying other tokens.
+type RevokedToken struct {
+	bun.BaseModel `bun:"table:revoked_tokens"`
+
+	// JTI is the "jti" claim of the revoked token.
+	JTI string `bun:"jti,pk"`
+	// Usage is the key usage the token was signed for. The revocation only applies under it.
+	Usage string `bun:"usage,pk"`
+	// ExpiresAt is when the token stops verifying anyway, leeway included. The revocation is
+	// useless past this time.
+	ExpiresAt time.Time `bun:"expires_at"`
+	// RevokedAt is when the token was revoked.
+	RevokedAt time.Time `bun:"revoked_at"`
+}
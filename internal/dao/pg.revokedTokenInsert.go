@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.revokedTokenInsert.sql
+var revokedTokenInsertQuery string
+
+// RevokedTokenInsertRequest holds the parameters for a [PgRevokedTokenInsert.Exec] call.
+type RevokedTokenInsertRequest struct {
+	// JTI is the "jti" claim of the token to revoke.
+	JTI string
+	// Usage is the key usage the token was signed for.
+	Usage string
+	// ExpiresAt is when the token stops verifying anyway, leeway included.
+	ExpiresAt time.Time
+	// Now is the timestamp recorded as the revocation time.
+	Now time.Time
+}
+
+// A PgRevokedTokenInsert revokes a token, and purges the revocations of expired tokens.
+//
+// Revoking a token that is already revoked under the same usage keeps, and returns, the original
+// revocation. The same ID under another usage is another token.
+type PgRevokedTokenInsert struct{}
+
+// NewPgRevokedTokenInsert returns a new PgRevokedTokenInsert dao.
+func NewPgRevokedTokenInsert() *PgRevokedTokenInsert {
+	return &PgRevokedTokenInsert{}
+}
+
+func (dao *PgRevokedTokenInsert) Exec(
+	ctx context.Context, request *RevokedTokenInsertRequest,
+) (*RevokedToken, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgRevokedTokenInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("token.jti", request.JTI),
+		attribute.String("token.usage", request.Usage),
+		attribute.Int64("token.expires_at", request.ExpiresAt.Unix()),
+		attribute.Int64("token.revoked_at", request.Now.Unix()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(RevokedToken)
+
+	err = tx.
+		NewRaw(revokedTokenInsertQuery, request.JTI, request.Usage, request.ExpiresAt, request.Now).
+		Scan(ctx, entity)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
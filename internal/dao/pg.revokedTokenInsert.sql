@@ -0,0 +1,19 @@
+WITH
+  -- Revocations of expired tokens are useless: purge them on the way. The revoked token itself
+  -- is left to the insert, as a row cannot be modified twice by the same statement.
+  purged AS (
+    DELETE FROM revoked_tokens
+    WHERE
+      expires_at <= ?3
+      AND (usage, jti) <> (?1, ?0)
+  )
+INSERT INTO
+  revoked_tokens (jti, usage, expires_at, revoked_at)
+VALUES
+  (?0, ?1, ?2, ?3)
+ON CONFLICT (usage, jti) DO UPDATE
+SET
+  -- Keep the original revocation, and return it.
+  jti = revoked_tokens.jti
+RETURNING
+  *;
@@ -0,0 +1,112 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgRevokedTokenInsert(t *testing.T) {
+	t.Parallel()
+
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+	now := time.Now().UTC().Round(time.Second)
+	hourLater := time.Now().Add(time.Hour).UTC().Round(time.Second)
+
+	fixtures := []*dao.RevokedToken{
+		{JTI: "revoked-token", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: hourAgo},
+		{JTI: "expired-token", Usage: "test-usage", ExpiresAt: hourAgo, RevokedAt: hourAgo.Add(-time.Hour)},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.RevokedTokenInsertRequest
+
+		expect *dao.RevokedToken
+	}{
+		{
+			name: "Success",
+
+			request: &dao.RevokedTokenInsertRequest{
+				JTI:       "test-token",
+				Usage:     "test-usage",
+				ExpiresAt: hourLater,
+				Now:       now,
+			},
+
+			expect: &dao.RevokedToken{JTI: "test-token", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: now},
+		},
+		{
+			name: "Success/AlreadyRevoked",
+
+			request: &dao.RevokedTokenInsertRequest{
+				JTI:       "revoked-token",
+				Usage:     "test-usage",
+				ExpiresAt: hourLater.Add(time.Hour),
+				Now:       now,
+			},
+
+			expect: fixtures[0],
+		},
+		{
+			// A token ID is only unique within its usage.
+			name: "Success/OtherUsage",
+
+			request: &dao.RevokedTokenInsertRequest{
+				JTI:       "revoked-token",
+				Usage:     "other-usage",
+				ExpiresAt: hourLater,
+				Now:       now,
+			},
+
+			expect: &dao.RevokedToken{JTI: "revoked-token", Usage: "other-usage", ExpiresAt: hourLater, RevokedAt: now},
+		},
+	}
+
+	dao := dao.NewPgRevokedTokenInsert()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					db, err := postgres.GetContext(ctx)
+					require.NoError(t, err)
+
+					_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+					require.NoError(t, err)
+
+					revoked, err := dao.Exec(ctx, testCase.request)
+					require.NoError(t, err)
+					require.Equal(t, testCase.expect, revoked)
+
+					// The revocation of the expired token is purged.
+					var jtis []string
+
+					err = db.NewSelect().
+						Table("revoked_tokens").
+						Column("jti").
+						Order("jti").
+						Scan(ctx, &jtis)
+					require.NoError(t, err)
+					require.NotContains(t, jtis, "expired-token")
+				},
+			)
+		})
+	}
+}
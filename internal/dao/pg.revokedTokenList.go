@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.revokedTokenList.sql
+var revokedTokenListQuery string
+
+// RevokedTokenListRequest holds the parameters for a [PgRevokedTokenList.Exec] call.
+type RevokedTokenListRequest struct {
+	// Since only lists the tokens revoked after this time. The zero value lists every revocation.
+	Since time.Time
+	// Now is the reference time: revocations of tokens expired by then are not listed.
+	Now time.Time
+}
+
+// A PgRevokedTokenList lists the revocations of unexpired tokens, oldest first.
+//
+// Revocations only live until their token expires, so the list is never paginated.
+type PgRevokedTokenList struct{}
+
+// NewPgRevokedTokenList returns a new PgRevokedTokenList dao.
+func NewPgRevokedTokenList() *PgRevokedTokenList {
+	return &PgRevokedTokenList{}
+}
+
+func (dao *PgRevokedTokenList) Exec(ctx context.Context, request *RevokedTokenListRequest) ([]*RevokedToken, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgRevokedTokenList")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int64("tokens.since", request.Since.Unix()),
+		attribute.Int64("tokens.now", request.Now.Unix()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var entities []*RevokedToken
+
+	err = tx.NewRaw(revokedTokenListQuery, request.Since, request.Now).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("tokens.count", len(entities)))
+
+	return otel.ReportSuccess(span, entities), nil
+}
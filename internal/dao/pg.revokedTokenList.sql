@@ -0,0 +1,12 @@
+SELECT
+  *
+FROM
+  revoked_tokens
+WHERE
+  revoked_at > ?0
+  -- Revocations of expired tokens are useless.
+  AND expires_at > ?1
+ORDER BY
+  revoked_at,
+  usage,
+  jti;
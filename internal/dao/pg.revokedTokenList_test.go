@@ -0,0 +1,94 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgRevokedTokenList(t *testing.T) {
+	t.Parallel()
+
+	twoHoursAgo := time.Now().Add(-2 * time.Hour).UTC().Round(time.Second)
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+	now := time.Now().UTC().Round(time.Second)
+	hourLater := time.Now().Add(time.Hour).UTC().Round(time.Second)
+
+	fixtures := []*dao.RevokedToken{
+		{JTI: "token-b", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: hourAgo},
+		{JTI: "token-a", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: hourAgo},
+		{JTI: "token-c", Usage: "other-usage", ExpiresAt: hourLater, RevokedAt: now},
+		{JTI: "token-old", Usage: "test-usage", ExpiresAt: hourLater, RevokedAt: twoHoursAgo},
+		{JTI: "token-expired", Usage: "test-usage", ExpiresAt: hourAgo, RevokedAt: twoHoursAgo},
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.RevokedToken
+		request  *dao.RevokedTokenListRequest
+
+		expect []*dao.RevokedToken
+	}{
+		{
+			name: "Success",
+
+			fixtures: fixtures,
+			request:  &dao.RevokedTokenListRequest{Now: now},
+
+			expect: []*dao.RevokedToken{fixtures[3], fixtures[1], fixtures[0], fixtures[2]},
+		},
+		{
+			name: "Success/Since",
+
+			fixtures: fixtures,
+			request:  &dao.RevokedTokenListRequest{Since: twoHoursAgo, Now: now},
+
+			expect: []*dao.RevokedToken{fixtures[1], fixtures[0], fixtures[2]},
+		},
+		{
+			name: "Success/NoRevocation",
+
+			request: &dao.RevokedTokenListRequest{Now: now},
+
+			expect: nil,
+		},
+	}
+
+	dao := dao.NewPgRevokedTokenList()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					db, err := postgres.GetContext(ctx)
+					require.NoError(t, err)
+
+					if len(testCase.fixtures) > 0 {
+						_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+						require.NoError(t, err)
+					}
+
+					revoked, err := dao.Exec(ctx, testCase.request)
+					require.NoError(t, err)
+					require.Equal(t, testCase.expect, revoked)
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// A SigningFreeze stops the signing of a usage: no token is issued for it while the freeze
+// exists. Verification is not affected.
+type SigningFreeze struct {
+	bun.BaseModel `bun:"table:signing_freezes"`
+
+	// Usage is the frozen key usage.
+	Usage string `bun:"usage,pk"`
+	// Comment is the reason of the freeze.
+	Comment string `bun:"comment"`
+	// FrozenAt is when the usage was frozen.
+	FrozenAt time.Time `bun:"frozen_at"`
+}
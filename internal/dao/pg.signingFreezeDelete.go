@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.signingFreezeDelete.sql
+var signingFreezeDeleteQuery string
+
+// ErrSigningFreezeDeleteNotFound is returned when the usage is not frozen.
+var ErrSigningFreezeDeleteNotFound = errors.New("signing freeze not found")
+
+// SigningFreezeDeleteRequest holds the parameters for a [PgSigningFreezeDelete.Exec] call.
+type SigningFreezeDeleteRequest struct {
+	// Usage is the key usage to unfreeze.
+	Usage string
+}
+
+// A PgSigningFreezeDelete lifts the signing freeze of a usage, and returns it.
+type PgSigningFreezeDelete struct{}
+
+// NewPgSigningFreezeDelete returns a new PgSigningFreezeDelete dao.
+func NewPgSigningFreezeDelete() *PgSigningFreezeDelete {
+	return &PgSigningFreezeDelete{}
+}
+
+func (dao *PgSigningFreezeDelete) Exec(
+	ctx context.Context, request *SigningFreezeDeleteRequest,
+) (*SigningFreeze, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgSigningFreezeDelete")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("freeze.usage", request.Usage))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(SigningFreeze)
+
+	err = tx.NewRaw(signingFreezeDeleteQuery, request.Usage).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSigningFreezeDeleteNotFound
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,5 @@
+DELETE FROM signing_freezes
+WHERE
+  usage = ?0
+RETURNING
+  *;
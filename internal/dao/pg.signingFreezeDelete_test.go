@@ -0,0 +1,76 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgSigningFreezeDelete(t *testing.T) {
+	t.Parallel()
+
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+
+	fixtures := []*dao.SigningFreeze{
+		{Usage: "frozen-usage", Comment: "incident", FrozenAt: hourAgo},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.SigningFreezeDeleteRequest
+
+		expect    *dao.SigningFreeze
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.SigningFreezeDeleteRequest{Usage: "frozen-usage"},
+
+			expect: fixtures[0],
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &dao.SigningFreezeDeleteRequest{Usage: "test-usage"},
+
+			expectErr: dao.ErrSigningFreezeDeleteNotFound,
+		},
+	}
+
+	dao := dao.NewPgSigningFreezeDelete()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					db, err := postgres.GetContext(ctx)
+					require.NoError(t, err)
+
+					_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+					require.NoError(t, err)
+
+					freeze, err := dao.Exec(ctx, testCase.request)
+					require.ErrorIs(t, err, testCase.expectErr)
+					require.Equal(t, testCase.expect, freeze)
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.signingFreezeInsert.sql
+var signingFreezeInsertQuery string
+
+// SigningFreezeInsertRequest holds the parameters for a [PgSigningFreezeInsert.Exec] call.
+type SigningFreezeInsertRequest struct {
+	// Usage is the key usage to freeze.
+	Usage string
+	// Comment is the reason of the freeze.
+	Comment string
+	// Now is the timestamp recorded as the freeze time.
+	Now time.Time
+}
+
+// A PgSigningFreezeInsert freezes the signing of a usage.
+//
+// Freezing a usage that is already frozen keeps, and returns, the original freeze.
+type PgSigningFreezeInsert struct{}
+
+// NewPgSigningFreezeInsert returns a new PgSigningFreezeInsert dao.
+func NewPgSigningFreezeInsert() *PgSigningFreezeInsert {
+	return &PgSigningFreezeInsert{}
+}
+
+func (dao *PgSigningFreezeInsert) Exec(
+	ctx context.Context, request *SigningFreezeInsertRequest,
+) (*SigningFreeze, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgSigningFreezeInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("freeze.usage", request.Usage),
+		attribute.Int64("freeze.frozen_at", request.Now.Unix()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(SigningFreeze)
+
+	err = tx.NewRaw(signingFreezeInsertQuery, request.Usage, request.Comment, request.Now).Scan(ctx, entity)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,10 @@
+INSERT INTO
+  signing_freezes (usage, comment, frozen_at)
+VALUES
+  (?0, ?1, ?2)
+ON CONFLICT (usage) DO UPDATE
+SET
+  -- Keep the original freeze, and return it.
+  usage = signing_freezes.usage
+RETURNING
+  *;
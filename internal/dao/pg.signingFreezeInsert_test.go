@@ -0,0 +1,76 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgSigningFreezeInsert(t *testing.T) {
+	t.Parallel()
+
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+	now := time.Now().UTC().Round(time.Second)
+
+	fixtures := []*dao.SigningFreeze{
+		{Usage: "frozen-usage", Comment: "first incident", FrozenAt: hourAgo},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.SigningFreezeInsertRequest
+
+		expect *dao.SigningFreeze
+	}{
+		{
+			name: "Success",
+
+			request: &dao.SigningFreezeInsertRequest{Usage: "test-usage", Comment: "incident", Now: now},
+
+			expect: &dao.SigningFreeze{Usage: "test-usage", Comment: "incident", FrozenAt: now},
+		},
+		{
+			name: "Success/AlreadyFrozen",
+
+			request: &dao.SigningFreezeInsertRequest{Usage: "frozen-usage", Comment: "second incident", Now: now},
+
+			expect: fixtures[0],
+		},
+	}
+
+	dao := dao.NewPgSigningFreezeInsert()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					db, err := postgres.GetContext(ctx)
+					require.NoError(t, err)
+
+					_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+					require.NoError(t, err)
+
+					freeze, err := dao.Exec(ctx, testCase.request)
+					require.NoError(t, err)
+					require.Equal(t, testCase.expect, freeze)
+				},
+			)
+		})
+	}
+}
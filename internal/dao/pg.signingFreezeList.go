@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.signingFreezeList.sql
+var signingFreezeListQuery string
+
+// SigningFreezeListRequest holds the parameters for a [PgSigningFreezeList.Exec] call.
+type SigningFreezeListRequest struct{}
+
+// A PgSigningFreezeList lists the frozen usages, by usage. There is at most one freeze per
+// configured usage, so the list is never paginated.
+type PgSigningFreezeList struct{}
+
+// NewPgSigningFreezeList returns a new PgSigningFreezeList dao.
+func NewPgSigningFreezeList() *PgSigningFreezeList {
+	return &PgSigningFreezeList{}
+}
+
+func (dao *PgSigningFreezeList) Exec(ctx context.Context, _ *SigningFreezeListRequest) ([]*SigningFreeze, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.PgSigningFreezeList")
+	defer span.End()
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var entities []*SigningFreeze
+
+	err = tx.NewRaw(signingFreezeListQuery).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("freezes.count", len(entities)))
+
+	return otel.ReportSuccess(span, entities), nil
+}
@@ -0,0 +1,6 @@
+SELECT
+  *
+FROM
+  signing_freezes
+ORDER BY
+  usage;
@@ -0,0 +1,78 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/dao"
+	"github.com/a-novel/service-json-keys/v2/internal/models/migrations"
+)
+
+func TestPgSigningFreezeList(t *testing.T) {
+	t.Parallel()
+
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+	now := time.Now().UTC().Round(time.Second)
+
+	fixtures := []*dao.SigningFreeze{
+		{Usage: "usage-b", Comment: "incident", FrozenAt: hourAgo},
+		{Usage: "usage-a", Comment: "other incident", FrozenAt: now},
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.SigningFreeze
+
+		expect []*dao.SigningFreeze
+	}{
+		{
+			name: "Success",
+
+			fixtures: fixtures,
+
+			expect: []*dao.SigningFreeze{fixtures[1], fixtures[0]},
+		},
+		{
+			name: "Success/NoFreeze",
+
+			expect: nil,
+		},
+	}
+
+	request := &dao.SigningFreezeListRequest{}
+	dao := dao.NewPgSigningFreezeList()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(
+				t,
+				configtest.PostgresPreset,
+				migrations.Migrations,
+				func(ctx context.Context, t *testing.T) {
+					t.Helper()
+
+					db, err := postgres.GetContext(ctx)
+					require.NoError(t, err)
+
+					if len(testCase.fixtures) > 0 {
+						_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+						require.NoError(t, err)
+					}
+
+					freezes, err := dao.Exec(ctx, request)
+					require.NoError(t, err)
+					require.Equal(t, testCase.expect, freezes)
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+// GrpcApiKeyMetadata is the gRPC metadata key that carries an API key, for clients that cannot
+// set the "authorization" metadata.
+const GrpcApiKeyMetadata = "x-api-key"
+
+// grpcApiKeyOperations maps the RPCs that need an API key permission to that permission. Other
+// RPCs, such as Status, never need a key.
+var grpcApiKeyOperations = map[string]core.ApiKeyOperation{
+	jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName:               core.ApiKeyOperationSign,
+	jsonkeysv2.PayloadSignService_PayloadSign_FullMethodName:             core.ApiKeyOperationSign,
+	jsonkeysv2.HttpSignatureSignService_HttpSignatureSign_FullMethodName: core.ApiKeyOperationSign,
+	jsonkeysv2.JwkGetService_JwkGet_FullMethodName:                       core.ApiKeyOperationList,
+	jsonkeysv2.JwkListService_JwkList_FullMethodName:                     core.ApiKeyOperationList,
+	jsonkeysv2.ClaimsVerifyService_ClaimsVerify_FullMethodName:           core.ApiKeyOperationList,
+	jsonkeysv2.RevokedTokenSyncService_RevokedTokenSync_FullMethodName:   core.ApiKeyOperationList,
+	jsonkeysv2.AuditEventSearchService_AuditEventSearch_FullMethodName:   core.ApiKeyOperationAdmin,
+	jsonkeysv2.JwkRotateService_JwkRotate_FullMethodName:                 core.ApiKeyOperationAdmin,
+	jsonkeysv2.JwkBurnService_JwkBurn_FullMethodName:                     core.ApiKeyOperationAdmin,
+	jsonkeysv2.SigningFreezeService_SigningFreeze_FullMethodName:         core.ApiKeyOperationAdmin,
+	jsonkeysv2.RevokeTokenService_RevokeToken_FullMethodName:             core.ApiKeyOperationAdmin,
+}
+
+// GrpcApiKeysService is the service dependency of [GrpcApiKeys].
+type GrpcApiKeysService interface {
+	Exec(ctx context.Context, request *core.ApiKeyAuthenticateRequest) (*core.ApiKey, error)
+}
+
+// GrpcApiKeys is a gRPC interceptor that authenticates callers with API keys, sent in the
+// "authorization" metadata as "Bearer <key>", or in the [GrpcApiKeyMetadata] metadata.
+//
+// A presented key must be valid, and allow the operation of the RPC on the usage of the request
+// (see [core.ApiKey.Allows]); the key is then made available to the handlers through
+// [core.ApiKeyFromContext]. Requests without a key are let through, unless keys are required.
+//
+// Admin RPCs fail closed, whether keys are required or not: they need a key that allows admin on
+// the usage of the request, which must be set, or a caller identified as an admin by its client
+// certificate (see [GrpcCallerIdentity]).
+type GrpcApiKeys struct {
+	service  GrpcApiKeysService
+	required bool
+	admins   []string
+}
+
+// NewGrpcApiKeys returns a new GrpcApiKeys interceptor. When required is set, RPCs that need a
+// permission are refused to callers without a key. Callers without a key are only let through
+// admin RPCs when their client certificate identity is one of admins.
+func NewGrpcApiKeys(service GrpcApiKeysService, required bool, admins []string) *GrpcApiKeys {
+	return &GrpcApiKeys{service: service, required: required, admins: admins}
+}
+
+// UnaryInterceptor returns the interceptor, to register on the gRPC server. It answers
+// Unauthenticated to callers with an invalid key, or without a key when one is required, and
+// PermissionDenied to keys that do not allow the request, or to callers without a key that are
+// not admins on admin RPCs.
+func (interceptor *GrpcApiKeys) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		operation, needsPermission := grpcApiKeyOperations[info.FullMethod]
+
+		secret := grpcApiKeySecret(ctx)
+		if secret == "" {
+			if operation == core.ApiKeyOperationAdmin {
+				return interceptor.admin(ctx, req, handler)
+			}
+
+			if interceptor.required && needsPermission {
+				return nil, status.Error(codes.Unauthenticated, "an api key is required")
+			}
+
+			return handler(ctx, req)
+		}
+
+		ctx, span := otel.Tracer().Start(ctx, "grpc.ApiKeys")
+		defer span.End()
+
+		key, err := interceptor.service.Exec(ctx, &core.ApiKeyAuthenticateRequest{Secret: secret})
+		if errors.Is(err, core.ErrApiKeyInvalid) {
+			_ = otel.ReportError(span, err)
+
+			return nil, status.Error(codes.Unauthenticated, "invalid api key")
+		}
+
+		if err != nil {
+			_ = otel.ReportError(span, err)
+
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+
+		span.SetAttributes(attribute.String("api_key.id", key.ID.String()))
+
+		if needsPermission {
+			usageRequest, _ := req.(interface{ GetUsage() string })
+
+			usage := ""
+			if usageRequest != nil {
+				usage = usageRequest.GetUsage()
+			}
+
+			// An admin key only acts on the usages it names: admin RPCs without a usage are refused.
+			allowed := key.Allows(operation, usage)
+			if operation == core.ApiKeyOperationAdmin {
+				allowed = allowed && slices.Contains(key.Usages, usage)
+			}
+
+			if !allowed {
+				_ = otel.ReportError(span, status.Error(codes.PermissionDenied, string(operation)))
+
+				return nil, status.Errorf(codes.PermissionDenied, "api key does not allow %s on this usage", operation)
+			}
+		}
+
+		otel.ReportSuccessNoContent(span)
+
+		return handler(core.NewApiKeyContext(ctx, key), req)
+	}
+}
+
+// admin lets a caller without a key through an admin RPC, when its client certificate identifies
+// it as an admin.
+func (interceptor *GrpcApiKeys) admin(ctx context.Context, req any, handler grpc.UnaryHandler) (any, error) {
+	identity, ok := GrpcCallerIdentity(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "an api key or an admin client certificate is required")
+	}
+
+	if !slices.Contains(interceptor.admins, identity) {
+		return nil, status.Error(codes.PermissionDenied, "caller is not an admin")
+	}
+
+	return handler(ctx, req)
+}
+
+// grpcApiKeySecret reads the API key presented with a request, if any.
+func grpcApiKeySecret(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, authorization := range md.Get("authorization") {
+		scheme, token, found := strings.Cut(authorization, " ")
+		if found && strings.EqualFold(scheme, "Bearer") && token != "" {
+			return token
+		}
+	}
+
+	if keys := md.Get(GrpcApiKeyMetadata); len(keys) > 0 {
+		return keys[0]
+	}
+
+	return ""
+}
@@ -0,0 +1,354 @@
+package handlers_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+func TestGrpcApiKeys(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	signer := &core.ApiKey{
+		Name:       "service-authentication",
+		Usages:     []string{"auth"},
+		Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+	}
+
+	lister := &core.ApiKey{
+		Name:       "service-gateway",
+		Usages:     []string{"auth"},
+		Operations: []core.ApiKeyOperation{core.ApiKeyOperationList},
+	}
+
+	admin := &core.ApiKey{
+		Name:       "incident-response",
+		Usages:     []string{"auth"},
+		Operations: []core.ApiKeyOperation{core.ApiKeyOperationAdmin},
+	}
+
+	withIdentity := func(identity string) *peer.Peer {
+		return &peer.Peer{AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{
+				{Subject: pkix.Name{CommonName: identity}},
+			}}},
+		}}
+	}
+
+	presented := "jsk_prefix_secret"
+
+	type serviceMock struct {
+		secret string
+		resp   *core.ApiKey
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		metadata metadata.MD
+		peer     *peer.Peer
+		required bool
+		admins   []string
+		method   string
+		request  any
+
+		serviceMock *serviceMock
+
+		expectCode   codes.Code
+		expectApiKey bool
+	}{
+		{
+			name: "Success/Authorization",
+
+			metadata: metadata.Pairs("authorization", "Bearer jsk_prefix_secret"),
+			method:   jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request:  &jsonkeysv2.ClaimsSignRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{secret: presented, resp: signer},
+
+			expectCode:   codes.OK,
+			expectApiKey: true,
+		},
+		{
+			name: "Success/ApiKeyMetadata",
+
+			metadata: metadata.Pairs(handlers.GrpcApiKeyMetadata, "jsk_prefix_secret"),
+			method:   jsonkeysv2.PayloadSignService_PayloadSign_FullMethodName,
+			request:  &jsonkeysv2.PayloadSignRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{secret: presented, resp: signer},
+
+			expectCode:   codes.OK,
+			expectApiKey: true,
+		},
+		{
+			// The denylist spans every usage: syncing it only checks the operation.
+			name: "Success/SyncAnyUsage",
+
+			metadata: metadata.Pairs("authorization", "Bearer jsk_prefix_secret"),
+			method:   jsonkeysv2.RevokedTokenSyncService_RevokedTokenSync_FullMethodName,
+			request:  &jsonkeysv2.RevokedTokenSyncRequest{},
+
+			serviceMock: &serviceMock{secret: presented, resp: lister},
+
+			expectCode:   codes.OK,
+			expectApiKey: true,
+		},
+		{
+			name: "Success/NoKey",
+
+			method:  jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request: &jsonkeysv2.ClaimsSignRequest{Usage: "auth"},
+
+			expectCode: codes.OK,
+		},
+		{
+			name: "Success/RequiredButNoPermissionNeeded",
+
+			required: true,
+			method:   jsonkeysv2.StatusService_Status_FullMethodName,
+			request:  &jsonkeysv2.StatusRequest{},
+
+			expectCode: codes.OK,
+		},
+		{
+			name: "Success/AdminKey",
+
+			metadata: metadata.Pairs("authorization", "Bearer jsk_prefix_secret"),
+			method:   jsonkeysv2.JwkBurnService_JwkBurn_FullMethodName,
+			request:  &jsonkeysv2.JwkBurnRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{secret: presented, resp: admin},
+
+			expectCode:   codes.OK,
+			expectApiKey: true,
+		},
+		{
+			name: "Success/AdminIdentity",
+
+			peer:    withIdentity("spiffe-ops"),
+			admins:  []string{"spiffe-ops"},
+			method:  jsonkeysv2.JwkRotateService_JwkRotate_FullMethodName,
+			request: &jsonkeysv2.JwkRotateRequest{Usage: "auth"},
+
+			expectCode: codes.OK,
+		},
+		{
+			// Admin RPCs fail closed, even when keys are not required.
+			name: "Error/AdminNoKey",
+
+			method:  jsonkeysv2.JwkRotateService_JwkRotate_FullMethodName,
+			request: &jsonkeysv2.JwkRotateRequest{Usage: "auth"},
+
+			expectCode: codes.Unauthenticated,
+		},
+		{
+			name: "Error/AdminIdentityNotAdmin",
+
+			peer:    withIdentity("service-authentication"),
+			admins:  []string{"spiffe-ops"},
+			method:  jsonkeysv2.SigningFreezeService_SigningFreeze_FullMethodName,
+			request: &jsonkeysv2.SigningFreezeRequest{Usage: "auth", Frozen: true},
+
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name: "Error/AdminKeyNoUsage",
+
+			metadata: metadata.Pairs("authorization", "Bearer jsk_prefix_secret"),
+			method:   jsonkeysv2.AuditEventSearchService_AuditEventSearch_FullMethodName,
+			request:  &jsonkeysv2.AuditEventSearchRequest{},
+
+			serviceMock: &serviceMock{secret: presented, resp: admin},
+
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name: "Error/AdminKeyOtherUsage",
+
+			metadata: metadata.Pairs("authorization", "Bearer jsk_prefix_secret"),
+			method:   jsonkeysv2.RevokeTokenService_RevokeToken_FullMethodName,
+			request:  &jsonkeysv2.RevokeTokenRequest{Usage: "refresh", TokenId: "jti-1"},
+
+			serviceMock: &serviceMock{secret: presented, resp: admin},
+
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name: "Error/Required",
+
+			required: true,
+			method:   jsonkeysv2.JwkListService_JwkList_FullMethodName,
+			request:  &jsonkeysv2.JwkListRequest{Usage: "auth"},
+
+			expectCode: codes.Unauthenticated,
+		},
+		{
+			name: "Error/InvalidKey",
+
+			metadata: metadata.Pairs("authorization", "Bearer jsk_prefix_secret"),
+			method:   jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request:  &jsonkeysv2.ClaimsSignRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{secret: presented, err: core.ErrApiKeyInvalid},
+
+			expectCode: codes.Unauthenticated,
+		},
+		{
+			name: "Error/AuditNeedsAdmin",
+
+			metadata: metadata.Pairs("authorization", "Bearer jsk_prefix_secret"),
+			method:   jsonkeysv2.AuditEventSearchService_AuditEventSearch_FullMethodName,
+			request:  &jsonkeysv2.AuditEventSearchRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{secret: presented, resp: signer},
+
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name: "Error/RotateNeedsAdmin",
+
+			metadata: metadata.Pairs("authorization", "Bearer jsk_prefix_secret"),
+			method:   jsonkeysv2.JwkRotateService_JwkRotate_FullMethodName,
+			request:  &jsonkeysv2.JwkRotateRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{secret: presented, resp: signer},
+
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name: "Error/FreezeNeedsAdmin",
+
+			metadata: metadata.Pairs("authorization", "Bearer jsk_prefix_secret"),
+			method:   jsonkeysv2.SigningFreezeService_SigningFreeze_FullMethodName,
+			request:  &jsonkeysv2.SigningFreezeRequest{Usage: "auth", Frozen: true},
+
+			serviceMock: &serviceMock{secret: presented, resp: signer},
+
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name: "Error/RevokeNeedsAdmin",
+
+			metadata: metadata.Pairs("authorization", "Bearer jsk_prefix_secret"),
+			method:   jsonkeysv2.RevokeTokenService_RevokeToken_FullMethodName,
+			request:  &jsonkeysv2.RevokeTokenRequest{Usage: "auth", TokenId: "jti-1"},
+
+			serviceMock: &serviceMock{secret: presented, resp: signer},
+
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			// Verifying only needs the public keys, which signing keys are not allowed to list.
+			name: "Error/VerifyNeedsList",
+
+			metadata: metadata.Pairs("authorization", "Bearer jsk_prefix_secret"),
+			method:   jsonkeysv2.ClaimsVerifyService_ClaimsVerify_FullMethodName,
+			request:  &jsonkeysv2.ClaimsVerifyRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{secret: presented, resp: signer},
+
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name: "Error/OtherUsage",
+
+			metadata: metadata.Pairs("authorization", "Bearer jsk_prefix_secret"),
+			method:   jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request:  &jsonkeysv2.ClaimsSignRequest{Usage: "refresh"},
+
+			serviceMock: &serviceMock{secret: presented, resp: signer},
+
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name: "Error/OtherOperation",
+
+			metadata: metadata.Pairs("authorization", "Bearer jsk_prefix_secret"),
+			method:   jsonkeysv2.JwkGetService_JwkGet_FullMethodName,
+			request:  &jsonkeysv2.JwkGetRequest{},
+
+			serviceMock: &serviceMock{secret: presented, resp: signer},
+
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name: "Error/Internal",
+
+			metadata: metadata.Pairs("authorization", "Bearer jsk_prefix_secret"),
+			method:   jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request:  &jsonkeysv2.ClaimsSignRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{secret: presented, err: errFoo},
+
+			expectCode: codes.Internal,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockGrpcApiKeysService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, &core.ApiKeyAuthenticateRequest{Secret: testCase.serviceMock.secret}).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err).
+					Once()
+			}
+
+			interceptor := handlers.NewGrpcApiKeys(service, testCase.required, testCase.admins).UnaryInterceptor()
+
+			ctx := t.Context()
+			if testCase.metadata != nil {
+				ctx = metadata.NewIncomingContext(ctx, testCase.metadata)
+			}
+
+			if testCase.peer != nil {
+				ctx = peer.NewContext(ctx, testCase.peer)
+			}
+
+			called := false
+
+			_, err := interceptor(
+				ctx,
+				testCase.request,
+				&grpc.UnaryServerInfo{FullMethod: testCase.method},
+				func(handlerCtx context.Context, _ any) (any, error) {
+					called = true
+
+					_, ok := core.ApiKeyFromContext(handlerCtx)
+					require.Equal(t, testCase.expectApiKey, ok)
+
+					return nil, nil
+				},
+			)
+
+			require.Equal(t, testCase.expectCode, status.Code(err))
+			require.Equal(t, testCase.expectCode == codes.OK, called)
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+)
+
+// GrpcAuditCaller is a gRPC interceptor that attributes the audited operations of a request to
+// the client certificate identity of its caller (see [GrpcCallerIdentity]). Callers authenticated
+// by an API key are attributed to their key instead, see [core.AuditCallerFromContext].
+type GrpcAuditCaller struct{}
+
+// NewGrpcAuditCaller returns a new GrpcAuditCaller interceptor.
+func NewGrpcAuditCaller() *GrpcAuditCaller {
+	return &GrpcAuditCaller{}
+}
+
+// UnaryInterceptor returns the interceptor, to register on the gRPC server. It never refuses a
+// request: callers without an identity are audited as unknown.
+func (interceptor *GrpcAuditCaller) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		if identity, ok := GrpcCallerIdentity(ctx); ok {
+			ctx = core.NewAuditCallerContext(ctx, identity)
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,88 @@
+package handlers_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+func TestGrpcAuditCaller(t *testing.T) {
+	t.Parallel()
+
+	certificatePeer := &peer.Peer{AuthInfo: credentials.TLSInfo{
+		State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{
+			{Subject: pkix.Name{CommonName: "service-authentication"}},
+		}}},
+	}}
+
+	testCases := []struct {
+		name string
+
+		peer   *peer.Peer
+		apiKey *core.ApiKey
+
+		expectCaller string
+	}{
+		{
+			name: "Certificate",
+
+			peer: certificatePeer,
+
+			expectCaller: "service-authentication",
+		},
+		{
+			name: "ApiKeyTakesPrecedence",
+
+			peer:   certificatePeer,
+			apiKey: &core.ApiKey{Name: "service-other"},
+
+			expectCaller: "api-key:service-other",
+		},
+		{
+			name: "Anonymous",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			interceptor := handlers.NewGrpcAuditCaller().UnaryInterceptor()
+
+			ctx := t.Context()
+			if testCase.peer != nil {
+				ctx = peer.NewContext(ctx, testCase.peer)
+			}
+
+			if testCase.apiKey != nil {
+				ctx = core.NewApiKeyContext(ctx, testCase.apiKey)
+			}
+
+			var caller string
+
+			_, err := interceptor(
+				ctx,
+				&jsonkeysv2.ClaimsSignRequest{Usage: "auth"},
+				&grpc.UnaryServerInfo{FullMethod: jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName},
+				func(ctx context.Context, _ any) (any, error) {
+					caller = core.AuditCallerFromContext(ctx)
+
+					return nil, nil
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectCaller, caller)
+		})
+	}
+}
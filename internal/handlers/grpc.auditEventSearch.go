@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+// GrpcAuditEventSearchService is the service dependency of [GrpcAuditEventSearch].
+type GrpcAuditEventSearchService interface {
+	Exec(ctx context.Context, request *core.AuditEventSearchRequest) ([]*core.AuditEvent, error)
+}
+
+// GrpcAuditEventSearch is the gRPC handler that reads the audit trail.
+//
+// Callers authenticated by an API key must search the events of a usage the key allows: the
+// events of every usage are only readable without a key.
+type GrpcAuditEventSearch struct {
+	jsonkeysv2.UnimplementedAuditEventSearchServiceServer
+
+	service GrpcAuditEventSearchService
+}
+
+// NewGrpcAuditEventSearch returns a new GrpcAuditEventSearch handler backed by the given service.
+func NewGrpcAuditEventSearch(service GrpcAuditEventSearchService) *GrpcAuditEventSearch {
+	return &GrpcAuditEventSearch{service: service}
+}
+
+func (handler *GrpcAuditEventSearch) AuditEventSearch(
+	ctx context.Context, request *jsonkeysv2.AuditEventSearchRequest,
+) (*jsonkeysv2.AuditEventSearchResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "grpc.AuditEventSearch")
+	defer span.End()
+
+	// The API key interceptor lets an empty usage through, as it is not tied to a usage. Here, it
+	// would reach the events of usages the key was never granted.
+	apiKey, ok := core.ApiKeyFromContext(ctx)
+	if ok && !slices.Contains(apiKey.Usages, request.GetUsage()) {
+		err := status.Error(codes.PermissionDenied, "api key does not allow admin on this usage")
+		_ = otel.ReportError(span, err)
+
+		return nil, err
+	}
+
+	events, err := handler.service.Exec(ctx, &core.AuditEventSearchRequest{
+		Usage: request.GetUsage(),
+		From:  grpcOptionalTime(request.GetStart()),
+		To:    grpcOptionalTime(request.GetEnd()),
+		Limit: int(request.GetLimit()),
+	})
+	if errors.Is(err, core.ErrAuditEventSearchInvalidRange) {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.InvalidArgument, "end must be after start")
+	}
+
+	if err != nil {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return otel.ReportSuccess(span, &jsonkeysv2.AuditEventSearchResponse{
+		Events: lo.Map(events, func(item *core.AuditEvent, _ int) *jsonkeysv2.AuditEvent {
+			return &jsonkeysv2.AuditEvent{
+				Id:         item.ID.String(),
+				OccurredAt: timestamppb.New(item.OccurredAt),
+				Action:     string(item.Action),
+				Outcome:    string(item.Outcome),
+				Caller:     item.Caller,
+				Usage:      item.Usage,
+				Kid:        item.KID,
+				TokenId:    item.TokenID,
+				TokenHash:  item.TokenHash,
+				Detail:     item.Detail,
+			}
+		}),
+	}), nil
+}
@@ -0,0 +1,210 @@
+package handlers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+func TestGrpcAuditEventSearch(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	now := time.Now().UTC().Round(time.Second)
+
+	type serviceMock struct {
+		resp []*core.AuditEvent
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *jsonkeysv2.AuditEventSearchRequest
+		apiKey  *core.ApiKey
+
+		serviceRequest *core.AuditEventSearchRequest
+		serviceMock    *serviceMock
+
+		expect       *jsonkeysv2.AuditEventSearchResponse
+		expectStatus codes.Code
+	}{
+		{
+			name: "Success",
+
+			request: &jsonkeysv2.AuditEventSearchRequest{
+				Usage: "auth",
+				Start: timestamppb.New(now.Add(-time.Hour)),
+				End:   timestamppb.New(now),
+				Limit: 10,
+			},
+
+			serviceRequest: &core.AuditEventSearchRequest{
+				Usage: "auth",
+				From:  now.Add(-time.Hour),
+				To:    now,
+				Limit: 10,
+			},
+			serviceMock: &serviceMock{
+				resp: []*core.AuditEvent{
+					{
+						ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						OccurredAt: now.Add(-time.Minute),
+						Action:     core.AuditActionClaimsSign,
+						Outcome:    core.AuditOutcomeSuccess,
+						Caller:     "api-key:service-authentication",
+						Usage:      "auth",
+						KID:        "kid-1",
+						TokenID:    "jti-1",
+						TokenHash:  "hash-1",
+					},
+				},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.AuditEventSearchResponse{
+				Events: []*jsonkeysv2.AuditEvent{
+					{
+						Id:         "00000000-0000-0000-0000-000000000001",
+						OccurredAt: timestamppb.New(now.Add(-time.Minute)),
+						Action:     "claims.sign",
+						Outcome:    "success",
+						Caller:     "api-key:service-authentication",
+						Usage:      "auth",
+						Kid:        "kid-1",
+						TokenId:    "jti-1",
+						TokenHash:  "hash-1",
+					},
+				},
+			},
+		},
+		{
+			name: "Success/NoRange",
+
+			request: &jsonkeysv2.AuditEventSearchRequest{},
+
+			serviceRequest: &core.AuditEventSearchRequest{},
+			serviceMock:    &serviceMock{resp: []*core.AuditEvent{}},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.AuditEventSearchResponse{
+				Events: []*jsonkeysv2.AuditEvent{},
+			},
+		},
+		{
+			name: "Success/ApiKey",
+
+			request: &jsonkeysv2.AuditEventSearchRequest{Usage: "auth"},
+			apiKey: &core.ApiKey{
+				Usages:     []string{"auth"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationAdmin},
+			},
+
+			serviceRequest: &core.AuditEventSearchRequest{Usage: "auth"},
+			serviceMock:    &serviceMock{resp: []*core.AuditEvent{}},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.AuditEventSearchResponse{
+				Events: []*jsonkeysv2.AuditEvent{},
+			},
+		},
+		{
+			name: "Error/ApiKeyAllUsages",
+
+			request: &jsonkeysv2.AuditEventSearchRequest{},
+			apiKey: &core.ApiKey{
+				Usages:     []string{"auth"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationAdmin},
+			},
+
+			expectStatus: codes.PermissionDenied,
+		},
+		{
+			name: "Error/ApiKeyOtherUsage",
+
+			request: &jsonkeysv2.AuditEventSearchRequest{Usage: "refresh"},
+			apiKey: &core.ApiKey{
+				Usages:     []string{"auth"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationAdmin},
+			},
+
+			expectStatus: codes.PermissionDenied,
+		},
+		{
+			name: "Error/InvalidRange",
+
+			request: &jsonkeysv2.AuditEventSearchRequest{
+				Start: timestamppb.New(now),
+				End:   timestamppb.New(now.Add(-time.Hour)),
+			},
+
+			serviceRequest: &core.AuditEventSearchRequest{
+				From: now,
+				To:   now.Add(-time.Hour),
+			},
+			serviceMock: &serviceMock{err: core.ErrAuditEventSearchInvalidRange},
+
+			expectStatus: codes.InvalidArgument,
+		},
+		{
+			name: "Error/Internal",
+
+			request: &jsonkeysv2.AuditEventSearchRequest{},
+
+			serviceRequest: &core.AuditEventSearchRequest{},
+			serviceMock:    &serviceMock{err: errFoo},
+
+			expectStatus: codes.Internal,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockGrpcAuditEventSearchService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(request *core.AuditEventSearchRequest) bool {
+						return request.Usage == testCase.serviceRequest.Usage &&
+							request.From.Equal(testCase.serviceRequest.From) &&
+							request.To.Equal(testCase.serviceRequest.To) &&
+							request.Limit == testCase.serviceRequest.Limit
+					})).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			ctx := t.Context()
+			if testCase.apiKey != nil {
+				ctx = core.NewApiKeyContext(ctx, testCase.apiKey)
+			}
+
+			handler := handlers.NewGrpcAuditEventSearch(service)
+
+			res, err := handler.AuditEventSearch(ctx, testCase.request)
+			resSt, ok := status.FromError(err)
+			require.True(t, ok, resSt.Code().String())
+			require.Equal(
+				t,
+				testCase.expectStatus, resSt.Code(),
+				"expected status code %s, got %s (%v)", testCase.expectStatus, resSt.Code(), err,
+			)
+			require.Equal(t, testCase.expect, res)
+
+			service.AssertExpectations(t)
+		})
+	}
+}
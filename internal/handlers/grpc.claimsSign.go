@@ -19,7 +19,8 @@ type GrpcClaimsSignService interface {
 	Exec(ctx context.Context, request *core.ClaimsSignRequest) (string, error)
 }
 
-// GrpcClaimsSign is the gRPC handler that signs a set of claims and returns a compact JWT.
+// GrpcClaimsSign is the gRPC handler that signs a set of claims and returns a compact JWT, or a
+// multi-signature JWS JSON serialization on request.
 type GrpcClaimsSign struct {
 	jsonkeysv2.UnimplementedClaimsSignServiceServer
 
@@ -45,13 +46,19 @@ func (handler *GrpcClaimsSign) ClaimsSign(
 	}
 
 	signed, err := handler.service.Exec(ctx, &core.ClaimsSignRequest{
-		Claims: extractedClaims,
-		Usage:  request.GetUsage(),
+		Claims:         extractedClaims,
+		Usage:          request.GetUsage(),
+		MultiSignature: request.GetMultiSignature(),
 	})
 	if errors.Is(err, core.ErrConfigNotFound) {
 		return nil, status.Error(codes.Unavailable, "unknown usage")
 	}
 
+	// A frozen usage is a deliberate state of the server, that the caller cannot fix by retrying.
+	if errors.Is(err, core.ErrSigningFrozen) {
+		return nil, status.Error(codes.FailedPrecondition, "signing is frozen for this usage")
+	}
+
 	// The registered claims belong to the usage's envelope. Naming the set keeps
 	// the caller from having to guess which of their claims was refused, without
 	// echoing the service's own error text back to them.
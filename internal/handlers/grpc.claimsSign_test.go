@@ -60,6 +60,25 @@ func TestGrpcClaimsSign(t *testing.T) {
 				Token: "access-token",
 			},
 		},
+		{
+			name: "Success/MultiSignature",
+
+			request: &jsonkeysv2.ClaimsSignRequest{
+				Payload:        lo.Must(grpcf.MarshalJSONAsAny(map[string]any{"message": "hello world"})),
+				Usage:          "test-usage",
+				MultiSignature: true,
+			},
+
+			serviceMock: &serviceMock{
+				req:  map[string]any{"message": "hello world"},
+				resp: "multi-signature-token",
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.ClaimsSignResponse{
+				Token: "multi-signature-token",
+			},
+		},
 		{
 			name: "Error/InvalidPayload",
 
@@ -85,6 +104,21 @@ func TestGrpcClaimsSign(t *testing.T) {
 
 			expectStatus: codes.Unavailable,
 		},
+		{
+			name: "Error/SigningFrozen",
+
+			request: &jsonkeysv2.ClaimsSignRequest{
+				Payload: lo.Must(grpcf.MarshalJSONAsAny(map[string]any{"message": "hello world"})),
+				Usage:   "test-usage",
+			},
+
+			serviceMock: &serviceMock{
+				req: map[string]any{"message": "hello world"},
+				err: core.ErrSigningFrozen,
+			},
+
+			expectStatus: codes.FailedPrecondition,
+		},
 		{
 			// A caller naming a registered claim sent a bad request. Reporting it
 			// as Internal would blame the service for the caller's input and give
@@ -130,8 +164,9 @@ func TestGrpcClaimsSign(t *testing.T) {
 			if testCase.serviceMock != nil {
 				service.EXPECT().
 					Exec(mock.Anything, &core.ClaimsSignRequest{
-						Claims: testCase.serviceMock.req,
-						Usage:  testCase.request.GetUsage(),
+						Claims:         testCase.serviceMock.req,
+						Usage:          testCase.request.GetUsage(),
+						MultiSignature: testCase.request.GetMultiSignature(),
 					}).
 					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
 			}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/a-novel-kit/golib/grpcf"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+// grpcTokenChecks maps each [core.TokenCheck] to its proto value.
+var grpcTokenChecks = map[core.TokenCheck]jsonkeysv2.TokenCheck{
+	core.TokenCheckFormat:     jsonkeysv2.TokenCheck_TOKEN_CHECK_FORMAT,
+	core.TokenCheckAlgorithm:  jsonkeysv2.TokenCheck_TOKEN_CHECK_ALGORITHM,
+	core.TokenCheckKey:        jsonkeysv2.TokenCheck_TOKEN_CHECK_KEY,
+	core.TokenCheckSignature:  jsonkeysv2.TokenCheck_TOKEN_CHECK_SIGNATURE,
+	core.TokenCheckAudience:   jsonkeysv2.TokenCheck_TOKEN_CHECK_AUDIENCE,
+	core.TokenCheckIssuer:     jsonkeysv2.TokenCheck_TOKEN_CHECK_ISSUER,
+	core.TokenCheckSubject:    jsonkeysv2.TokenCheck_TOKEN_CHECK_SUBJECT,
+	core.TokenCheckExpiration: jsonkeysv2.TokenCheck_TOKEN_CHECK_EXPIRATION,
+	core.TokenCheckNotBefore:  jsonkeysv2.TokenCheck_TOKEN_CHECK_NOT_BEFORE,
+	core.TokenCheckRevoked:    jsonkeysv2.TokenCheck_TOKEN_CHECK_REVOKED,
+}
+
+// GrpcClaimsVerifyService is the service dependency of [GrpcClaimsVerify].
+type GrpcClaimsVerifyService interface {
+	Exec(ctx context.Context, request *core.ClaimsInspectRequest) (*core.ClaimsInspectResult, error)
+}
+
+// GrpcClaimsVerify is the gRPC handler that verifies a token on the server, and reports its claims
+// or the checks it failed.
+type GrpcClaimsVerify struct {
+	jsonkeysv2.UnimplementedClaimsVerifyServiceServer
+
+	service GrpcClaimsVerifyService
+}
+
+// NewGrpcClaimsVerify returns a new GrpcClaimsVerify handler backed by the given service.
+func NewGrpcClaimsVerify(service GrpcClaimsVerifyService) *GrpcClaimsVerify {
+	return &GrpcClaimsVerify{service: service}
+}
+
+func (handler *GrpcClaimsVerify) ClaimsVerify(
+	ctx context.Context, request *jsonkeysv2.ClaimsVerifyRequest,
+) (*jsonkeysv2.ClaimsVerifyResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "grpc.ClaimsVerify")
+	defer span.End()
+
+	result, err := handler.service.Exec(ctx, &core.ClaimsInspectRequest{
+		Token:         request.GetToken(),
+		Usage:         request.GetUsage(),
+		IgnoreExpired: request.GetIgnoreExpired(),
+	})
+	if errors.Is(err, core.ErrConfigNotFound) {
+		return nil, status.Error(codes.Unavailable, "unknown usage")
+	}
+
+	if err != nil {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	output := &jsonkeysv2.ClaimsVerifyResponse{Valid: result.Valid()}
+
+	if result.Header != nil {
+		output.Kid = result.Header.KID
+	}
+
+	if !output.GetValid() {
+		output.Failures = lo.Map(result.Failures, func(item *core.TokenCheckFailure, _ int) *jsonkeysv2.TokenCheckFailure {
+			return &jsonkeysv2.TokenCheckFailure{Check: grpcTokenChecks[item.Check], Message: item.Message}
+		})
+		output.Reason = output.GetFailures()[0].GetCheck()
+
+		return otel.ReportSuccess(span, output), nil
+	}
+
+	// The claims are only trusted, and so only returned, once the token passed every check.
+	output.Signer = result.Signer
+
+	output.Claims, err = grpcf.MarshalJSONAsAny(result.Claims)
+	if err != nil {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return otel.ReportSuccess(span, output), nil
+}
@@ -0,0 +1,195 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/a-novel-kit/golib/grpcf"
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+func TestGrpcClaimsVerify(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	header := &jwa.JWH{
+		JWHCommon: jwa.JWHCommon{JWHEmbeddedKey: jwa.JWHEmbeddedKey{KID: "key-1"}, Alg: jwa.EdDSA},
+	}
+
+	type serviceMock struct {
+		resp *core.ClaimsInspectResult
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *jsonkeysv2.ClaimsVerifyRequest
+
+		serviceMock *serviceMock
+
+		expect       *jsonkeysv2.ClaimsVerifyResponse
+		expectClaims any
+		expectStatus codes.Code
+	}{
+		{
+			name: "Success",
+
+			request: &jsonkeysv2.ClaimsVerifyRequest{Usage: "test-usage", Token: "token"},
+
+			serviceMock: &serviceMock{
+				resp: &core.ClaimsInspectResult{
+					Header: header,
+					Claims: json.RawMessage(`{"message":"hello world"}`),
+					Signer: "test-usage",
+				},
+			},
+
+			expect: &jsonkeysv2.ClaimsVerifyResponse{
+				Valid:  true,
+				Signer: "test-usage",
+				Kid:    "key-1",
+			},
+			expectClaims: map[string]any{"message": "hello world"},
+			expectStatus: codes.OK,
+		},
+		{
+			name: "Success/IgnoreExpired",
+
+			request: &jsonkeysv2.ClaimsVerifyRequest{Usage: "test-usage", Token: "token", IgnoreExpired: true},
+
+			serviceMock: &serviceMock{
+				resp: &core.ClaimsInspectResult{
+					Header: header,
+					Claims: json.RawMessage(`{"message":"hello world"}`),
+					Signer: "test-usage",
+				},
+			},
+
+			expect: &jsonkeysv2.ClaimsVerifyResponse{
+				Valid:  true,
+				Signer: "test-usage",
+				Kid:    "key-1",
+			},
+			expectClaims: map[string]any{"message": "hello world"},
+			expectStatus: codes.OK,
+		},
+		{
+			// Claims of a token that failed a check cannot be trusted, and are not returned.
+			name: "Success/Invalid",
+
+			request: &jsonkeysv2.ClaimsVerifyRequest{Usage: "test-usage", Token: "token"},
+
+			serviceMock: &serviceMock{
+				resp: &core.ClaimsInspectResult{
+					Header: header,
+					Claims: json.RawMessage(`{"message":"hello world"}`),
+					Failures: []*core.TokenCheckFailure{
+						{Check: core.TokenCheckSignature, Message: "bad signature"},
+						{Check: core.TokenCheckExpiration, Message: "expired"},
+					},
+				},
+			},
+
+			expect: &jsonkeysv2.ClaimsVerifyResponse{
+				Kid:    "key-1",
+				Reason: jsonkeysv2.TokenCheck_TOKEN_CHECK_SIGNATURE,
+				Failures: []*jsonkeysv2.TokenCheckFailure{
+					{Check: jsonkeysv2.TokenCheck_TOKEN_CHECK_SIGNATURE, Message: "bad signature"},
+					{Check: jsonkeysv2.TokenCheck_TOKEN_CHECK_EXPIRATION, Message: "expired"},
+				},
+			},
+			expectStatus: codes.OK,
+		},
+		{
+			name: "Success/Malformed",
+
+			request: &jsonkeysv2.ClaimsVerifyRequest{Usage: "test-usage", Token: "token"},
+
+			serviceMock: &serviceMock{
+				resp: &core.ClaimsInspectResult{
+					Failures: []*core.TokenCheckFailure{{Check: core.TokenCheckFormat, Message: "malformed"}},
+				},
+			},
+
+			expect: &jsonkeysv2.ClaimsVerifyResponse{
+				Reason: jsonkeysv2.TokenCheck_TOKEN_CHECK_FORMAT,
+				Failures: []*jsonkeysv2.TokenCheckFailure{
+					{Check: jsonkeysv2.TokenCheck_TOKEN_CHECK_FORMAT, Message: "malformed"},
+				},
+			},
+			expectStatus: codes.OK,
+		},
+		{
+			name: "Error/BadConfig",
+
+			request: &jsonkeysv2.ClaimsVerifyRequest{Usage: "test-usage", Token: "token"},
+
+			serviceMock: &serviceMock{err: core.ErrConfigNotFound},
+
+			expectStatus: codes.Unavailable,
+		},
+		{
+			name: "Error/Internal",
+
+			request: &jsonkeysv2.ClaimsVerifyRequest{Usage: "test-usage", Token: "token"},
+
+			serviceMock: &serviceMock{err: errFoo},
+
+			expectStatus: codes.Internal,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockGrpcClaimsVerifyService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, &core.ClaimsInspectRequest{
+						Token:         testCase.request.GetToken(),
+						Usage:         testCase.request.GetUsage(),
+						IgnoreExpired: testCase.request.GetIgnoreExpired(),
+					}).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewGrpcClaimsVerify(service)
+
+			res, err := handler.ClaimsVerify(t.Context(), testCase.request)
+			resSt, ok := status.FromError(err)
+			require.True(t, ok, resSt.Code().String())
+			require.Equal(
+				t,
+				testCase.expectStatus, resSt.Code(),
+				"expected status code %s, got %s (%v)", testCase.expectStatus, resSt.Code(), err,
+			)
+
+			if testCase.expectClaims != nil {
+				claims, err := grpcf.UnmarshalJSONFromAny(res.GetClaims())
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectClaims, claims)
+
+				res.Claims = nil
+			}
+
+			require.Equal(t, testCase.expect, res)
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"context"
+
+	golibproto "github.com/a-novel-kit/golib/grpcf/proto/gen"
+)
+
+// GrpcEcho is the gRPC handler of the echo service, a connectivity check that answers without
+// touching any dependency.
+type GrpcEcho struct {
+	golibproto.UnimplementedEchoServiceServer
+}
+
+// NewGrpcEcho returns a new GrpcEcho handler.
+func NewGrpcEcho() *GrpcEcho {
+	return &GrpcEcho{}
+}
+
+func (handler *GrpcEcho) UnaryEcho(
+	context.Context, *golibproto.UnaryEchoRequest,
+) (*golibproto.UnaryEchoResponse, error) {
+	return &golibproto.UnaryEchoResponse{Message: "Hello world!"}, nil
+}
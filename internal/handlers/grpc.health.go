@@ -0,0 +1,235 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+// ErrGrpcHealthNoSigningKey is reported when a configured usage has no active key to sign with.
+var ErrGrpcHealthNoSigningKey = errors.New("no signing key")
+
+// GrpcHealthServiceLifecycle is the key lifecycle service dependency of [GrpcHealth].
+type GrpcHealthServiceLifecycle interface {
+	Exec(ctx context.Context, request *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error)
+}
+
+// GrpcHealthServiceWarmUp is the key warm-up service dependency of [GrpcHealth].
+type GrpcHealthServiceWarmUp interface {
+	Exec(ctx context.Context) error
+}
+
+// grpcHealthDatabaseServices lists the services that serve when Postgres is reachable.
+var grpcHealthDatabaseServices = []string{
+	jsonkeysv2.JwkGetService_ServiceDesc.ServiceName,
+	jsonkeysv2.JwkListService_ServiceDesc.ServiceName,
+	jsonkeysv2.ClaimsVerifyService_ServiceDesc.ServiceName,
+	jsonkeysv2.AuditEventSearchService_ServiceDesc.ServiceName,
+	jsonkeysv2.JwkRotateService_ServiceDesc.ServiceName,
+	jsonkeysv2.JwkBurnService_ServiceDesc.ServiceName,
+	jsonkeysv2.SigningFreezeService_ServiceDesc.ServiceName,
+	jsonkeysv2.RevokeTokenService_ServiceDesc.ServiceName,
+	jsonkeysv2.RevokedTokenSyncService_ServiceDesc.ServiceName,
+}
+
+// grpcHealthSigningServices lists the services that serve when Postgres is reachable, and every
+// configured usage has a key to sign with.
+var grpcHealthSigningServices = []string{
+	jsonkeysv2.ClaimsSignService_ServiceDesc.ServiceName,
+	jsonkeysv2.PayloadSignService_ServiceDesc.ServiceName,
+	jsonkeysv2.HttpSignatureSignService_ServiceDesc.ServiceName,
+}
+
+// GrpcHealth implements the standard gRPC health checking protocol (grpc.health.v1), for
+// Kubernetes probes and service meshes. It reports a status per service:
+//
+//   - the overall server (the empty service name) serves when Postgres is reachable, and the
+//     signing keys of every usage have been warmed up. This is the readiness status.
+//   - JwkGetService, JwkListService, ClaimsVerifyService, AuditEventSearchService,
+//     JwkRotateService, JwkBurnService, SigningFreezeService, RevokeTokenService and
+//     RevokedTokenSyncService serve when Postgres is reachable.
+//   - ClaimsSignService, PayloadSignService and HttpSignatureSignService serve when Postgres is
+//     reachable, and every usage in the configuration has a main key to sign with.
+//   - StatusService always serves: it reports what is wrong.
+//
+// Statuses are assessed by [GrpcHealth.Refresh], which [GrpcHealth.Run] calls periodically.
+// Watch streams receive every change. Refreshes retry the warm-up until it succeeds once.
+type GrpcHealth struct {
+	*health.Server
+
+	serviceLifecycle GrpcHealthServiceLifecycle
+	serviceWarmUp    GrpcHealthServiceWarmUp
+	keysConfig       map[string]*config.Jwk
+
+	warm atomic.Bool
+}
+
+// NewGrpcHealth returns a new GrpcHealth handler. Every service reports NOT_SERVING until the
+// first refresh.
+func NewGrpcHealth(
+	serviceLifecycle GrpcHealthServiceLifecycle,
+	serviceWarmUp GrpcHealthServiceWarmUp,
+	keysConfig map[string]*config.Jwk,
+) *GrpcHealth {
+	handler := &GrpcHealth{
+		Server:           health.NewServer(),
+		serviceLifecycle: serviceLifecycle,
+		serviceWarmUp:    serviceWarmUp,
+		keysConfig:       keysConfig,
+	}
+
+	handler.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	for _, service := range grpcHealthDatabaseServices {
+		handler.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	for _, service := range grpcHealthSigningServices {
+		handler.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	handler.SetServingStatus(jsonkeysv2.StatusService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
+	return handler
+}
+
+// Run refreshes the statuses now, then at every interval, until ctx is canceled. The context
+// must carry the database connection. A non-positive interval refreshes once.
+func (handler *GrpcHealth) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		handler.Refresh(ctx)
+
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		handler.Refresh(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh assesses the dependencies once, and updates the statuses. Statuses left unchanged
+// notify no watcher.
+func (handler *GrpcHealth) Refresh(ctx context.Context) {
+	ctx, span := otel.Tracer().Start(ctx, "grpc.Health")
+	defer span.End()
+
+	databaseUp := handler.reportPostgres(ctx) == nil
+	warmUp := databaseUp && handler.reportWarmUp(ctx) == nil
+	signingUp := warmUp && handler.reportSigningKeys(ctx) == nil
+
+	span.SetAttributes(
+		attribute.Bool("health.database", databaseUp),
+		attribute.Bool("health.warm_up", warmUp),
+		attribute.Bool("health.signing", signingUp),
+	)
+
+	databaseStatus := servingStatus(databaseUp)
+	signingStatus := servingStatus(signingUp)
+
+	handler.SetServingStatus("", servingStatus(warmUp))
+
+	for _, service := range grpcHealthDatabaseServices {
+		handler.SetServingStatus(service, databaseStatus)
+	}
+
+	for _, service := range grpcHealthSigningServices {
+		handler.SetServingStatus(service, signingStatus)
+	}
+
+	otel.ReportSuccessNoContent(span)
+}
+
+// reportWarmUp runs the warm-up, until it succeeds once: cached keys are then refreshed by
+// their sources.
+func (handler *GrpcHealth) reportWarmUp(ctx context.Context) error {
+	if handler.warm.Load() {
+		return nil
+	}
+
+	ctx, span := otel.Tracer().Start(ctx, "grpc.Health(reportWarmUp)")
+	defer span.End()
+
+	err := handler.serviceWarmUp.Exec(ctx)
+	if err != nil {
+		return otel.ReportError(span, err)
+	}
+
+	handler.warm.Store(true)
+
+	otel.ReportSuccessNoContent(span)
+
+	return nil
+}
+
+func (handler *GrpcHealth) reportSigningKeys(ctx context.Context) error {
+	ctx, span := otel.Tracer().Start(ctx, "grpc.Health(reportSigningKeys)")
+	defer span.End()
+
+	for usage := range handler.keysConfig {
+		lifecycle, err := handler.serviceLifecycle.Exec(ctx, &core.JwkLifecycleRequest{Usage: usage})
+		if err != nil {
+			return otel.ReportError(span, fmt.Errorf("usage %s: %w", usage, err))
+		}
+
+		if !lifecycle.CanSign() {
+			return otel.ReportError(span, fmt.Errorf("usage %s: %w", usage, ErrGrpcHealthNoSigningKey))
+		}
+	}
+
+	otel.ReportSuccessNoContent(span)
+
+	return nil
+}
+
+func (handler *GrpcHealth) reportPostgres(ctx context.Context) error {
+	ctx, span := otel.Tracer().Start(ctx, "grpc.Health(reportPostgres)")
+	defer span.End()
+
+	pg, err := postgres.GetContext(ctx)
+	if err != nil {
+		return otel.ReportError(span, err)
+	}
+
+	pgdb, ok := pg.(*bun.DB)
+	if !ok {
+		// Cannot assess the DB connection in transaction mode.
+		return nil
+	}
+
+	err = pgdb.PingContext(ctx)
+	if err != nil {
+		return otel.ReportError(span, err)
+	}
+
+	otel.ReportSuccessNoContent(span)
+
+	return nil
+}
+
+func servingStatus(up bool) healthpb.HealthCheckResponse_ServingStatus {
+	return lo.Ternary(up, healthpb.HealthCheckResponse_SERVING, healthpb.HealthCheckResponse_NOT_SERVING)
+}
@@ -0,0 +1,238 @@
+package handlers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+func TestGrpcHealth(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	const (
+		serving    = healthpb.HealthCheckResponse_SERVING
+		notServing = healthpb.HealthCheckResponse_NOT_SERVING
+	)
+
+	keysConfig := map[string]*config.Jwk{"test-usage": {}}
+
+	type serviceLifecycleMock struct {
+		resp *core.JwkLifecycleStatus
+		err  error
+	}
+
+	type serviceWarmUpMock struct {
+		err error
+	}
+
+	testCases := []struct {
+		name string
+
+		skipPostgres bool
+		refresh      bool
+
+		serviceWarmUpMock    *serviceWarmUpMock
+		serviceLifecycleMock *serviceLifecycleMock
+
+		expectReady    healthpb.HealthCheckResponse_ServingStatus
+		expectDatabase healthpb.HealthCheckResponse_ServingStatus
+		expectSigning  healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{
+			name: "Success",
+
+			refresh: true,
+
+			serviceWarmUpMock: &serviceWarmUpMock{},
+			serviceLifecycleMock: &serviceLifecycleMock{
+				resp: &core.JwkLifecycleStatus{ActiveKeys: 1, MainKID: "kid-1", SigningKeys: 1, Rotation: time.Hour},
+			},
+
+			expectReady:    serving,
+			expectDatabase: serving,
+			expectSigning:  serving,
+		},
+		{
+			name: "Success/NoSigningKey",
+
+			refresh: true,
+
+			serviceWarmUpMock: &serviceWarmUpMock{},
+			serviceLifecycleMock: &serviceLifecycleMock{
+				resp: &core.JwkLifecycleStatus{Rotation: time.Hour},
+			},
+
+			expectReady:    serving,
+			expectDatabase: serving,
+			expectSigning:  notServing,
+		},
+		{
+			// In the middle of an algorithm migration, the keys of the usage all use its previous
+			// algorithm: none signs.
+			name: "Success/NoSigningKeyAlgMigration",
+
+			refresh: true,
+
+			serviceWarmUpMock: &serviceWarmUpMock{},
+			serviceLifecycleMock: &serviceLifecycleMock{
+				resp: &core.JwkLifecycleStatus{ActiveKeys: 1, MainKID: "kid-1", Rotation: time.Hour},
+			},
+
+			expectReady:    serving,
+			expectDatabase: serving,
+			expectSigning:  notServing,
+		},
+		{
+			name: "Success/LifecycleError",
+
+			refresh: true,
+
+			serviceWarmUpMock:    &serviceWarmUpMock{},
+			serviceLifecycleMock: &serviceLifecycleMock{err: errFoo},
+
+			expectReady:    serving,
+			expectDatabase: serving,
+			expectSigning:  notServing,
+		},
+		{
+			// The server is not ready until its signing keys are loaded, though it can serve
+			// key reads.
+			name: "Success/WarmUpError",
+
+			refresh: true,
+
+			serviceWarmUpMock: &serviceWarmUpMock{err: core.ErrJwkNotFound},
+
+			expectReady:    notServing,
+			expectDatabase: serving,
+			expectSigning:  notServing,
+		},
+		{
+			// Without Postgres, the keys are not even read.
+			name: "Success/PostgresDown",
+
+			skipPostgres: true,
+			refresh:      true,
+
+			expectReady:    notServing,
+			expectDatabase: notServing,
+			expectSigning:  notServing,
+		},
+		{
+			name: "Success/NotRefreshed",
+
+			expectReady:    notServing,
+			expectDatabase: notServing,
+			expectSigning:  notServing,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			serviceLifecycle := handlersmocks.NewMockGrpcHealthServiceLifecycle(t)
+			serviceWarmUp := handlersmocks.NewMockGrpcHealthServiceWarmUp(t)
+
+			if testCase.serviceWarmUpMock != nil {
+				serviceWarmUp.EXPECT().
+					Exec(mock.Anything).
+					Return(testCase.serviceWarmUpMock.err)
+			}
+
+			if testCase.serviceLifecycleMock != nil {
+				serviceLifecycle.EXPECT().
+					Exec(mock.Anything, &core.JwkLifecycleRequest{Usage: "test-usage"}).
+					Return(testCase.serviceLifecycleMock.resp, testCase.serviceLifecycleMock.err)
+			}
+
+			handler := handlers.NewGrpcHealth(serviceLifecycle, serviceWarmUp, keysConfig)
+
+			ctx := t.Context()
+
+			if !testCase.skipPostgres {
+				var err error
+
+				ctx, err = postgres.NewContext(ctx, configtest.PostgresPreset)
+				require.NoError(t, err)
+			}
+
+			if testCase.refresh {
+				handler.Refresh(ctx)
+			}
+
+			expect := map[string]healthpb.HealthCheckResponse_ServingStatus{
+				"":                                  testCase.expectReady,
+				"anovel.jsonkeys.v2.JwkGetService":  testCase.expectDatabase,
+				"anovel.jsonkeys.v2.JwkListService": testCase.expectDatabase,
+				"anovel.jsonkeys.v2.AuditEventSearchService":  testCase.expectDatabase,
+				"anovel.jsonkeys.v2.JwkRotateService":         testCase.expectDatabase,
+				"anovel.jsonkeys.v2.JwkBurnService":           testCase.expectDatabase,
+				"anovel.jsonkeys.v2.SigningFreezeService":     testCase.expectDatabase,
+				"anovel.jsonkeys.v2.RevokeTokenService":       testCase.expectDatabase,
+				"anovel.jsonkeys.v2.RevokedTokenSyncService":  testCase.expectDatabase,
+				"anovel.jsonkeys.v2.ClaimsVerifyService":      testCase.expectDatabase,
+				"anovel.jsonkeys.v2.ClaimsSignService":        testCase.expectSigning,
+				"anovel.jsonkeys.v2.PayloadSignService":       testCase.expectSigning,
+				"anovel.jsonkeys.v2.HttpSignatureSignService": testCase.expectSigning,
+				// The status service always answers, to tell what is wrong.
+				jsonkeysv2.StatusService_ServiceDesc.ServiceName: serving,
+			}
+
+			for service, status := range expect {
+				res, err := handler.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+				require.NoError(t, err)
+				require.Equal(t, status, res.GetStatus(), service)
+			}
+
+			serviceLifecycle.AssertExpectations(t)
+			serviceWarmUp.AssertExpectations(t)
+		})
+	}
+}
+
+// TestGrpcHealthWarmUpOnce checks that refreshes stop warming the keys once it has succeeded:
+// the cached sources refresh themselves from there.
+func TestGrpcHealthWarmUpOnce(t *testing.T) {
+	t.Parallel()
+
+	ctx, err := postgres.NewContext(t.Context(), configtest.PostgresPreset)
+	require.NoError(t, err)
+
+	serviceLifecycle := handlersmocks.NewMockGrpcHealthServiceLifecycle(t)
+	serviceWarmUp := handlersmocks.NewMockGrpcHealthServiceWarmUp(t)
+
+	serviceWarmUp.EXPECT().Exec(mock.Anything).Return(core.ErrJwkNotFound).Once()
+	serviceWarmUp.EXPECT().Exec(mock.Anything).Return(nil).Once()
+
+	handler := handlers.NewGrpcHealth(serviceLifecycle, serviceWarmUp, map[string]*config.Jwk{})
+
+	for _, expect := range []healthpb.HealthCheckResponse_ServingStatus{
+		healthpb.HealthCheckResponse_NOT_SERVING,
+		healthpb.HealthCheckResponse_SERVING,
+		healthpb.HealthCheckResponse_SERVING,
+	} {
+		handler.Refresh(ctx)
+
+		res, err := handler.Check(ctx, &healthpb.HealthCheckRequest{})
+		require.NoError(t, err)
+		require.Equal(t, expect, res.GetStatus())
+	}
+
+	serviceWarmUp.AssertExpectations(t)
+}
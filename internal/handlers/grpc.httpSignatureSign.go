@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+// GrpcHttpSignatureSignService is the service dependency of [GrpcHttpSignatureSign].
+type GrpcHttpSignatureSignService interface {
+	Exec(ctx context.Context, request *core.HttpSignatureSignRequest) (*core.HttpSignatureSignResponse, error)
+}
+
+// GrpcHttpSignatureSign is the gRPC handler that signs HTTP messages, following RFC 9421.
+type GrpcHttpSignatureSign struct {
+	jsonkeysv2.UnimplementedHttpSignatureSignServiceServer
+
+	service GrpcHttpSignatureSignService
+}
+
+// NewGrpcHttpSignatureSign returns a new GrpcHttpSignatureSign handler backed by the given service.
+func NewGrpcHttpSignatureSign(service GrpcHttpSignatureSignService) *GrpcHttpSignatureSign {
+	return &GrpcHttpSignatureSign{service: service}
+}
+
+func (handler *GrpcHttpSignatureSign) HttpSignatureSign(
+	ctx context.Context, request *jsonkeysv2.HttpSignatureSignRequest,
+) (*jsonkeysv2.HttpSignatureSignResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "grpc.HttpSignatureSign")
+	defer span.End()
+
+	params := &core.HttpSignatureParams{
+		Components: make([]string, len(request.GetComponents())),
+		Created:    grpcOptionalTime(request.GetCreated()),
+		Expires:    grpcOptionalTime(request.GetExpires()),
+		Nonce:      request.GetNonce(),
+		Tag:        request.GetTag(),
+	}
+	values := make([]string, len(request.GetComponents()))
+
+	for i, component := range request.GetComponents() {
+		params.Components[i] = component.GetName()
+		values[i] = component.GetValue()
+	}
+
+	signed, err := handler.service.Exec(ctx, &core.HttpSignatureSignRequest{
+		Usage:  request.GetUsage(),
+		Params: params,
+		Values: values,
+	})
+	if errors.Is(err, core.ErrConfigNotFound) {
+		return nil, status.Error(codes.Unavailable, "unknown usage")
+	}
+
+	// A frozen usage is a deliberate state of the server, that the caller cannot fix by retrying.
+	if errors.Is(err, core.ErrSigningFrozen) {
+		return nil, status.Error(codes.FailedPrecondition, "signing is frozen for this usage")
+	}
+
+	if errors.Is(err, core.ErrHttpSignatureInvalidComponent) {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err != nil {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return otel.ReportSuccess(span, &jsonkeysv2.HttpSignatureSignResponse{
+		SignatureParams: signed.Params.String(),
+		Signature:       signed.Signature,
+	}), nil
+}
+
+// grpcOptionalTime converts an optional timestamp, mapping an unset one to the zero time.
+func grpcOptionalTime(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+
+	return timestamp.AsTime()
+}
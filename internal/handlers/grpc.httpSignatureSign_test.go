@@ -0,0 +1,180 @@
+package handlers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+func TestGrpcHttpSignatureSign(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	created := time.Unix(1618884473, 0).UTC()
+
+	type serviceMock struct {
+		req  *core.HttpSignatureSignRequest
+		resp *core.HttpSignatureSignResponse
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *jsonkeysv2.HttpSignatureSignRequest
+
+		serviceMock *serviceMock
+
+		expect       *jsonkeysv2.HttpSignatureSignResponse
+		expectStatus codes.Code
+	}{
+		{
+			name: "Success",
+
+			request: &jsonkeysv2.HttpSignatureSignRequest{
+				Usage: "test-usage",
+				Components: []*jsonkeysv2.HttpSignatureComponent{
+					{Name: "@method", Value: "POST"},
+					{Name: "content-type", Value: "application/json"},
+				},
+				Created: timestamppb.New(created),
+				Nonce:   "nonce",
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.HttpSignatureSignRequest{
+					Usage: "test-usage",
+					Params: &core.HttpSignatureParams{
+						Components: []string{"@method", "content-type"},
+						Created:    created,
+						Nonce:      "nonce",
+					},
+					Values: []string{"POST", "application/json"},
+				},
+				resp: &core.HttpSignatureSignResponse{
+					Params: &core.HttpSignatureParams{
+						Components: []string{"@method", "content-type"},
+						Created:    created,
+						Nonce:      "nonce",
+						Alg:        "ed25519",
+						KeyID:      "kid",
+					},
+					Signature: []byte("signature"),
+				},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.HttpSignatureSignResponse{
+				SignatureParams: `("@method" "content-type");created=1618884473;nonce="nonce";alg="ed25519";keyid="kid"`,
+				Signature:       []byte("signature"),
+			},
+		},
+		{
+			name: "Error/BadConfig",
+
+			request: &jsonkeysv2.HttpSignatureSignRequest{Usage: "test-usage"},
+
+			serviceMock: &serviceMock{
+				req: &core.HttpSignatureSignRequest{
+					Usage:  "test-usage",
+					Params: &core.HttpSignatureParams{Components: []string{}},
+					Values: []string{},
+				},
+				err: core.ErrConfigNotFound,
+			},
+
+			expectStatus: codes.Unavailable,
+		},
+		{
+			name: "Error/Frozen",
+
+			request: &jsonkeysv2.HttpSignatureSignRequest{Usage: "test-usage"},
+
+			serviceMock: &serviceMock{
+				req: &core.HttpSignatureSignRequest{
+					Usage:  "test-usage",
+					Params: &core.HttpSignatureParams{Components: []string{}},
+					Values: []string{},
+				},
+				err: core.ErrSigningFrozen,
+			},
+
+			expectStatus: codes.FailedPrecondition,
+		},
+		{
+			name: "Error/InvalidComponent",
+
+			request: &jsonkeysv2.HttpSignatureSignRequest{
+				Usage:      "test-usage",
+				Components: []*jsonkeysv2.HttpSignatureComponent{{Name: "@status", Value: "200"}},
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.HttpSignatureSignRequest{
+					Usage:  "test-usage",
+					Params: &core.HttpSignatureParams{Components: []string{"@status"}},
+					Values: []string{"200"},
+				},
+				err: core.ErrHttpSignatureInvalidComponent,
+			},
+
+			expectStatus: codes.InvalidArgument,
+		},
+		{
+			name: "Error/Internal",
+
+			request: &jsonkeysv2.HttpSignatureSignRequest{Usage: "test-usage"},
+
+			serviceMock: &serviceMock{
+				req: &core.HttpSignatureSignRequest{
+					Usage:  "test-usage",
+					Params: &core.HttpSignatureParams{Components: []string{}},
+					Values: []string{},
+				},
+				err: errFoo,
+			},
+
+			expectStatus: codes.Internal,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockGrpcHttpSignatureSignService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewGrpcHttpSignatureSign(service)
+
+			res, err := handler.HttpSignatureSign(t.Context(), testCase.request)
+			resSt, ok := status.FromError(err)
+			require.True(t, ok, resSt.Code().String())
+			require.Equal(
+				t,
+				testCase.expectStatus, resSt.Code(),
+				"expected status code %s, got %s (%v)", testCase.expectStatus, resSt.Code(), err,
+			)
+			require.Equal(t, testCase.expect, res)
+
+			service.AssertExpectations(t)
+		})
+	}
+}
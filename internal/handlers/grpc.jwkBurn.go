@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+// GrpcJwkBurnService is the service dependency of [GrpcJwkBurn].
+type GrpcJwkBurnService interface {
+	Exec(ctx context.Context, request *core.JwkBurnRequest) (*core.JwkBurnResponse, error)
+}
+
+// GrpcJwkBurn is the gRPC handler that replaces every key of a usage.
+type GrpcJwkBurn struct {
+	jsonkeysv2.UnimplementedJwkBurnServiceServer
+
+	service GrpcJwkBurnService
+}
+
+// NewGrpcJwkBurn returns a new GrpcJwkBurn handler backed by the given service.
+func NewGrpcJwkBurn(service GrpcJwkBurnService) *GrpcJwkBurn {
+	return &GrpcJwkBurn{service: service}
+}
+
+func (handler *GrpcJwkBurn) JwkBurn(
+	ctx context.Context, request *jsonkeysv2.JwkBurnRequest,
+) (*jsonkeysv2.JwkBurnResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "grpc.JwkBurn")
+	defer span.End()
+
+	resp, err := handler.service.Exec(ctx, &core.JwkBurnRequest{
+		Usage:   request.GetUsage(),
+		Comment: request.GetComment(),
+	})
+	if errors.Is(err, core.ErrConfigNotFound) {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.NotFound, "usage not found")
+	}
+
+	if errors.Is(err, core.ErrJwkRevokeNoComment) {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.InvalidArgument, "burning a usage needs a comment")
+	}
+
+	if err != nil {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return otel.ReportSuccess(span, &jsonkeysv2.JwkBurnResponse{
+		Kid:       resp.Key.KID.String(),
+		ExpiresAt: timestamppb.New(resp.Key.ExpiresAt),
+		RevokedKids: lo.Map(resp.Revoked, func(item *core.JwkMetadata, _ int) string {
+			return item.KID.String()
+		}),
+	}), nil
+}
@@ -0,0 +1,129 @@
+package handlers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+func TestGrpcJwkBurn(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	now := time.Now().UTC().Round(time.Second)
+
+	type serviceMock struct {
+		resp *core.JwkBurnResponse
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *jsonkeysv2.JwkBurnRequest
+
+		serviceMock *serviceMock
+
+		expect       *jsonkeysv2.JwkBurnResponse
+		expectStatus codes.Code
+	}{
+		{
+			name: "Success",
+
+			request: &jsonkeysv2.JwkBurnRequest{Usage: "auth", Comment: "leaked"},
+
+			serviceMock: &serviceMock{
+				resp: &core.JwkBurnResponse{
+					Key: &core.JwkMetadata{
+						KID:       uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						Usage:     "auth",
+						Main:      true,
+						ExpiresAt: now.Add(24 * time.Hour),
+					},
+					Revoked: []*core.JwkMetadata{
+						{KID: uuid.MustParse("00000000-0000-0000-0000-000000000002"), RevokedAt: &now},
+						{KID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), RevokedAt: &now},
+					},
+				},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.JwkBurnResponse{
+				Kid:       "00000000-0000-0000-0000-000000000003",
+				ExpiresAt: timestamppb.New(now.Add(24 * time.Hour)),
+				RevokedKids: []string{
+					"00000000-0000-0000-0000-000000000002",
+					"00000000-0000-0000-0000-000000000001",
+				},
+			},
+		},
+		{
+			name: "Error/ConfigNotFound",
+
+			request: &jsonkeysv2.JwkBurnRequest{Usage: "auth", Comment: "leaked"},
+
+			serviceMock: &serviceMock{err: core.ErrConfigNotFound},
+
+			expectStatus: codes.NotFound,
+		},
+		{
+			name: "Error/NoComment",
+
+			request: &jsonkeysv2.JwkBurnRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{err: core.ErrJwkRevokeNoComment},
+
+			expectStatus: codes.InvalidArgument,
+		},
+		{
+			name: "Error/Internal",
+
+			request: &jsonkeysv2.JwkBurnRequest{Usage: "auth", Comment: "leaked"},
+
+			serviceMock: &serviceMock{err: errFoo},
+
+			expectStatus: codes.Internal,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockGrpcJwkBurnService(t)
+
+			service.EXPECT().
+				Exec(mock.Anything, &core.JwkBurnRequest{
+					Usage:   testCase.request.GetUsage(),
+					Comment: testCase.request.GetComment(),
+				}).
+				Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+
+			handler := handlers.NewGrpcJwkBurn(service)
+
+			res, err := handler.JwkBurn(t.Context(), testCase.request)
+			resSt, ok := status.FromError(err)
+			require.True(t, ok, resSt.Code().String())
+			require.Equal(
+				t,
+				testCase.expectStatus, resSt.Code(),
+				"expected status code %s, got %s (%v)", testCase.expectStatus, resSt.Code(), err,
+			)
+			require.Equal(t, testCase.expect, res)
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+// GrpcJwkRotateService is the service dependency of [GrpcJwkRotate].
+type GrpcJwkRotateService interface {
+	Exec(ctx context.Context, request *core.JwkRotateRequest) (*core.JwkRotateResponse, error)
+}
+
+// GrpcJwkRotate is the gRPC handler that replaces the main key of a usage on demand.
+type GrpcJwkRotate struct {
+	jsonkeysv2.UnimplementedJwkRotateServiceServer
+
+	service GrpcJwkRotateService
+}
+
+// NewGrpcJwkRotate returns a new GrpcJwkRotate handler backed by the given service.
+func NewGrpcJwkRotate(service GrpcJwkRotateService) *GrpcJwkRotate {
+	return &GrpcJwkRotate{service: service}
+}
+
+func (handler *GrpcJwkRotate) JwkRotate(
+	ctx context.Context, request *jsonkeysv2.JwkRotateRequest,
+) (*jsonkeysv2.JwkRotateResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "grpc.JwkRotate")
+	defer span.End()
+
+	resp, err := handler.service.Exec(ctx, &core.JwkRotateRequest{
+		Usage:          request.GetUsage(),
+		RevokePrevious: request.GetRevokePrevious(),
+		Comment:        request.GetComment(),
+	})
+	if errors.Is(err, core.ErrConfigNotFound) {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.NotFound, "usage not found")
+	}
+
+	if errors.Is(err, core.ErrJwkRevokeNoComment) {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.InvalidArgument, "revoking the previous key needs a comment")
+	}
+
+	if err != nil {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	output := &jsonkeysv2.JwkRotateResponse{
+		Kid:       resp.Key.KID.String(),
+		ExpiresAt: timestamppb.New(resp.Key.ExpiresAt),
+	}
+
+	if resp.Previous != nil {
+		output.PreviousKid = resp.Previous.KID.String()
+		output.PreviousExpiresAt = timestamppb.New(resp.Previous.ExpiresAt)
+		output.PreviousStatus = jsonkeysv2.PreviousKeyStatus_PREVIOUS_KEY_STATUS_ACTIVE
+
+		if resp.Previous.RevokedAt != nil {
+			output.PreviousStatus = jsonkeysv2.PreviousKeyStatus_PREVIOUS_KEY_STATUS_REVOKED
+		}
+	}
+
+	return otel.ReportSuccess(span, output), nil
+}
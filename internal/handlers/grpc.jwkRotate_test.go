@@ -0,0 +1,172 @@
+package handlers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+func TestGrpcJwkRotate(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	now := time.Now().UTC().Round(time.Second)
+
+	newKey := &core.JwkMetadata{
+		KID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Usage:     "auth",
+		Main:      true,
+		ExpiresAt: now.Add(24 * time.Hour),
+	}
+
+	type serviceMock struct {
+		resp *core.JwkRotateResponse
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *jsonkeysv2.JwkRotateRequest
+
+		serviceMock *serviceMock
+
+		expect       *jsonkeysv2.JwkRotateResponse
+		expectStatus codes.Code
+	}{
+		{
+			name: "Success",
+
+			request: &jsonkeysv2.JwkRotateRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{
+				resp: &core.JwkRotateResponse{
+					Key: newKey,
+					Previous: &core.JwkMetadata{
+						KID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Usage:     "auth",
+						ExpiresAt: now.Add(time.Hour),
+					},
+				},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.JwkRotateResponse{
+				Kid:               "00000000-0000-0000-0000-000000000002",
+				ExpiresAt:         timestamppb.New(now.Add(24 * time.Hour)),
+				PreviousKid:       "00000000-0000-0000-0000-000000000001",
+				PreviousStatus:    jsonkeysv2.PreviousKeyStatus_PREVIOUS_KEY_STATUS_ACTIVE,
+				PreviousExpiresAt: timestamppb.New(now.Add(time.Hour)),
+			},
+		},
+		{
+			name: "Success/RevokePrevious",
+
+			request: &jsonkeysv2.JwkRotateRequest{Usage: "auth", RevokePrevious: true, Comment: "leak"},
+
+			serviceMock: &serviceMock{
+				resp: &core.JwkRotateResponse{
+					Key: newKey,
+					Previous: &core.JwkMetadata{
+						KID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Usage:          "auth",
+						ExpiresAt:      now.Add(time.Hour),
+						RevokedAt:      &now,
+						RevokedComment: "leak",
+					},
+				},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.JwkRotateResponse{
+				Kid:               "00000000-0000-0000-0000-000000000002",
+				ExpiresAt:         timestamppb.New(now.Add(24 * time.Hour)),
+				PreviousKid:       "00000000-0000-0000-0000-000000000001",
+				PreviousStatus:    jsonkeysv2.PreviousKeyStatus_PREVIOUS_KEY_STATUS_REVOKED,
+				PreviousExpiresAt: timestamppb.New(now.Add(time.Hour)),
+			},
+		},
+		{
+			name: "Success/NoPreviousKey",
+
+			request: &jsonkeysv2.JwkRotateRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{resp: &core.JwkRotateResponse{Key: newKey}},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.JwkRotateResponse{
+				Kid:       "00000000-0000-0000-0000-000000000002",
+				ExpiresAt: timestamppb.New(now.Add(24 * time.Hour)),
+			},
+		},
+		{
+			name: "Error/ConfigNotFound",
+
+			request: &jsonkeysv2.JwkRotateRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{err: core.ErrConfigNotFound},
+
+			expectStatus: codes.NotFound,
+		},
+		{
+			name: "Error/NoComment",
+
+			request: &jsonkeysv2.JwkRotateRequest{Usage: "auth", RevokePrevious: true},
+
+			serviceMock: &serviceMock{err: core.ErrJwkRevokeNoComment},
+
+			expectStatus: codes.InvalidArgument,
+		},
+		{
+			name: "Error/Internal",
+
+			request: &jsonkeysv2.JwkRotateRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{err: errFoo},
+
+			expectStatus: codes.Internal,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockGrpcJwkRotateService(t)
+
+			service.EXPECT().
+				Exec(mock.Anything, &core.JwkRotateRequest{
+					Usage:          testCase.request.GetUsage(),
+					RevokePrevious: testCase.request.GetRevokePrevious(),
+					Comment:        testCase.request.GetComment(),
+				}).
+				Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+
+			handler := handlers.NewGrpcJwkRotate(service)
+
+			res, err := handler.JwkRotate(t.Context(), testCase.request)
+			resSt, ok := status.FromError(err)
+			require.True(t, ok, resSt.Code().String())
+			require.Equal(
+				t,
+				testCase.expectStatus, resSt.Code(),
+				"expected status code %s, got %s (%v)", testCase.expectStatus, resSt.Code(), err,
+			)
+			require.Equal(t, testCase.expect, res)
+
+			service.AssertExpectations(t)
+		})
+	}
+}
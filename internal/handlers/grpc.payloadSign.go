@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+// GrpcPayloadSignService is the service dependency of [GrpcPayloadSign].
+type GrpcPayloadSignService interface {
+	Exec(ctx context.Context, request *core.PayloadSignRequest) (string, error)
+}
+
+// GrpcPayloadSign is the gRPC handler that signs an arbitrary payload and returns a detached JWS.
+type GrpcPayloadSign struct {
+	jsonkeysv2.UnimplementedPayloadSignServiceServer
+
+	service GrpcPayloadSignService
+}
+
+// NewGrpcPayloadSign returns a new GrpcPayloadSign handler backed by the given service.
+func NewGrpcPayloadSign(service GrpcPayloadSignService) *GrpcPayloadSign {
+	return &GrpcPayloadSign{service: service}
+}
+
+func (handler *GrpcPayloadSign) PayloadSign(
+	ctx context.Context, request *jsonkeysv2.PayloadSignRequest,
+) (*jsonkeysv2.PayloadSignResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "grpc.PayloadSign")
+	defer span.End()
+
+	signature, err := handler.service.Exec(ctx, &core.PayloadSignRequest{
+		Payload:   request.GetPayload(),
+		Usage:     request.GetUsage(),
+		Unencoded: request.GetUnencoded(),
+	})
+	if errors.Is(err, core.ErrConfigNotFound) {
+		return nil, status.Error(codes.Unavailable, "unknown usage")
+	}
+
+	// A frozen usage is a deliberate state of the server, that the caller cannot fix by retrying.
+	if errors.Is(err, core.ErrSigningFrozen) {
+		return nil, status.Error(codes.FailedPrecondition, "signing is frozen for this usage")
+	}
+
+	if err != nil {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return otel.ReportSuccess(span, &jsonkeysv2.PayloadSignResponse{Signature: signature}), nil
+}
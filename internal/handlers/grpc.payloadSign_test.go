@@ -0,0 +1,148 @@
+package handlers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+func TestGrpcPayloadSign(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		resp string
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *jsonkeysv2.PayloadSignRequest
+
+		serviceMock *serviceMock
+
+		expect       *jsonkeysv2.PayloadSignResponse
+		expectStatus codes.Code
+	}{
+		{
+			name: "Success",
+
+			request: &jsonkeysv2.PayloadSignRequest{
+				Payload: []byte("hello world"),
+				Usage:   "test-usage",
+			},
+
+			serviceMock: &serviceMock{
+				resp: "header..signature",
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.PayloadSignResponse{
+				Signature: "header..signature",
+			},
+		},
+		{
+			name: "Success/Unencoded",
+
+			request: &jsonkeysv2.PayloadSignRequest{
+				Payload:   []byte("hello.world"),
+				Usage:     "test-usage",
+				Unencoded: true,
+			},
+
+			serviceMock: &serviceMock{
+				resp: "unencoded-header..signature",
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.PayloadSignResponse{
+				Signature: "unencoded-header..signature",
+			},
+		},
+		{
+			name: "Error/BadConfig",
+
+			request: &jsonkeysv2.PayloadSignRequest{
+				Payload: []byte("hello world"),
+				Usage:   "test-usage",
+			},
+
+			serviceMock: &serviceMock{
+				err: core.ErrConfigNotFound,
+			},
+
+			expectStatus: codes.Unavailable,
+		},
+		{
+			name: "Error/Frozen",
+
+			request: &jsonkeysv2.PayloadSignRequest{
+				Payload: []byte("hello world"),
+				Usage:   "test-usage",
+			},
+
+			serviceMock: &serviceMock{
+				err: core.ErrSigningFrozen,
+			},
+
+			expectStatus: codes.FailedPrecondition,
+		},
+		{
+			name: "Error/Internal",
+
+			request: &jsonkeysv2.PayloadSignRequest{
+				Payload: []byte("hello world"),
+				Usage:   "test-usage",
+			},
+
+			serviceMock: &serviceMock{
+				err: errFoo,
+			},
+
+			expectStatus: codes.Internal,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockGrpcPayloadSignService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, &core.PayloadSignRequest{
+						Payload:   testCase.request.GetPayload(),
+						Usage:     testCase.request.GetUsage(),
+						Unencoded: testCase.request.GetUnencoded(),
+					}).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewGrpcPayloadSign(service)
+
+			res, err := handler.PayloadSign(t.Context(), testCase.request)
+			resSt, ok := status.FromError(err)
+			require.True(t, ok, resSt.Code().String())
+			require.Equal(
+				t,
+				testCase.expectStatus, resSt.Code(),
+				"expected status code %s, got %s (%v)", testCase.expectStatus, resSt.Code(), err,
+			)
+			require.Equal(t, testCase.expect, res)
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+// grpcSigningMethods lists the RPCs that sign with the private key of a usage.
+var grpcSigningMethods = []string{
+	jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+	jsonkeysv2.PayloadSignService_PayloadSign_FullMethodName,
+	jsonkeysv2.HttpSignatureSignService_HttpSignatureSign_FullMethodName,
+}
+
+// GrpcCallerIdentity returns the identity of the caller of a gRPC request, read from the client
+// certificate it presented over mutual TLS: the first URI SAN of the certificate (such as a
+// SPIFFE ID) when it has one, its subject common name otherwise.
+//
+// Only certificates verified by the server count: the second return value is false for
+// plaintext connections, and for TLS connections without a verified client certificate.
+func GrpcCallerIdentity(ctx context.Context) (string, bool) {
+	caller, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	tlsInfo, ok := caller.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	certificate := tlsInfo.State.VerifiedChains[0][0]
+
+	if len(certificate.URIs) > 0 {
+		return certificate.URIs[0].String(), true
+	}
+
+	return certificate.Subject.CommonName, certificate.Subject.CommonName != ""
+}
+
+// GrpcProducers is a gRPC interceptor that restricts signing to the producers of a usage (see
+// [config.Jwk.Producers]), identified by [GrpcCallerIdentity].
+//
+// Usages without producers, and unknown usages, are left to the handlers. Callers authenticated
+// by an API key pass on the permission of their key instead: [GrpcApiKeys] has already checked it
+// against the usage of the request, and must run before this interceptor.
+//
+// A multi-signature request also signs with the key of each co-signer of the usage (see
+// [config.Jwk.CoSigners]): the caller must be allowed to sign for each of them, the same way.
+type GrpcProducers struct {
+	keysConfig map[string]*config.Jwk
+}
+
+// NewGrpcProducers returns a new GrpcProducers interceptor.
+func NewGrpcProducers(keysConfig map[string]*config.Jwk) *GrpcProducers {
+	return &GrpcProducers{keysConfig: keysConfig}
+}
+
+// UnaryInterceptor returns the interceptor, to register on the gRPC server. It answers
+// Unauthenticated to callers without an identity, and PermissionDenied to callers that are not
+// producers of the usage they sign for.
+func (interceptor *GrpcProducers) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		if !slices.Contains(grpcSigningMethods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		usageRequest, ok := req.(interface{ GetUsage() string })
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		multiSignatureRequest, _ := req.(interface{ GetMultiSignature() bool })
+		multiSignature := multiSignatureRequest != nil && multiSignatureRequest.GetMultiSignature()
+
+		ctx, span := otel.Tracer().Start(ctx, "grpc.Producers")
+		defer span.End()
+
+		span.SetAttributes(
+			attribute.String("key.usage", usageRequest.GetUsage()),
+			attribute.Bool("multi_signature", multiSignature),
+		)
+
+		for _, usage := range signingUsages(interceptor.keysConfig, usageRequest.GetUsage(), multiSignature) {
+			err := interceptor.authorize(ctx, usage)
+			if err != nil {
+				_ = otel.ReportError(span, err)
+
+				return nil, err
+			}
+		}
+
+		otel.ReportSuccessNoContent(span)
+
+		return handler(ctx, req)
+	}
+}
+
+// authorize checks that the caller may sign with the key of usage.
+func (interceptor *GrpcProducers) authorize(ctx context.Context, usage string) error {
+	if apiKey, ok := core.ApiKeyFromContext(ctx); ok {
+		if !apiKey.Allows(core.ApiKeyOperationSign, usage) {
+			return status.Errorf(codes.PermissionDenied, "api key does not allow sign on usage %s", usage)
+		}
+
+		return nil
+	}
+
+	keyConfig, ok := interceptor.keysConfig[usage]
+	if !ok || len(keyConfig.Producers) == 0 {
+		return nil
+	}
+
+	identity, ok := GrpcCallerIdentity(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "a client certificate is required to sign for usage %s", usage)
+	}
+
+	if !slices.Contains(keyConfig.Producers, identity) {
+		return status.Errorf(codes.PermissionDenied, "caller is not a producer of usage %s", usage)
+	}
+
+	return nil
+}
+
+// signingUsages returns the usages whose key signs a request for usage: the usage itself and,
+// with a multi-signature, its co-signers (see [config.Jwk.CoSigners]).
+func signingUsages(keysConfig map[string]*config.Jwk, usage string, multiSignature bool) []string {
+	usages := []string{usage}
+
+	if keyConfig, ok := keysConfig[usage]; ok && multiSignature {
+		usages = append(usages, keyConfig.CoSigners...)
+	}
+
+	return usages
+}
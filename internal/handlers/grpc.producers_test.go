@@ -0,0 +1,257 @@
+package handlers_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+func TestGrpcProducers(t *testing.T) {
+	t.Parallel()
+
+	keysConfig := map[string]*config.Jwk{
+		"owned": {Producers: []string{"spiffe://anovel/authentication", "service-authentication"}},
+		"open":  {},
+		// A multi-signature of co-signed is also signed by the key of owned.
+		"co-signed": {CoSigners: []string{"owned"}},
+	}
+
+	// withCertificate returns a peer that presented a verified mutual TLS client certificate.
+	withCertificate := func(certificate *x509.Certificate) *peer.Peer {
+		return &peer.Peer{AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{certificate}}},
+		}}
+	}
+
+	spiffeID := mustParseURL(t, "spiffe://anovel/authentication")
+
+	testCases := []struct {
+		name string
+
+		peer    *peer.Peer
+		apiKey  *core.ApiKey
+		method  string
+		request any
+
+		expectCode codes.Code
+	}{
+		{
+			name: "Success/URISAN",
+
+			peer:    withCertificate(&x509.Certificate{URIs: []*url.URL{spiffeID}}),
+			method:  jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request: &jsonkeysv2.ClaimsSignRequest{Usage: "owned"},
+
+			expectCode: codes.OK,
+		},
+		{
+			name: "Success/CommonName",
+
+			peer: withCertificate(&x509.Certificate{
+				Subject: pkix.Name{CommonName: "service-authentication"},
+			}),
+			method:  jsonkeysv2.PayloadSignService_PayloadSign_FullMethodName,
+			request: &jsonkeysv2.PayloadSignRequest{Usage: "owned"},
+
+			expectCode: codes.OK,
+		},
+		{
+			name: "Success/NoProducers",
+
+			method:  jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request: &jsonkeysv2.ClaimsSignRequest{Usage: "open"},
+
+			expectCode: codes.OK,
+		},
+		{
+			name: "Success/UnknownUsage",
+
+			method:  jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request: &jsonkeysv2.ClaimsSignRequest{Usage: "other"},
+
+			expectCode: codes.OK,
+		},
+		{
+			name: "Success/ApiKey",
+
+			apiKey: &core.ApiKey{
+				Usages:     []string{"owned"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+			},
+			method:  jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request: &jsonkeysv2.ClaimsSignRequest{Usage: "owned"},
+
+			expectCode: codes.OK,
+		},
+		{
+			name: "Success/CoSignerProducer",
+
+			peer:    withCertificate(&x509.Certificate{URIs: []*url.URL{spiffeID}}),
+			method:  jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request: &jsonkeysv2.ClaimsSignRequest{Usage: "co-signed", MultiSignature: true},
+
+			expectCode: codes.OK,
+		},
+		{
+			// Co-signers only sign multi-signatures.
+			name: "Success/CoSignersSingleSignature",
+
+			method:  jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request: &jsonkeysv2.ClaimsSignRequest{Usage: "co-signed"},
+
+			expectCode: codes.OK,
+		},
+		{
+			name: "Success/ApiKeyCoSigners",
+
+			apiKey: &core.ApiKey{
+				Usages:     []string{"co-signed", "owned"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+			},
+			method:  jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request: &jsonkeysv2.ClaimsSignRequest{Usage: "co-signed", MultiSignature: true},
+
+			expectCode: codes.OK,
+		},
+		{
+			name: "Success/NotSigning",
+
+			method:  jsonkeysv2.JwkListService_JwkList_FullMethodName,
+			request: &jsonkeysv2.JwkListRequest{Usage: "owned"},
+
+			expectCode: codes.OK,
+		},
+		{
+			name: "Error/NoPeer",
+
+			method:  jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request: &jsonkeysv2.ClaimsSignRequest{Usage: "owned"},
+
+			expectCode: codes.Unauthenticated,
+		},
+		{
+			name: "Error/UnverifiedCertificate",
+
+			peer: &peer.Peer{AuthInfo: credentials.TLSInfo{
+				State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{{
+					Subject: pkix.Name{CommonName: "service-authentication"},
+				}}},
+			}},
+			method:  jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request: &jsonkeysv2.ClaimsSignRequest{Usage: "owned"},
+
+			expectCode: codes.Unauthenticated,
+		},
+		{
+			name: "Error/NotProducer",
+
+			peer: withCertificate(&x509.Certificate{
+				Subject: pkix.Name{CommonName: "service-other"},
+			}),
+			method:  jsonkeysv2.HttpSignatureSignService_HttpSignatureSign_FullMethodName,
+			request: &jsonkeysv2.HttpSignatureSignRequest{Usage: "owned"},
+
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name: "Error/CoSignerNotProducer",
+
+			peer: withCertificate(&x509.Certificate{
+				Subject: pkix.Name{CommonName: "service-other"},
+			}),
+			method:  jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request: &jsonkeysv2.ClaimsSignRequest{Usage: "co-signed", MultiSignature: true},
+
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name: "Error/CoSignerNoPeer",
+
+			method:  jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request: &jsonkeysv2.ClaimsSignRequest{Usage: "co-signed", MultiSignature: true},
+
+			expectCode: codes.Unauthenticated,
+		},
+		{
+			name: "Error/ApiKeyCoSignerForbidden",
+
+			apiKey: &core.ApiKey{
+				Usages:     []string{"co-signed"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+			},
+			method:  jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request: &jsonkeysv2.ClaimsSignRequest{Usage: "co-signed", MultiSignature: true},
+
+			expectCode: codes.PermissionDenied,
+		},
+		{
+			name: "Error/URISANTakesPrecedence",
+
+			peer: withCertificate(&x509.Certificate{
+				Subject: pkix.Name{CommonName: "service-authentication"},
+				URIs:    []*url.URL{mustParseURL(t, "spiffe://anovel/other")},
+			}),
+			method:  jsonkeysv2.ClaimsSignService_ClaimsSign_FullMethodName,
+			request: &jsonkeysv2.ClaimsSignRequest{Usage: "owned"},
+
+			expectCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			interceptor := handlers.NewGrpcProducers(keysConfig).UnaryInterceptor()
+
+			ctx := t.Context()
+			if testCase.peer != nil {
+				ctx = peer.NewContext(ctx, testCase.peer)
+			}
+
+			if testCase.apiKey != nil {
+				ctx = core.NewApiKeyContext(ctx, testCase.apiKey)
+			}
+
+			called := false
+
+			_, err := interceptor(
+				ctx,
+				testCase.request,
+				&grpc.UnaryServerInfo{FullMethod: testCase.method},
+				func(_ context.Context, _ any) (any, error) {
+					called = true
+
+					return nil, nil
+				},
+			)
+
+			require.Equal(t, testCase.expectCode, status.Code(err))
+			require.Equal(t, testCase.expectCode == codes.OK, called)
+		})
+	}
+}
+
+func mustParseURL(t *testing.T, raw string) *url.URL {
+	t.Helper()
+
+	parsed, err := url.Parse(raw)
+	require.NoError(t, err)
+
+	return parsed
+}
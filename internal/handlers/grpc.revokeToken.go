@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+// GrpcRevokeTokenService is the service dependency of [GrpcRevokeToken].
+type GrpcRevokeTokenService interface {
+	Exec(ctx context.Context, request *core.TokenRevokeRequest) (*core.RevokedToken, error)
+}
+
+// GrpcRevokeToken is the gRPC handler that revokes a single token.
+type GrpcRevokeToken struct {
+	jsonkeysv2.UnimplementedRevokeTokenServiceServer
+
+	service GrpcRevokeTokenService
+}
+
+// NewGrpcRevokeToken returns a new GrpcRevokeToken handler backed by the given service.
+func NewGrpcRevokeToken(service GrpcRevokeTokenService) *GrpcRevokeToken {
+	return &GrpcRevokeToken{service: service}
+}
+
+func (handler *GrpcRevokeToken) RevokeToken(
+	ctx context.Context, request *jsonkeysv2.RevokeTokenRequest,
+) (*jsonkeysv2.RevokeTokenResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "grpc.RevokeToken")
+	defer span.End()
+
+	revoked, err := handler.service.Exec(ctx, &core.TokenRevokeRequest{
+		Usage:   request.GetUsage(),
+		Token:   request.GetToken(),
+		TokenID: request.GetTokenId(),
+	})
+	if errors.Is(err, core.ErrConfigNotFound) {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.NotFound, "usage not found")
+	}
+
+	if errors.Is(err, core.ErrTokenRevokeNoID) || errors.Is(err, core.ErrTokenRevokeInvalid) {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err != nil {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return otel.ReportSuccess(span, &jsonkeysv2.RevokeTokenResponse{
+		Token: grpcRevokedToken(revoked),
+	}), nil
+}
+
+// grpcRevokedToken converts a [core.RevokedToken] to its proto value.
+func grpcRevokedToken(revoked *core.RevokedToken) *jsonkeysv2.RevokedToken {
+	return &jsonkeysv2.RevokedToken{
+		TokenId:   revoked.TokenID,
+		Usage:     revoked.Usage,
+		ExpiresAt: timestamppb.New(revoked.ExpiresAt),
+		RevokedAt: timestamppb.New(revoked.RevokedAt),
+	}
+}
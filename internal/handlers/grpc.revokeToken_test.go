@@ -0,0 +1,147 @@
+package handlers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+func TestGrpcRevokeToken(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	now := time.Now().UTC().Round(time.Second)
+	hourLater := now.Add(time.Hour)
+
+	type serviceMock struct {
+		resp *core.RevokedToken
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *jsonkeysv2.RevokeTokenRequest
+
+		serviceMock *serviceMock
+
+		expect       *jsonkeysv2.RevokeTokenResponse
+		expectStatus codes.Code
+	}{
+		{
+			name: "Success/Token",
+
+			request: &jsonkeysv2.RevokeTokenRequest{Usage: "auth", Token: "token"},
+
+			serviceMock: &serviceMock{
+				resp: &core.RevokedToken{TokenID: "jti-1", Usage: "auth", ExpiresAt: hourLater, RevokedAt: now},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.RevokeTokenResponse{
+				Token: &jsonkeysv2.RevokedToken{
+					TokenId:   "jti-1",
+					Usage:     "auth",
+					ExpiresAt: timestamppb.New(hourLater),
+					RevokedAt: timestamppb.New(now),
+				},
+			},
+		},
+		{
+			name: "Success/TokenID",
+
+			request: &jsonkeysv2.RevokeTokenRequest{Usage: "auth", TokenId: "jti-1"},
+
+			serviceMock: &serviceMock{
+				resp: &core.RevokedToken{TokenID: "jti-1", Usage: "auth", ExpiresAt: hourLater, RevokedAt: now},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.RevokeTokenResponse{
+				Token: &jsonkeysv2.RevokedToken{
+					TokenId:   "jti-1",
+					Usage:     "auth",
+					ExpiresAt: timestamppb.New(hourLater),
+					RevokedAt: timestamppb.New(now),
+				},
+			},
+		},
+		{
+			name: "Error/ConfigNotFound",
+
+			request: &jsonkeysv2.RevokeTokenRequest{Usage: "auth", TokenId: "jti-1"},
+
+			serviceMock: &serviceMock{err: core.ErrConfigNotFound},
+
+			expectStatus: codes.NotFound,
+		},
+		{
+			name: "Error/NoID",
+
+			request: &jsonkeysv2.RevokeTokenRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{err: core.ErrTokenRevokeNoID},
+
+			expectStatus: codes.InvalidArgument,
+		},
+		{
+			name: "Error/InvalidToken",
+
+			request: &jsonkeysv2.RevokeTokenRequest{Usage: "auth", Token: "token"},
+
+			serviceMock: &serviceMock{err: core.ErrTokenRevokeInvalid},
+
+			expectStatus: codes.InvalidArgument,
+		},
+		{
+			name: "Error/Internal",
+
+			request: &jsonkeysv2.RevokeTokenRequest{Usage: "auth", TokenId: "jti-1"},
+
+			serviceMock: &serviceMock{err: errFoo},
+
+			expectStatus: codes.Internal,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockGrpcRevokeTokenService(t)
+
+			service.EXPECT().
+				Exec(mock.Anything, &core.TokenRevokeRequest{
+					Usage:   testCase.request.GetUsage(),
+					Token:   testCase.request.GetToken(),
+					TokenID: testCase.request.GetTokenId(),
+				}).
+				Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+
+			handler := handlers.NewGrpcRevokeToken(service)
+
+			res, err := handler.RevokeToken(t.Context(), testCase.request)
+			resSt, ok := status.FromError(err)
+			require.True(t, ok, resSt.Code().String())
+			require.Equal(
+				t,
+				testCase.expectStatus, resSt.Code(),
+				"expected status code %s, got %s (%v)", testCase.expectStatus, resSt.Code(), err,
+			)
+			require.Equal(t, testCase.expect, res)
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+// GrpcRevokedTokenSyncService is the service dependency of [GrpcRevokedTokenSync].
+type GrpcRevokedTokenSyncService interface {
+	Exec(ctx context.Context, request *core.RevokedTokenSyncRequest) (*core.RevokedTokenSyncResult, error)
+}
+
+// GrpcRevokedTokenSync is the gRPC handler that returns the revocations made since a cursor.
+type GrpcRevokedTokenSync struct {
+	jsonkeysv2.UnimplementedRevokedTokenSyncServiceServer
+
+	service GrpcRevokedTokenSyncService
+}
+
+// NewGrpcRevokedTokenSync returns a new GrpcRevokedTokenSync handler backed by the given service.
+func NewGrpcRevokedTokenSync(service GrpcRevokedTokenSyncService) *GrpcRevokedTokenSync {
+	return &GrpcRevokedTokenSync{service: service}
+}
+
+func (handler *GrpcRevokedTokenSync) RevokedTokenSync(
+	ctx context.Context, request *jsonkeysv2.RevokedTokenSyncRequest,
+) (*jsonkeysv2.RevokedTokenSyncResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "grpc.RevokedTokenSync")
+	defer span.End()
+
+	syncRequest := new(core.RevokedTokenSyncRequest)
+	if request.GetSince() != nil {
+		syncRequest.Since = request.GetSince().AsTime()
+	}
+
+	result, err := handler.service.Exec(ctx, syncRequest)
+	if err != nil {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	output := &jsonkeysv2.RevokedTokenSyncResponse{}
+
+	if len(result.Tokens) > 0 {
+		output.Tokens = lo.Map(result.Tokens, func(item *core.RevokedToken, _ int) *jsonkeysv2.RevokedToken {
+			return grpcRevokedToken(item)
+		})
+	}
+
+	if !result.Cursor.IsZero() {
+		output.Cursor = timestamppb.New(result.Cursor)
+	}
+
+	return otel.ReportSuccess(span, output), nil
+}
@@ -0,0 +1,138 @@
+package handlers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+func TestGrpcRevokedTokenSync(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+	now := time.Now().UTC().Round(time.Second)
+	hourLater := now.Add(time.Hour)
+
+	type serviceMock struct {
+		request *core.RevokedTokenSyncRequest
+
+		resp *core.RevokedTokenSyncResult
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *jsonkeysv2.RevokedTokenSyncRequest
+
+		serviceMock *serviceMock
+
+		expect       *jsonkeysv2.RevokedTokenSyncResponse
+		expectStatus codes.Code
+	}{
+		{
+			name: "Success",
+
+			request: &jsonkeysv2.RevokedTokenSyncRequest{},
+
+			serviceMock: &serviceMock{
+				request: &core.RevokedTokenSyncRequest{},
+				resp: &core.RevokedTokenSyncResult{
+					Tokens: []*core.RevokedToken{
+						{TokenID: "jti-1", Usage: "auth", ExpiresAt: hourLater, RevokedAt: now},
+					},
+					Cursor: now,
+				},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.RevokedTokenSyncResponse{
+				Tokens: []*jsonkeysv2.RevokedToken{
+					{
+						TokenId:   "jti-1",
+						Usage:     "auth",
+						ExpiresAt: timestamppb.New(hourLater),
+						RevokedAt: timestamppb.New(now),
+					},
+				},
+				Cursor: timestamppb.New(now),
+			},
+		},
+		{
+			name: "Success/Since",
+
+			request: &jsonkeysv2.RevokedTokenSyncRequest{Since: timestamppb.New(hourAgo)},
+
+			serviceMock: &serviceMock{
+				request: &core.RevokedTokenSyncRequest{Since: hourAgo},
+				resp:    &core.RevokedTokenSyncResult{Cursor: hourAgo},
+			},
+
+			expectStatus: codes.OK,
+			expect:       &jsonkeysv2.RevokedTokenSyncResponse{Cursor: timestamppb.New(hourAgo)},
+		},
+		{
+			name: "Success/NoRevocation",
+
+			request: &jsonkeysv2.RevokedTokenSyncRequest{},
+
+			serviceMock: &serviceMock{
+				request: &core.RevokedTokenSyncRequest{},
+				resp:    &core.RevokedTokenSyncResult{},
+			},
+
+			expectStatus: codes.OK,
+			expect:       &jsonkeysv2.RevokedTokenSyncResponse{},
+		},
+		{
+			name: "Error/Internal",
+
+			request: &jsonkeysv2.RevokedTokenSyncRequest{},
+
+			serviceMock: &serviceMock{
+				request: &core.RevokedTokenSyncRequest{},
+				err:     errFoo,
+			},
+
+			expectStatus: codes.Internal,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockGrpcRevokedTokenSyncService(t)
+
+			service.EXPECT().
+				Exec(mock.Anything, testCase.serviceMock.request).
+				Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+
+			handler := handlers.NewGrpcRevokedTokenSync(service)
+
+			res, err := handler.RevokedTokenSync(t.Context(), testCase.request)
+			resSt, ok := status.FromError(err)
+			require.True(t, ok, resSt.Code().String())
+			require.Equal(
+				t,
+				testCase.expectStatus, resSt.Code(),
+				"expected status code %s, got %s (%v)", testCase.expectStatus, resSt.Code(), err,
+			)
+			require.Equal(t, testCase.expect, res)
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+// GrpcSigningFreezeService is the service dependency of [GrpcSigningFreeze].
+type GrpcSigningFreezeService interface {
+	Exec(ctx context.Context, request *core.SigningFreezeSetRequest) (*core.SigningFreeze, error)
+}
+
+// GrpcSigningFreeze is the gRPC handler that freezes, or unfreezes, the signing of a usage.
+type GrpcSigningFreeze struct {
+	jsonkeysv2.UnimplementedSigningFreezeServiceServer
+
+	service GrpcSigningFreezeService
+}
+
+// NewGrpcSigningFreeze returns a new GrpcSigningFreeze handler backed by the given service.
+func NewGrpcSigningFreeze(service GrpcSigningFreezeService) *GrpcSigningFreeze {
+	return &GrpcSigningFreeze{service: service}
+}
+
+func (handler *GrpcSigningFreeze) SigningFreeze(
+	ctx context.Context, request *jsonkeysv2.SigningFreezeRequest,
+) (*jsonkeysv2.SigningFreezeResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "grpc.SigningFreeze")
+	defer span.End()
+
+	freeze, err := handler.service.Exec(ctx, &core.SigningFreezeSetRequest{
+		Usage:   request.GetUsage(),
+		Frozen:  request.GetFrozen(),
+		Comment: request.GetComment(),
+	})
+	if errors.Is(err, core.ErrConfigNotFound) {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.NotFound, "usage not found")
+	}
+
+	if errors.Is(err, core.ErrSigningFreezeNoComment) {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.InvalidArgument, "freezing a usage needs a comment")
+	}
+
+	if err != nil {
+		_ = otel.ReportError(span, err)
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	if freeze == nil {
+		return otel.ReportSuccess(span, &jsonkeysv2.SigningFreezeResponse{}), nil
+	}
+
+	return otel.ReportSuccess(span, &jsonkeysv2.SigningFreezeResponse{
+		Frozen:   true,
+		Comment:  freeze.Comment,
+		FrozenAt: timestamppb.New(freeze.FrozenAt),
+	}), nil
+}
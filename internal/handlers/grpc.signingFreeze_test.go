@@ -0,0 +1,125 @@
+package handlers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+func TestGrpcSigningFreeze(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	now := time.Now().UTC().Round(time.Second)
+
+	type serviceMock struct {
+		resp *core.SigningFreeze
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *jsonkeysv2.SigningFreezeRequest
+
+		serviceMock *serviceMock
+
+		expect       *jsonkeysv2.SigningFreezeResponse
+		expectStatus codes.Code
+	}{
+		{
+			name: "Success/Freeze",
+
+			request: &jsonkeysv2.SigningFreezeRequest{Usage: "auth", Frozen: true, Comment: "incident"},
+
+			serviceMock: &serviceMock{
+				resp: &core.SigningFreeze{Usage: "auth", Comment: "incident", FrozenAt: now},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.SigningFreezeResponse{
+				Frozen:   true,
+				Comment:  "incident",
+				FrozenAt: timestamppb.New(now),
+			},
+		},
+		{
+			name: "Success/Unfreeze",
+
+			request: &jsonkeysv2.SigningFreezeRequest{Usage: "auth"},
+
+			serviceMock: &serviceMock{},
+
+			expectStatus: codes.OK,
+			expect:       &jsonkeysv2.SigningFreezeResponse{},
+		},
+		{
+			name: "Error/ConfigNotFound",
+
+			request: &jsonkeysv2.SigningFreezeRequest{Usage: "auth", Frozen: true, Comment: "incident"},
+
+			serviceMock: &serviceMock{err: core.ErrConfigNotFound},
+
+			expectStatus: codes.NotFound,
+		},
+		{
+			name: "Error/NoComment",
+
+			request: &jsonkeysv2.SigningFreezeRequest{Usage: "auth", Frozen: true},
+
+			serviceMock: &serviceMock{err: core.ErrSigningFreezeNoComment},
+
+			expectStatus: codes.InvalidArgument,
+		},
+		{
+			name: "Error/Internal",
+
+			request: &jsonkeysv2.SigningFreezeRequest{Usage: "auth", Frozen: true, Comment: "incident"},
+
+			serviceMock: &serviceMock{err: errFoo},
+
+			expectStatus: codes.Internal,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockGrpcSigningFreezeService(t)
+
+			service.EXPECT().
+				Exec(mock.Anything, &core.SigningFreezeSetRequest{
+					Usage:   testCase.request.GetUsage(),
+					Frozen:  testCase.request.GetFrozen(),
+					Comment: testCase.request.GetComment(),
+				}).
+				Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+
+			handler := handlers.NewGrpcSigningFreeze(service)
+
+			res, err := handler.SigningFreeze(t.Context(), testCase.request)
+			resSt, ok := status.FromError(err)
+			require.True(t, ok, resSt.Code().String())
+			require.Equal(
+				t,
+				testCase.expectStatus, resSt.Code(),
+				"expected status code %s, got %s (%v)", testCase.expectStatus, resSt.Code(), err,
+			)
+			require.Equal(t, testCase.expect, res)
+
+			service.AssertExpectations(t)
+		})
+	}
+}
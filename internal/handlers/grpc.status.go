@@ -2,16 +2,43 @@ package handlers
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/uptrace/bun"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/a-novel-kit/golib/otel"
 	"github.com/a-novel-kit/golib/postgres"
+	"github.com/a-novel-kit/jwt/v2/jwa"
 
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
 	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
 )
 
+// GrpcStatusServiceAlgMigration is the algorithm migration service dependency of [GrpcStatus].
+type GrpcStatusServiceAlgMigration interface {
+	Exec(ctx context.Context, request *core.JwkAlgMigrationRequest) (*core.JwkAlgMigrationStatus, error)
+}
+
+// GrpcStatusServiceLifecycle is the key lifecycle service dependency of [GrpcStatus].
+type GrpcStatusServiceLifecycle interface {
+	Exec(ctx context.Context, request *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error)
+}
+
+// GrpcStatusServiceRotationSchedule is the rotation schedule service dependency of [GrpcStatus].
+type GrpcStatusServiceRotationSchedule interface {
+	Exec(ctx context.Context, request *core.JwkRotationScheduleRequest) (*core.JwkRotationScheduleStatus, error)
+}
+
+// GrpcStatusServiceSigningFreezes is the signing freeze list service dependency of [GrpcStatus].
+type GrpcStatusServiceSigningFreezes interface {
+	Exec(ctx context.Context, request *core.SigningFreezeListRequest) ([]*core.SigningFreeze, error)
+}
+
 // NewGrpcHealthStatus converts an error into a DependencyHealth proto message,
 // mapping nil to DEPENDENCY_STATUS_UP and any non-nil error to DEPENDENCY_STATUS_DOWN.
 //
@@ -27,15 +54,99 @@ func NewGrpcHealthStatus(err error) *jsonkeysv2.DependencyHealth {
 	}
 }
 
+// NewGrpcKeyHealth converts the lifecycle of a usage's keys into a KeyHealth proto message. A
+// nil lifecycle, for keys that could not be read, reports KEY_HEALTH_STATUS_UNSPECIFIED alone.
+func NewGrpcKeyHealth(lifecycle *core.JwkLifecycleStatus) *jsonkeysv2.KeyHealth {
+	if lifecycle == nil {
+		return &jsonkeysv2.KeyHealth{}
+	}
+
+	output := &jsonkeysv2.KeyHealth{
+		Status:          jsonkeysv2.KeyHealthStatus_KEY_HEALTH_STATUS_OK,
+		HasMainKey:      lifecycle.CanSign(),
+		Rotation:        durationpb.New(lifecycle.Rotation),
+		LegacyKeys:      int32(lifecycle.LegacyKeys), //nolint:gosec // bounded by the active key count.
+		RotationStalled: lifecycle.RotationStalled,
+	}
+
+	switch {
+	case !lifecycle.CanSign():
+		output.Status = jsonkeysv2.KeyHealthStatus_KEY_HEALTH_STATUS_UNAVAILABLE
+	case lifecycle.RotationStalled:
+		output.Status = jsonkeysv2.KeyHealthStatus_KEY_HEALTH_STATUS_STALLED
+	}
+
+	if lifecycle.CanSign() {
+		output.MainKeyAge = durationpb.New(time.Since(lifecycle.MainCreatedAt))
+	}
+
+	if !lifecycle.NextExpiresAt.IsZero() {
+		output.NextExpiresAt = timestamppb.New(lifecycle.NextExpiresAt)
+	}
+
+	return output
+}
+
+// setGrpcSigningFreeze reports the signing freeze of a usage on its KeyHealth proto message. A nil
+// freeze leaves the usage unfrozen.
+func setGrpcSigningFreeze(health *jsonkeysv2.KeyHealth, freeze *core.SigningFreeze) {
+	if freeze == nil {
+		return
+	}
+
+	health.SigningFrozen = true
+	health.SigningFrozenAt = timestamppb.New(freeze.FrozenAt)
+}
+
+// NewGrpcRotationSchedule converts the status of the scheduled key rotation into a
+// RotationSchedule proto message.
+func NewGrpcRotationSchedule(schedule *core.JwkRotationScheduleStatus) *jsonkeysv2.RotationSchedule {
+	return &jsonkeysv2.RotationSchedule{
+		Interval:      durationpb.New(schedule.Interval),
+		Leader:        schedule.Leader,
+		LeaderElected: schedule.LeaderElected,
+		LastRunAt:     lo.Ternary(schedule.LastRunAt.IsZero(), nil, timestamppb.New(schedule.LastRunAt)),
+		LastRunFailed: schedule.LastRunFailed,
+		NextRunAt:     lo.Ternary(schedule.NextRunAt.IsZero(), nil, timestamppb.New(schedule.NextRunAt)),
+	}
+}
+
 // GrpcStatus is the gRPC handler that reports the operational health of the service
-// and its dependencies.
+// and its dependencies, the health of each usage's keys, and the progress of any algorithm
+// migration. When the server schedules the key rotation, the status reports it too.
+//
+// The overall status degrades when Postgres is down, or when a usage cannot sign: it has no
+// main key, or its keys could not be read. A stalled rotation is reported on the usage, but
+// does not degrade the status while the usage can still sign, and neither does a signing freeze,
+// which is deliberate.
 type GrpcStatus struct {
 	jsonkeysv2.UnimplementedStatusServiceServer
+
+	serviceAlgMigration   GrpcStatusServiceAlgMigration
+	serviceLifecycle      GrpcStatusServiceLifecycle
+	serviceSigningFreezes GrpcStatusServiceSigningFreezes
+	// Nil when the server does not schedule the key rotation.
+	serviceRotationSchedule GrpcStatusServiceRotationSchedule
+	keysConfig              map[string]*config.Jwk
 }
 
-// NewGrpcStatus returns a new GrpcStatus handler.
-func NewGrpcStatus() *GrpcStatus {
-	return &GrpcStatus{}
+// NewGrpcStatus returns a new GrpcStatus handler. Key health is reported for every usage in
+// keysConfig, and migrations for the usages that list previous algorithms. The rotation schedule
+// service is nil when the server does not schedule the key rotation.
+func NewGrpcStatus(
+	serviceAlgMigration GrpcStatusServiceAlgMigration,
+	serviceLifecycle GrpcStatusServiceLifecycle,
+	serviceSigningFreezes GrpcStatusServiceSigningFreezes,
+	serviceRotationSchedule GrpcStatusServiceRotationSchedule,
+	keysConfig map[string]*config.Jwk,
+) *GrpcStatus {
+	return &GrpcStatus{
+		serviceAlgMigration:     serviceAlgMigration,
+		serviceLifecycle:        serviceLifecycle,
+		serviceSigningFreezes:   serviceSigningFreezes,
+		serviceRotationSchedule: serviceRotationSchedule,
+		keysConfig:              keysConfig,
+	}
 }
 
 func (handler *GrpcStatus) Status(
@@ -44,11 +155,125 @@ func (handler *GrpcStatus) Status(
 	ctx, span := otel.Tracer().Start(ctx, "grpc.Status")
 	defer span.End()
 
+	postgresHealth := NewGrpcHealthStatus(handler.reportPostgres(ctx))
+	keys := handler.reportKeys(ctx)
+
+	degraded := postgresHealth.GetStatus() != jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_UP
+
+	for _, health := range keys {
+		if !health.GetHasMainKey() {
+			degraded = true
+		}
+	}
+
 	return otel.ReportSuccess(span, &jsonkeysv2.StatusResponse{
-		Postgres: NewGrpcHealthStatus(handler.reportPostgres(ctx)),
+		Postgres:      postgresHealth,
+		AlgMigrations: handler.reportAlgMigrations(ctx),
+		Keys:          keys,
+		Status: lo.Ternary(
+			degraded,
+			jsonkeysv2.ServiceStatus_SERVICE_STATUS_DEGRADED,
+			jsonkeysv2.ServiceStatus_SERVICE_STATUS_UP,
+		),
+		RotationSchedule: handler.reportRotationSchedule(ctx),
 	}), nil
 }
 
+func (handler *GrpcStatus) reportRotationSchedule(ctx context.Context) *jsonkeysv2.RotationSchedule {
+	if handler.serviceRotationSchedule == nil {
+		return nil
+	}
+
+	ctx, span := otel.Tracer().Start(ctx, "grpc.Status(reportRotationSchedule)")
+	defer span.End()
+
+	schedule, err := handler.serviceRotationSchedule.Exec(ctx, &core.JwkRotationScheduleRequest{})
+	if err != nil {
+		// The status stays available; the schedule is left out, and the error is on the span.
+		_ = otel.ReportError(span, err)
+
+		return nil
+	}
+
+	return otel.ReportSuccess(span, NewGrpcRotationSchedule(schedule))
+}
+
+func (handler *GrpcStatus) reportKeys(ctx context.Context) map[string]*jsonkeysv2.KeyHealth {
+	ctx, span := otel.Tracer().Start(ctx, "grpc.Status(reportKeys)")
+	defer span.End()
+
+	if len(handler.keysConfig) == 0 {
+		return nil
+	}
+
+	freezes := make(map[string]*core.SigningFreeze)
+
+	signingFreezes, err := handler.serviceSigningFreezes.Exec(ctx, &core.SigningFreezeListRequest{})
+	if err != nil {
+		// The usages are reported unfrozen; the error is on the span.
+		_ = otel.ReportError(span, fmt.Errorf("signing freezes: %w", err))
+	}
+
+	for _, freeze := range signingFreezes {
+		freezes[freeze.Usage] = freeze
+	}
+
+	output := make(map[string]*jsonkeysv2.KeyHealth)
+
+	for usage := range handler.keysConfig {
+		lifecycle, err := handler.serviceLifecycle.Exec(ctx, &core.JwkLifecycleRequest{Usage: usage})
+		if err != nil {
+			// The usage stays listed, as unknown: keys that cannot be read cannot sign either.
+			_ = otel.ReportError(span, fmt.Errorf("usage %s: %w", usage, err))
+			lifecycle = nil
+		}
+
+		output[usage] = NewGrpcKeyHealth(lifecycle)
+		setGrpcSigningFreeze(output[usage], freezes[usage])
+	}
+
+	return output
+}
+
+func (handler *GrpcStatus) reportAlgMigrations(ctx context.Context) map[string]*jsonkeysv2.AlgMigration {
+	ctx, span := otel.Tracer().Start(ctx, "grpc.Status(reportAlgMigrations)")
+	defer span.End()
+
+	output := make(map[string]*jsonkeysv2.AlgMigration)
+
+	for usage, keyConfig := range handler.keysConfig {
+		if len(keyConfig.PreviousAlgs) == 0 {
+			continue
+		}
+
+		migration, err := handler.serviceAlgMigration.Exec(ctx, &core.JwkAlgMigrationRequest{Usage: usage})
+		if err != nil {
+			// The status stays available; the usage is left out, and the error is on the span.
+			_ = otel.ReportError(span, fmt.Errorf("usage %s: %w", usage, err))
+
+			continue
+		}
+
+		output[usage] = &jsonkeysv2.AlgMigration{
+			Alg: migration.Alg.String(),
+			PreviousAlgs: lo.Map(migration.PreviousAlgs, func(item jwa.Alg, _ int) string {
+				return item.String()
+			}),
+			LegacyKeys: int32(migration.LegacyKeys), //nolint:gosec // bounded by the active key count.
+			LegacyExpiresAt: lo.Ternary(
+				migration.LegacyExpiresAt.IsZero(), nil, timestamppb.New(migration.LegacyExpiresAt),
+			),
+			Complete: migration.Complete,
+		}
+	}
+
+	if len(output) == 0 {
+		return nil
+	}
+
+	return output
+}
+
 func (handler *GrpcStatus) reportPostgres(ctx context.Context) error {
 	ctx, span := otel.Tracer().Start(ctx, "grpc.Status(reportPostgres)")
 	defer span.End()
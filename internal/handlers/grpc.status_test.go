@@ -1,27 +1,90 @@
 package handlers_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/a-novel-kit/golib/postgres"
+	"github.com/a-novel-kit/jwt/v2/jwa"
 
+	"github.com/a-novel/service-json-keys/v2/internal/config"
 	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
 	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
 	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
 )
 
 func TestGrpcStatus(t *testing.T) {
 	t.Parallel()
 
+	errFoo := errors.New("foo")
+
+	legacyExpiresAt := time.Now().Add(time.Hour)
+
+	type serviceLifecycleMock struct {
+		resp *core.JwkLifecycleStatus
+		err  error
+	}
+
+	// Main keys are an hour old; the reported age is checked, then cleared, in the test loop.
+	healthyLifecycle := &serviceLifecycleMock{
+		resp: &core.JwkLifecycleStatus{
+			ActiveKeys:    1,
+			MainKID:       "kid-1",
+			MainCreatedAt: time.Now().Add(-time.Hour),
+			SigningKeys:   1,
+			NextExpiresAt: legacyExpiresAt,
+			Rotation:      24 * time.Hour,
+		},
+	}
+	healthyKeys := &jsonkeysv2.KeyHealth{
+		Status:        jsonkeysv2.KeyHealthStatus_KEY_HEALTH_STATUS_OK,
+		HasMainKey:    true,
+		Rotation:      durationpb.New(24 * time.Hour),
+		NextExpiresAt: timestamppb.New(legacyExpiresAt),
+	}
+
+	type serviceAlgMigrationMock struct {
+		resp *core.JwkAlgMigrationStatus
+		err  error
+	}
+
+	type serviceSigningFreezesMock struct {
+		resp []*core.SigningFreeze
+		err  error
+	}
+
+	frozenAt := time.Now().Add(-time.Minute)
+
+	type serviceRotationScheduleMock struct {
+		resp *core.JwkRotationScheduleStatus
+		err  error
+	}
+
+	lastRunAt := time.Now().Add(-time.Minute)
+
 	testCases := []struct {
 		name string
 
 		skipPostgres bool
 
+		keysConfig              map[string]*config.Jwk
+		serviceAlgMigrationMock *serviceAlgMigrationMock
+		serviceLifecycleMock    map[string]*serviceLifecycleMock
+		// The freezes are read when keysConfig lists usages; none are frozen when nil.
+		serviceSigningFreezesMock *serviceSigningFreezesMock
+		// The server does not schedule the rotation when nil.
+		serviceRotationScheduleMock *serviceRotationScheduleMock
+
 		expect       *jsonkeysv2.StatusResponse
 		expectStatus codes.Code
 	}{
@@ -33,6 +96,7 @@ func TestGrpcStatus(t *testing.T) {
 				Postgres: &jsonkeysv2.DependencyHealth{
 					Status: jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_UP,
 				},
+				Status: jsonkeysv2.ServiceStatus_SERVICE_STATUS_UP,
 			},
 		},
 		{
@@ -49,6 +113,351 @@ func TestGrpcStatus(t *testing.T) {
 				Postgres: &jsonkeysv2.DependencyHealth{
 					Status: jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_DOWN,
 				},
+				Status: jsonkeysv2.ServiceStatus_SERVICE_STATUS_DEGRADED,
+			},
+		},
+		{
+			name: "Success/AlgMigration",
+
+			keysConfig: map[string]*config.Jwk{
+				"test-usage":  {Alg: jwa.EdDSA, PreviousAlgs: []jwa.Alg{jwa.ES256}},
+				"other-usage": {Alg: jwa.EdDSA},
+			},
+			serviceAlgMigrationMock: &serviceAlgMigrationMock{
+				resp: &core.JwkAlgMigrationStatus{
+					Alg:             jwa.EdDSA,
+					PreviousAlgs:    []jwa.Alg{jwa.ES256},
+					LegacyKeys:      2,
+					LegacyExpiresAt: legacyExpiresAt,
+				},
+			},
+			serviceLifecycleMock: map[string]*serviceLifecycleMock{
+				"test-usage":  healthyLifecycle,
+				"other-usage": healthyLifecycle,
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.StatusResponse{
+				Postgres: &jsonkeysv2.DependencyHealth{
+					Status: jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_UP,
+				},
+				AlgMigrations: map[string]*jsonkeysv2.AlgMigration{
+					"test-usage": {
+						Alg:             "EdDSA",
+						PreviousAlgs:    []string{"ES256"},
+						LegacyKeys:      2,
+						LegacyExpiresAt: timestamppb.New(legacyExpiresAt),
+					},
+				},
+				Keys: map[string]*jsonkeysv2.KeyHealth{
+					"test-usage":  healthyKeys,
+					"other-usage": healthyKeys,
+				},
+				Status: jsonkeysv2.ServiceStatus_SERVICE_STATUS_UP,
+			},
+		},
+		{
+			name: "Success/AlgMigrationComplete",
+
+			keysConfig: map[string]*config.Jwk{
+				"test-usage": {Alg: jwa.EdDSA, PreviousAlgs: []jwa.Alg{jwa.ES256}},
+			},
+			serviceAlgMigrationMock: &serviceAlgMigrationMock{
+				resp: &core.JwkAlgMigrationStatus{
+					Alg:          jwa.EdDSA,
+					PreviousAlgs: []jwa.Alg{jwa.ES256},
+					Complete:     true,
+				},
+			},
+			serviceLifecycleMock: map[string]*serviceLifecycleMock{"test-usage": healthyLifecycle},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.StatusResponse{
+				Postgres: &jsonkeysv2.DependencyHealth{
+					Status: jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_UP,
+				},
+				AlgMigrations: map[string]*jsonkeysv2.AlgMigration{
+					"test-usage": {
+						Alg:          "EdDSA",
+						PreviousAlgs: []string{"ES256"},
+						Complete:     true,
+					},
+				},
+				Keys:   map[string]*jsonkeysv2.KeyHealth{"test-usage": healthyKeys},
+				Status: jsonkeysv2.ServiceStatus_SERVICE_STATUS_UP,
+			},
+		},
+		{
+			// A migration that cannot be assessed is left out rather than failing the whole status.
+			name: "Success/AlgMigrationError",
+
+			keysConfig: map[string]*config.Jwk{
+				"test-usage": {Alg: jwa.EdDSA, PreviousAlgs: []jwa.Alg{jwa.ES256}},
+			},
+			serviceAlgMigrationMock: &serviceAlgMigrationMock{
+				err: errFoo,
+			},
+			serviceLifecycleMock: map[string]*serviceLifecycleMock{"test-usage": healthyLifecycle},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.StatusResponse{
+				Postgres: &jsonkeysv2.DependencyHealth{
+					Status: jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_UP,
+				},
+				Keys:   map[string]*jsonkeysv2.KeyHealth{"test-usage": healthyKeys},
+				Status: jsonkeysv2.ServiceStatus_SERVICE_STATUS_UP,
+			},
+		},
+		{
+			// A freeze is deliberate: it is reported on the usage, but the service stays up.
+			name: "Success/SigningFrozen",
+
+			keysConfig: map[string]*config.Jwk{
+				"test-usage":  {Alg: jwa.EdDSA},
+				"other-usage": {Alg: jwa.EdDSA},
+			},
+			serviceLifecycleMock: map[string]*serviceLifecycleMock{
+				"test-usage":  healthyLifecycle,
+				"other-usage": healthyLifecycle,
+			},
+			serviceSigningFreezesMock: &serviceSigningFreezesMock{
+				resp: []*core.SigningFreeze{{Usage: "test-usage", Comment: "incident", FrozenAt: frozenAt}},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.StatusResponse{
+				Postgres: &jsonkeysv2.DependencyHealth{
+					Status: jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_UP,
+				},
+				Keys: map[string]*jsonkeysv2.KeyHealth{
+					"test-usage": {
+						Status:          jsonkeysv2.KeyHealthStatus_KEY_HEALTH_STATUS_OK,
+						HasMainKey:      true,
+						Rotation:        durationpb.New(24 * time.Hour),
+						NextExpiresAt:   timestamppb.New(legacyExpiresAt),
+						SigningFrozen:   true,
+						SigningFrozenAt: timestamppb.New(frozenAt),
+					},
+					"other-usage": healthyKeys,
+				},
+				Status: jsonkeysv2.ServiceStatus_SERVICE_STATUS_UP,
+			},
+		},
+		{
+			// Freezes that cannot be read are left out rather than failing the whole status.
+			name: "Success/SigningFreezesError",
+
+			keysConfig: map[string]*config.Jwk{
+				"test-usage": {Alg: jwa.EdDSA},
+			},
+			serviceLifecycleMock:      map[string]*serviceLifecycleMock{"test-usage": healthyLifecycle},
+			serviceSigningFreezesMock: &serviceSigningFreezesMock{err: errFoo},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.StatusResponse{
+				Postgres: &jsonkeysv2.DependencyHealth{
+					Status: jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_UP,
+				},
+				Keys:   map[string]*jsonkeysv2.KeyHealth{"test-usage": healthyKeys},
+				Status: jsonkeysv2.ServiceStatus_SERVICE_STATUS_UP,
+			},
+		},
+		{
+			// A stalled rotation is reported, but the usage still signs: the service stays up.
+			name: "Success/KeysStalled",
+
+			keysConfig: map[string]*config.Jwk{
+				"test-usage": {Alg: jwa.EdDSA},
+			},
+			serviceLifecycleMock: map[string]*serviceLifecycleMock{
+				"test-usage": {
+					resp: &core.JwkLifecycleStatus{
+						ActiveKeys:      3,
+						MainKID:         "kid-1",
+						MainCreatedAt:   time.Now().Add(-time.Hour),
+						LegacyKeys:      2,
+						SigningKeys:     3,
+						NextExpiresAt:   legacyExpiresAt,
+						Rotation:        20 * time.Minute,
+						RotationStalled: true,
+					},
+				},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.StatusResponse{
+				Postgres: &jsonkeysv2.DependencyHealth{
+					Status: jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_UP,
+				},
+				Keys: map[string]*jsonkeysv2.KeyHealth{
+					"test-usage": {
+						Status:          jsonkeysv2.KeyHealthStatus_KEY_HEALTH_STATUS_STALLED,
+						HasMainKey:      true,
+						Rotation:        durationpb.New(20 * time.Minute),
+						LegacyKeys:      2,
+						NextExpiresAt:   timestamppb.New(legacyExpiresAt),
+						RotationStalled: true,
+					},
+				},
+				Status: jsonkeysv2.ServiceStatus_SERVICE_STATUS_UP,
+			},
+		},
+		{
+			name: "Success/KeysUnavailable",
+
+			keysConfig: map[string]*config.Jwk{
+				"test-usage":  {Alg: jwa.EdDSA},
+				"other-usage": {Alg: jwa.EdDSA},
+			},
+			serviceLifecycleMock: map[string]*serviceLifecycleMock{
+				"test-usage":  healthyLifecycle,
+				"other-usage": {resp: &core.JwkLifecycleStatus{Rotation: 24 * time.Hour}},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.StatusResponse{
+				Postgres: &jsonkeysv2.DependencyHealth{
+					Status: jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_UP,
+				},
+				Keys: map[string]*jsonkeysv2.KeyHealth{
+					"test-usage": healthyKeys,
+					"other-usage": {
+						Status:   jsonkeysv2.KeyHealthStatus_KEY_HEALTH_STATUS_UNAVAILABLE,
+						Rotation: durationpb.New(24 * time.Hour),
+					},
+				},
+				Status: jsonkeysv2.ServiceStatus_SERVICE_STATUS_DEGRADED,
+			},
+		},
+		{
+			// The usage moved to another algorithm, and has no key of it yet: its keys still
+			// verify, but it cannot sign.
+			name: "Success/KeysAlgMigration",
+
+			keysConfig: map[string]*config.Jwk{
+				"test-usage": {Alg: jwa.EdDSA, PreviousAlgs: []jwa.Alg{jwa.ES256}},
+			},
+			serviceAlgMigrationMock: &serviceAlgMigrationMock{
+				resp: &core.JwkAlgMigrationStatus{
+					Alg:             jwa.EdDSA,
+					PreviousAlgs:    []jwa.Alg{jwa.ES256},
+					LegacyKeys:      2,
+					LegacyExpiresAt: legacyExpiresAt,
+				},
+			},
+			serviceLifecycleMock: map[string]*serviceLifecycleMock{
+				"test-usage": {
+					resp: &core.JwkLifecycleStatus{
+						ActiveKeys:    2,
+						MainKID:       "kid-1",
+						MainCreatedAt: time.Now().Add(-time.Hour),
+						LegacyKeys:    1,
+						NextExpiresAt: legacyExpiresAt,
+						Rotation:      24 * time.Hour,
+					},
+				},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.StatusResponse{
+				Postgres: &jsonkeysv2.DependencyHealth{
+					Status: jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_UP,
+				},
+				AlgMigrations: map[string]*jsonkeysv2.AlgMigration{
+					"test-usage": {
+						Alg:             "EdDSA",
+						PreviousAlgs:    []string{"ES256"},
+						LegacyKeys:      2,
+						LegacyExpiresAt: timestamppb.New(legacyExpiresAt),
+					},
+				},
+				Keys: map[string]*jsonkeysv2.KeyHealth{
+					"test-usage": {
+						Status:        jsonkeysv2.KeyHealthStatus_KEY_HEALTH_STATUS_UNAVAILABLE,
+						Rotation:      durationpb.New(24 * time.Hour),
+						LegacyKeys:    1,
+						NextExpiresAt: timestamppb.New(legacyExpiresAt),
+					},
+				},
+				Status: jsonkeysv2.ServiceStatus_SERVICE_STATUS_DEGRADED,
+			},
+		},
+		{
+			// Keys that cannot be read cannot sign either.
+			name: "Success/KeysError",
+
+			keysConfig: map[string]*config.Jwk{
+				"test-usage": {Alg: jwa.EdDSA},
+			},
+			serviceLifecycleMock: map[string]*serviceLifecycleMock{
+				"test-usage": {err: errFoo},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.StatusResponse{
+				Postgres: &jsonkeysv2.DependencyHealth{
+					Status: jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_UP,
+				},
+				Keys:   map[string]*jsonkeysv2.KeyHealth{"test-usage": {}},
+				Status: jsonkeysv2.ServiceStatus_SERVICE_STATUS_DEGRADED,
+			},
+		},
+		{
+			name: "Success/RotationSchedule",
+
+			serviceRotationScheduleMock: &serviceRotationScheduleMock{
+				resp: &core.JwkRotationScheduleStatus{
+					Interval:      time.Hour,
+					Leader:        true,
+					LeaderElected: true,
+					LastRunAt:     lastRunAt,
+					NextRunAt:     lastRunAt.Add(time.Hour),
+				},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.StatusResponse{
+				Postgres: &jsonkeysv2.DependencyHealth{
+					Status: jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_UP,
+				},
+				Status: jsonkeysv2.ServiceStatus_SERVICE_STATUS_UP,
+				RotationSchedule: &jsonkeysv2.RotationSchedule{
+					Interval:      durationpb.New(time.Hour),
+					Leader:        true,
+					LeaderElected: true,
+					LastRunAt:     timestamppb.New(lastRunAt),
+					NextRunAt:     timestamppb.New(lastRunAt.Add(time.Hour)),
+				},
+			},
+		},
+		{
+			name: "Success/RotationScheduleNeverRun",
+
+			serviceRotationScheduleMock: &serviceRotationScheduleMock{
+				resp: &core.JwkRotationScheduleStatus{Interval: time.Hour},
+			},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.StatusResponse{
+				Postgres: &jsonkeysv2.DependencyHealth{
+					Status: jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_UP,
+				},
+				Status:           jsonkeysv2.ServiceStatus_SERVICE_STATUS_UP,
+				RotationSchedule: &jsonkeysv2.RotationSchedule{Interval: durationpb.New(time.Hour)},
+			},
+		},
+		{
+			// The status stays available without the schedule.
+			name: "Success/RotationScheduleError",
+
+			serviceRotationScheduleMock: &serviceRotationScheduleMock{err: errFoo},
+
+			expectStatus: codes.OK,
+			expect: &jsonkeysv2.StatusResponse{
+				Postgres: &jsonkeysv2.DependencyHealth{
+					Status: jsonkeysv2.DependencyStatus_DEPENDENCY_STATUS_UP,
+				},
+				Status: jsonkeysv2.ServiceStatus_SERVICE_STATUS_UP,
 			},
 		},
 	}
@@ -57,7 +466,50 @@ func TestGrpcStatus(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
 
-			handler := handlers.NewGrpcStatus()
+			serviceAlgMigration := handlersmocks.NewMockGrpcStatusServiceAlgMigration(t)
+
+			if testCase.serviceAlgMigrationMock != nil {
+				serviceAlgMigration.EXPECT().
+					Exec(mock.Anything, &core.JwkAlgMigrationRequest{Usage: "test-usage"}).
+					Return(testCase.serviceAlgMigrationMock.resp, testCase.serviceAlgMigrationMock.err)
+			}
+
+			serviceLifecycle := handlersmocks.NewMockGrpcStatusServiceLifecycle(t)
+
+			for usage, lifecycleMock := range testCase.serviceLifecycleMock {
+				serviceLifecycle.EXPECT().
+					Exec(mock.Anything, &core.JwkLifecycleRequest{Usage: usage}).
+					Return(lifecycleMock.resp, lifecycleMock.err)
+			}
+
+			serviceSigningFreezes := handlersmocks.NewMockGrpcStatusServiceSigningFreezes(t)
+
+			if len(testCase.keysConfig) > 0 {
+				freezesMock := testCase.serviceSigningFreezesMock
+				if freezesMock == nil {
+					freezesMock = &serviceSigningFreezesMock{}
+				}
+
+				serviceSigningFreezes.EXPECT().
+					Exec(mock.Anything, &core.SigningFreezeListRequest{}).
+					Return(freezesMock.resp, freezesMock.err)
+			}
+
+			var serviceRotationSchedule handlers.GrpcStatusServiceRotationSchedule
+
+			if testCase.serviceRotationScheduleMock != nil {
+				mockRotationSchedule := handlersmocks.NewMockGrpcStatusServiceRotationSchedule(t)
+				mockRotationSchedule.EXPECT().
+					Exec(mock.Anything, &core.JwkRotationScheduleRequest{}).
+					Return(testCase.serviceRotationScheduleMock.resp, testCase.serviceRotationScheduleMock.err)
+
+				serviceRotationSchedule = mockRotationSchedule
+			}
+
+			handler := handlers.NewGrpcStatus(
+				serviceAlgMigration, serviceLifecycle, serviceSigningFreezes, serviceRotationSchedule,
+				testCase.keysConfig,
+			)
 
 			ctx := t.Context()
 
@@ -76,6 +528,15 @@ func TestGrpcStatus(t *testing.T) {
 				testCase.expectStatus, resSt.Code(),
 				"expected status code %s, got %s (%v)", testCase.expectStatus, resSt.Code(), err,
 			)
+
+			for _, health := range res.GetKeys() {
+				if health.GetMainKeyAge() != nil {
+					require.InDelta(t, time.Hour.Seconds(), health.GetMainKeyAge().AsDuration().Seconds(), 60)
+
+					health.MainKeyAge = nil
+				}
+			}
+
 			require.Equal(t, testCase.expect, res)
 		})
 	}
@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+)
+
+// MetricsNamespace prefixes the name of every metric exposed by [Metrics].
+const MetricsNamespace = "jsonkeys"
+
+// Metric labels.
+const (
+	metricsLabelUsage = "usage"
+	metricsLabelCode  = "code"
+)
+
+// metricsUnmatchedRoute labels the REST requests that matched no route.
+const metricsUnmatchedRoute = "unmatched"
+
+// MetricsServiceLifecycle is the key lifecycle service dependency of [Metrics].
+type MetricsServiceLifecycle interface {
+	Exec(ctx context.Context, request *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error)
+}
+
+// Metrics collects the Prometheus metrics of a server, and serves them for scraping:
+//
+//   - jsonkeys_signatures_total{operation,usage,kid}: signatures issued, see [core.SignatureRecorder].
+//   - jsonkeys_grpc_request_duration_seconds{method,code}: latency of the gRPC calls.
+//   - jsonkeys_grpc_errors_total{method,code}: failed gRPC calls, by status code.
+//   - jsonkeys_http_request_duration_seconds{route,code}: latency of the REST requests.
+//   - jsonkeys_http_errors_total{route,code}: REST requests answered with an error status.
+//   - jsonkeys_active_keys{usage}: active keys, main and legacy, of each configured usage.
+//   - jsonkeys_main_key_age_seconds{usage}: age of the main key of each usage.
+//   - jsonkeys_next_key_expiry_seconds{usage}: time until the next active key of each usage expires.
+//   - jsonkeys_key_rotation_interval_seconds{usage}: configured rotation interval of each usage.
+//
+// Alert on main_key_age_seconds exceeding key_rotation_interval_seconds to catch a stalled
+// rotation job before keys run out. Key gauges are refreshed from the database on every scrape.
+type Metrics struct {
+	serviceLifecycle MetricsServiceLifecycle
+	keysConfig       map[string]*config.Jwk
+	logger           logging.Log
+
+	registry *prometheus.Registry
+
+	signatures *prometheus.CounterVec
+
+	grpcDuration *prometheus.HistogramVec
+	grpcErrors   *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+	httpErrors   *prometheus.CounterVec
+
+	activeKeys     *prometheus.GaugeVec
+	mainKeyAge     *prometheus.GaugeVec
+	nextKeyExpiry  *prometheus.GaugeVec
+	rotationPeriod *prometheus.GaugeVec
+}
+
+// NewMetrics returns a new Metrics collector. Key gauges are reported for every usage in
+// keysConfig. Metrics are registered on a dedicated registry, along with the Go runtime and
+// process collectors.
+func NewMetrics(
+	serviceLifecycle MetricsServiceLifecycle, keysConfig map[string]*config.Jwk, logger logging.Log,
+) *Metrics {
+	metrics := &Metrics{
+		serviceLifecycle: serviceLifecycle,
+		keysConfig:       keysConfig,
+		logger:           logger,
+		registry:         prometheus.NewRegistry(),
+
+		signatures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "signatures_total",
+			Help:      "Signatures issued, by operation, key usage and key ID.",
+		}, []string{"operation", metricsLabelUsage, "kid"}),
+
+		grpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Latency of the gRPC calls, by method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", metricsLabelCode}),
+		grpcErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "grpc_errors_total",
+			Help:      "Failed gRPC calls, by method and status code.",
+		}, []string{"method", metricsLabelCode}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latency of the REST requests, by route and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route", metricsLabelCode}),
+		httpErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "http_errors_total",
+			Help:      "REST requests answered with an error status, by route and status code.",
+		}, []string{"route", metricsLabelCode}),
+
+		activeKeys: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "active_keys",
+			Help:      "Active keys, main and legacy, of each key usage.",
+		}, []string{metricsLabelUsage}),
+		mainKeyAge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "main_key_age_seconds",
+			Help:      "Age of the main key of each key usage.",
+		}, []string{metricsLabelUsage}),
+		nextKeyExpiry: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "next_key_expiry_seconds",
+			Help:      "Time until the next active key of each key usage expires.",
+		}, []string{metricsLabelUsage}),
+		rotationPeriod: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "key_rotation_interval_seconds",
+			Help:      "Configured rotation interval of each key usage.",
+		}, []string{metricsLabelUsage}),
+	}
+
+	metrics.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		metrics.signatures,
+		metrics.grpcDuration,
+		metrics.grpcErrors,
+		metrics.httpDuration,
+		metrics.httpErrors,
+		metrics.activeKeys,
+		metrics.mainKeyAge,
+		metrics.nextKeyExpiry,
+		metrics.rotationPeriod,
+	)
+
+	return metrics
+}
+
+// RecordSignature implements [core.SignatureRecorder].
+func (metrics *Metrics) RecordSignature(operation core.SignatureOperation, usage, kid string) {
+	metrics.signatures.WithLabelValues(string(operation), usage, kid).Inc()
+}
+
+// Handler returns the HTTP handler serving the metrics, in the Prometheus exposition format.
+// Its requests must carry the database connection in their context.
+func (metrics *Metrics) Handler() http.Handler {
+	serve := promhttp.HandlerFor(metrics.registry, promhttp.HandlerOpts{})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		metrics.refreshKeys(r.Context())
+		serve.ServeHTTP(w, r)
+	})
+}
+
+// UnaryInterceptor returns the gRPC interceptor measuring calls, to register on the gRPC server.
+func (metrics *Metrics) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+
+		res, err := handler(ctx, req)
+
+		code := status.Code(err).String()
+		metrics.grpcDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(start).Seconds())
+
+		if err != nil {
+			metrics.grpcErrors.WithLabelValues(info.FullMethod, code).Inc()
+		}
+
+		return res, err
+	}
+}
+
+// Middleware measures the REST requests. Requests are labeled with their route pattern rather
+// than their path, which would give every key ID its own series.
+func (metrics *Metrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		next.ServeHTTP(ww, r)
+
+		route := metricsUnmatchedRoute
+		if routeCtx := chi.RouteContext(r.Context()); routeCtx != nil && routeCtx.RoutePattern() != "" {
+			route = routeCtx.RoutePattern()
+		}
+
+		// Handlers that never write answer with 200.
+		statusCode := ww.Status()
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		}
+
+		code := strconv.Itoa(statusCode)
+		metrics.httpDuration.WithLabelValues(route, code).Observe(time.Since(start).Seconds())
+
+		if statusCode >= http.StatusBadRequest {
+			metrics.httpErrors.WithLabelValues(route, code).Inc()
+		}
+	})
+}
+
+// refreshKeys updates the key gauges. A usage that cannot be read is dropped from the gauges
+// until the next scrape, rather than reporting stale values.
+func (metrics *Metrics) refreshKeys(ctx context.Context) {
+	ctx, span := otel.Tracer().Start(ctx, "metrics.refreshKeys")
+	defer span.End()
+
+	now := time.Now()
+
+	for usage, keyConfig := range metrics.keysConfig {
+		metrics.rotationPeriod.WithLabelValues(usage).Set(keyConfig.Key.Rotation.Seconds())
+
+		lifecycle, err := metrics.serviceLifecycle.Exec(ctx, &core.JwkLifecycleRequest{Usage: usage})
+		if err != nil {
+			err = otel.ReportError(span, fmt.Errorf("usage %s: %w", usage, err))
+			if metrics.logger != nil {
+				metrics.logger.Err(ctx, err.Error())
+			}
+
+			metrics.activeKeys.DeleteLabelValues(usage)
+			metrics.mainKeyAge.DeleteLabelValues(usage)
+			metrics.nextKeyExpiry.DeleteLabelValues(usage)
+
+			continue
+		}
+
+		metrics.activeKeys.WithLabelValues(usage).Set(float64(lifecycle.ActiveKeys))
+
+		// Without keys, there is no age or expiry to report.
+		if lifecycle.ActiveKeys == 0 {
+			metrics.mainKeyAge.DeleteLabelValues(usage)
+			metrics.nextKeyExpiry.DeleteLabelValues(usage)
+
+			continue
+		}
+
+		metrics.mainKeyAge.WithLabelValues(usage).Set(now.Sub(lifecycle.MainCreatedAt).Seconds())
+		metrics.nextKeyExpiry.WithLabelValues(usage).Set(lifecycle.NextExpiresAt.Sub(now).Seconds())
+	}
+
+	otel.ReportSuccessNoContent(span)
+}
@@ -0,0 +1,177 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+)
+
+func scrapeMetrics(t *testing.T, metrics *handlers.Metrics) string {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(w, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/metrics", nil))
+
+	res := w.Result()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, errors.Join(err, res.Body.Close()))
+
+	return string(body)
+}
+
+func TestMetricsKeys(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	keysConfig := map[string]*config.Jwk{
+		"test-usage": {Key: config.JwkKey{Rotation: time.Hour}},
+	}
+
+	type serviceLifecycleMock struct {
+		resp *core.JwkLifecycleStatus
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		serviceLifecycleMock *serviceLifecycleMock
+
+		expectContains    []string
+		expectNotContains []string
+	}{
+		{
+			name: "Success",
+
+			serviceLifecycleMock: &serviceLifecycleMock{
+				resp: &core.JwkLifecycleStatus{
+					ActiveKeys:    2,
+					MainKID:       "kid-1",
+					MainCreatedAt: time.Now().Add(-time.Minute),
+					SigningKeys:   2,
+					NextExpiresAt: time.Now().Add(time.Hour),
+					Rotation:      time.Hour,
+				},
+			},
+
+			expectContains: []string{
+				`jsonkeys_active_keys{usage="test-usage"} 2`,
+				`jsonkeys_key_rotation_interval_seconds{usage="test-usage"} 3600`,
+				`jsonkeys_main_key_age_seconds{usage="test-usage"}`,
+				`jsonkeys_next_key_expiry_seconds{usage="test-usage"}`,
+			},
+		},
+		{
+			name: "Success/NoKeys",
+
+			serviceLifecycleMock: &serviceLifecycleMock{
+				resp: &core.JwkLifecycleStatus{Rotation: time.Hour},
+			},
+
+			expectContains: []string{
+				`jsonkeys_active_keys{usage="test-usage"} 0`,
+			},
+			expectNotContains: []string{
+				`jsonkeys_main_key_age_seconds{`,
+				`jsonkeys_next_key_expiry_seconds{`,
+			},
+		},
+		{
+			name: "Error/Lifecycle",
+
+			serviceLifecycleMock: &serviceLifecycleMock{err: errFoo},
+
+			expectContains: []string{
+				`jsonkeys_key_rotation_interval_seconds{usage="test-usage"} 3600`,
+			},
+			expectNotContains: []string{
+				`jsonkeys_active_keys{`,
+				`jsonkeys_main_key_age_seconds{`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			serviceLifecycle := handlersmocks.NewMockMetricsServiceLifecycle(t)
+
+			serviceLifecycle.EXPECT().
+				Exec(mock.Anything, &core.JwkLifecycleRequest{Usage: "test-usage"}).
+				Return(testCase.serviceLifecycleMock.resp, testCase.serviceLifecycleMock.err)
+
+			metrics := handlers.NewMetrics(serviceLifecycle, keysConfig, nil)
+
+			body := scrapeMetrics(t, metrics)
+
+			for _, expect := range testCase.expectContains {
+				require.Contains(t, body, expect)
+			}
+
+			for _, expect := range testCase.expectNotContains {
+				require.NotContains(t, body, expect)
+			}
+
+			serviceLifecycle.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMetricsRequests(t *testing.T) {
+	t.Parallel()
+
+	metrics := handlers.NewMetrics(nil, nil, nil)
+
+	metrics.RecordSignature(core.SignatureOperationClaims, "test-usage", "kid-1")
+	metrics.RecordSignature(core.SignatureOperationClaims, "test-usage", "kid-1")
+
+	interceptor := metrics.UnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	_, err := interceptor(t.Context(), nil, info, func(_ context.Context, _ any) (any, error) {
+		return nil, status.Error(codes.PermissionDenied, "denied")
+	})
+	require.Error(t, err)
+
+	router := chi.NewRouter()
+	router.Use(metrics.Middleware)
+	router.Get("/jwks/{kid}", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	router.ServeHTTP(
+		httptest.NewRecorder(),
+		httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/jwks/kid-1", nil),
+	)
+
+	body := scrapeMetrics(t, metrics)
+
+	require.Contains(t, body, `jsonkeys_signatures_total{kid="kid-1",operation="claims",usage="test-usage"} 2`)
+	require.Contains(t, body, `jsonkeys_grpc_errors_total{code="PermissionDenied",method="/test.Service/Method"} 1`)
+	require.Contains(
+		t, body,
+		`jsonkeys_grpc_request_duration_seconds_count{code="PermissionDenied",method="/test.Service/Method"} 1`,
+	)
+	require.Contains(t, body, `jsonkeys_http_errors_total{code="404",route="/jwks/{kid}"} 1`)
+	require.Contains(t, body, `jsonkeys_http_request_duration_seconds_count{code="404",route="/jwks/{kid}"} 1`)
+}
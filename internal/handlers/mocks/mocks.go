@@ -11,6 +11,196 @@ import (
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockGrpcApiKeysService creates a new instance of MockGrpcApiKeysService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcApiKeysService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcApiKeysService {
+	mock := &MockGrpcApiKeysService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcApiKeysService is an autogenerated mock type for the GrpcApiKeysService type
+type MockGrpcApiKeysService struct {
+	mock.Mock
+}
+
+type MockGrpcApiKeysService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcApiKeysService) EXPECT() *MockGrpcApiKeysService_Expecter {
+	return &MockGrpcApiKeysService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcApiKeysService
+func (_mock *MockGrpcApiKeysService) Exec(ctx context.Context, request *core.ApiKeyAuthenticateRequest) (*core.ApiKey, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ApiKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ApiKeyAuthenticateRequest) (*core.ApiKey, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ApiKeyAuthenticateRequest) *core.ApiKey); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ApiKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ApiKeyAuthenticateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcApiKeysService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcApiKeysService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ApiKeyAuthenticateRequest
+func (_e *MockGrpcApiKeysService_Expecter) Exec(ctx any, request any) *MockGrpcApiKeysService_Exec_Call {
+	return &MockGrpcApiKeysService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcApiKeysService_Exec_Call) Run(run func(ctx context.Context, request *core.ApiKeyAuthenticateRequest)) *MockGrpcApiKeysService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ApiKeyAuthenticateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ApiKeyAuthenticateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcApiKeysService_Exec_Call) Return(apiKey *core.ApiKey, err error) *MockGrpcApiKeysService_Exec_Call {
+	_c.Call.Return(apiKey, err)
+	return _c
+}
+
+func (_c *MockGrpcApiKeysService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ApiKeyAuthenticateRequest) (*core.ApiKey, error)) *MockGrpcApiKeysService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcAuditEventSearchService creates a new instance of MockGrpcAuditEventSearchService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcAuditEventSearchService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcAuditEventSearchService {
+	mock := &MockGrpcAuditEventSearchService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcAuditEventSearchService is an autogenerated mock type for the GrpcAuditEventSearchService type
+type MockGrpcAuditEventSearchService struct {
+	mock.Mock
+}
+
+type MockGrpcAuditEventSearchService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcAuditEventSearchService) EXPECT() *MockGrpcAuditEventSearchService_Expecter {
+	return &MockGrpcAuditEventSearchService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcAuditEventSearchService
+func (_mock *MockGrpcAuditEventSearchService) Exec(ctx context.Context, request *core.AuditEventSearchRequest) ([]*core.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*core.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.AuditEventSearchRequest) ([]*core.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.AuditEventSearchRequest) []*core.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*core.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.AuditEventSearchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcAuditEventSearchService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcAuditEventSearchService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.AuditEventSearchRequest
+func (_e *MockGrpcAuditEventSearchService_Expecter) Exec(ctx any, request any) *MockGrpcAuditEventSearchService_Exec_Call {
+	return &MockGrpcAuditEventSearchService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcAuditEventSearchService_Exec_Call) Run(run func(ctx context.Context, request *core.AuditEventSearchRequest)) *MockGrpcAuditEventSearchService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.AuditEventSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.AuditEventSearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcAuditEventSearchService_Exec_Call) Return(auditEvents []*core.AuditEvent, err error) *MockGrpcAuditEventSearchService_Exec_Call {
+	_c.Call.Return(auditEvents, err)
+	return _c
+}
+
+func (_c *MockGrpcAuditEventSearchService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.AuditEventSearchRequest) ([]*core.AuditEvent, error)) *MockGrpcAuditEventSearchService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockGrpcClaimsSignService creates a new instance of MockGrpcClaimsSignService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockGrpcClaimsSignService(t interface {
@@ -104,13 +294,13 @@ func (_c *MockGrpcClaimsSignService_Exec_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
-// NewMockGrpcJwkGetService creates a new instance of MockGrpcJwkGetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockGrpcClaimsVerifyService creates a new instance of MockGrpcClaimsVerifyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockGrpcJwkGetService(t interface {
+func NewMockGrpcClaimsVerifyService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockGrpcJwkGetService {
-	mock := &MockGrpcJwkGetService{}
+}) *MockGrpcClaimsVerifyService {
+	mock := &MockGrpcClaimsVerifyService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -118,40 +308,40 @@ func NewMockGrpcJwkGetService(t interface {
 	return mock
 }
 
-// MockGrpcJwkGetService is an autogenerated mock type for the GrpcJwkGetService type
-type MockGrpcJwkGetService struct {
+// MockGrpcClaimsVerifyService is an autogenerated mock type for the GrpcClaimsVerifyService type
+type MockGrpcClaimsVerifyService struct {
 	mock.Mock
 }
 
-type MockGrpcJwkGetService_Expecter struct {
+type MockGrpcClaimsVerifyService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockGrpcJwkGetService) EXPECT() *MockGrpcJwkGetService_Expecter {
-	return &MockGrpcJwkGetService_Expecter{mock: &_m.Mock}
+func (_m *MockGrpcClaimsVerifyService) EXPECT() *MockGrpcClaimsVerifyService_Expecter {
+	return &MockGrpcClaimsVerifyService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockGrpcJwkGetService
-func (_mock *MockGrpcJwkGetService) Exec(ctx context.Context, request *core.JwkSelectRequest) (*core.Jwk, error) {
+// Exec provides a mock function for the type MockGrpcClaimsVerifyService
+func (_mock *MockGrpcClaimsVerifyService) Exec(ctx context.Context, request *core.ClaimsInspectRequest) (*core.ClaimsInspectResult, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.Jwk
+	var r0 *core.ClaimsInspectResult
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkSelectRequest) (*core.Jwk, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ClaimsInspectRequest) (*core.ClaimsInspectResult, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkSelectRequest) *core.Jwk); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ClaimsInspectRequest) *core.ClaimsInspectResult); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.Jwk)
+			r0 = ret.Get(0).(*core.ClaimsInspectResult)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ClaimsInspectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -159,27 +349,27 @@ func (_mock *MockGrpcJwkGetService) Exec(ctx context.Context, request *core.JwkS
 	return r0, r1
 }
 
-// MockGrpcJwkGetService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockGrpcJwkGetService_Exec_Call struct {
+// MockGrpcClaimsVerifyService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcClaimsVerifyService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.JwkSelectRequest
-func (_e *MockGrpcJwkGetService_Expecter) Exec(ctx any, request any) *MockGrpcJwkGetService_Exec_Call {
-	return &MockGrpcJwkGetService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *core.ClaimsInspectRequest
+func (_e *MockGrpcClaimsVerifyService_Expecter) Exec(ctx any, request any) *MockGrpcClaimsVerifyService_Exec_Call {
+	return &MockGrpcClaimsVerifyService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockGrpcJwkGetService_Exec_Call) Run(run func(ctx context.Context, request *core.JwkSelectRequest)) *MockGrpcJwkGetService_Exec_Call {
+func (_c *MockGrpcClaimsVerifyService_Exec_Call) Run(run func(ctx context.Context, request *core.ClaimsInspectRequest)) *MockGrpcClaimsVerifyService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.JwkSelectRequest
+		var arg1 *core.ClaimsInspectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.JwkSelectRequest)
+			arg1 = args[1].(*core.ClaimsInspectRequest)
 		}
 		run(
 			arg0,
@@ -189,23 +379,23 @@ func (_c *MockGrpcJwkGetService_Exec_Call) Run(run func(ctx context.Context, req
 	return _c
 }
 
-func (_c *MockGrpcJwkGetService_Exec_Call) Return(v *core.Jwk, err error) *MockGrpcJwkGetService_Exec_Call {
-	_c.Call.Return(v, err)
+func (_c *MockGrpcClaimsVerifyService_Exec_Call) Return(claimsInspectResult *core.ClaimsInspectResult, err error) *MockGrpcClaimsVerifyService_Exec_Call {
+	_c.Call.Return(claimsInspectResult, err)
 	return _c
 }
 
-func (_c *MockGrpcJwkGetService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkSelectRequest) (*core.Jwk, error)) *MockGrpcJwkGetService_Exec_Call {
+func (_c *MockGrpcClaimsVerifyService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ClaimsInspectRequest) (*core.ClaimsInspectResult, error)) *MockGrpcClaimsVerifyService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockGrpcJwkListService creates a new instance of MockGrpcJwkListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockGrpcHealthServiceLifecycle creates a new instance of MockGrpcHealthServiceLifecycle. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockGrpcJwkListService(t interface {
+func NewMockGrpcHealthServiceLifecycle(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockGrpcJwkListService {
-	mock := &MockGrpcJwkListService{}
+}) *MockGrpcHealthServiceLifecycle {
+	mock := &MockGrpcHealthServiceLifecycle{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -213,40 +403,40 @@ func NewMockGrpcJwkListService(t interface {
 	return mock
 }
 
-// MockGrpcJwkListService is an autogenerated mock type for the GrpcJwkListService type
-type MockGrpcJwkListService struct {
+// MockGrpcHealthServiceLifecycle is an autogenerated mock type for the GrpcHealthServiceLifecycle type
+type MockGrpcHealthServiceLifecycle struct {
 	mock.Mock
 }
 
-type MockGrpcJwkListService_Expecter struct {
+type MockGrpcHealthServiceLifecycle_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockGrpcJwkListService) EXPECT() *MockGrpcJwkListService_Expecter {
-	return &MockGrpcJwkListService_Expecter{mock: &_m.Mock}
+func (_m *MockGrpcHealthServiceLifecycle) EXPECT() *MockGrpcHealthServiceLifecycle_Expecter {
+	return &MockGrpcHealthServiceLifecycle_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockGrpcJwkListService
-func (_mock *MockGrpcJwkListService) Exec(ctx context.Context, request *core.JwkSearchRequest) ([]*core.Jwk, error) {
+// Exec provides a mock function for the type MockGrpcHealthServiceLifecycle
+func (_mock *MockGrpcHealthServiceLifecycle) Exec(ctx context.Context, request *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 []*core.Jwk
+	var r0 *core.JwkLifecycleStatus
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkSearchRequest) ([]*core.Jwk, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkSearchRequest) []*core.Jwk); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkLifecycleRequest) *core.JwkLifecycleStatus); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*core.Jwk)
+			r0 = ret.Get(0).(*core.JwkLifecycleStatus)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkSearchRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkLifecycleRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -254,27 +444,27 @@ func (_mock *MockGrpcJwkListService) Exec(ctx context.Context, request *core.Jwk
 	return r0, r1
 }
 
-// MockGrpcJwkListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockGrpcJwkListService_Exec_Call struct {
+// MockGrpcHealthServiceLifecycle_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcHealthServiceLifecycle_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.JwkSearchRequest
-func (_e *MockGrpcJwkListService_Expecter) Exec(ctx any, request any) *MockGrpcJwkListService_Exec_Call {
-	return &MockGrpcJwkListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *core.JwkLifecycleRequest
+func (_e *MockGrpcHealthServiceLifecycle_Expecter) Exec(ctx any, request any) *MockGrpcHealthServiceLifecycle_Exec_Call {
+	return &MockGrpcHealthServiceLifecycle_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockGrpcJwkListService_Exec_Call) Run(run func(ctx context.Context, request *core.JwkSearchRequest)) *MockGrpcJwkListService_Exec_Call {
+func (_c *MockGrpcHealthServiceLifecycle_Exec_Call) Run(run func(ctx context.Context, request *core.JwkLifecycleRequest)) *MockGrpcHealthServiceLifecycle_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.JwkSearchRequest
+		var arg1 *core.JwkLifecycleRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.JwkSearchRequest)
+			arg1 = args[1].(*core.JwkLifecycleRequest)
 		}
 		run(
 			arg0,
@@ -284,12 +474,1796 @@ func (_c *MockGrpcJwkListService_Exec_Call) Run(run func(ctx context.Context, re
 	return _c
 }
 
-func (_c *MockGrpcJwkListService_Exec_Call) Return(vs []*core.Jwk, err error) *MockGrpcJwkListService_Exec_Call {
-	_c.Call.Return(vs, err)
+func (_c *MockGrpcHealthServiceLifecycle_Exec_Call) Return(jwkLifecycleStatus *core.JwkLifecycleStatus, err error) *MockGrpcHealthServiceLifecycle_Exec_Call {
+	_c.Call.Return(jwkLifecycleStatus, err)
 	return _c
 }
 
-func (_c *MockGrpcJwkListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkSearchRequest) ([]*core.Jwk, error)) *MockGrpcJwkListService_Exec_Call {
+func (_c *MockGrpcHealthServiceLifecycle_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error)) *MockGrpcHealthServiceLifecycle_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcHealthServiceWarmUp creates a new instance of MockGrpcHealthServiceWarmUp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcHealthServiceWarmUp(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcHealthServiceWarmUp {
+	mock := &MockGrpcHealthServiceWarmUp{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcHealthServiceWarmUp is an autogenerated mock type for the GrpcHealthServiceWarmUp type
+type MockGrpcHealthServiceWarmUp struct {
+	mock.Mock
+}
+
+type MockGrpcHealthServiceWarmUp_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcHealthServiceWarmUp) EXPECT() *MockGrpcHealthServiceWarmUp_Expecter {
+	return &MockGrpcHealthServiceWarmUp_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcHealthServiceWarmUp
+func (_mock *MockGrpcHealthServiceWarmUp) Exec(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGrpcHealthServiceWarmUp_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcHealthServiceWarmUp_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGrpcHealthServiceWarmUp_Expecter) Exec(ctx any) *MockGrpcHealthServiceWarmUp_Exec_Call {
+	return &MockGrpcHealthServiceWarmUp_Exec_Call{Call: _e.mock.On("Exec", ctx)}
+}
+
+func (_c *MockGrpcHealthServiceWarmUp_Exec_Call) Run(run func(ctx context.Context)) *MockGrpcHealthServiceWarmUp_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcHealthServiceWarmUp_Exec_Call) Return(err error) *MockGrpcHealthServiceWarmUp_Exec_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGrpcHealthServiceWarmUp_Exec_Call) RunAndReturn(run func(ctx context.Context) error) *MockGrpcHealthServiceWarmUp_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcHttpSignatureSignService creates a new instance of MockGrpcHttpSignatureSignService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcHttpSignatureSignService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcHttpSignatureSignService {
+	mock := &MockGrpcHttpSignatureSignService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcHttpSignatureSignService is an autogenerated mock type for the GrpcHttpSignatureSignService type
+type MockGrpcHttpSignatureSignService struct {
+	mock.Mock
+}
+
+type MockGrpcHttpSignatureSignService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcHttpSignatureSignService) EXPECT() *MockGrpcHttpSignatureSignService_Expecter {
+	return &MockGrpcHttpSignatureSignService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcHttpSignatureSignService
+func (_mock *MockGrpcHttpSignatureSignService) Exec(ctx context.Context, request *core.HttpSignatureSignRequest) (*core.HttpSignatureSignResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.HttpSignatureSignResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.HttpSignatureSignRequest) (*core.HttpSignatureSignResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.HttpSignatureSignRequest) *core.HttpSignatureSignResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.HttpSignatureSignResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.HttpSignatureSignRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcHttpSignatureSignService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcHttpSignatureSignService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.HttpSignatureSignRequest
+func (_e *MockGrpcHttpSignatureSignService_Expecter) Exec(ctx any, request any) *MockGrpcHttpSignatureSignService_Exec_Call {
+	return &MockGrpcHttpSignatureSignService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcHttpSignatureSignService_Exec_Call) Run(run func(ctx context.Context, request *core.HttpSignatureSignRequest)) *MockGrpcHttpSignatureSignService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.HttpSignatureSignRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.HttpSignatureSignRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcHttpSignatureSignService_Exec_Call) Return(httpSignatureSignResponse *core.HttpSignatureSignResponse, err error) *MockGrpcHttpSignatureSignService_Exec_Call {
+	_c.Call.Return(httpSignatureSignResponse, err)
+	return _c
+}
+
+func (_c *MockGrpcHttpSignatureSignService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.HttpSignatureSignRequest) (*core.HttpSignatureSignResponse, error)) *MockGrpcHttpSignatureSignService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcJwkBurnService creates a new instance of MockGrpcJwkBurnService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcJwkBurnService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcJwkBurnService {
+	mock := &MockGrpcJwkBurnService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcJwkBurnService is an autogenerated mock type for the GrpcJwkBurnService type
+type MockGrpcJwkBurnService struct {
+	mock.Mock
+}
+
+type MockGrpcJwkBurnService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcJwkBurnService) EXPECT() *MockGrpcJwkBurnService_Expecter {
+	return &MockGrpcJwkBurnService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcJwkBurnService
+func (_mock *MockGrpcJwkBurnService) Exec(ctx context.Context, request *core.JwkBurnRequest) (*core.JwkBurnResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.JwkBurnResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkBurnRequest) (*core.JwkBurnResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkBurnRequest) *core.JwkBurnResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.JwkBurnResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkBurnRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcJwkBurnService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcJwkBurnService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkBurnRequest
+func (_e *MockGrpcJwkBurnService_Expecter) Exec(ctx any, request any) *MockGrpcJwkBurnService_Exec_Call {
+	return &MockGrpcJwkBurnService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcJwkBurnService_Exec_Call) Run(run func(ctx context.Context, request *core.JwkBurnRequest)) *MockGrpcJwkBurnService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkBurnRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkBurnRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcJwkBurnService_Exec_Call) Return(jwkBurnResponse *core.JwkBurnResponse, err error) *MockGrpcJwkBurnService_Exec_Call {
+	_c.Call.Return(jwkBurnResponse, err)
+	return _c
+}
+
+func (_c *MockGrpcJwkBurnService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkBurnRequest) (*core.JwkBurnResponse, error)) *MockGrpcJwkBurnService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcJwkGetService creates a new instance of MockGrpcJwkGetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcJwkGetService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcJwkGetService {
+	mock := &MockGrpcJwkGetService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcJwkGetService is an autogenerated mock type for the GrpcJwkGetService type
+type MockGrpcJwkGetService struct {
+	mock.Mock
+}
+
+type MockGrpcJwkGetService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcJwkGetService) EXPECT() *MockGrpcJwkGetService_Expecter {
+	return &MockGrpcJwkGetService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcJwkGetService
+func (_mock *MockGrpcJwkGetService) Exec(ctx context.Context, request *core.JwkSelectRequest) (*core.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkSelectRequest) (*core.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkSelectRequest) *core.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcJwkGetService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcJwkGetService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkSelectRequest
+func (_e *MockGrpcJwkGetService_Expecter) Exec(ctx any, request any) *MockGrpcJwkGetService_Exec_Call {
+	return &MockGrpcJwkGetService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcJwkGetService_Exec_Call) Run(run func(ctx context.Context, request *core.JwkSelectRequest)) *MockGrpcJwkGetService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcJwkGetService_Exec_Call) Return(v *core.Jwk, err error) *MockGrpcJwkGetService_Exec_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockGrpcJwkGetService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkSelectRequest) (*core.Jwk, error)) *MockGrpcJwkGetService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcJwkListService creates a new instance of MockGrpcJwkListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcJwkListService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcJwkListService {
+	mock := &MockGrpcJwkListService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcJwkListService is an autogenerated mock type for the GrpcJwkListService type
+type MockGrpcJwkListService struct {
+	mock.Mock
+}
+
+type MockGrpcJwkListService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcJwkListService) EXPECT() *MockGrpcJwkListService_Expecter {
+	return &MockGrpcJwkListService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcJwkListService
+func (_mock *MockGrpcJwkListService) Exec(ctx context.Context, request *core.JwkSearchRequest) ([]*core.Jwk, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*core.Jwk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkSearchRequest) ([]*core.Jwk, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkSearchRequest) []*core.Jwk); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*core.Jwk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkSearchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcJwkListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcJwkListService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkSearchRequest
+func (_e *MockGrpcJwkListService_Expecter) Exec(ctx any, request any) *MockGrpcJwkListService_Exec_Call {
+	return &MockGrpcJwkListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcJwkListService_Exec_Call) Run(run func(ctx context.Context, request *core.JwkSearchRequest)) *MockGrpcJwkListService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkSearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcJwkListService_Exec_Call) Return(vs []*core.Jwk, err error) *MockGrpcJwkListService_Exec_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockGrpcJwkListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkSearchRequest) ([]*core.Jwk, error)) *MockGrpcJwkListService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcJwkRotateService creates a new instance of MockGrpcJwkRotateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcJwkRotateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcJwkRotateService {
+	mock := &MockGrpcJwkRotateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcJwkRotateService is an autogenerated mock type for the GrpcJwkRotateService type
+type MockGrpcJwkRotateService struct {
+	mock.Mock
+}
+
+type MockGrpcJwkRotateService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcJwkRotateService) EXPECT() *MockGrpcJwkRotateService_Expecter {
+	return &MockGrpcJwkRotateService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcJwkRotateService
+func (_mock *MockGrpcJwkRotateService) Exec(ctx context.Context, request *core.JwkRotateRequest) (*core.JwkRotateResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.JwkRotateResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkRotateRequest) (*core.JwkRotateResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkRotateRequest) *core.JwkRotateResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.JwkRotateResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkRotateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcJwkRotateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcJwkRotateService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkRotateRequest
+func (_e *MockGrpcJwkRotateService_Expecter) Exec(ctx any, request any) *MockGrpcJwkRotateService_Exec_Call {
+	return &MockGrpcJwkRotateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcJwkRotateService_Exec_Call) Run(run func(ctx context.Context, request *core.JwkRotateRequest)) *MockGrpcJwkRotateService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkRotateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkRotateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcJwkRotateService_Exec_Call) Return(jwkRotateResponse *core.JwkRotateResponse, err error) *MockGrpcJwkRotateService_Exec_Call {
+	_c.Call.Return(jwkRotateResponse, err)
+	return _c
+}
+
+func (_c *MockGrpcJwkRotateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkRotateRequest) (*core.JwkRotateResponse, error)) *MockGrpcJwkRotateService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcPayloadSignService creates a new instance of MockGrpcPayloadSignService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcPayloadSignService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcPayloadSignService {
+	mock := &MockGrpcPayloadSignService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcPayloadSignService is an autogenerated mock type for the GrpcPayloadSignService type
+type MockGrpcPayloadSignService struct {
+	mock.Mock
+}
+
+type MockGrpcPayloadSignService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcPayloadSignService) EXPECT() *MockGrpcPayloadSignService_Expecter {
+	return &MockGrpcPayloadSignService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcPayloadSignService
+func (_mock *MockGrpcPayloadSignService) Exec(ctx context.Context, request *core.PayloadSignRequest) (string, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.PayloadSignRequest) (string, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.PayloadSignRequest) string); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.PayloadSignRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcPayloadSignService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcPayloadSignService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.PayloadSignRequest
+func (_e *MockGrpcPayloadSignService_Expecter) Exec(ctx any, request any) *MockGrpcPayloadSignService_Exec_Call {
+	return &MockGrpcPayloadSignService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcPayloadSignService_Exec_Call) Run(run func(ctx context.Context, request *core.PayloadSignRequest)) *MockGrpcPayloadSignService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.PayloadSignRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.PayloadSignRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcPayloadSignService_Exec_Call) Return(s string, err error) *MockGrpcPayloadSignService_Exec_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockGrpcPayloadSignService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.PayloadSignRequest) (string, error)) *MockGrpcPayloadSignService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcRevokeTokenService creates a new instance of MockGrpcRevokeTokenService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcRevokeTokenService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcRevokeTokenService {
+	mock := &MockGrpcRevokeTokenService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcRevokeTokenService is an autogenerated mock type for the GrpcRevokeTokenService type
+type MockGrpcRevokeTokenService struct {
+	mock.Mock
+}
+
+type MockGrpcRevokeTokenService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcRevokeTokenService) EXPECT() *MockGrpcRevokeTokenService_Expecter {
+	return &MockGrpcRevokeTokenService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcRevokeTokenService
+func (_mock *MockGrpcRevokeTokenService) Exec(ctx context.Context, request *core.TokenRevokeRequest) (*core.RevokedToken, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.RevokedToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.TokenRevokeRequest) (*core.RevokedToken, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.TokenRevokeRequest) *core.RevokedToken); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.RevokedToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.TokenRevokeRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcRevokeTokenService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcRevokeTokenService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.TokenRevokeRequest
+func (_e *MockGrpcRevokeTokenService_Expecter) Exec(ctx any, request any) *MockGrpcRevokeTokenService_Exec_Call {
+	return &MockGrpcRevokeTokenService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcRevokeTokenService_Exec_Call) Run(run func(ctx context.Context, request *core.TokenRevokeRequest)) *MockGrpcRevokeTokenService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.TokenRevokeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.TokenRevokeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcRevokeTokenService_Exec_Call) Return(revokedToken *core.RevokedToken, err error) *MockGrpcRevokeTokenService_Exec_Call {
+	_c.Call.Return(revokedToken, err)
+	return _c
+}
+
+func (_c *MockGrpcRevokeTokenService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.TokenRevokeRequest) (*core.RevokedToken, error)) *MockGrpcRevokeTokenService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcRevokedTokenSyncService creates a new instance of MockGrpcRevokedTokenSyncService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcRevokedTokenSyncService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcRevokedTokenSyncService {
+	mock := &MockGrpcRevokedTokenSyncService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcRevokedTokenSyncService is an autogenerated mock type for the GrpcRevokedTokenSyncService type
+type MockGrpcRevokedTokenSyncService struct {
+	mock.Mock
+}
+
+type MockGrpcRevokedTokenSyncService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcRevokedTokenSyncService) EXPECT() *MockGrpcRevokedTokenSyncService_Expecter {
+	return &MockGrpcRevokedTokenSyncService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcRevokedTokenSyncService
+func (_mock *MockGrpcRevokedTokenSyncService) Exec(ctx context.Context, request *core.RevokedTokenSyncRequest) (*core.RevokedTokenSyncResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.RevokedTokenSyncResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.RevokedTokenSyncRequest) (*core.RevokedTokenSyncResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.RevokedTokenSyncRequest) *core.RevokedTokenSyncResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.RevokedTokenSyncResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.RevokedTokenSyncRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcRevokedTokenSyncService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcRevokedTokenSyncService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.RevokedTokenSyncRequest
+func (_e *MockGrpcRevokedTokenSyncService_Expecter) Exec(ctx any, request any) *MockGrpcRevokedTokenSyncService_Exec_Call {
+	return &MockGrpcRevokedTokenSyncService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcRevokedTokenSyncService_Exec_Call) Run(run func(ctx context.Context, request *core.RevokedTokenSyncRequest)) *MockGrpcRevokedTokenSyncService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.RevokedTokenSyncRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.RevokedTokenSyncRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcRevokedTokenSyncService_Exec_Call) Return(revokedTokenSyncResult *core.RevokedTokenSyncResult, err error) *MockGrpcRevokedTokenSyncService_Exec_Call {
+	_c.Call.Return(revokedTokenSyncResult, err)
+	return _c
+}
+
+func (_c *MockGrpcRevokedTokenSyncService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.RevokedTokenSyncRequest) (*core.RevokedTokenSyncResult, error)) *MockGrpcRevokedTokenSyncService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcSigningFreezeService creates a new instance of MockGrpcSigningFreezeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcSigningFreezeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcSigningFreezeService {
+	mock := &MockGrpcSigningFreezeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcSigningFreezeService is an autogenerated mock type for the GrpcSigningFreezeService type
+type MockGrpcSigningFreezeService struct {
+	mock.Mock
+}
+
+type MockGrpcSigningFreezeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcSigningFreezeService) EXPECT() *MockGrpcSigningFreezeService_Expecter {
+	return &MockGrpcSigningFreezeService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcSigningFreezeService
+func (_mock *MockGrpcSigningFreezeService) Exec(ctx context.Context, request *core.SigningFreezeSetRequest) (*core.SigningFreeze, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.SigningFreeze
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.SigningFreezeSetRequest) (*core.SigningFreeze, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.SigningFreezeSetRequest) *core.SigningFreeze); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.SigningFreeze)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.SigningFreezeSetRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcSigningFreezeService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcSigningFreezeService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.SigningFreezeSetRequest
+func (_e *MockGrpcSigningFreezeService_Expecter) Exec(ctx any, request any) *MockGrpcSigningFreezeService_Exec_Call {
+	return &MockGrpcSigningFreezeService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcSigningFreezeService_Exec_Call) Run(run func(ctx context.Context, request *core.SigningFreezeSetRequest)) *MockGrpcSigningFreezeService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.SigningFreezeSetRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.SigningFreezeSetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcSigningFreezeService_Exec_Call) Return(signingFreeze *core.SigningFreeze, err error) *MockGrpcSigningFreezeService_Exec_Call {
+	_c.Call.Return(signingFreeze, err)
+	return _c
+}
+
+func (_c *MockGrpcSigningFreezeService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.SigningFreezeSetRequest) (*core.SigningFreeze, error)) *MockGrpcSigningFreezeService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcStatusServiceAlgMigration creates a new instance of MockGrpcStatusServiceAlgMigration. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcStatusServiceAlgMigration(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcStatusServiceAlgMigration {
+	mock := &MockGrpcStatusServiceAlgMigration{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcStatusServiceAlgMigration is an autogenerated mock type for the GrpcStatusServiceAlgMigration type
+type MockGrpcStatusServiceAlgMigration struct {
+	mock.Mock
+}
+
+type MockGrpcStatusServiceAlgMigration_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcStatusServiceAlgMigration) EXPECT() *MockGrpcStatusServiceAlgMigration_Expecter {
+	return &MockGrpcStatusServiceAlgMigration_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcStatusServiceAlgMigration
+func (_mock *MockGrpcStatusServiceAlgMigration) Exec(ctx context.Context, request *core.JwkAlgMigrationRequest) (*core.JwkAlgMigrationStatus, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.JwkAlgMigrationStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkAlgMigrationRequest) (*core.JwkAlgMigrationStatus, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkAlgMigrationRequest) *core.JwkAlgMigrationStatus); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.JwkAlgMigrationStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkAlgMigrationRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcStatusServiceAlgMigration_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcStatusServiceAlgMigration_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkAlgMigrationRequest
+func (_e *MockGrpcStatusServiceAlgMigration_Expecter) Exec(ctx any, request any) *MockGrpcStatusServiceAlgMigration_Exec_Call {
+	return &MockGrpcStatusServiceAlgMigration_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcStatusServiceAlgMigration_Exec_Call) Run(run func(ctx context.Context, request *core.JwkAlgMigrationRequest)) *MockGrpcStatusServiceAlgMigration_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkAlgMigrationRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkAlgMigrationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcStatusServiceAlgMigration_Exec_Call) Return(jwkAlgMigrationStatus *core.JwkAlgMigrationStatus, err error) *MockGrpcStatusServiceAlgMigration_Exec_Call {
+	_c.Call.Return(jwkAlgMigrationStatus, err)
+	return _c
+}
+
+func (_c *MockGrpcStatusServiceAlgMigration_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkAlgMigrationRequest) (*core.JwkAlgMigrationStatus, error)) *MockGrpcStatusServiceAlgMigration_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcStatusServiceLifecycle creates a new instance of MockGrpcStatusServiceLifecycle. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcStatusServiceLifecycle(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcStatusServiceLifecycle {
+	mock := &MockGrpcStatusServiceLifecycle{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcStatusServiceLifecycle is an autogenerated mock type for the GrpcStatusServiceLifecycle type
+type MockGrpcStatusServiceLifecycle struct {
+	mock.Mock
+}
+
+type MockGrpcStatusServiceLifecycle_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcStatusServiceLifecycle) EXPECT() *MockGrpcStatusServiceLifecycle_Expecter {
+	return &MockGrpcStatusServiceLifecycle_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcStatusServiceLifecycle
+func (_mock *MockGrpcStatusServiceLifecycle) Exec(ctx context.Context, request *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.JwkLifecycleStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkLifecycleRequest) *core.JwkLifecycleStatus); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.JwkLifecycleStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkLifecycleRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcStatusServiceLifecycle_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcStatusServiceLifecycle_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkLifecycleRequest
+func (_e *MockGrpcStatusServiceLifecycle_Expecter) Exec(ctx any, request any) *MockGrpcStatusServiceLifecycle_Exec_Call {
+	return &MockGrpcStatusServiceLifecycle_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcStatusServiceLifecycle_Exec_Call) Run(run func(ctx context.Context, request *core.JwkLifecycleRequest)) *MockGrpcStatusServiceLifecycle_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkLifecycleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkLifecycleRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcStatusServiceLifecycle_Exec_Call) Return(jwkLifecycleStatus *core.JwkLifecycleStatus, err error) *MockGrpcStatusServiceLifecycle_Exec_Call {
+	_c.Call.Return(jwkLifecycleStatus, err)
+	return _c
+}
+
+func (_c *MockGrpcStatusServiceLifecycle_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error)) *MockGrpcStatusServiceLifecycle_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcStatusServiceRotationSchedule creates a new instance of MockGrpcStatusServiceRotationSchedule. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcStatusServiceRotationSchedule(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcStatusServiceRotationSchedule {
+	mock := &MockGrpcStatusServiceRotationSchedule{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcStatusServiceRotationSchedule is an autogenerated mock type for the GrpcStatusServiceRotationSchedule type
+type MockGrpcStatusServiceRotationSchedule struct {
+	mock.Mock
+}
+
+type MockGrpcStatusServiceRotationSchedule_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcStatusServiceRotationSchedule) EXPECT() *MockGrpcStatusServiceRotationSchedule_Expecter {
+	return &MockGrpcStatusServiceRotationSchedule_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcStatusServiceRotationSchedule
+func (_mock *MockGrpcStatusServiceRotationSchedule) Exec(ctx context.Context, request *core.JwkRotationScheduleRequest) (*core.JwkRotationScheduleStatus, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.JwkRotationScheduleStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkRotationScheduleRequest) (*core.JwkRotationScheduleStatus, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkRotationScheduleRequest) *core.JwkRotationScheduleStatus); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.JwkRotationScheduleStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkRotationScheduleRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcStatusServiceRotationSchedule_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcStatusServiceRotationSchedule_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkRotationScheduleRequest
+func (_e *MockGrpcStatusServiceRotationSchedule_Expecter) Exec(ctx any, request any) *MockGrpcStatusServiceRotationSchedule_Exec_Call {
+	return &MockGrpcStatusServiceRotationSchedule_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcStatusServiceRotationSchedule_Exec_Call) Run(run func(ctx context.Context, request *core.JwkRotationScheduleRequest)) *MockGrpcStatusServiceRotationSchedule_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkRotationScheduleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkRotationScheduleRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcStatusServiceRotationSchedule_Exec_Call) Return(jwkRotationScheduleStatus *core.JwkRotationScheduleStatus, err error) *MockGrpcStatusServiceRotationSchedule_Exec_Call {
+	_c.Call.Return(jwkRotationScheduleStatus, err)
+	return _c
+}
+
+func (_c *MockGrpcStatusServiceRotationSchedule_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkRotationScheduleRequest) (*core.JwkRotationScheduleStatus, error)) *MockGrpcStatusServiceRotationSchedule_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGrpcStatusServiceSigningFreezes creates a new instance of MockGrpcStatusServiceSigningFreezes. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGrpcStatusServiceSigningFreezes(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGrpcStatusServiceSigningFreezes {
+	mock := &MockGrpcStatusServiceSigningFreezes{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGrpcStatusServiceSigningFreezes is an autogenerated mock type for the GrpcStatusServiceSigningFreezes type
+type MockGrpcStatusServiceSigningFreezes struct {
+	mock.Mock
+}
+
+type MockGrpcStatusServiceSigningFreezes_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGrpcStatusServiceSigningFreezes) EXPECT() *MockGrpcStatusServiceSigningFreezes_Expecter {
+	return &MockGrpcStatusServiceSigningFreezes_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGrpcStatusServiceSigningFreezes
+func (_mock *MockGrpcStatusServiceSigningFreezes) Exec(ctx context.Context, request *core.SigningFreezeListRequest) ([]*core.SigningFreeze, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*core.SigningFreeze
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.SigningFreezeListRequest) ([]*core.SigningFreeze, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.SigningFreezeListRequest) []*core.SigningFreeze); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*core.SigningFreeze)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.SigningFreezeListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGrpcStatusServiceSigningFreezes_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGrpcStatusServiceSigningFreezes_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.SigningFreezeListRequest
+func (_e *MockGrpcStatusServiceSigningFreezes_Expecter) Exec(ctx any, request any) *MockGrpcStatusServiceSigningFreezes_Exec_Call {
+	return &MockGrpcStatusServiceSigningFreezes_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGrpcStatusServiceSigningFreezes_Exec_Call) Run(run func(ctx context.Context, request *core.SigningFreezeListRequest)) *MockGrpcStatusServiceSigningFreezes_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.SigningFreezeListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.SigningFreezeListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGrpcStatusServiceSigningFreezes_Exec_Call) Return(signingFreezes []*core.SigningFreeze, err error) *MockGrpcStatusServiceSigningFreezes_Exec_Call {
+	_c.Call.Return(signingFreezes, err)
+	return _c
+}
+
+func (_c *MockGrpcStatusServiceSigningFreezes_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.SigningFreezeListRequest) ([]*core.SigningFreeze, error)) *MockGrpcStatusServiceSigningFreezes_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMetricsServiceLifecycle creates a new instance of MockMetricsServiceLifecycle. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMetricsServiceLifecycle(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMetricsServiceLifecycle {
+	mock := &MockMetricsServiceLifecycle{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMetricsServiceLifecycle is an autogenerated mock type for the MetricsServiceLifecycle type
+type MockMetricsServiceLifecycle struct {
+	mock.Mock
+}
+
+type MockMetricsServiceLifecycle_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMetricsServiceLifecycle) EXPECT() *MockMetricsServiceLifecycle_Expecter {
+	return &MockMetricsServiceLifecycle_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockMetricsServiceLifecycle
+func (_mock *MockMetricsServiceLifecycle) Exec(ctx context.Context, request *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.JwkLifecycleStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkLifecycleRequest) *core.JwkLifecycleStatus); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.JwkLifecycleStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkLifecycleRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMetricsServiceLifecycle_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockMetricsServiceLifecycle_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkLifecycleRequest
+func (_e *MockMetricsServiceLifecycle_Expecter) Exec(ctx any, request any) *MockMetricsServiceLifecycle_Exec_Call {
+	return &MockMetricsServiceLifecycle_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockMetricsServiceLifecycle_Exec_Call) Run(run func(ctx context.Context, request *core.JwkLifecycleRequest)) *MockMetricsServiceLifecycle_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkLifecycleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkLifecycleRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMetricsServiceLifecycle_Exec_Call) Return(jwkLifecycleStatus *core.JwkLifecycleStatus, err error) *MockMetricsServiceLifecycle_Exec_Call {
+	_c.Call.Return(jwkLifecycleStatus, err)
+	return _c
+}
+
+func (_c *MockMetricsServiceLifecycle_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error)) *MockMetricsServiceLifecycle_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestBearerAuthService creates a new instance of MockRestBearerAuthService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestBearerAuthService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestBearerAuthService {
+	mock := &MockRestBearerAuthService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRestBearerAuthService is an autogenerated mock type for the RestBearerAuthService type
+type MockRestBearerAuthService struct {
+	mock.Mock
+}
+
+type MockRestBearerAuthService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestBearerAuthService) EXPECT() *MockRestBearerAuthService_Expecter {
+	return &MockRestBearerAuthService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRestBearerAuthService
+func (_mock *MockRestBearerAuthService) Exec(ctx context.Context, request *core.ApiKeyAuthenticateRequest) (*core.ApiKey, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ApiKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ApiKeyAuthenticateRequest) (*core.ApiKey, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ApiKeyAuthenticateRequest) *core.ApiKey); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ApiKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ApiKeyAuthenticateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRestBearerAuthService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRestBearerAuthService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ApiKeyAuthenticateRequest
+func (_e *MockRestBearerAuthService_Expecter) Exec(ctx any, request any) *MockRestBearerAuthService_Exec_Call {
+	return &MockRestBearerAuthService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRestBearerAuthService_Exec_Call) Run(run func(ctx context.Context, request *core.ApiKeyAuthenticateRequest)) *MockRestBearerAuthService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ApiKeyAuthenticateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ApiKeyAuthenticateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestBearerAuthService_Exec_Call) Return(apiKey *core.ApiKey, err error) *MockRestBearerAuthService_Exec_Call {
+	_c.Call.Return(apiKey, err)
+	return _c
+}
+
+func (_c *MockRestBearerAuthService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ApiKeyAuthenticateRequest) (*core.ApiKey, error)) *MockRestBearerAuthService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestClaimsSignService creates a new instance of MockRestClaimsSignService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestClaimsSignService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestClaimsSignService {
+	mock := &MockRestClaimsSignService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRestClaimsSignService is an autogenerated mock type for the RestClaimsSignService type
+type MockRestClaimsSignService struct {
+	mock.Mock
+}
+
+type MockRestClaimsSignService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestClaimsSignService) EXPECT() *MockRestClaimsSignService_Expecter {
+	return &MockRestClaimsSignService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRestClaimsSignService
+func (_mock *MockRestClaimsSignService) Exec(ctx context.Context, request *core.ClaimsSignRequest) (string, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ClaimsSignRequest) (string, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ClaimsSignRequest) string); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ClaimsSignRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRestClaimsSignService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRestClaimsSignService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ClaimsSignRequest
+func (_e *MockRestClaimsSignService_Expecter) Exec(ctx any, request any) *MockRestClaimsSignService_Exec_Call {
+	return &MockRestClaimsSignService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRestClaimsSignService_Exec_Call) Run(run func(ctx context.Context, request *core.ClaimsSignRequest)) *MockRestClaimsSignService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ClaimsSignRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ClaimsSignRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestClaimsSignService_Exec_Call) Return(s string, err error) *MockRestClaimsSignService_Exec_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRestClaimsSignService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ClaimsSignRequest) (string, error)) *MockRestClaimsSignService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestHealthServiceLifecycle creates a new instance of MockRestHealthServiceLifecycle. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestHealthServiceLifecycle(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestHealthServiceLifecycle {
+	mock := &MockRestHealthServiceLifecycle{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRestHealthServiceLifecycle is an autogenerated mock type for the RestHealthServiceLifecycle type
+type MockRestHealthServiceLifecycle struct {
+	mock.Mock
+}
+
+type MockRestHealthServiceLifecycle_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestHealthServiceLifecycle) EXPECT() *MockRestHealthServiceLifecycle_Expecter {
+	return &MockRestHealthServiceLifecycle_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRestHealthServiceLifecycle
+func (_mock *MockRestHealthServiceLifecycle) Exec(ctx context.Context, request *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.JwkLifecycleStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.JwkLifecycleRequest) *core.JwkLifecycleStatus); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.JwkLifecycleStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.JwkLifecycleRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRestHealthServiceLifecycle_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRestHealthServiceLifecycle_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.JwkLifecycleRequest
+func (_e *MockRestHealthServiceLifecycle_Expecter) Exec(ctx any, request any) *MockRestHealthServiceLifecycle_Exec_Call {
+	return &MockRestHealthServiceLifecycle_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRestHealthServiceLifecycle_Exec_Call) Run(run func(ctx context.Context, request *core.JwkLifecycleRequest)) *MockRestHealthServiceLifecycle_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.JwkLifecycleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.JwkLifecycleRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestHealthServiceLifecycle_Exec_Call) Return(jwkLifecycleStatus *core.JwkLifecycleStatus, err error) *MockRestHealthServiceLifecycle_Exec_Call {
+	_c.Call.Return(jwkLifecycleStatus, err)
+	return _c
+}
+
+func (_c *MockRestHealthServiceLifecycle_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error)) *MockRestHealthServiceLifecycle_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestIntrospectService creates a new instance of MockRestIntrospectService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestIntrospectService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestIntrospectService {
+	mock := &MockRestIntrospectService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRestIntrospectService is an autogenerated mock type for the RestIntrospectService type
+type MockRestIntrospectService struct {
+	mock.Mock
+}
+
+type MockRestIntrospectService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestIntrospectService) EXPECT() *MockRestIntrospectService_Expecter {
+	return &MockRestIntrospectService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRestIntrospectService
+func (_mock *MockRestIntrospectService) Exec(ctx context.Context, request *core.ClaimsInspectRequest) (*core.ClaimsInspectResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ClaimsInspectResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ClaimsInspectRequest) (*core.ClaimsInspectResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ClaimsInspectRequest) *core.ClaimsInspectResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ClaimsInspectResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ClaimsInspectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRestIntrospectService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRestIntrospectService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ClaimsInspectRequest
+func (_e *MockRestIntrospectService_Expecter) Exec(ctx any, request any) *MockRestIntrospectService_Exec_Call {
+	return &MockRestIntrospectService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRestIntrospectService_Exec_Call) Run(run func(ctx context.Context, request *core.ClaimsInspectRequest)) *MockRestIntrospectService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ClaimsInspectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ClaimsInspectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestIntrospectService_Exec_Call) Return(claimsInspectResult *core.ClaimsInspectResult, err error) *MockRestIntrospectService_Exec_Call {
+	_c.Call.Return(claimsInspectResult, err)
+	return _c
+}
+
+func (_c *MockRestIntrospectService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ClaimsInspectRequest) (*core.ClaimsInspectResult, error)) *MockRestIntrospectService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
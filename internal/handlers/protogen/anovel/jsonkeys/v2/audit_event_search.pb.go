@@ -0,0 +1,359 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: anovel/jsonkeys/v2/audit_event_search.proto
+
+package jsonkeysv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuditEventSearchRequest filters the audit events to return.
+type AuditEventSearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only return the events of this key usage. All events are returned when empty, which callers
+	// authenticated by an API key may not ask for.
+	Usage string `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	// The start of the time range, inclusive. Searches from the oldest event when unset.
+	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// The end of the time range, exclusive. Defaults to now.
+	End *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// The maximum number of events to return. Capped to 1000, which is also the default.
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEventSearchRequest) Reset() {
+	*x = AuditEventSearchRequest{}
+	mi := &file_anovel_jsonkeys_v2_audit_event_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEventSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEventSearchRequest) ProtoMessage() {}
+
+func (x *AuditEventSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_audit_event_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEventSearchRequest.ProtoReflect.Descriptor instead.
+func (*AuditEventSearchRequest) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_audit_event_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditEventSearchRequest) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *AuditEventSearchRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *AuditEventSearchRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *AuditEventSearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// AuditEvent is an entry of the audit trail. Fields that do not apply to the action are empty.
+type AuditEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the event.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// When the operation completed.
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// The operation, such as "claims.sign" or "jwk.rotate".
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// Either "success" or "failure".
+	Outcome string `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	// Who asked for the operation: an API key ("api-key:<name>"), a client certificate identity,
+	// or a command.
+	Caller string `protobuf:"bytes,5,opt,name=caller,proto3" json:"caller,omitempty"`
+	// The key usage the operation applied to.
+	Usage string `protobuf:"bytes,6,opt,name=usage,proto3" json:"usage,omitempty"`
+	// The ID of the key used or produced by the operation.
+	Kid string `protobuf:"bytes,7,opt,name=kid,proto3" json:"kid,omitempty"`
+	// The "jti" claim of the token issued by the operation.
+	TokenId string `protobuf:"bytes,8,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// The hex-encoded SHA-256 digest of the token issued by the operation.
+	TokenHash string `protobuf:"bytes,9,opt,name=token_hash,json=tokenHash,proto3" json:"token_hash,omitempty"`
+	// Free-form context, such as the reason of a failure.
+	Detail        string `protobuf:"bytes,10,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_anovel_jsonkeys_v2_audit_event_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_audit_event_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_audit_event_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTokenHash() string {
+	if x != nil {
+		return x.TokenHash
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// AuditEventSearchResponse contains the matching audit events.
+type AuditEventSearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The matching events, newest first.
+	Events        []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEventSearchResponse) Reset() {
+	*x = AuditEventSearchResponse{}
+	mi := &file_anovel_jsonkeys_v2_audit_event_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEventSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEventSearchResponse) ProtoMessage() {}
+
+func (x *AuditEventSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_audit_event_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEventSearchResponse.ProtoReflect.Descriptor instead.
+func (*AuditEventSearchResponse) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_audit_event_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuditEventSearchResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_anovel_jsonkeys_v2_audit_event_search_proto protoreflect.FileDescriptor
+
+const file_anovel_jsonkeys_v2_audit_event_search_proto_rawDesc = "" +
+	"\n" +
+	"+anovel/jsonkeys/v2/audit_event_search.proto\x12\x12anovel.jsonkeys.v2\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa5\x01\n" +
+	"\x17AuditEventSearchRequest\x12\x14\n" +
+	"\x05usage\x18\x01 \x01(\tR\x05usage\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x9d\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x18\n" +
+	"\aoutcome\x18\x04 \x01(\tR\aoutcome\x12\x16\n" +
+	"\x06caller\x18\x05 \x01(\tR\x06caller\x12\x14\n" +
+	"\x05usage\x18\x06 \x01(\tR\x05usage\x12\x10\n" +
+	"\x03kid\x18\a \x01(\tR\x03kid\x12\x19\n" +
+	"\btoken_id\x18\b \x01(\tR\atokenId\x12\x1d\n" +
+	"\n" +
+	"token_hash\x18\t \x01(\tR\ttokenHash\x12\x16\n" +
+	"\x06detail\x18\n" +
+	" \x01(\tR\x06detail\"R\n" +
+	"\x18AuditEventSearchResponse\x126\n" +
+	"\x06events\x18\x01 \x03(\v2\x1e.anovel.jsonkeys.v2.AuditEventR\x06events2\x88\x01\n" +
+	"\x17AuditEventSearchService\x12m\n" +
+	"\x10AuditEventSearch\x12+.anovel.jsonkeys.v2.AuditEventSearchRequest\x1a,.anovel.jsonkeys.v2.AuditEventSearchResponseB\xfb\x01\n" +
+	"\x16com.anovel.jsonkeys.v2B\x15AuditEventSearchProtoP\x01Z`github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2;jsonkeysv2\xa2\x02\x03AJX\xaa\x02\x12Anovel.Jsonkeys.V2\xca\x02\x12Anovel\\Jsonkeys\\V2\xe2\x02\x1eAnovel\\Jsonkeys\\V2\\GPBMetadata\xea\x02\x14Anovel::Jsonkeys::V2b\x06proto3"
+
+var (
+	file_anovel_jsonkeys_v2_audit_event_search_proto_rawDescOnce sync.Once
+	file_anovel_jsonkeys_v2_audit_event_search_proto_rawDescData []byte
+)
+
+func file_anovel_jsonkeys_v2_audit_event_search_proto_rawDescGZIP() []byte {
+	file_anovel_jsonkeys_v2_audit_event_search_proto_rawDescOnce.Do(func() {
+		file_anovel_jsonkeys_v2_audit_event_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_audit_event_search_proto_rawDesc), len(file_anovel_jsonkeys_v2_audit_event_search_proto_rawDesc)))
+	})
+	return file_anovel_jsonkeys_v2_audit_event_search_proto_rawDescData
+}
+
+var file_anovel_jsonkeys_v2_audit_event_search_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_anovel_jsonkeys_v2_audit_event_search_proto_goTypes = []any{
+	(*AuditEventSearchRequest)(nil),  // 0: anovel.jsonkeys.v2.AuditEventSearchRequest
+	(*AuditEvent)(nil),               // 1: anovel.jsonkeys.v2.AuditEvent
+	(*AuditEventSearchResponse)(nil), // 2: anovel.jsonkeys.v2.AuditEventSearchResponse
+	(*timestamppb.Timestamp)(nil),    // 3: google.protobuf.Timestamp
+}
+var file_anovel_jsonkeys_v2_audit_event_search_proto_depIdxs = []int32{
+	3, // 0: anovel.jsonkeys.v2.AuditEventSearchRequest.start:type_name -> google.protobuf.Timestamp
+	3, // 1: anovel.jsonkeys.v2.AuditEventSearchRequest.end:type_name -> google.protobuf.Timestamp
+	3, // 2: anovel.jsonkeys.v2.AuditEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	1, // 3: anovel.jsonkeys.v2.AuditEventSearchResponse.events:type_name -> anovel.jsonkeys.v2.AuditEvent
+	0, // 4: anovel.jsonkeys.v2.AuditEventSearchService.AuditEventSearch:input_type -> anovel.jsonkeys.v2.AuditEventSearchRequest
+	2, // 5: anovel.jsonkeys.v2.AuditEventSearchService.AuditEventSearch:output_type -> anovel.jsonkeys.v2.AuditEventSearchResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_anovel_jsonkeys_v2_audit_event_search_proto_init() }
+func file_anovel_jsonkeys_v2_audit_event_search_proto_init() {
+	if File_anovel_jsonkeys_v2_audit_event_search_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_audit_event_search_proto_rawDesc), len(file_anovel_jsonkeys_v2_audit_event_search_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_anovel_jsonkeys_v2_audit_event_search_proto_goTypes,
+		DependencyIndexes: file_anovel_jsonkeys_v2_audit_event_search_proto_depIdxs,
+		MessageInfos:      file_anovel_jsonkeys_v2_audit_event_search_proto_msgTypes,
+	}.Build()
+	File_anovel_jsonkeys_v2_audit_event_search_proto = out.File
+	file_anovel_jsonkeys_v2_audit_event_search_proto_goTypes = nil
+	file_anovel_jsonkeys_v2_audit_event_search_proto_depIdxs = nil
+}
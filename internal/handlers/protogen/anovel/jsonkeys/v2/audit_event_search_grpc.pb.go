@@ -0,0 +1,130 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: anovel/jsonkeys/v2/audit_event_search.proto
+
+package jsonkeysv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AuditEventSearchService_AuditEventSearch_FullMethodName = "/anovel.jsonkeys.v2.AuditEventSearchService/AuditEventSearch"
+)
+
+// AuditEventSearchServiceClient is the client API for AuditEventSearchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AuditEventSearchService reads the audit trail of signing and key-management operations.
+type AuditEventSearchServiceClient interface {
+	// Returns the audit events of a time range, newest first. Requires an API key with the admin
+	// operation on the usage, or an admin client certificate.
+	AuditEventSearch(ctx context.Context, in *AuditEventSearchRequest, opts ...grpc.CallOption) (*AuditEventSearchResponse, error)
+}
+
+type auditEventSearchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditEventSearchServiceClient(cc grpc.ClientConnInterface) AuditEventSearchServiceClient {
+	return &auditEventSearchServiceClient{cc}
+}
+
+func (c *auditEventSearchServiceClient) AuditEventSearch(ctx context.Context, in *AuditEventSearchRequest, opts ...grpc.CallOption) (*AuditEventSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditEventSearchResponse)
+	err := c.cc.Invoke(ctx, AuditEventSearchService_AuditEventSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditEventSearchServiceServer is the server API for AuditEventSearchService service.
+// All implementations must embed UnimplementedAuditEventSearchServiceServer
+// for forward compatibility.
+//
+// AuditEventSearchService reads the audit trail of signing and key-management operations.
+type AuditEventSearchServiceServer interface {
+	// Returns the audit events of a time range, newest first. Requires an API key with the admin
+	// operation on the usage, or an admin client certificate.
+	AuditEventSearch(context.Context, *AuditEventSearchRequest) (*AuditEventSearchResponse, error)
+	mustEmbedUnimplementedAuditEventSearchServiceServer()
+}
+
+// UnimplementedAuditEventSearchServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditEventSearchServiceServer struct{}
+
+func (UnimplementedAuditEventSearchServiceServer) AuditEventSearch(context.Context, *AuditEventSearchRequest) (*AuditEventSearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AuditEventSearch not implemented")
+}
+func (UnimplementedAuditEventSearchServiceServer) mustEmbedUnimplementedAuditEventSearchServiceServer() {
+}
+func (UnimplementedAuditEventSearchServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAuditEventSearchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditEventSearchServiceServer will
+// result in compilation errors.
+type UnsafeAuditEventSearchServiceServer interface {
+	mustEmbedUnimplementedAuditEventSearchServiceServer()
+}
+
+func RegisterAuditEventSearchServiceServer(s grpc.ServiceRegistrar, srv AuditEventSearchServiceServer) {
+	// If the following call panics, it indicates UnimplementedAuditEventSearchServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuditEventSearchService_ServiceDesc, srv)
+}
+
+func _AuditEventSearchService_AuditEventSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditEventSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditEventSearchServiceServer).AuditEventSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditEventSearchService_AuditEventSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditEventSearchServiceServer).AuditEventSearch(ctx, req.(*AuditEventSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditEventSearchService_ServiceDesc is the grpc.ServiceDesc for AuditEventSearchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditEventSearchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "anovel.jsonkeys.v2.AuditEventSearchService",
+	HandlerType: (*AuditEventSearchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AuditEventSearch",
+			Handler:    _AuditEventSearchService_AuditEventSearch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anovel/jsonkeys/v2/audit_event_search.proto",
+}
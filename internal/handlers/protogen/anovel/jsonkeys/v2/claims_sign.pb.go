@@ -36,9 +36,12 @@ type ClaimsSignRequest struct {
 	// server stamps from the usage config, and naming one here is rejected rather than
 	// applied. Verification checks the server's values, so a token carrying the caller's
 	// would not verify.
-	Payload       *anypb.Any `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Payload *anypb.Any `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Requests a JWS JSON general serialization (RFC 7515, section 7.2.1) signed by the usage's
+	// key and by the current key of each of its configured co-signers, instead of a compact JWT.
+	MultiSignature bool `protobuf:"varint,3,opt,name=multi_signature,json=multiSignature,proto3" json:"multi_signature,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ClaimsSignRequest) Reset() {
@@ -85,10 +88,18 @@ func (x *ClaimsSignRequest) GetPayload() *anypb.Any {
 	return nil
 }
 
-// ClaimsSignResponse carries the signed compact JWT.
+func (x *ClaimsSignRequest) GetMultiSignature() bool {
+	if x != nil {
+		return x.MultiSignature
+	}
+	return false
+}
+
+// ClaimsSignResponse carries the signed token.
 type ClaimsSignResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The signed compact JWT (base64url header.payload.signature).
+	// The signed compact JWT (base64url header.payload.signature), or its JWS JSON general
+	// serialization when multi_signature was requested.
 	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -135,10 +146,11 @@ var File_anovel_jsonkeys_v2_claims_sign_proto protoreflect.FileDescriptor
 
 const file_anovel_jsonkeys_v2_claims_sign_proto_rawDesc = "" +
 	"\n" +
-	"$anovel/jsonkeys/v2/claims_sign.proto\x12\x12anovel.jsonkeys.v2\x1a\x19google/protobuf/any.proto\"Y\n" +
+	"$anovel/jsonkeys/v2/claims_sign.proto\x12\x12anovel.jsonkeys.v2\x1a\x19google/protobuf/any.proto\"\x82\x01\n" +
 	"\x11ClaimsSignRequest\x12\x14\n" +
 	"\x05usage\x18\x01 \x01(\tR\x05usage\x12.\n" +
-	"\apayload\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\apayload\"*\n" +
+	"\apayload\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\apayload\x12'\n" +
+	"\x0fmulti_signature\x18\x03 \x01(\bR\x0emultiSignature\"*\n" +
 	"\x12ClaimsSignResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token2p\n" +
 	"\x11ClaimsSignService\x12[\n" +
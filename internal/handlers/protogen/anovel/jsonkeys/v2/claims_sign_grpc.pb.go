@@ -29,10 +29,11 @@ const (
 // ClaimsSignService remotely generates a signed token from claims. The parameters used to
 // sign a token depend on the intended usage.
 type ClaimsSignServiceClient interface {
-	// Signs the provided claims and returns a compact JWT. The signing key and all token
-	// parameters are determined by the requested usage. Returns UNAVAILABLE if the usage is
-	// not configured on the server, and INVALID_ARGUMENT if the payload names a registered
-	// claim.
+	// Signs the provided claims and returns a compact JWT, or a multi-signature JWS JSON
+	// serialization when requested. The signing key and all token parameters are determined by
+	// the requested usage. Returns UNAVAILABLE if the usage is
+	// not configured on the server, INVALID_ARGUMENT if the payload names a registered claim, and
+	// FAILED_PRECONDITION while the signing of the usage, or of a co-signer, is frozen.
 	ClaimsSign(ctx context.Context, in *ClaimsSignRequest, opts ...grpc.CallOption) (*ClaimsSignResponse, error)
 }
 
@@ -61,10 +62,11 @@ func (c *claimsSignServiceClient) ClaimsSign(ctx context.Context, in *ClaimsSign
 // ClaimsSignService remotely generates a signed token from claims. The parameters used to
 // sign a token depend on the intended usage.
 type ClaimsSignServiceServer interface {
-	// Signs the provided claims and returns a compact JWT. The signing key and all token
-	// parameters are determined by the requested usage. Returns UNAVAILABLE if the usage is
-	// not configured on the server, and INVALID_ARGUMENT if the payload names a registered
-	// claim.
+	// Signs the provided claims and returns a compact JWT, or a multi-signature JWS JSON
+	// serialization when requested. The signing key and all token parameters are determined by
+	// the requested usage. Returns UNAVAILABLE if the usage is
+	// not configured on the server, INVALID_ARGUMENT if the payload names a registered claim, and
+	// FAILED_PRECONDITION while the signing of the usage, or of a co-signer, is frozen.
 	ClaimsSign(context.Context, *ClaimsSignRequest) (*ClaimsSignResponse, error)
 	mustEmbedUnimplementedClaimsSignServiceServer()
 }
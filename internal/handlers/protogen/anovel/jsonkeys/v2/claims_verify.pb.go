@@ -0,0 +1,423 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: anovel/jsonkeys/v2/claims_verify.proto
+
+package jsonkeysv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TokenCheck names a check of the verification of a token. Checks are listed in the order they
+// run.
+type TokenCheck int32
+
+const (
+	// TOKEN_CHECK_UNSPECIFIED is never reported.
+	TokenCheck_TOKEN_CHECK_UNSPECIFIED TokenCheck = 0
+	// TOKEN_CHECK_FORMAT fails for tokens that are not a compact JWS or a JWS JSON serialization,
+	// or whose header or payload is not JSON.
+	TokenCheck_TOKEN_CHECK_FORMAT TokenCheck = 1
+	// TOKEN_CHECK_ALGORITHM fails when the header algorithm is not accepted by the usage.
+	TokenCheck_TOKEN_CHECK_ALGORITHM TokenCheck = 2
+	// TOKEN_CHECK_KEY fails when the header key ID names no active key of the usage: the key
+	// expired, was revoked, is unknown, or belongs to a co-signer, whose signature alone does not
+	// vouch for the usage's tokens.
+	TokenCheck_TOKEN_CHECK_KEY TokenCheck = 3
+	// TOKEN_CHECK_SIGNATURE fails when the signature does not verify.
+	TokenCheck_TOKEN_CHECK_SIGNATURE TokenCheck = 4
+	// TOKEN_CHECK_AUDIENCE fails when the "aud" claim does not contain the usage's audience.
+	TokenCheck_TOKEN_CHECK_AUDIENCE TokenCheck = 5
+	// TOKEN_CHECK_ISSUER fails when the "iss" claim is not the usage's issuer.
+	TokenCheck_TOKEN_CHECK_ISSUER TokenCheck = 6
+	// TOKEN_CHECK_SUBJECT fails when the "sub" claim is not the usage's subject.
+	TokenCheck_TOKEN_CHECK_SUBJECT TokenCheck = 7
+	// TOKEN_CHECK_EXPIRATION fails when the "exp" claim is missing or past.
+	TokenCheck_TOKEN_CHECK_EXPIRATION TokenCheck = 8
+	// TOKEN_CHECK_NOT_BEFORE fails when the "nbf" claim is in the future.
+	TokenCheck_TOKEN_CHECK_NOT_BEFORE TokenCheck = 9
+	// TOKEN_CHECK_REVOKED fails when the token was revoked, by its "jti" claim. See
+	// RevokeTokenService.
+	TokenCheck_TOKEN_CHECK_REVOKED TokenCheck = 10
+)
+
+// Enum value maps for TokenCheck.
+var (
+	TokenCheck_name = map[int32]string{
+		0:  "TOKEN_CHECK_UNSPECIFIED",
+		1:  "TOKEN_CHECK_FORMAT",
+		2:  "TOKEN_CHECK_ALGORITHM",
+		3:  "TOKEN_CHECK_KEY",
+		4:  "TOKEN_CHECK_SIGNATURE",
+		5:  "TOKEN_CHECK_AUDIENCE",
+		6:  "TOKEN_CHECK_ISSUER",
+		7:  "TOKEN_CHECK_SUBJECT",
+		8:  "TOKEN_CHECK_EXPIRATION",
+		9:  "TOKEN_CHECK_NOT_BEFORE",
+		10: "TOKEN_CHECK_REVOKED",
+	}
+	TokenCheck_value = map[string]int32{
+		"TOKEN_CHECK_UNSPECIFIED": 0,
+		"TOKEN_CHECK_FORMAT":      1,
+		"TOKEN_CHECK_ALGORITHM":   2,
+		"TOKEN_CHECK_KEY":         3,
+		"TOKEN_CHECK_SIGNATURE":   4,
+		"TOKEN_CHECK_AUDIENCE":    5,
+		"TOKEN_CHECK_ISSUER":      6,
+		"TOKEN_CHECK_SUBJECT":     7,
+		"TOKEN_CHECK_EXPIRATION":  8,
+		"TOKEN_CHECK_NOT_BEFORE":  9,
+		"TOKEN_CHECK_REVOKED":     10,
+	}
+)
+
+func (x TokenCheck) Enum() *TokenCheck {
+	p := new(TokenCheck)
+	*p = x
+	return p
+}
+
+func (x TokenCheck) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TokenCheck) Descriptor() protoreflect.EnumDescriptor {
+	return file_anovel_jsonkeys_v2_claims_verify_proto_enumTypes[0].Descriptor()
+}
+
+func (TokenCheck) Type() protoreflect.EnumType {
+	return &file_anovel_jsonkeys_v2_claims_verify_proto_enumTypes[0]
+}
+
+func (x TokenCheck) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TokenCheck.Descriptor instead.
+func (TokenCheck) EnumDescriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_claims_verify_proto_rawDescGZIP(), []int{0}
+}
+
+// TokenCheckFailure reports a failed check.
+type TokenCheckFailure struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The check that failed.
+	Check TokenCheck `protobuf:"varint,1,opt,name=check,proto3,enum=anovel.jsonkeys.v2.TokenCheck" json:"check,omitempty"`
+	// Explains the failure, for humans. Its wording may change; match on check instead.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenCheckFailure) Reset() {
+	*x = TokenCheckFailure{}
+	mi := &file_anovel_jsonkeys_v2_claims_verify_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenCheckFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenCheckFailure) ProtoMessage() {}
+
+func (x *TokenCheckFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_claims_verify_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenCheckFailure.ProtoReflect.Descriptor instead.
+func (*TokenCheckFailure) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_claims_verify_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TokenCheckFailure) GetCheck() TokenCheck {
+	if x != nil {
+		return x.Check
+	}
+	return TokenCheck_TOKEN_CHECK_UNSPECIFIED
+}
+
+func (x *TokenCheckFailure) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ClaimsVerifyRequest carries the token to verify and the usage it was signed for.
+type ClaimsVerifyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Intended usage of the token; must match the value used at signing time.
+	Usage string `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	// The token to verify: a compact JWT, or its JWS JSON serialization. A multi-signature token
+	// is valid as soon as one of its signatures verifies.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Skips the expiration and not-before checks. Useful for refresh flows.
+	IgnoreExpired bool `protobuf:"varint,3,opt,name=ignore_expired,json=ignoreExpired,proto3" json:"ignore_expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimsVerifyRequest) Reset() {
+	*x = ClaimsVerifyRequest{}
+	mi := &file_anovel_jsonkeys_v2_claims_verify_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimsVerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimsVerifyRequest) ProtoMessage() {}
+
+func (x *ClaimsVerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_claims_verify_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimsVerifyRequest.ProtoReflect.Descriptor instead.
+func (*ClaimsVerifyRequest) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_claims_verify_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClaimsVerifyRequest) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *ClaimsVerifyRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ClaimsVerifyRequest) GetIgnoreExpired() bool {
+	if x != nil {
+		return x.IgnoreExpired
+	}
+	return false
+}
+
+// ClaimsVerifyResponse reports the outcome of a verification.
+type ClaimsVerifyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// True when the token passed every check.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The claims of the token, as JSON in a google.protobuf.BytesValue, like the payload of
+	// ClaimsSignRequest. Only set when valid.
+	Claims *anypb.Any `protobuf:"bytes,2,opt,name=claims,proto3" json:"claims,omitempty"`
+	// The usage whose key verified the token. Only set when valid.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// The key ID named by the token header. Unset when the token could not be parsed.
+	Kid string `protobuf:"bytes,4,opt,name=kid,proto3" json:"kid,omitempty"`
+	// The first check that failed: why the token is not valid. Unset when valid.
+	Reason TokenCheck `protobuf:"varint,5,opt,name=reason,proto3,enum=anovel.jsonkeys.v2.TokenCheck" json:"reason,omitempty"`
+	// Every check that failed, in the order they run. A token can fail several at once, as the
+	// claims are checked even when the signature fails.
+	Failures      []*TokenCheckFailure `protobuf:"bytes,6,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimsVerifyResponse) Reset() {
+	*x = ClaimsVerifyResponse{}
+	mi := &file_anovel_jsonkeys_v2_claims_verify_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimsVerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimsVerifyResponse) ProtoMessage() {}
+
+func (x *ClaimsVerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_claims_verify_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimsVerifyResponse.ProtoReflect.Descriptor instead.
+func (*ClaimsVerifyResponse) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_claims_verify_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClaimsVerifyResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ClaimsVerifyResponse) GetClaims() *anypb.Any {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *ClaimsVerifyResponse) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *ClaimsVerifyResponse) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *ClaimsVerifyResponse) GetReason() TokenCheck {
+	if x != nil {
+		return x.Reason
+	}
+	return TokenCheck_TOKEN_CHECK_UNSPECIFIED
+}
+
+func (x *ClaimsVerifyResponse) GetFailures() []*TokenCheckFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+var File_anovel_jsonkeys_v2_claims_verify_proto protoreflect.FileDescriptor
+
+const file_anovel_jsonkeys_v2_claims_verify_proto_rawDesc = "" +
+	"\n" +
+	"&anovel/jsonkeys/v2/claims_verify.proto\x12\x12anovel.jsonkeys.v2\x1a\x19google/protobuf/any.proto\"c\n" +
+	"\x11TokenCheckFailure\x124\n" +
+	"\x05check\x18\x01 \x01(\x0e2\x1e.anovel.jsonkeys.v2.TokenCheckR\x05check\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"h\n" +
+	"\x13ClaimsVerifyRequest\x12\x14\n" +
+	"\x05usage\x18\x01 \x01(\tR\x05usage\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12%\n" +
+	"\x0eignore_expired\x18\x03 \x01(\bR\rignoreExpired\"\xff\x01\n" +
+	"\x14ClaimsVerifyResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12,\n" +
+	"\x06claims\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x06claims\x12\x16\n" +
+	"\x06signer\x18\x03 \x01(\tR\x06signer\x12\x10\n" +
+	"\x03kid\x18\x04 \x01(\tR\x03kid\x126\n" +
+	"\x06reason\x18\x05 \x01(\x0e2\x1e.anovel.jsonkeys.v2.TokenCheckR\x06reason\x12A\n" +
+	"\bfailures\x18\x06 \x03(\v2%.anovel.jsonkeys.v2.TokenCheckFailureR\bfailures*\xa8\x02\n" +
+	"\n" +
+	"TokenCheck\x12\x1b\n" +
+	"\x17TOKEN_CHECK_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12TOKEN_CHECK_FORMAT\x10\x01\x12\x19\n" +
+	"\x15TOKEN_CHECK_ALGORITHM\x10\x02\x12\x13\n" +
+	"\x0fTOKEN_CHECK_KEY\x10\x03\x12\x19\n" +
+	"\x15TOKEN_CHECK_SIGNATURE\x10\x04\x12\x18\n" +
+	"\x14TOKEN_CHECK_AUDIENCE\x10\x05\x12\x16\n" +
+	"\x12TOKEN_CHECK_ISSUER\x10\x06\x12\x17\n" +
+	"\x13TOKEN_CHECK_SUBJECT\x10\a\x12\x1a\n" +
+	"\x16TOKEN_CHECK_EXPIRATION\x10\b\x12\x1a\n" +
+	"\x16TOKEN_CHECK_NOT_BEFORE\x10\t\x12\x17\n" +
+	"\x13TOKEN_CHECK_REVOKED\x10\n" +
+	"2x\n" +
+	"\x13ClaimsVerifyService\x12a\n" +
+	"\fClaimsVerify\x12'.anovel.jsonkeys.v2.ClaimsVerifyRequest\x1a(.anovel.jsonkeys.v2.ClaimsVerifyResponseB\xf7\x01\n" +
+	"\x16com.anovel.jsonkeys.v2B\x11ClaimsVerifyProtoP\x01Z`github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2;jsonkeysv2\xa2\x02\x03AJX\xaa\x02\x12Anovel.Jsonkeys.V2\xca\x02\x12Anovel\\Jsonkeys\\V2\xe2\x02\x1eAnovel\\Jsonkeys\\V2\\GPBMetadata\xea\x02\x14Anovel::Jsonkeys::V2b\x06proto3"
+
+var (
+	file_anovel_jsonkeys_v2_claims_verify_proto_rawDescOnce sync.Once
+	file_anovel_jsonkeys_v2_claims_verify_proto_rawDescData []byte
+)
+
+func file_anovel_jsonkeys_v2_claims_verify_proto_rawDescGZIP() []byte {
+	file_anovel_jsonkeys_v2_claims_verify_proto_rawDescOnce.Do(func() {
+		file_anovel_jsonkeys_v2_claims_verify_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_claims_verify_proto_rawDesc), len(file_anovel_jsonkeys_v2_claims_verify_proto_rawDesc)))
+	})
+	return file_anovel_jsonkeys_v2_claims_verify_proto_rawDescData
+}
+
+var file_anovel_jsonkeys_v2_claims_verify_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_anovel_jsonkeys_v2_claims_verify_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_anovel_jsonkeys_v2_claims_verify_proto_goTypes = []any{
+	(TokenCheck)(0),              // 0: anovel.jsonkeys.v2.TokenCheck
+	(*TokenCheckFailure)(nil),    // 1: anovel.jsonkeys.v2.TokenCheckFailure
+	(*ClaimsVerifyRequest)(nil),  // 2: anovel.jsonkeys.v2.ClaimsVerifyRequest
+	(*ClaimsVerifyResponse)(nil), // 3: anovel.jsonkeys.v2.ClaimsVerifyResponse
+	(*anypb.Any)(nil),            // 4: google.protobuf.Any
+}
+var file_anovel_jsonkeys_v2_claims_verify_proto_depIdxs = []int32{
+	0, // 0: anovel.jsonkeys.v2.TokenCheckFailure.check:type_name -> anovel.jsonkeys.v2.TokenCheck
+	4, // 1: anovel.jsonkeys.v2.ClaimsVerifyResponse.claims:type_name -> google.protobuf.Any
+	0, // 2: anovel.jsonkeys.v2.ClaimsVerifyResponse.reason:type_name -> anovel.jsonkeys.v2.TokenCheck
+	1, // 3: anovel.jsonkeys.v2.ClaimsVerifyResponse.failures:type_name -> anovel.jsonkeys.v2.TokenCheckFailure
+	2, // 4: anovel.jsonkeys.v2.ClaimsVerifyService.ClaimsVerify:input_type -> anovel.jsonkeys.v2.ClaimsVerifyRequest
+	3, // 5: anovel.jsonkeys.v2.ClaimsVerifyService.ClaimsVerify:output_type -> anovel.jsonkeys.v2.ClaimsVerifyResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_anovel_jsonkeys_v2_claims_verify_proto_init() }
+func file_anovel_jsonkeys_v2_claims_verify_proto_init() {
+	if File_anovel_jsonkeys_v2_claims_verify_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_claims_verify_proto_rawDesc), len(file_anovel_jsonkeys_v2_claims_verify_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_anovel_jsonkeys_v2_claims_verify_proto_goTypes,
+		DependencyIndexes: file_anovel_jsonkeys_v2_claims_verify_proto_depIdxs,
+		EnumInfos:         file_anovel_jsonkeys_v2_claims_verify_proto_enumTypes,
+		MessageInfos:      file_anovel_jsonkeys_v2_claims_verify_proto_msgTypes,
+	}.Build()
+	File_anovel_jsonkeys_v2_claims_verify_proto = out.File
+	file_anovel_jsonkeys_v2_claims_verify_proto_goTypes = nil
+	file_anovel_jsonkeys_v2_claims_verify_proto_depIdxs = nil
+}
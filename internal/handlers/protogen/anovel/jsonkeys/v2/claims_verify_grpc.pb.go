@@ -0,0 +1,137 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: anovel/jsonkeys/v2/claims_verify.proto
+
+package jsonkeysv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ClaimsVerifyService_ClaimsVerify_FullMethodName = "/anovel.jsonkeys.v2.ClaimsVerifyService/ClaimsVerify"
+)
+
+// ClaimsVerifyServiceClient is the client API for ClaimsVerifyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ClaimsVerifyService verifies tokens on the server, for consumers that cannot verify them
+// locally with the Go client library.
+type ClaimsVerifyServiceClient interface {
+	// Verifies a token signed for the given usage, with the same checks as local verification:
+	// key ID, signature, issuer, audience, subject and expiration, within the usage's leeway, and
+	// revocation.
+	// A token that fails a check is not an error: the response reports why. Returns UNAVAILABLE if
+	// the usage is not configured on the server.
+	ClaimsVerify(ctx context.Context, in *ClaimsVerifyRequest, opts ...grpc.CallOption) (*ClaimsVerifyResponse, error)
+}
+
+type claimsVerifyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClaimsVerifyServiceClient(cc grpc.ClientConnInterface) ClaimsVerifyServiceClient {
+	return &claimsVerifyServiceClient{cc}
+}
+
+func (c *claimsVerifyServiceClient) ClaimsVerify(ctx context.Context, in *ClaimsVerifyRequest, opts ...grpc.CallOption) (*ClaimsVerifyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimsVerifyResponse)
+	err := c.cc.Invoke(ctx, ClaimsVerifyService_ClaimsVerify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClaimsVerifyServiceServer is the server API for ClaimsVerifyService service.
+// All implementations must embed UnimplementedClaimsVerifyServiceServer
+// for forward compatibility.
+//
+// ClaimsVerifyService verifies tokens on the server, for consumers that cannot verify them
+// locally with the Go client library.
+type ClaimsVerifyServiceServer interface {
+	// Verifies a token signed for the given usage, with the same checks as local verification:
+	// key ID, signature, issuer, audience, subject and expiration, within the usage's leeway, and
+	// revocation.
+	// A token that fails a check is not an error: the response reports why. Returns UNAVAILABLE if
+	// the usage is not configured on the server.
+	ClaimsVerify(context.Context, *ClaimsVerifyRequest) (*ClaimsVerifyResponse, error)
+	mustEmbedUnimplementedClaimsVerifyServiceServer()
+}
+
+// UnimplementedClaimsVerifyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedClaimsVerifyServiceServer struct{}
+
+func (UnimplementedClaimsVerifyServiceServer) ClaimsVerify(context.Context, *ClaimsVerifyRequest) (*ClaimsVerifyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClaimsVerify not implemented")
+}
+func (UnimplementedClaimsVerifyServiceServer) mustEmbedUnimplementedClaimsVerifyServiceServer() {}
+func (UnimplementedClaimsVerifyServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeClaimsVerifyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClaimsVerifyServiceServer will
+// result in compilation errors.
+type UnsafeClaimsVerifyServiceServer interface {
+	mustEmbedUnimplementedClaimsVerifyServiceServer()
+}
+
+func RegisterClaimsVerifyServiceServer(s grpc.ServiceRegistrar, srv ClaimsVerifyServiceServer) {
+	// If the following call panics, it indicates UnimplementedClaimsVerifyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ClaimsVerifyService_ServiceDesc, srv)
+}
+
+func _ClaimsVerifyService_ClaimsVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimsVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClaimsVerifyServiceServer).ClaimsVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClaimsVerifyService_ClaimsVerify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClaimsVerifyServiceServer).ClaimsVerify(ctx, req.(*ClaimsVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClaimsVerifyService_ServiceDesc is the grpc.ServiceDesc for ClaimsVerifyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClaimsVerifyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "anovel.jsonkeys.v2.ClaimsVerifyService",
+	HandlerType: (*ClaimsVerifyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClaimsVerify",
+			Handler:    _ClaimsVerifyService_ClaimsVerify_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anovel/jsonkeys/v2/claims_verify.proto",
+}
@@ -0,0 +1,309 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: anovel/jsonkeys/v2/http_signature_sign.proto
+
+package jsonkeysv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// HttpSignatureComponent is a covered component of an HTTP message, and its value.
+type HttpSignatureComponent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The component identifier: a derived component ("@method", "@target-uri", "@authority",
+	// "@scheme", "@request-target", "@path", "@query"), or a lowercase HTTP field name.
+	// Component parameters are not supported.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The component value, as it appears in the signature base. It must fit on a single line.
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HttpSignatureComponent) Reset() {
+	*x = HttpSignatureComponent{}
+	mi := &file_anovel_jsonkeys_v2_http_signature_sign_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HttpSignatureComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpSignatureComponent) ProtoMessage() {}
+
+func (x *HttpSignatureComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_http_signature_sign_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpSignatureComponent.ProtoReflect.Descriptor instead.
+func (*HttpSignatureComponent) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_http_signature_sign_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HttpSignatureComponent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HttpSignatureComponent) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// HttpSignatureSignRequest carries the covered components and the optional signature parameters.
+type HttpSignatureSignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Intended usage of the signature. Determines the signing key used to generate (and later
+	// verify) the signature.
+	Usage string `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	// The covered components, in signing order.
+	Components []*HttpSignatureComponent `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	// The "created" parameter. Left out when unset.
+	Created *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	// The "expires" parameter. Left out when unset.
+	Expires *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires,proto3" json:"expires,omitempty"`
+	// The "nonce" parameter. Left out when empty.
+	Nonce string `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// The "tag" parameter. Left out when empty.
+	Tag           string `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HttpSignatureSignRequest) Reset() {
+	*x = HttpSignatureSignRequest{}
+	mi := &file_anovel_jsonkeys_v2_http_signature_sign_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HttpSignatureSignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpSignatureSignRequest) ProtoMessage() {}
+
+func (x *HttpSignatureSignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_http_signature_sign_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpSignatureSignRequest.ProtoReflect.Descriptor instead.
+func (*HttpSignatureSignRequest) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_http_signature_sign_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HttpSignatureSignRequest) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *HttpSignatureSignRequest) GetComponents() []*HttpSignatureComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *HttpSignatureSignRequest) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *HttpSignatureSignRequest) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+func (x *HttpSignatureSignRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *HttpSignatureSignRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// HttpSignatureSignResponse carries the signature, and the parameters it covers.
+type HttpSignatureSignResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The serialized signature parameters, keyid and alg included: the value of the signature's
+	// member in the Signature-Input field.
+	SignatureParams string `protobuf:"bytes,1,opt,name=signature_params,json=signatureParams,proto3" json:"signature_params,omitempty"`
+	// The raw signature: the value of the signature's member in the Signature field, before
+	// byte-sequence encoding.
+	Signature     []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HttpSignatureSignResponse) Reset() {
+	*x = HttpSignatureSignResponse{}
+	mi := &file_anovel_jsonkeys_v2_http_signature_sign_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HttpSignatureSignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpSignatureSignResponse) ProtoMessage() {}
+
+func (x *HttpSignatureSignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_http_signature_sign_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpSignatureSignResponse.ProtoReflect.Descriptor instead.
+func (*HttpSignatureSignResponse) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_http_signature_sign_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HttpSignatureSignResponse) GetSignatureParams() string {
+	if x != nil {
+		return x.SignatureParams
+	}
+	return ""
+}
+
+func (x *HttpSignatureSignResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+var File_anovel_jsonkeys_v2_http_signature_sign_proto protoreflect.FileDescriptor
+
+const file_anovel_jsonkeys_v2_http_signature_sign_proto_rawDesc = "" +
+	"\n" +
+	",anovel/jsonkeys/v2/http_signature_sign.proto\x12\x12anovel.jsonkeys.v2\x1a\x1fgoogle/protobuf/timestamp.proto\"B\n" +
+	"\x16HttpSignatureComponent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\x90\x02\n" +
+	"\x18HttpSignatureSignRequest\x12\x14\n" +
+	"\x05usage\x18\x01 \x01(\tR\x05usage\x12J\n" +
+	"\n" +
+	"components\x18\x02 \x03(\v2*.anovel.jsonkeys.v2.HttpSignatureComponentR\n" +
+	"components\x124\n" +
+	"\acreated\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x124\n" +
+	"\aexpires\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aexpires\x12\x14\n" +
+	"\x05nonce\x18\x05 \x01(\tR\x05nonce\x12\x10\n" +
+	"\x03tag\x18\x06 \x01(\tR\x03tag\"d\n" +
+	"\x19HttpSignatureSignResponse\x12)\n" +
+	"\x10signature_params\x18\x01 \x01(\tR\x0fsignatureParams\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature2\x8c\x01\n" +
+	"\x18HttpSignatureSignService\x12p\n" +
+	"\x11HttpSignatureSign\x12,.anovel.jsonkeys.v2.HttpSignatureSignRequest\x1a-.anovel.jsonkeys.v2.HttpSignatureSignResponseB\xfc\x01\n" +
+	"\x16com.anovel.jsonkeys.v2B\x16HttpSignatureSignProtoP\x01Z`github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2;jsonkeysv2\xa2\x02\x03AJX\xaa\x02\x12Anovel.Jsonkeys.V2\xca\x02\x12Anovel\\Jsonkeys\\V2\xe2\x02\x1eAnovel\\Jsonkeys\\V2\\GPBMetadata\xea\x02\x14Anovel::Jsonkeys::V2b\x06proto3"
+
+var (
+	file_anovel_jsonkeys_v2_http_signature_sign_proto_rawDescOnce sync.Once
+	file_anovel_jsonkeys_v2_http_signature_sign_proto_rawDescData []byte
+)
+
+func file_anovel_jsonkeys_v2_http_signature_sign_proto_rawDescGZIP() []byte {
+	file_anovel_jsonkeys_v2_http_signature_sign_proto_rawDescOnce.Do(func() {
+		file_anovel_jsonkeys_v2_http_signature_sign_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_http_signature_sign_proto_rawDesc), len(file_anovel_jsonkeys_v2_http_signature_sign_proto_rawDesc)))
+	})
+	return file_anovel_jsonkeys_v2_http_signature_sign_proto_rawDescData
+}
+
+var file_anovel_jsonkeys_v2_http_signature_sign_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_anovel_jsonkeys_v2_http_signature_sign_proto_goTypes = []any{
+	(*HttpSignatureComponent)(nil),    // 0: anovel.jsonkeys.v2.HttpSignatureComponent
+	(*HttpSignatureSignRequest)(nil),  // 1: anovel.jsonkeys.v2.HttpSignatureSignRequest
+	(*HttpSignatureSignResponse)(nil), // 2: anovel.jsonkeys.v2.HttpSignatureSignResponse
+	(*timestamppb.Timestamp)(nil),     // 3: google.protobuf.Timestamp
+}
+var file_anovel_jsonkeys_v2_http_signature_sign_proto_depIdxs = []int32{
+	0, // 0: anovel.jsonkeys.v2.HttpSignatureSignRequest.components:type_name -> anovel.jsonkeys.v2.HttpSignatureComponent
+	3, // 1: anovel.jsonkeys.v2.HttpSignatureSignRequest.created:type_name -> google.protobuf.Timestamp
+	3, // 2: anovel.jsonkeys.v2.HttpSignatureSignRequest.expires:type_name -> google.protobuf.Timestamp
+	1, // 3: anovel.jsonkeys.v2.HttpSignatureSignService.HttpSignatureSign:input_type -> anovel.jsonkeys.v2.HttpSignatureSignRequest
+	2, // 4: anovel.jsonkeys.v2.HttpSignatureSignService.HttpSignatureSign:output_type -> anovel.jsonkeys.v2.HttpSignatureSignResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_anovel_jsonkeys_v2_http_signature_sign_proto_init() }
+func file_anovel_jsonkeys_v2_http_signature_sign_proto_init() {
+	if File_anovel_jsonkeys_v2_http_signature_sign_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_http_signature_sign_proto_rawDesc), len(file_anovel_jsonkeys_v2_http_signature_sign_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_anovel_jsonkeys_v2_http_signature_sign_proto_goTypes,
+		DependencyIndexes: file_anovel_jsonkeys_v2_http_signature_sign_proto_depIdxs,
+		MessageInfos:      file_anovel_jsonkeys_v2_http_signature_sign_proto_msgTypes,
+	}.Build()
+	File_anovel_jsonkeys_v2_http_signature_sign_proto = out.File
+	file_anovel_jsonkeys_v2_http_signature_sign_proto_goTypes = nil
+	file_anovel_jsonkeys_v2_http_signature_sign_proto_depIdxs = nil
+}
@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: anovel/jsonkeys/v2/http_signature_sign.proto
+
+package jsonkeysv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	HttpSignatureSignService_HttpSignatureSign_FullMethodName = "/anovel.jsonkeys.v2.HttpSignatureSignService/HttpSignatureSign"
+)
+
+// HttpSignatureSignServiceClient is the client API for HttpSignatureSignService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// HttpSignatureSignService remotely signs HTTP messages, following RFC 9421 (HTTP Message
+// Signatures). The caller extracts the covered components from the message; the server builds
+// the signature base and signs it with the current key of the requested usage.
+type HttpSignatureSignServiceClient interface {
+	// Signs the signature base made of the provided components and parameters. Returns
+	// UNAVAILABLE if the usage is not configured on the server, INVALID_ARGUMENT if a component
+	// cannot be covered, and FAILED_PRECONDITION while the signing of the usage is frozen.
+	HttpSignatureSign(ctx context.Context, in *HttpSignatureSignRequest, opts ...grpc.CallOption) (*HttpSignatureSignResponse, error)
+}
+
+type httpSignatureSignServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHttpSignatureSignServiceClient(cc grpc.ClientConnInterface) HttpSignatureSignServiceClient {
+	return &httpSignatureSignServiceClient{cc}
+}
+
+func (c *httpSignatureSignServiceClient) HttpSignatureSign(ctx context.Context, in *HttpSignatureSignRequest, opts ...grpc.CallOption) (*HttpSignatureSignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HttpSignatureSignResponse)
+	err := c.cc.Invoke(ctx, HttpSignatureSignService_HttpSignatureSign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HttpSignatureSignServiceServer is the server API for HttpSignatureSignService service.
+// All implementations must embed UnimplementedHttpSignatureSignServiceServer
+// for forward compatibility.
+//
+// HttpSignatureSignService remotely signs HTTP messages, following RFC 9421 (HTTP Message
+// Signatures). The caller extracts the covered components from the message; the server builds
+// the signature base and signs it with the current key of the requested usage.
+type HttpSignatureSignServiceServer interface {
+	// Signs the signature base made of the provided components and parameters. Returns
+	// UNAVAILABLE if the usage is not configured on the server, INVALID_ARGUMENT if a component
+	// cannot be covered, and FAILED_PRECONDITION while the signing of the usage is frozen.
+	HttpSignatureSign(context.Context, *HttpSignatureSignRequest) (*HttpSignatureSignResponse, error)
+	mustEmbedUnimplementedHttpSignatureSignServiceServer()
+}
+
+// UnimplementedHttpSignatureSignServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHttpSignatureSignServiceServer struct{}
+
+func (UnimplementedHttpSignatureSignServiceServer) HttpSignatureSign(context.Context, *HttpSignatureSignRequest) (*HttpSignatureSignResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method HttpSignatureSign not implemented")
+}
+func (UnimplementedHttpSignatureSignServiceServer) mustEmbedUnimplementedHttpSignatureSignServiceServer() {
+}
+func (UnimplementedHttpSignatureSignServiceServer) testEmbeddedByValue() {}
+
+// UnsafeHttpSignatureSignServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HttpSignatureSignServiceServer will
+// result in compilation errors.
+type UnsafeHttpSignatureSignServiceServer interface {
+	mustEmbedUnimplementedHttpSignatureSignServiceServer()
+}
+
+func RegisterHttpSignatureSignServiceServer(s grpc.ServiceRegistrar, srv HttpSignatureSignServiceServer) {
+	// If the following call panics, it indicates UnimplementedHttpSignatureSignServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HttpSignatureSignService_ServiceDesc, srv)
+}
+
+func _HttpSignatureSignService_HttpSignatureSign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HttpSignatureSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HttpSignatureSignServiceServer).HttpSignatureSign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HttpSignatureSignService_HttpSignatureSign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HttpSignatureSignServiceServer).HttpSignatureSign(ctx, req.(*HttpSignatureSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HttpSignatureSignService_ServiceDesc is the grpc.ServiceDesc for HttpSignatureSignService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HttpSignatureSignService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "anovel.jsonkeys.v2.HttpSignatureSignService",
+	HandlerType: (*HttpSignatureSignServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HttpSignatureSign",
+			Handler:    _HttpSignatureSignService_HttpSignatureSign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anovel/jsonkeys/v2/http_signature_sign.proto",
+}
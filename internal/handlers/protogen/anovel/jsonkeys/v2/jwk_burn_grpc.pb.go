@@ -0,0 +1,135 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: anovel/jsonkeys/v2/jwk_burn.proto
+
+package jsonkeysv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	JwkBurnService_JwkBurn_FullMethodName = "/anovel.jsonkeys.v2.JwkBurnService/JwkBurn"
+)
+
+// JwkBurnServiceClient is the client API for JwkBurnService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// JwkBurnService replaces every key of a usage, in an emergency.
+type JwkBurnServiceClient interface {
+	// Revokes every active key of a usage, and generates a new main key, in a single transaction,
+	// for instance when its signing key has been exfiltrated. Every server sharing the database
+	// drops its cached keys of the usage once the transaction commits. Requires an API key with the
+	// admin operation on the usage, or an admin client certificate.
+	// Returns NOT_FOUND if the usage is not configured, and INVALID_ARGUMENT without a comment.
+	JwkBurn(ctx context.Context, in *JwkBurnRequest, opts ...grpc.CallOption) (*JwkBurnResponse, error)
+}
+
+type jwkBurnServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJwkBurnServiceClient(cc grpc.ClientConnInterface) JwkBurnServiceClient {
+	return &jwkBurnServiceClient{cc}
+}
+
+func (c *jwkBurnServiceClient) JwkBurn(ctx context.Context, in *JwkBurnRequest, opts ...grpc.CallOption) (*JwkBurnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JwkBurnResponse)
+	err := c.cc.Invoke(ctx, JwkBurnService_JwkBurn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JwkBurnServiceServer is the server API for JwkBurnService service.
+// All implementations must embed UnimplementedJwkBurnServiceServer
+// for forward compatibility.
+//
+// JwkBurnService replaces every key of a usage, in an emergency.
+type JwkBurnServiceServer interface {
+	// Revokes every active key of a usage, and generates a new main key, in a single transaction,
+	// for instance when its signing key has been exfiltrated. Every server sharing the database
+	// drops its cached keys of the usage once the transaction commits. Requires an API key with the
+	// admin operation on the usage, or an admin client certificate.
+	// Returns NOT_FOUND if the usage is not configured, and INVALID_ARGUMENT without a comment.
+	JwkBurn(context.Context, *JwkBurnRequest) (*JwkBurnResponse, error)
+	mustEmbedUnimplementedJwkBurnServiceServer()
+}
+
+// UnimplementedJwkBurnServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedJwkBurnServiceServer struct{}
+
+func (UnimplementedJwkBurnServiceServer) JwkBurn(context.Context, *JwkBurnRequest) (*JwkBurnResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JwkBurn not implemented")
+}
+func (UnimplementedJwkBurnServiceServer) mustEmbedUnimplementedJwkBurnServiceServer() {}
+func (UnimplementedJwkBurnServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeJwkBurnServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JwkBurnServiceServer will
+// result in compilation errors.
+type UnsafeJwkBurnServiceServer interface {
+	mustEmbedUnimplementedJwkBurnServiceServer()
+}
+
+func RegisterJwkBurnServiceServer(s grpc.ServiceRegistrar, srv JwkBurnServiceServer) {
+	// If the following call panics, it indicates UnimplementedJwkBurnServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&JwkBurnService_ServiceDesc, srv)
+}
+
+func _JwkBurnService_JwkBurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JwkBurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JwkBurnServiceServer).JwkBurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JwkBurnService_JwkBurn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JwkBurnServiceServer).JwkBurn(ctx, req.(*JwkBurnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JwkBurnService_ServiceDesc is the grpc.ServiceDesc for JwkBurnService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JwkBurnService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "anovel.jsonkeys.v2.JwkBurnService",
+	HandlerType: (*JwkBurnServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "JwkBurn",
+			Handler:    _JwkBurnService_JwkBurn_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anovel/jsonkeys/v2/jwk_burn.proto",
+}
@@ -0,0 +1,307 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: anovel/jsonkeys/v2/jwk_rotate.proto
+
+package jsonkeysv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PreviousKeyStatus classifies the state of the previous main key after a rotation.
+type PreviousKeyStatus int32
+
+const (
+	// PREVIOUS_KEY_STATUS_UNSPECIFIED means the usage had no key before the rotation.
+	PreviousKeyStatus_PREVIOUS_KEY_STATUS_UNSPECIFIED PreviousKeyStatus = 0
+	// PREVIOUS_KEY_STATUS_ACTIVE means the previous key no longer signs, but still verifies the
+	// tokens it signed until it expires.
+	PreviousKeyStatus_PREVIOUS_KEY_STATUS_ACTIVE PreviousKeyStatus = 1
+	// PREVIOUS_KEY_STATUS_REVOKED means the previous key was revoked, and no longer verifies tokens.
+	PreviousKeyStatus_PREVIOUS_KEY_STATUS_REVOKED PreviousKeyStatus = 2
+)
+
+// Enum value maps for PreviousKeyStatus.
+var (
+	PreviousKeyStatus_name = map[int32]string{
+		0: "PREVIOUS_KEY_STATUS_UNSPECIFIED",
+		1: "PREVIOUS_KEY_STATUS_ACTIVE",
+		2: "PREVIOUS_KEY_STATUS_REVOKED",
+	}
+	PreviousKeyStatus_value = map[string]int32{
+		"PREVIOUS_KEY_STATUS_UNSPECIFIED": 0,
+		"PREVIOUS_KEY_STATUS_ACTIVE":      1,
+		"PREVIOUS_KEY_STATUS_REVOKED":     2,
+	}
+)
+
+func (x PreviousKeyStatus) Enum() *PreviousKeyStatus {
+	p := new(PreviousKeyStatus)
+	*p = x
+	return p
+}
+
+func (x PreviousKeyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PreviousKeyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_anovel_jsonkeys_v2_jwk_rotate_proto_enumTypes[0].Descriptor()
+}
+
+func (PreviousKeyStatus) Type() protoreflect.EnumType {
+	return &file_anovel_jsonkeys_v2_jwk_rotate_proto_enumTypes[0]
+}
+
+func (x PreviousKeyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PreviousKeyStatus.Descriptor instead.
+func (PreviousKeyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_jwk_rotate_proto_rawDescGZIP(), []int{0}
+}
+
+// JwkRotateRequest names the usage to rotate.
+type JwkRotateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The key usage to rotate.
+	Usage string `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Revokes the previous main key in the same transaction as the rotation, so tokens it signed
+	// stop verifying. Otherwise, it stays active until it expires.
+	RevokePrevious bool `protobuf:"varint,2,opt,name=revoke_previous,json=revokePrevious,proto3" json:"revoke_previous,omitempty"`
+	// The reason of the revocation, kept with the key and in the audit trail. Required with
+	// revoke_previous.
+	Comment       string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JwkRotateRequest) Reset() {
+	*x = JwkRotateRequest{}
+	mi := &file_anovel_jsonkeys_v2_jwk_rotate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JwkRotateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JwkRotateRequest) ProtoMessage() {}
+
+func (x *JwkRotateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_jwk_rotate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JwkRotateRequest.ProtoReflect.Descriptor instead.
+func (*JwkRotateRequest) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_jwk_rotate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JwkRotateRequest) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *JwkRotateRequest) GetRevokePrevious() bool {
+	if x != nil {
+		return x.RevokePrevious
+	}
+	return false
+}
+
+func (x *JwkRotateRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// JwkRotateResponse describes the new main key, and what became of the previous one.
+type JwkRotateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the new main key. Servers sharing the database sign with it within a second.
+	Kid string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// When the new main key expires.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// The ID of the previous main key. Empty if the usage had none.
+	PreviousKid string `protobuf:"bytes,3,opt,name=previous_kid,json=previousKid,proto3" json:"previous_kid,omitempty"`
+	// The state of the previous main key.
+	PreviousStatus PreviousKeyStatus `protobuf:"varint,4,opt,name=previous_status,json=previousStatus,proto3,enum=anovel.jsonkeys.v2.PreviousKeyStatus" json:"previous_status,omitempty"`
+	// When the previous main key expires, or expired, if any.
+	PreviousExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=previous_expires_at,json=previousExpiresAt,proto3" json:"previous_expires_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *JwkRotateResponse) Reset() {
+	*x = JwkRotateResponse{}
+	mi := &file_anovel_jsonkeys_v2_jwk_rotate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JwkRotateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JwkRotateResponse) ProtoMessage() {}
+
+func (x *JwkRotateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_jwk_rotate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JwkRotateResponse.ProtoReflect.Descriptor instead.
+func (*JwkRotateResponse) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_jwk_rotate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JwkRotateResponse) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JwkRotateResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *JwkRotateResponse) GetPreviousKid() string {
+	if x != nil {
+		return x.PreviousKid
+	}
+	return ""
+}
+
+func (x *JwkRotateResponse) GetPreviousStatus() PreviousKeyStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return PreviousKeyStatus_PREVIOUS_KEY_STATUS_UNSPECIFIED
+}
+
+func (x *JwkRotateResponse) GetPreviousExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousExpiresAt
+	}
+	return nil
+}
+
+var File_anovel_jsonkeys_v2_jwk_rotate_proto protoreflect.FileDescriptor
+
+const file_anovel_jsonkeys_v2_jwk_rotate_proto_rawDesc = "" +
+	"\n" +
+	"#anovel/jsonkeys/v2/jwk_rotate.proto\x12\x12anovel.jsonkeys.v2\x1a\x1fgoogle/protobuf/timestamp.proto\"k\n" +
+	"\x10JwkRotateRequest\x12\x14\n" +
+	"\x05usage\x18\x01 \x01(\tR\x05usage\x12'\n" +
+	"\x0frevoke_previous\x18\x02 \x01(\bR\x0erevokePrevious\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"\x9f\x02\n" +
+	"\x11JwkRotateResponse\x12\x10\n" +
+	"\x03kid\x18\x01 \x01(\tR\x03kid\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12!\n" +
+	"\fprevious_kid\x18\x03 \x01(\tR\vpreviousKid\x12N\n" +
+	"\x0fprevious_status\x18\x04 \x01(\x0e2%.anovel.jsonkeys.v2.PreviousKeyStatusR\x0epreviousStatus\x12J\n" +
+	"\x13previous_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x11previousExpiresAt*y\n" +
+	"\x11PreviousKeyStatus\x12#\n" +
+	"\x1fPREVIOUS_KEY_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aPREVIOUS_KEY_STATUS_ACTIVE\x10\x01\x12\x1f\n" +
+	"\x1bPREVIOUS_KEY_STATUS_REVOKED\x10\x022l\n" +
+	"\x10JwkRotateService\x12X\n" +
+	"\tJwkRotate\x12$.anovel.jsonkeys.v2.JwkRotateRequest\x1a%.anovel.jsonkeys.v2.JwkRotateResponseB\xf4\x01\n" +
+	"\x16com.anovel.jsonkeys.v2B\x0eJwkRotateProtoP\x01Z`github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2;jsonkeysv2\xa2\x02\x03AJX\xaa\x02\x12Anovel.Jsonkeys.V2\xca\x02\x12Anovel\\Jsonkeys\\V2\xe2\x02\x1eAnovel\\Jsonkeys\\V2\\GPBMetadata\xea\x02\x14Anovel::Jsonkeys::V2b\x06proto3"
+
+var (
+	file_anovel_jsonkeys_v2_jwk_rotate_proto_rawDescOnce sync.Once
+	file_anovel_jsonkeys_v2_jwk_rotate_proto_rawDescData []byte
+)
+
+func file_anovel_jsonkeys_v2_jwk_rotate_proto_rawDescGZIP() []byte {
+	file_anovel_jsonkeys_v2_jwk_rotate_proto_rawDescOnce.Do(func() {
+		file_anovel_jsonkeys_v2_jwk_rotate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_jwk_rotate_proto_rawDesc), len(file_anovel_jsonkeys_v2_jwk_rotate_proto_rawDesc)))
+	})
+	return file_anovel_jsonkeys_v2_jwk_rotate_proto_rawDescData
+}
+
+var file_anovel_jsonkeys_v2_jwk_rotate_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_anovel_jsonkeys_v2_jwk_rotate_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_anovel_jsonkeys_v2_jwk_rotate_proto_goTypes = []any{
+	(PreviousKeyStatus)(0),        // 0: anovel.jsonkeys.v2.PreviousKeyStatus
+	(*JwkRotateRequest)(nil),      // 1: anovel.jsonkeys.v2.JwkRotateRequest
+	(*JwkRotateResponse)(nil),     // 2: anovel.jsonkeys.v2.JwkRotateResponse
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_anovel_jsonkeys_v2_jwk_rotate_proto_depIdxs = []int32{
+	3, // 0: anovel.jsonkeys.v2.JwkRotateResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0, // 1: anovel.jsonkeys.v2.JwkRotateResponse.previous_status:type_name -> anovel.jsonkeys.v2.PreviousKeyStatus
+	3, // 2: anovel.jsonkeys.v2.JwkRotateResponse.previous_expires_at:type_name -> google.protobuf.Timestamp
+	1, // 3: anovel.jsonkeys.v2.JwkRotateService.JwkRotate:input_type -> anovel.jsonkeys.v2.JwkRotateRequest
+	2, // 4: anovel.jsonkeys.v2.JwkRotateService.JwkRotate:output_type -> anovel.jsonkeys.v2.JwkRotateResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_anovel_jsonkeys_v2_jwk_rotate_proto_init() }
+func file_anovel_jsonkeys_v2_jwk_rotate_proto_init() {
+	if File_anovel_jsonkeys_v2_jwk_rotate_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_jwk_rotate_proto_rawDesc), len(file_anovel_jsonkeys_v2_jwk_rotate_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_anovel_jsonkeys_v2_jwk_rotate_proto_goTypes,
+		DependencyIndexes: file_anovel_jsonkeys_v2_jwk_rotate_proto_depIdxs,
+		EnumInfos:         file_anovel_jsonkeys_v2_jwk_rotate_proto_enumTypes,
+		MessageInfos:      file_anovel_jsonkeys_v2_jwk_rotate_proto_msgTypes,
+	}.Build()
+	File_anovel_jsonkeys_v2_jwk_rotate_proto = out.File
+	file_anovel_jsonkeys_v2_jwk_rotate_proto_goTypes = nil
+	file_anovel_jsonkeys_v2_jwk_rotate_proto_depIdxs = nil
+}
@@ -0,0 +1,135 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: anovel/jsonkeys/v2/jwk_rotate.proto
+
+package jsonkeysv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	JwkRotateService_JwkRotate_FullMethodName = "/anovel.jsonkeys.v2.JwkRotateService/JwkRotate"
+)
+
+// JwkRotateServiceClient is the client API for JwkRotateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// JwkRotateService replaces the main key of a usage on demand.
+type JwkRotateServiceClient interface {
+	// Generates a new main key for a usage right away, whatever the age of the current one, for
+	// instance when it is suspected to have leaked. Requires an API key with the admin operation
+	// on the usage, or an admin client certificate.
+	// Returns NOT_FOUND if the usage is not configured, and INVALID_ARGUMENT if the previous key
+	// is revoked without a comment.
+	JwkRotate(ctx context.Context, in *JwkRotateRequest, opts ...grpc.CallOption) (*JwkRotateResponse, error)
+}
+
+type jwkRotateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJwkRotateServiceClient(cc grpc.ClientConnInterface) JwkRotateServiceClient {
+	return &jwkRotateServiceClient{cc}
+}
+
+func (c *jwkRotateServiceClient) JwkRotate(ctx context.Context, in *JwkRotateRequest, opts ...grpc.CallOption) (*JwkRotateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JwkRotateResponse)
+	err := c.cc.Invoke(ctx, JwkRotateService_JwkRotate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JwkRotateServiceServer is the server API for JwkRotateService service.
+// All implementations must embed UnimplementedJwkRotateServiceServer
+// for forward compatibility.
+//
+// JwkRotateService replaces the main key of a usage on demand.
+type JwkRotateServiceServer interface {
+	// Generates a new main key for a usage right away, whatever the age of the current one, for
+	// instance when it is suspected to have leaked. Requires an API key with the admin operation
+	// on the usage, or an admin client certificate.
+	// Returns NOT_FOUND if the usage is not configured, and INVALID_ARGUMENT if the previous key
+	// is revoked without a comment.
+	JwkRotate(context.Context, *JwkRotateRequest) (*JwkRotateResponse, error)
+	mustEmbedUnimplementedJwkRotateServiceServer()
+}
+
+// UnimplementedJwkRotateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedJwkRotateServiceServer struct{}
+
+func (UnimplementedJwkRotateServiceServer) JwkRotate(context.Context, *JwkRotateRequest) (*JwkRotateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JwkRotate not implemented")
+}
+func (UnimplementedJwkRotateServiceServer) mustEmbedUnimplementedJwkRotateServiceServer() {}
+func (UnimplementedJwkRotateServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeJwkRotateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JwkRotateServiceServer will
+// result in compilation errors.
+type UnsafeJwkRotateServiceServer interface {
+	mustEmbedUnimplementedJwkRotateServiceServer()
+}
+
+func RegisterJwkRotateServiceServer(s grpc.ServiceRegistrar, srv JwkRotateServiceServer) {
+	// If the following call panics, it indicates UnimplementedJwkRotateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&JwkRotateService_ServiceDesc, srv)
+}
+
+func _JwkRotateService_JwkRotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JwkRotateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JwkRotateServiceServer).JwkRotate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JwkRotateService_JwkRotate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JwkRotateServiceServer).JwkRotate(ctx, req.(*JwkRotateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JwkRotateService_ServiceDesc is the grpc.ServiceDesc for JwkRotateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JwkRotateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "anovel.jsonkeys.v2.JwkRotateService",
+	HandlerType: (*JwkRotateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "JwkRotate",
+			Handler:    _JwkRotateService_JwkRotate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anovel/jsonkeys/v2/jwk_rotate.proto",
+}
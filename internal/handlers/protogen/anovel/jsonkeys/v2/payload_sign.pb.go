@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: anovel/jsonkeys/v2/payload_sign.proto
+
+package jsonkeysv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PayloadSignRequest carries the payload to sign and the usage that selects the signing key.
+type PayloadSignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Intended usage of the signature. Determines the signing key used to generate (and later
+	// verify) the signature.
+	Usage string `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	// The payload to sign. It is not included in the response: the caller sends it alongside
+	// the signature, and verifiers need the exact same bytes.
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Signs the payload as is rather than its base64url encoding, with the "b64": false header
+	// of RFC 7797. Useful when the payload is already transmitted verbatim, e.g. an HTTP body.
+	Unencoded     bool `protobuf:"varint,3,opt,name=unencoded,proto3" json:"unencoded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayloadSignRequest) Reset() {
+	*x = PayloadSignRequest{}
+	mi := &file_anovel_jsonkeys_v2_payload_sign_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayloadSignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayloadSignRequest) ProtoMessage() {}
+
+func (x *PayloadSignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_payload_sign_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayloadSignRequest.ProtoReflect.Descriptor instead.
+func (*PayloadSignRequest) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_payload_sign_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PayloadSignRequest) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *PayloadSignRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *PayloadSignRequest) GetUnencoded() bool {
+	if x != nil {
+		return x.Unencoded
+	}
+	return false
+}
+
+// PayloadSignResponse carries the detached signature.
+type PayloadSignResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The detached JWS (RFC 7515, appendix F): a compact JWS with an empty payload segment,
+	// base64url header..signature. Its header types it "payload+jose", and marks "b64" critical,
+	// so it never passes for a token of the usage.
+	Signature     string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayloadSignResponse) Reset() {
+	*x = PayloadSignResponse{}
+	mi := &file_anovel_jsonkeys_v2_payload_sign_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayloadSignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayloadSignResponse) ProtoMessage() {}
+
+func (x *PayloadSignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_payload_sign_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayloadSignResponse.ProtoReflect.Descriptor instead.
+func (*PayloadSignResponse) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_payload_sign_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PayloadSignResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+var File_anovel_jsonkeys_v2_payload_sign_proto protoreflect.FileDescriptor
+
+const file_anovel_jsonkeys_v2_payload_sign_proto_rawDesc = "" +
+	"\n" +
+	"%anovel/jsonkeys/v2/payload_sign.proto\x12\x12anovel.jsonkeys.v2\"b\n" +
+	"\x12PayloadSignRequest\x12\x14\n" +
+	"\x05usage\x18\x01 \x01(\tR\x05usage\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\x12\x1c\n" +
+	"\tunencoded\x18\x03 \x01(\bR\tunencoded\"3\n" +
+	"\x13PayloadSignResponse\x12\x1c\n" +
+	"\tsignature\x18\x01 \x01(\tR\tsignature2t\n" +
+	"\x12PayloadSignService\x12^\n" +
+	"\vPayloadSign\x12&.anovel.jsonkeys.v2.PayloadSignRequest\x1a'.anovel.jsonkeys.v2.PayloadSignResponseB\xf6\x01\n" +
+	"\x16com.anovel.jsonkeys.v2B\x10PayloadSignProtoP\x01Z`github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2;jsonkeysv2\xa2\x02\x03AJX\xaa\x02\x12Anovel.Jsonkeys.V2\xca\x02\x12Anovel\\Jsonkeys\\V2\xe2\x02\x1eAnovel\\Jsonkeys\\V2\\GPBMetadata\xea\x02\x14Anovel::Jsonkeys::V2b\x06proto3"
+
+var (
+	file_anovel_jsonkeys_v2_payload_sign_proto_rawDescOnce sync.Once
+	file_anovel_jsonkeys_v2_payload_sign_proto_rawDescData []byte
+)
+
+func file_anovel_jsonkeys_v2_payload_sign_proto_rawDescGZIP() []byte {
+	file_anovel_jsonkeys_v2_payload_sign_proto_rawDescOnce.Do(func() {
+		file_anovel_jsonkeys_v2_payload_sign_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_payload_sign_proto_rawDesc), len(file_anovel_jsonkeys_v2_payload_sign_proto_rawDesc)))
+	})
+	return file_anovel_jsonkeys_v2_payload_sign_proto_rawDescData
+}
+
+var file_anovel_jsonkeys_v2_payload_sign_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_anovel_jsonkeys_v2_payload_sign_proto_goTypes = []any{
+	(*PayloadSignRequest)(nil),  // 0: anovel.jsonkeys.v2.PayloadSignRequest
+	(*PayloadSignResponse)(nil), // 1: anovel.jsonkeys.v2.PayloadSignResponse
+}
+var file_anovel_jsonkeys_v2_payload_sign_proto_depIdxs = []int32{
+	0, // 0: anovel.jsonkeys.v2.PayloadSignService.PayloadSign:input_type -> anovel.jsonkeys.v2.PayloadSignRequest
+	1, // 1: anovel.jsonkeys.v2.PayloadSignService.PayloadSign:output_type -> anovel.jsonkeys.v2.PayloadSignResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_anovel_jsonkeys_v2_payload_sign_proto_init() }
+func file_anovel_jsonkeys_v2_payload_sign_proto_init() {
+	if File_anovel_jsonkeys_v2_payload_sign_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_payload_sign_proto_rawDesc), len(file_anovel_jsonkeys_v2_payload_sign_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_anovel_jsonkeys_v2_payload_sign_proto_goTypes,
+		DependencyIndexes: file_anovel_jsonkeys_v2_payload_sign_proto_depIdxs,
+		MessageInfos:      file_anovel_jsonkeys_v2_payload_sign_proto_msgTypes,
+	}.Build()
+	File_anovel_jsonkeys_v2_payload_sign_proto = out.File
+	file_anovel_jsonkeys_v2_payload_sign_proto_goTypes = nil
+	file_anovel_jsonkeys_v2_payload_sign_proto_depIdxs = nil
+}
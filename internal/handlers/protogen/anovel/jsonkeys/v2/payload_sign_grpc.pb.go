@@ -0,0 +1,133 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: anovel/jsonkeys/v2/payload_sign.proto
+
+package jsonkeysv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PayloadSignService_PayloadSign_FullMethodName = "/anovel.jsonkeys.v2.PayloadSignService/PayloadSign"
+)
+
+// PayloadSignServiceClient is the client API for PayloadSignService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PayloadSignService remotely signs arbitrary payloads. Unlike ClaimsSignService, no JWT claims
+// are attached, and the payload is left out of the returned signature.
+type PayloadSignServiceClient interface {
+	// Signs the provided payload with the current key of the requested usage, and returns a
+	// detached JWS. Returns UNAVAILABLE if the usage is not configured on the server, and
+	// FAILED_PRECONDITION while the signing of the usage is frozen.
+	PayloadSign(ctx context.Context, in *PayloadSignRequest, opts ...grpc.CallOption) (*PayloadSignResponse, error)
+}
+
+type payloadSignServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPayloadSignServiceClient(cc grpc.ClientConnInterface) PayloadSignServiceClient {
+	return &payloadSignServiceClient{cc}
+}
+
+func (c *payloadSignServiceClient) PayloadSign(ctx context.Context, in *PayloadSignRequest, opts ...grpc.CallOption) (*PayloadSignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayloadSignResponse)
+	err := c.cc.Invoke(ctx, PayloadSignService_PayloadSign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PayloadSignServiceServer is the server API for PayloadSignService service.
+// All implementations must embed UnimplementedPayloadSignServiceServer
+// for forward compatibility.
+//
+// PayloadSignService remotely signs arbitrary payloads. Unlike ClaimsSignService, no JWT claims
+// are attached, and the payload is left out of the returned signature.
+type PayloadSignServiceServer interface {
+	// Signs the provided payload with the current key of the requested usage, and returns a
+	// detached JWS. Returns UNAVAILABLE if the usage is not configured on the server, and
+	// FAILED_PRECONDITION while the signing of the usage is frozen.
+	PayloadSign(context.Context, *PayloadSignRequest) (*PayloadSignResponse, error)
+	mustEmbedUnimplementedPayloadSignServiceServer()
+}
+
+// UnimplementedPayloadSignServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPayloadSignServiceServer struct{}
+
+func (UnimplementedPayloadSignServiceServer) PayloadSign(context.Context, *PayloadSignRequest) (*PayloadSignResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PayloadSign not implemented")
+}
+func (UnimplementedPayloadSignServiceServer) mustEmbedUnimplementedPayloadSignServiceServer() {}
+func (UnimplementedPayloadSignServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafePayloadSignServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PayloadSignServiceServer will
+// result in compilation errors.
+type UnsafePayloadSignServiceServer interface {
+	mustEmbedUnimplementedPayloadSignServiceServer()
+}
+
+func RegisterPayloadSignServiceServer(s grpc.ServiceRegistrar, srv PayloadSignServiceServer) {
+	// If the following call panics, it indicates UnimplementedPayloadSignServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PayloadSignService_ServiceDesc, srv)
+}
+
+func _PayloadSignService_PayloadSign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayloadSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayloadSignServiceServer).PayloadSign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayloadSignService_PayloadSign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayloadSignServiceServer).PayloadSign(ctx, req.(*PayloadSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PayloadSignService_ServiceDesc is the grpc.ServiceDesc for PayloadSignService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PayloadSignService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "anovel.jsonkeys.v2.PayloadSignService",
+	HandlerType: (*PayloadSignServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PayloadSign",
+			Handler:    _PayloadSignService_PayloadSign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anovel/jsonkeys/v2/payload_sign.proto",
+}
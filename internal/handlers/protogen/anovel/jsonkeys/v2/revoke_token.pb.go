@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: anovel/jsonkeys/v2/revoke_token.proto
+
+package jsonkeysv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RevokeTokenRequest names the token to revoke. Either token or token_id must be set.
+type RevokeTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The key usage the token was signed for. Tokens of other usages with the same ID are not
+	// revoked.
+	Usage string `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	// The token to revoke, compact or in JWS JSON serialization. It must verify for the usage,
+	// expired or not; its "exp" claim tells how long the revocation is kept. When set, token_id is
+	// ignored.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// The "jti" claim of the token to revoke, for callers that no longer hold the token. The
+	// revocation is kept for the usage's token TTL.
+	TokenId       string `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokenRequest) Reset() {
+	*x = RevokeTokenRequest{}
+	mi := &file_anovel_jsonkeys_v2_revoke_token_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokenRequest) ProtoMessage() {}
+
+func (x *RevokeTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_revoke_token_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTokenRequest) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_revoke_token_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RevokeTokenRequest) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RevokeTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RevokeTokenRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+// RevokedToken describes the revocation of a single token.
+type RevokedToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The "jti" claim of the revoked token.
+	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// The key usage the token was signed for.
+	Usage string `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	// When the token stops verifying anyway, leeway included. The revocation is forgotten past
+	// this time.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// When the token was revoked.
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokedToken) Reset() {
+	*x = RevokedToken{}
+	mi := &file_anovel_jsonkeys_v2_revoke_token_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokedToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokedToken) ProtoMessage() {}
+
+func (x *RevokedToken) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_revoke_token_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokedToken.ProtoReflect.Descriptor instead.
+func (*RevokedToken) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_revoke_token_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RevokedToken) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *RevokedToken) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RevokedToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *RevokedToken) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+// RevokeTokenResponse describes the revocation.
+type RevokeTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The revocation.
+	Token         *RevokedToken `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokenResponse) Reset() {
+	*x = RevokeTokenResponse{}
+	mi := &file_anovel_jsonkeys_v2_revoke_token_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokenResponse) ProtoMessage() {}
+
+func (x *RevokeTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_revoke_token_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeTokenResponse) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_revoke_token_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RevokeTokenResponse) GetToken() *RevokedToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+var File_anovel_jsonkeys_v2_revoke_token_proto protoreflect.FileDescriptor
+
+const file_anovel_jsonkeys_v2_revoke_token_proto_rawDesc = "" +
+	"\n" +
+	"%anovel/jsonkeys/v2/revoke_token.proto\x12\x12anovel.jsonkeys.v2\x1a\x1fgoogle/protobuf/timestamp.proto\"[\n" +
+	"\x12RevokeTokenRequest\x12\x14\n" +
+	"\x05usage\x18\x01 \x01(\tR\x05usage\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\"\xb5\x01\n" +
+	"\fRevokedToken\x12\x19\n" +
+	"\btoken_id\x18\x01 \x01(\tR\atokenId\x12\x14\n" +
+	"\x05usage\x18\x02 \x01(\tR\x05usage\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"M\n" +
+	"\x13RevokeTokenResponse\x126\n" +
+	"\x05token\x18\x01 \x01(\v2 .anovel.jsonkeys.v2.RevokedTokenR\x05token2t\n" +
+	"\x12RevokeTokenService\x12^\n" +
+	"\vRevokeToken\x12&.anovel.jsonkeys.v2.RevokeTokenRequest\x1a'.anovel.jsonkeys.v2.RevokeTokenResponseB\xf6\x01\n" +
+	"\x16com.anovel.jsonkeys.v2B\x10RevokeTokenProtoP\x01Z`github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2;jsonkeysv2\xa2\x02\x03AJX\xaa\x02\x12Anovel.Jsonkeys.V2\xca\x02\x12Anovel\\Jsonkeys\\V2\xe2\x02\x1eAnovel\\Jsonkeys\\V2\\GPBMetadata\xea\x02\x14Anovel::Jsonkeys::V2b\x06proto3"
+
+var (
+	file_anovel_jsonkeys_v2_revoke_token_proto_rawDescOnce sync.Once
+	file_anovel_jsonkeys_v2_revoke_token_proto_rawDescData []byte
+)
+
+func file_anovel_jsonkeys_v2_revoke_token_proto_rawDescGZIP() []byte {
+	file_anovel_jsonkeys_v2_revoke_token_proto_rawDescOnce.Do(func() {
+		file_anovel_jsonkeys_v2_revoke_token_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_revoke_token_proto_rawDesc), len(file_anovel_jsonkeys_v2_revoke_token_proto_rawDesc)))
+	})
+	return file_anovel_jsonkeys_v2_revoke_token_proto_rawDescData
+}
+
+var file_anovel_jsonkeys_v2_revoke_token_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_anovel_jsonkeys_v2_revoke_token_proto_goTypes = []any{
+	(*RevokeTokenRequest)(nil),    // 0: anovel.jsonkeys.v2.RevokeTokenRequest
+	(*RevokedToken)(nil),          // 1: anovel.jsonkeys.v2.RevokedToken
+	(*RevokeTokenResponse)(nil),   // 2: anovel.jsonkeys.v2.RevokeTokenResponse
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_anovel_jsonkeys_v2_revoke_token_proto_depIdxs = []int32{
+	3, // 0: anovel.jsonkeys.v2.RevokedToken.expires_at:type_name -> google.protobuf.Timestamp
+	3, // 1: anovel.jsonkeys.v2.RevokedToken.revoked_at:type_name -> google.protobuf.Timestamp
+	1, // 2: anovel.jsonkeys.v2.RevokeTokenResponse.token:type_name -> anovel.jsonkeys.v2.RevokedToken
+	0, // 3: anovel.jsonkeys.v2.RevokeTokenService.RevokeToken:input_type -> anovel.jsonkeys.v2.RevokeTokenRequest
+	2, // 4: anovel.jsonkeys.v2.RevokeTokenService.RevokeToken:output_type -> anovel.jsonkeys.v2.RevokeTokenResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_anovel_jsonkeys_v2_revoke_token_proto_init() }
+func file_anovel_jsonkeys_v2_revoke_token_proto_init() {
+	if File_anovel_jsonkeys_v2_revoke_token_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_revoke_token_proto_rawDesc), len(file_anovel_jsonkeys_v2_revoke_token_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_anovel_jsonkeys_v2_revoke_token_proto_goTypes,
+		DependencyIndexes: file_anovel_jsonkeys_v2_revoke_token_proto_depIdxs,
+		MessageInfos:      file_anovel_jsonkeys_v2_revoke_token_proto_msgTypes,
+	}.Build()
+	File_anovel_jsonkeys_v2_revoke_token_proto = out.File
+	file_anovel_jsonkeys_v2_revoke_token_proto_goTypes = nil
+	file_anovel_jsonkeys_v2_revoke_token_proto_depIdxs = nil
+}
@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: anovel/jsonkeys/v2/revoke_token.proto
+
+package jsonkeysv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RevokeTokenService_RevokeToken_FullMethodName = "/anovel.jsonkeys.v2.RevokeTokenService/RevokeToken"
+)
+
+// RevokeTokenServiceClient is the client API for RevokeTokenService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RevokeTokenService revokes single tokens.
+type RevokeTokenServiceClient interface {
+	// Revokes a single token of a usage, by its "jti" claim: verifiers refuse it from then on,
+	// while the key that signed it keeps verifying other tokens. The revocation only applies under
+	// the usage, and is kept until the token expires.
+	// Revoking a token twice keeps the original revocation. Servers apply a revocation within a
+	// second, client verifiers within their sync interval. Requires an API key with the admin
+	// operation on the usage, or an admin client certificate.
+	// Returns NOT_FOUND if the usage is not configured, and INVALID_ARGUMENT if the request names no
+	// token ID, or if the token does not verify for the usage.
+	RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error)
+}
+
+type revokeTokenServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRevokeTokenServiceClient(cc grpc.ClientConnInterface) RevokeTokenServiceClient {
+	return &revokeTokenServiceClient{cc}
+}
+
+func (c *revokeTokenServiceClient) RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeTokenResponse)
+	err := c.cc.Invoke(ctx, RevokeTokenService_RevokeToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RevokeTokenServiceServer is the server API for RevokeTokenService service.
+// All implementations must embed UnimplementedRevokeTokenServiceServer
+// for forward compatibility.
+//
+// RevokeTokenService revokes single tokens.
+type RevokeTokenServiceServer interface {
+	// Revokes a single token of a usage, by its "jti" claim: verifiers refuse it from then on,
+	// while the key that signed it keeps verifying other tokens. The revocation only applies under
+	// the usage, and is kept until the token expires.
+	// Revoking a token twice keeps the original revocation. Servers apply a revocation within a
+	// second, client verifiers within their sync interval. Requires an API key with the admin
+	// operation on the usage, or an admin client certificate.
+	// Returns NOT_FOUND if the usage is not configured, and INVALID_ARGUMENT if the request names no
+	// token ID, or if the token does not verify for the usage.
+	RevokeToken(context.Context, *RevokeTokenRequest) (*RevokeTokenResponse, error)
+	mustEmbedUnimplementedRevokeTokenServiceServer()
+}
+
+// UnimplementedRevokeTokenServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRevokeTokenServiceServer struct{}
+
+func (UnimplementedRevokeTokenServiceServer) RevokeToken(context.Context, *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeToken not implemented")
+}
+func (UnimplementedRevokeTokenServiceServer) mustEmbedUnimplementedRevokeTokenServiceServer() {}
+func (UnimplementedRevokeTokenServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeRevokeTokenServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RevokeTokenServiceServer will
+// result in compilation errors.
+type UnsafeRevokeTokenServiceServer interface {
+	mustEmbedUnimplementedRevokeTokenServiceServer()
+}
+
+func RegisterRevokeTokenServiceServer(s grpc.ServiceRegistrar, srv RevokeTokenServiceServer) {
+	// If the following call panics, it indicates UnimplementedRevokeTokenServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RevokeTokenService_ServiceDesc, srv)
+}
+
+func _RevokeTokenService_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RevokeTokenServiceServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RevokeTokenService_RevokeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RevokeTokenServiceServer).RevokeToken(ctx, req.(*RevokeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RevokeTokenService_ServiceDesc is the grpc.ServiceDesc for RevokeTokenService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RevokeTokenService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "anovel.jsonkeys.v2.RevokeTokenService",
+	HandlerType: (*RevokeTokenServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RevokeToken",
+			Handler:    _RevokeTokenService_RevokeToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anovel/jsonkeys/v2/revoke_token.proto",
+}
@@ -0,0 +1,195 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: anovel/jsonkeys/v2/revoked_token_sync.proto
+
+package jsonkeysv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RevokedTokenSyncRequest carries the cursor of the previous sync.
+type RevokedTokenSyncRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The cursor returned by the previous sync. Unset to return every revocation.
+	Since         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokedTokenSyncRequest) Reset() {
+	*x = RevokedTokenSyncRequest{}
+	mi := &file_anovel_jsonkeys_v2_revoked_token_sync_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokedTokenSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokedTokenSyncRequest) ProtoMessage() {}
+
+func (x *RevokedTokenSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_revoked_token_sync_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokedTokenSyncRequest.ProtoReflect.Descriptor instead.
+func (*RevokedTokenSyncRequest) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_revoked_token_sync_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RevokedTokenSyncRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// RevokedTokenSyncResponse lists the revocations made since the cursor.
+type RevokedTokenSyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The revocations made since the cursor, oldest first.
+	Tokens []*RevokedToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// The cursor to pass to the next sync.
+	Cursor        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokedTokenSyncResponse) Reset() {
+	*x = RevokedTokenSyncResponse{}
+	mi := &file_anovel_jsonkeys_v2_revoked_token_sync_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokedTokenSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokedTokenSyncResponse) ProtoMessage() {}
+
+func (x *RevokedTokenSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_revoked_token_sync_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokedTokenSyncResponse.ProtoReflect.Descriptor instead.
+func (*RevokedTokenSyncResponse) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_revoked_token_sync_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RevokedTokenSyncResponse) GetTokens() []*RevokedToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *RevokedTokenSyncResponse) GetCursor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+var File_anovel_jsonkeys_v2_revoked_token_sync_proto protoreflect.FileDescriptor
+
+const file_anovel_jsonkeys_v2_revoked_token_sync_proto_rawDesc = "" +
+	"\n" +
+	"+anovel/jsonkeys/v2/revoked_token_sync.proto\x12\x12anovel.jsonkeys.v2\x1a%anovel/jsonkeys/v2/revoke_token.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"K\n" +
+	"\x17RevokedTokenSyncRequest\x120\n" +
+	"\x05since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"\x88\x01\n" +
+	"\x18RevokedTokenSyncResponse\x128\n" +
+	"\x06tokens\x18\x01 \x03(\v2 .anovel.jsonkeys.v2.RevokedTokenR\x06tokens\x122\n" +
+	"\x06cursor\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06cursor2\x88\x01\n" +
+	"\x17RevokedTokenSyncService\x12m\n" +
+	"\x10RevokedTokenSync\x12+.anovel.jsonkeys.v2.RevokedTokenSyncRequest\x1a,.anovel.jsonkeys.v2.RevokedTokenSyncResponseB\xfb\x01\n" +
+	"\x16com.anovel.jsonkeys.v2B\x15RevokedTokenSyncProtoP\x01Z`github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2;jsonkeysv2\xa2\x02\x03AJX\xaa\x02\x12Anovel.Jsonkeys.V2\xca\x02\x12Anovel\\Jsonkeys\\V2\xe2\x02\x1eAnovel\\Jsonkeys\\V2\\GPBMetadata\xea\x02\x14Anovel::Jsonkeys::V2b\x06proto3"
+
+var (
+	file_anovel_jsonkeys_v2_revoked_token_sync_proto_rawDescOnce sync.Once
+	file_anovel_jsonkeys_v2_revoked_token_sync_proto_rawDescData []byte
+)
+
+func file_anovel_jsonkeys_v2_revoked_token_sync_proto_rawDescGZIP() []byte {
+	file_anovel_jsonkeys_v2_revoked_token_sync_proto_rawDescOnce.Do(func() {
+		file_anovel_jsonkeys_v2_revoked_token_sync_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_revoked_token_sync_proto_rawDesc), len(file_anovel_jsonkeys_v2_revoked_token_sync_proto_rawDesc)))
+	})
+	return file_anovel_jsonkeys_v2_revoked_token_sync_proto_rawDescData
+}
+
+var file_anovel_jsonkeys_v2_revoked_token_sync_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_anovel_jsonkeys_v2_revoked_token_sync_proto_goTypes = []any{
+	(*RevokedTokenSyncRequest)(nil),  // 0: anovel.jsonkeys.v2.RevokedTokenSyncRequest
+	(*RevokedTokenSyncResponse)(nil), // 1: anovel.jsonkeys.v2.RevokedTokenSyncResponse
+	(*timestamppb.Timestamp)(nil),    // 2: google.protobuf.Timestamp
+	(*RevokedToken)(nil),             // 3: anovel.jsonkeys.v2.RevokedToken
+}
+var file_anovel_jsonkeys_v2_revoked_token_sync_proto_depIdxs = []int32{
+	2, // 0: anovel.jsonkeys.v2.RevokedTokenSyncRequest.since:type_name -> google.protobuf.Timestamp
+	3, // 1: anovel.jsonkeys.v2.RevokedTokenSyncResponse.tokens:type_name -> anovel.jsonkeys.v2.RevokedToken
+	2, // 2: anovel.jsonkeys.v2.RevokedTokenSyncResponse.cursor:type_name -> google.protobuf.Timestamp
+	0, // 3: anovel.jsonkeys.v2.RevokedTokenSyncService.RevokedTokenSync:input_type -> anovel.jsonkeys.v2.RevokedTokenSyncRequest
+	1, // 4: anovel.jsonkeys.v2.RevokedTokenSyncService.RevokedTokenSync:output_type -> anovel.jsonkeys.v2.RevokedTokenSyncResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_anovel_jsonkeys_v2_revoked_token_sync_proto_init() }
+func file_anovel_jsonkeys_v2_revoked_token_sync_proto_init() {
+	if File_anovel_jsonkeys_v2_revoked_token_sync_proto != nil {
+		return
+	}
+	file_anovel_jsonkeys_v2_revoke_token_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_revoked_token_sync_proto_rawDesc), len(file_anovel_jsonkeys_v2_revoked_token_sync_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_anovel_jsonkeys_v2_revoked_token_sync_proto_goTypes,
+		DependencyIndexes: file_anovel_jsonkeys_v2_revoked_token_sync_proto_depIdxs,
+		MessageInfos:      file_anovel_jsonkeys_v2_revoked_token_sync_proto_msgTypes,
+	}.Build()
+	File_anovel_jsonkeys_v2_revoked_token_sync_proto = out.File
+	file_anovel_jsonkeys_v2_revoked_token_sync_proto_goTypes = nil
+	file_anovel_jsonkeys_v2_revoked_token_sync_proto_depIdxs = nil
+}
@@ -0,0 +1,134 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: anovel/jsonkeys/v2/revoked_token_sync.proto
+
+package jsonkeysv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RevokedTokenSyncService_RevokedTokenSync_FullMethodName = "/anovel.jsonkeys.v2.RevokedTokenSyncService/RevokedTokenSync"
+)
+
+// RevokedTokenSyncServiceClient is the client API for RevokedTokenSyncService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RevokedTokenSyncService lets verifiers keep a copy of the denylist of revoked tokens.
+type RevokedTokenSyncServiceClient interface {
+	// Returns the revocations of unexpired tokens made since a cursor. A verifier starts with an
+	// unset cursor, then passes the cursor of each response to the next call. Pulls overlap, so a
+	// revocation may be returned more than once. Requires an API key with the list operation when
+	// API keys are enforced.
+	RevokedTokenSync(ctx context.Context, in *RevokedTokenSyncRequest, opts ...grpc.CallOption) (*RevokedTokenSyncResponse, error)
+}
+
+type revokedTokenSyncServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRevokedTokenSyncServiceClient(cc grpc.ClientConnInterface) RevokedTokenSyncServiceClient {
+	return &revokedTokenSyncServiceClient{cc}
+}
+
+func (c *revokedTokenSyncServiceClient) RevokedTokenSync(ctx context.Context, in *RevokedTokenSyncRequest, opts ...grpc.CallOption) (*RevokedTokenSyncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokedTokenSyncResponse)
+	err := c.cc.Invoke(ctx, RevokedTokenSyncService_RevokedTokenSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RevokedTokenSyncServiceServer is the server API for RevokedTokenSyncService service.
+// All implementations must embed UnimplementedRevokedTokenSyncServiceServer
+// for forward compatibility.
+//
+// RevokedTokenSyncService lets verifiers keep a copy of the denylist of revoked tokens.
+type RevokedTokenSyncServiceServer interface {
+	// Returns the revocations of unexpired tokens made since a cursor. A verifier starts with an
+	// unset cursor, then passes the cursor of each response to the next call. Pulls overlap, so a
+	// revocation may be returned more than once. Requires an API key with the list operation when
+	// API keys are enforced.
+	RevokedTokenSync(context.Context, *RevokedTokenSyncRequest) (*RevokedTokenSyncResponse, error)
+	mustEmbedUnimplementedRevokedTokenSyncServiceServer()
+}
+
+// UnimplementedRevokedTokenSyncServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRevokedTokenSyncServiceServer struct{}
+
+func (UnimplementedRevokedTokenSyncServiceServer) RevokedTokenSync(context.Context, *RevokedTokenSyncRequest) (*RevokedTokenSyncResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokedTokenSync not implemented")
+}
+func (UnimplementedRevokedTokenSyncServiceServer) mustEmbedUnimplementedRevokedTokenSyncServiceServer() {
+}
+func (UnimplementedRevokedTokenSyncServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRevokedTokenSyncServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RevokedTokenSyncServiceServer will
+// result in compilation errors.
+type UnsafeRevokedTokenSyncServiceServer interface {
+	mustEmbedUnimplementedRevokedTokenSyncServiceServer()
+}
+
+func RegisterRevokedTokenSyncServiceServer(s grpc.ServiceRegistrar, srv RevokedTokenSyncServiceServer) {
+	// If the following call panics, it indicates UnimplementedRevokedTokenSyncServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RevokedTokenSyncService_ServiceDesc, srv)
+}
+
+func _RevokedTokenSyncService_RevokedTokenSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokedTokenSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RevokedTokenSyncServiceServer).RevokedTokenSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RevokedTokenSyncService_RevokedTokenSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RevokedTokenSyncServiceServer).RevokedTokenSync(ctx, req.(*RevokedTokenSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RevokedTokenSyncService_ServiceDesc is the grpc.ServiceDesc for RevokedTokenSyncService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RevokedTokenSyncService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "anovel.jsonkeys.v2.RevokedTokenSyncService",
+	HandlerType: (*RevokedTokenSyncServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RevokedTokenSync",
+			Handler:    _RevokedTokenSyncService_RevokedTokenSync_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anovel/jsonkeys/v2/revoked_token_sync.proto",
+}
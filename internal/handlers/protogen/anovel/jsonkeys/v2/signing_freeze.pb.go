@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: anovel/jsonkeys/v2/signing_freeze.proto
+
+package jsonkeysv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SigningFreezeRequest names the usage to freeze or unfreeze.
+type SigningFreezeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The key usage to freeze or unfreeze.
+	Usage string `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Freezes the usage when set, and lifts its freeze otherwise. Both are idempotent: freezing a
+	// frozen usage keeps its original freeze.
+	Frozen bool `protobuf:"varint,2,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// The reason of the freeze, kept with it and in the audit trail. Required to freeze.
+	Comment       string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SigningFreezeRequest) Reset() {
+	*x = SigningFreezeRequest{}
+	mi := &file_anovel_jsonkeys_v2_signing_freeze_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SigningFreezeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningFreezeRequest) ProtoMessage() {}
+
+func (x *SigningFreezeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_signing_freeze_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigningFreezeRequest.ProtoReflect.Descriptor instead.
+func (*SigningFreezeRequest) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_signing_freeze_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SigningFreezeRequest) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *SigningFreezeRequest) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *SigningFreezeRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// SigningFreezeResponse describes the freeze of the usage after the request.
+type SigningFreezeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// True when the signing of the usage is frozen.
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// The reason of the freeze. Empty when the usage is not frozen.
+	Comment string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	// When the usage was frozen. Unset when it is not.
+	FrozenAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=frozen_at,json=frozenAt,proto3" json:"frozen_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SigningFreezeResponse) Reset() {
+	*x = SigningFreezeResponse{}
+	mi := &file_anovel_jsonkeys_v2_signing_freeze_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SigningFreezeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningFreezeResponse) ProtoMessage() {}
+
+func (x *SigningFreezeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_signing_freeze_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigningFreezeResponse.ProtoReflect.Descriptor instead.
+func (*SigningFreezeResponse) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_signing_freeze_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SigningFreezeResponse) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *SigningFreezeResponse) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SigningFreezeResponse) GetFrozenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FrozenAt
+	}
+	return nil
+}
+
+var File_anovel_jsonkeys_v2_signing_freeze_proto protoreflect.FileDescriptor
+
+const file_anovel_jsonkeys_v2_signing_freeze_proto_rawDesc = "" +
+	"\n" +
+	"'anovel/jsonkeys/v2/signing_freeze.proto\x12\x12anovel.jsonkeys.v2\x1a\x1fgoogle/protobuf/timestamp.proto\"^\n" +
+	"\x14SigningFreezeRequest\x12\x14\n" +
+	"\x05usage\x18\x01 \x01(\tR\x05usage\x12\x16\n" +
+	"\x06frozen\x18\x02 \x01(\bR\x06frozen\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"\x82\x01\n" +
+	"\x15SigningFreezeResponse\x12\x16\n" +
+	"\x06frozen\x18\x01 \x01(\bR\x06frozen\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\tR\acomment\x127\n" +
+	"\tfrozen_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bfrozenAt2|\n" +
+	"\x14SigningFreezeService\x12d\n" +
+	"\rSigningFreeze\x12(.anovel.jsonkeys.v2.SigningFreezeRequest\x1a).anovel.jsonkeys.v2.SigningFreezeResponseB\xf8\x01\n" +
+	"\x16com.anovel.jsonkeys.v2B\x12SigningFreezeProtoP\x01Z`github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2;jsonkeysv2\xa2\x02\x03AJX\xaa\x02\x12Anovel.Jsonkeys.V2\xca\x02\x12Anovel\\Jsonkeys\\V2\xe2\x02\x1eAnovel\\Jsonkeys\\V2\\GPBMetadata\xea\x02\x14Anovel::Jsonkeys::V2b\x06proto3"
+
+var (
+	file_anovel_jsonkeys_v2_signing_freeze_proto_rawDescOnce sync.Once
+	file_anovel_jsonkeys_v2_signing_freeze_proto_rawDescData []byte
+)
+
+func file_anovel_jsonkeys_v2_signing_freeze_proto_rawDescGZIP() []byte {
+	file_anovel_jsonkeys_v2_signing_freeze_proto_rawDescOnce.Do(func() {
+		file_anovel_jsonkeys_v2_signing_freeze_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_signing_freeze_proto_rawDesc), len(file_anovel_jsonkeys_v2_signing_freeze_proto_rawDesc)))
+	})
+	return file_anovel_jsonkeys_v2_signing_freeze_proto_rawDescData
+}
+
+var file_anovel_jsonkeys_v2_signing_freeze_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_anovel_jsonkeys_v2_signing_freeze_proto_goTypes = []any{
+	(*SigningFreezeRequest)(nil),  // 0: anovel.jsonkeys.v2.SigningFreezeRequest
+	(*SigningFreezeResponse)(nil), // 1: anovel.jsonkeys.v2.SigningFreezeResponse
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_anovel_jsonkeys_v2_signing_freeze_proto_depIdxs = []int32{
+	2, // 0: anovel.jsonkeys.v2.SigningFreezeResponse.frozen_at:type_name -> google.protobuf.Timestamp
+	0, // 1: anovel.jsonkeys.v2.SigningFreezeService.SigningFreeze:input_type -> anovel.jsonkeys.v2.SigningFreezeRequest
+	1, // 2: anovel.jsonkeys.v2.SigningFreezeService.SigningFreeze:output_type -> anovel.jsonkeys.v2.SigningFreezeResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_anovel_jsonkeys_v2_signing_freeze_proto_init() }
+func file_anovel_jsonkeys_v2_signing_freeze_proto_init() {
+	if File_anovel_jsonkeys_v2_signing_freeze_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_signing_freeze_proto_rawDesc), len(file_anovel_jsonkeys_v2_signing_freeze_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_anovel_jsonkeys_v2_signing_freeze_proto_goTypes,
+		DependencyIndexes: file_anovel_jsonkeys_v2_signing_freeze_proto_depIdxs,
+		MessageInfos:      file_anovel_jsonkeys_v2_signing_freeze_proto_msgTypes,
+	}.Build()
+	File_anovel_jsonkeys_v2_signing_freeze_proto = out.File
+	file_anovel_jsonkeys_v2_signing_freeze_proto_goTypes = nil
+	file_anovel_jsonkeys_v2_signing_freeze_proto_depIdxs = nil
+}
@@ -0,0 +1,137 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: anovel/jsonkeys/v2/signing_freeze.proto
+
+package jsonkeysv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SigningFreezeService_SigningFreeze_FullMethodName = "/anovel.jsonkeys.v2.SigningFreezeService/SigningFreeze"
+)
+
+// SigningFreezeServiceClient is the client API for SigningFreezeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SigningFreezeService stops, or resumes, the signing of a usage.
+type SigningFreezeServiceClient interface {
+	// Freezes the signing of a usage, or lifts its freeze. While a usage is frozen, ClaimsSign,
+	// PayloadSign and HttpSignatureSign answer FAILED_PRECONDITION for it; its tokens still
+	// verify, and its public keys stay published. Servers apply a change within a second.
+	// Requires an API key with the admin operation on the usage, or an admin client certificate.
+	// Returns NOT_FOUND if the usage is not configured, and INVALID_ARGUMENT if the usage is
+	// frozen without a comment.
+	SigningFreeze(ctx context.Context, in *SigningFreezeRequest, opts ...grpc.CallOption) (*SigningFreezeResponse, error)
+}
+
+type signingFreezeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSigningFreezeServiceClient(cc grpc.ClientConnInterface) SigningFreezeServiceClient {
+	return &signingFreezeServiceClient{cc}
+}
+
+func (c *signingFreezeServiceClient) SigningFreeze(ctx context.Context, in *SigningFreezeRequest, opts ...grpc.CallOption) (*SigningFreezeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SigningFreezeResponse)
+	err := c.cc.Invoke(ctx, SigningFreezeService_SigningFreeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SigningFreezeServiceServer is the server API for SigningFreezeService service.
+// All implementations must embed UnimplementedSigningFreezeServiceServer
+// for forward compatibility.
+//
+// SigningFreezeService stops, or resumes, the signing of a usage.
+type SigningFreezeServiceServer interface {
+	// Freezes the signing of a usage, or lifts its freeze. While a usage is frozen, ClaimsSign,
+	// PayloadSign and HttpSignatureSign answer FAILED_PRECONDITION for it; its tokens still
+	// verify, and its public keys stay published. Servers apply a change within a second.
+	// Requires an API key with the admin operation on the usage, or an admin client certificate.
+	// Returns NOT_FOUND if the usage is not configured, and INVALID_ARGUMENT if the usage is
+	// frozen without a comment.
+	SigningFreeze(context.Context, *SigningFreezeRequest) (*SigningFreezeResponse, error)
+	mustEmbedUnimplementedSigningFreezeServiceServer()
+}
+
+// UnimplementedSigningFreezeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSigningFreezeServiceServer struct{}
+
+func (UnimplementedSigningFreezeServiceServer) SigningFreeze(context.Context, *SigningFreezeRequest) (*SigningFreezeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SigningFreeze not implemented")
+}
+func (UnimplementedSigningFreezeServiceServer) mustEmbedUnimplementedSigningFreezeServiceServer() {}
+func (UnimplementedSigningFreezeServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeSigningFreezeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SigningFreezeServiceServer will
+// result in compilation errors.
+type UnsafeSigningFreezeServiceServer interface {
+	mustEmbedUnimplementedSigningFreezeServiceServer()
+}
+
+func RegisterSigningFreezeServiceServer(s grpc.ServiceRegistrar, srv SigningFreezeServiceServer) {
+	// If the following call panics, it indicates UnimplementedSigningFreezeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SigningFreezeService_ServiceDesc, srv)
+}
+
+func _SigningFreezeService_SigningFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SigningFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SigningFreezeServiceServer).SigningFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SigningFreezeService_SigningFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SigningFreezeServiceServer).SigningFreeze(ctx, req.(*SigningFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SigningFreezeService_ServiceDesc is the grpc.ServiceDesc for SigningFreezeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SigningFreezeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "anovel.jsonkeys.v2.SigningFreezeService",
+	HandlerType: (*SigningFreezeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SigningFreeze",
+			Handler:    _SigningFreezeService_SigningFreeze_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anovel/jsonkeys/v2/signing_freeze.proto",
+}
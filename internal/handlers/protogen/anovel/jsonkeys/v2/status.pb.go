@@ -9,6 +9,8 @@ package jsonkeysv2
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -77,6 +79,118 @@ func (DependencyStatus) EnumDescriptor() ([]byte, []int) {
 	return file_anovel_jsonkeys_v2_status_proto_rawDescGZIP(), []int{0}
 }
 
+// ServiceStatus summarizes the health of the whole service.
+type ServiceStatus int32
+
+const (
+	// SERVICE_STATUS_UNSPECIFIED means the application has not assessed its status.
+	ServiceStatus_SERVICE_STATUS_UNSPECIFIED ServiceStatus = 0
+	// SERVICE_STATUS_UP means every dependency is up, and every configured usage can sign.
+	ServiceStatus_SERVICE_STATUS_UP ServiceStatus = 1
+	// SERVICE_STATUS_DEGRADED means a dependency is down, or a configured usage cannot sign.
+	ServiceStatus_SERVICE_STATUS_DEGRADED ServiceStatus = 2
+)
+
+// Enum value maps for ServiceStatus.
+var (
+	ServiceStatus_name = map[int32]string{
+		0: "SERVICE_STATUS_UNSPECIFIED",
+		1: "SERVICE_STATUS_UP",
+		2: "SERVICE_STATUS_DEGRADED",
+	}
+	ServiceStatus_value = map[string]int32{
+		"SERVICE_STATUS_UNSPECIFIED": 0,
+		"SERVICE_STATUS_UP":          1,
+		"SERVICE_STATUS_DEGRADED":    2,
+	}
+)
+
+func (x ServiceStatus) Enum() *ServiceStatus {
+	p := new(ServiceStatus)
+	*p = x
+	return p
+}
+
+func (x ServiceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServiceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_anovel_jsonkeys_v2_status_proto_enumTypes[1].Descriptor()
+}
+
+func (ServiceStatus) Type() protoreflect.EnumType {
+	return &file_anovel_jsonkeys_v2_status_proto_enumTypes[1]
+}
+
+func (x ServiceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServiceStatus.Descriptor instead.
+func (ServiceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_status_proto_rawDescGZIP(), []int{1}
+}
+
+// KeyHealthStatus classifies the state of a usage's keys.
+type KeyHealthStatus int32
+
+const (
+	// KEY_HEALTH_STATUS_UNSPECIFIED means the keys of the usage could not be read.
+	KeyHealthStatus_KEY_HEALTH_STATUS_UNSPECIFIED KeyHealthStatus = 0
+	// KEY_HEALTH_STATUS_OK means the usage has a main key, rotated on schedule.
+	KeyHealthStatus_KEY_HEALTH_STATUS_OK KeyHealthStatus = 1
+	// KEY_HEALTH_STATUS_STALLED means the usage can sign, but its main key is older than twice
+	// its rotation interval: the rotation job has stopped running.
+	KeyHealthStatus_KEY_HEALTH_STATUS_STALLED KeyHealthStatus = 2
+	// KEY_HEALTH_STATUS_UNAVAILABLE means the usage has no active key of its algorithm, and cannot
+	// sign: mid-migration, its keys may all use a previous algorithm.
+	KeyHealthStatus_KEY_HEALTH_STATUS_UNAVAILABLE KeyHealthStatus = 3
+)
+
+// Enum value maps for KeyHealthStatus.
+var (
+	KeyHealthStatus_name = map[int32]string{
+		0: "KEY_HEALTH_STATUS_UNSPECIFIED",
+		1: "KEY_HEALTH_STATUS_OK",
+		2: "KEY_HEALTH_STATUS_STALLED",
+		3: "KEY_HEALTH_STATUS_UNAVAILABLE",
+	}
+	KeyHealthStatus_value = map[string]int32{
+		"KEY_HEALTH_STATUS_UNSPECIFIED": 0,
+		"KEY_HEALTH_STATUS_OK":          1,
+		"KEY_HEALTH_STATUS_STALLED":     2,
+		"KEY_HEALTH_STATUS_UNAVAILABLE": 3,
+	}
+)
+
+func (x KeyHealthStatus) Enum() *KeyHealthStatus {
+	p := new(KeyHealthStatus)
+	*p = x
+	return p
+}
+
+func (x KeyHealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyHealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_anovel_jsonkeys_v2_status_proto_enumTypes[2].Descriptor()
+}
+
+func (KeyHealthStatus) Type() protoreflect.EnumType {
+	return &file_anovel_jsonkeys_v2_status_proto_enumTypes[2]
+}
+
+func (x KeyHealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyHealthStatus.Descriptor instead.
+func (KeyHealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_status_proto_rawDescGZIP(), []int{2}
+}
+
 // DependencyHealth reports the health of a single external dependency.
 //
 // The message carries the status alone. Health-check errors embed internal hostnames, ports and
@@ -127,6 +241,302 @@ func (x *DependencyHealth) GetStatus() DependencyStatus {
 	return DependencyStatus_DEPENDENCY_STATUS_UNSPECIFIED
 }
 
+// KeyHealth reports the state of a usage's keys against its rotation schedule. Fields other than
+// status are unset when the keys could not be read.
+type KeyHealth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The overall state of the usage's keys.
+	Status KeyHealthStatus `protobuf:"varint,1,opt,name=status,proto3,enum=anovel.jsonkeys.v2.KeyHealthStatus" json:"status,omitempty"`
+	// True when the usage has a key of its algorithm to sign with.
+	HasMainKey bool `protobuf:"varint,2,opt,name=has_main_key,json=hasMainKey,proto3" json:"has_main_key,omitempty"`
+	// The age of the main key. Unset when the usage cannot sign.
+	MainKeyAge *durationpb.Duration `protobuf:"bytes,3,opt,name=main_key_age,json=mainKeyAge,proto3" json:"main_key_age,omitempty"`
+	// The configured rotation interval. The main key is due for rotation once it is older.
+	Rotation *durationpb.Duration `protobuf:"bytes,4,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	// The number of active keys other than the main key, still used for verification.
+	LegacyKeys int32 `protobuf:"varint,5,opt,name=legacy_keys,json=legacyKeys,proto3" json:"legacy_keys,omitempty"`
+	// When the next active key expires. Unset when the usage has no active key.
+	NextExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_expires_at,json=nextExpiresAt,proto3" json:"next_expires_at,omitempty"`
+	// True when the main key is older than twice the rotation interval.
+	RotationStalled bool `protobuf:"varint,7,opt,name=rotation_stalled,json=rotationStalled,proto3" json:"rotation_stalled,omitempty"`
+	// True when the signing of the usage is frozen: no token is issued for it, though its keys
+	// are reported as usual. A freeze is deliberate, and does not degrade the status.
+	SigningFrozen bool `protobuf:"varint,8,opt,name=signing_frozen,json=signingFrozen,proto3" json:"signing_frozen,omitempty"`
+	// When the signing of the usage was frozen. Unset when it is not.
+	SigningFrozenAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=signing_frozen_at,json=signingFrozenAt,proto3" json:"signing_frozen_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *KeyHealth) Reset() {
+	*x = KeyHealth{}
+	mi := &file_anovel_jsonkeys_v2_status_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyHealth) ProtoMessage() {}
+
+func (x *KeyHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_status_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyHealth.ProtoReflect.Descriptor instead.
+func (*KeyHealth) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_status_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KeyHealth) GetStatus() KeyHealthStatus {
+	if x != nil {
+		return x.Status
+	}
+	return KeyHealthStatus_KEY_HEALTH_STATUS_UNSPECIFIED
+}
+
+func (x *KeyHealth) GetHasMainKey() bool {
+	if x != nil {
+		return x.HasMainKey
+	}
+	return false
+}
+
+func (x *KeyHealth) GetMainKeyAge() *durationpb.Duration {
+	if x != nil {
+		return x.MainKeyAge
+	}
+	return nil
+}
+
+func (x *KeyHealth) GetRotation() *durationpb.Duration {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+func (x *KeyHealth) GetLegacyKeys() int32 {
+	if x != nil {
+		return x.LegacyKeys
+	}
+	return 0
+}
+
+func (x *KeyHealth) GetNextExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextExpiresAt
+	}
+	return nil
+}
+
+func (x *KeyHealth) GetRotationStalled() bool {
+	if x != nil {
+		return x.RotationStalled
+	}
+	return false
+}
+
+func (x *KeyHealth) GetSigningFrozen() bool {
+	if x != nil {
+		return x.SigningFrozen
+	}
+	return false
+}
+
+func (x *KeyHealth) GetSigningFrozenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SigningFrozenAt
+	}
+	return nil
+}
+
+// AlgMigration reports the progress of a usage moving from its previous signing algorithms to its
+// current one. Tokens of a previous algorithm remain verifiable until the last key using it expires.
+type AlgMigration struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The algorithm new keys and tokens use.
+	Alg string `protobuf:"bytes,1,opt,name=alg,proto3" json:"alg,omitempty"`
+	// The previous algorithms, still accepted for verification.
+	PreviousAlgs []string `protobuf:"bytes,2,rep,name=previous_algs,json=previousAlgs,proto3" json:"previous_algs,omitempty"`
+	// The number of active keys that do not use alg.
+	LegacyKeys int32 `protobuf:"varint,3,opt,name=legacy_keys,json=legacyKeys,proto3" json:"legacy_keys,omitempty"`
+	// When the last legacy key expires. Unset when none remain.
+	LegacyExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=legacy_expires_at,json=legacyExpiresAt,proto3" json:"legacy_expires_at,omitempty"`
+	// True once every active key uses alg. The previous algorithms can then be removed from the
+	// usage configuration.
+	Complete      bool `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlgMigration) Reset() {
+	*x = AlgMigration{}
+	mi := &file_anovel_jsonkeys_v2_status_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlgMigration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlgMigration) ProtoMessage() {}
+
+func (x *AlgMigration) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_status_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlgMigration.ProtoReflect.Descriptor instead.
+func (*AlgMigration) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_status_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AlgMigration) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *AlgMigration) GetPreviousAlgs() []string {
+	if x != nil {
+		return x.PreviousAlgs
+	}
+	return nil
+}
+
+func (x *AlgMigration) GetLegacyKeys() int32 {
+	if x != nil {
+		return x.LegacyKeys
+	}
+	return 0
+}
+
+func (x *AlgMigration) GetLegacyExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LegacyExpiresAt
+	}
+	return nil
+}
+
+func (x *AlgMigration) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+// RotationSchedule reports the key rotation the gRPC servers run themselves, when enabled. One
+// server, the leader, runs it for all of them.
+type RotationSchedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time between two rotations.
+	Interval *durationpb.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// True when the server answering is the leader.
+	Leader bool `protobuf:"varint,2,opt,name=leader,proto3" json:"leader,omitempty"`
+	// True when a server is the leader. Rotations stop when none is.
+	LeaderElected bool `protobuf:"varint,3,opt,name=leader_elected,json=leaderElected,proto3" json:"leader_elected,omitempty"`
+	// When the last rotation completed. Unset before the first.
+	LastRunAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	// True when the last rotation failed. The error is in the leader's logs and traces.
+	LastRunFailed bool `protobuf:"varint,5,opt,name=last_run_failed,json=lastRunFailed,proto3" json:"last_run_failed,omitempty"`
+	// When the next rotation is due. Unset before the first.
+	NextRunAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotationSchedule) Reset() {
+	*x = RotationSchedule{}
+	mi := &file_anovel_jsonkeys_v2_status_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotationSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotationSchedule) ProtoMessage() {}
+
+func (x *RotationSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_anovel_jsonkeys_v2_status_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotationSchedule.ProtoReflect.Descriptor instead.
+func (*RotationSchedule) Descriptor() ([]byte, []int) {
+	return file_anovel_jsonkeys_v2_status_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RotationSchedule) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *RotationSchedule) GetLeader() bool {
+	if x != nil {
+		return x.Leader
+	}
+	return false
+}
+
+func (x *RotationSchedule) GetLeaderElected() bool {
+	if x != nil {
+		return x.LeaderElected
+	}
+	return false
+}
+
+func (x *RotationSchedule) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
+
+func (x *RotationSchedule) GetLastRunFailed() bool {
+	if x != nil {
+		return x.LastRunFailed
+	}
+	return false
+}
+
+func (x *RotationSchedule) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
 // StatusRequest carries no parameters; the server checks all dependencies automatically.
 type StatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -136,7 +546,7 @@ type StatusRequest struct {
 
 func (x *StatusRequest) Reset() {
 	*x = StatusRequest{}
-	mi := &file_anovel_jsonkeys_v2_status_proto_msgTypes[1]
+	mi := &file_anovel_jsonkeys_v2_status_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -148,7 +558,7 @@ func (x *StatusRequest) String() string {
 func (*StatusRequest) ProtoMessage() {}
 
 func (x *StatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_anovel_jsonkeys_v2_status_proto_msgTypes[1]
+	mi := &file_anovel_jsonkeys_v2_status_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,21 +571,31 @@ func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return file_anovel_jsonkeys_v2_status_proto_rawDescGZIP(), []int{1}
+	return file_anovel_jsonkeys_v2_status_proto_rawDescGZIP(), []int{4}
 }
 
 // StatusResponse reports the health of all service dependencies checked at request time.
 type StatusResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The health of the PostgreSQL database dependency.
-	Postgres      *DependencyHealth `protobuf:"bytes,1,opt,name=postgres,proto3" json:"postgres,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Postgres *DependencyHealth `protobuf:"bytes,1,opt,name=postgres,proto3" json:"postgres,omitempty"`
+	// Algorithm migrations, keyed by usage. Only usages configured with previous algorithms are
+	// listed; a usage whose keys could not be read is omitted.
+	AlgMigrations map[string]*AlgMigration `protobuf:"bytes,2,rep,name=alg_migrations,json=algMigrations,proto3" json:"alg_migrations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Key health, keyed by usage, for every configured usage.
+	Keys map[string]*KeyHealth `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The overall status: degraded when a dependency is down, or a usage cannot sign.
+	Status ServiceStatus `protobuf:"varint,4,opt,name=status,proto3,enum=anovel.jsonkeys.v2.ServiceStatus" json:"status,omitempty"`
+	// The key rotation scheduled inside the servers. Unset when the schedule is disabled, or could
+	// not be read.
+	RotationSchedule *RotationSchedule `protobuf:"bytes,5,opt,name=rotation_schedule,json=rotationSchedule,proto3" json:"rotation_schedule,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
-	mi := &file_anovel_jsonkeys_v2_status_proto_msgTypes[2]
+	mi := &file_anovel_jsonkeys_v2_status_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -187,7 +607,7 @@ func (x *StatusResponse) String() string {
 func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_anovel_jsonkeys_v2_status_proto_msgTypes[2]
+	mi := &file_anovel_jsonkeys_v2_status_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,7 +620,7 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_anovel_jsonkeys_v2_status_proto_rawDescGZIP(), []int{2}
+	return file_anovel_jsonkeys_v2_status_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *StatusResponse) GetPostgres() *DependencyHealth {
@@ -210,20 +630,94 @@ func (x *StatusResponse) GetPostgres() *DependencyHealth {
 	return nil
 }
 
+func (x *StatusResponse) GetAlgMigrations() map[string]*AlgMigration {
+	if x != nil {
+		return x.AlgMigrations
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetKeys() map[string]*KeyHealth {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetStatus() ServiceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ServiceStatus_SERVICE_STATUS_UNSPECIFIED
+}
+
+func (x *StatusResponse) GetRotationSchedule() *RotationSchedule {
+	if x != nil {
+		return x.RotationSchedule
+	}
+	return nil
+}
+
 var File_anovel_jsonkeys_v2_status_proto protoreflect.FileDescriptor
 
 const file_anovel_jsonkeys_v2_status_proto_rawDesc = "" +
 	"\n" +
-	"\x1fanovel/jsonkeys/v2/status.proto\x12\x12anovel.jsonkeys.v2\"[\n" +
+	"\x1fanovel/jsonkeys/v2/status.proto\x12\x12anovel.jsonkeys.v2\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"[\n" +
 	"\x10DependencyHealth\x12<\n" +
-	"\x06status\x18\x01 \x01(\x0e2$.anovel.jsonkeys.v2.DependencyStatusR\x06statusJ\x04\b\x02\x10\x03R\x03err\"\x0f\n" +
-	"\rStatusRequest\"R\n" +
+	"\x06status\x18\x01 \x01(\x0e2$.anovel.jsonkeys.v2.DependencyStatusR\x06statusJ\x04\b\x02\x10\x03R\x03err\"\xdd\x03\n" +
+	"\tKeyHealth\x12;\n" +
+	"\x06status\x18\x01 \x01(\x0e2#.anovel.jsonkeys.v2.KeyHealthStatusR\x06status\x12 \n" +
+	"\fhas_main_key\x18\x02 \x01(\bR\n" +
+	"hasMainKey\x12;\n" +
+	"\fmain_key_age\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"mainKeyAge\x125\n" +
+	"\brotation\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\brotation\x12\x1f\n" +
+	"\vlegacy_keys\x18\x05 \x01(\x05R\n" +
+	"legacyKeys\x12B\n" +
+	"\x0fnext_expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rnextExpiresAt\x12)\n" +
+	"\x10rotation_stalled\x18\a \x01(\bR\x0frotationStalled\x12%\n" +
+	"\x0esigning_frozen\x18\b \x01(\bR\rsigningFrozen\x12F\n" +
+	"\x11signing_frozen_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0fsigningFrozenAt\"\xca\x01\n" +
+	"\fAlgMigration\x12\x10\n" +
+	"\x03alg\x18\x01 \x01(\tR\x03alg\x12#\n" +
+	"\rprevious_algs\x18\x02 \x03(\tR\fpreviousAlgs\x12\x1f\n" +
+	"\vlegacy_keys\x18\x03 \x01(\x05R\n" +
+	"legacyKeys\x12F\n" +
+	"\x11legacy_expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0flegacyExpiresAt\x12\x1a\n" +
+	"\bcomplete\x18\x05 \x01(\bR\bcomplete\"\xa8\x02\n" +
+	"\x10RotationSchedule\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x16\n" +
+	"\x06leader\x18\x02 \x01(\bR\x06leader\x12%\n" +
+	"\x0eleader_elected\x18\x03 \x01(\bR\rleaderElected\x12:\n" +
+	"\vlast_run_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tlastRunAt\x12&\n" +
+	"\x0flast_run_failed\x18\x05 \x01(\bR\rlastRunFailed\x12:\n" +
+	"\vnext_run_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\"\x0f\n" +
+	"\rStatusRequest\"\xbc\x04\n" +
 	"\x0eStatusResponse\x12@\n" +
-	"\bpostgres\x18\x01 \x01(\v2$.anovel.jsonkeys.v2.DependencyHealthR\bpostgres*k\n" +
+	"\bpostgres\x18\x01 \x01(\v2$.anovel.jsonkeys.v2.DependencyHealthR\bpostgres\x12\\\n" +
+	"\x0ealg_migrations\x18\x02 \x03(\v25.anovel.jsonkeys.v2.StatusResponse.AlgMigrationsEntryR\ralgMigrations\x12@\n" +
+	"\x04keys\x18\x03 \x03(\v2,.anovel.jsonkeys.v2.StatusResponse.KeysEntryR\x04keys\x129\n" +
+	"\x06status\x18\x04 \x01(\x0e2!.anovel.jsonkeys.v2.ServiceStatusR\x06status\x12Q\n" +
+	"\x11rotation_schedule\x18\x05 \x01(\v2$.anovel.jsonkeys.v2.RotationScheduleR\x10rotationSchedule\x1ab\n" +
+	"\x12AlgMigrationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x126\n" +
+	"\x05value\x18\x02 \x01(\v2 .anovel.jsonkeys.v2.AlgMigrationR\x05value:\x028\x01\x1aV\n" +
+	"\tKeysEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.anovel.jsonkeys.v2.KeyHealthR\x05value:\x028\x01*k\n" +
 	"\x10DependencyStatus\x12!\n" +
 	"\x1dDEPENDENCY_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14DEPENDENCY_STATUS_UP\x10\x01\x12\x1a\n" +
-	"\x16DEPENDENCY_STATUS_DOWN\x10\x022`\n" +
+	"\x16DEPENDENCY_STATUS_DOWN\x10\x02*c\n" +
+	"\rServiceStatus\x12\x1e\n" +
+	"\x1aSERVICE_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11SERVICE_STATUS_UP\x10\x01\x12\x1b\n" +
+	"\x17SERVICE_STATUS_DEGRADED\x10\x02*\x90\x01\n" +
+	"\x0fKeyHealthStatus\x12!\n" +
+	"\x1dKEY_HEALTH_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14KEY_HEALTH_STATUS_OK\x10\x01\x12\x1d\n" +
+	"\x19KEY_HEALTH_STATUS_STALLED\x10\x02\x12!\n" +
+	"\x1dKEY_HEALTH_STATUS_UNAVAILABLE\x10\x032`\n" +
 	"\rStatusService\x12O\n" +
 	"\x06Status\x12!.anovel.jsonkeys.v2.StatusRequest\x1a\".anovel.jsonkeys.v2.StatusResponseB\xf1\x01\n" +
 	"\x16com.anovel.jsonkeys.v2B\vStatusProtoP\x01Z`github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2;jsonkeysv2\xa2\x02\x03AJX\xaa\x02\x12Anovel.Jsonkeys.V2\xca\x02\x12Anovel\\Jsonkeys\\V2\xe2\x02\x1eAnovel\\Jsonkeys\\V2\\GPBMetadata\xea\x02\x14Anovel::Jsonkeys::V2b\x06proto3"
@@ -240,24 +734,48 @@ func file_anovel_jsonkeys_v2_status_proto_rawDescGZIP() []byte {
 	return file_anovel_jsonkeys_v2_status_proto_rawDescData
 }
 
-var file_anovel_jsonkeys_v2_status_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_anovel_jsonkeys_v2_status_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_anovel_jsonkeys_v2_status_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_anovel_jsonkeys_v2_status_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_anovel_jsonkeys_v2_status_proto_goTypes = []any{
-	(DependencyStatus)(0),    // 0: anovel.jsonkeys.v2.DependencyStatus
-	(*DependencyHealth)(nil), // 1: anovel.jsonkeys.v2.DependencyHealth
-	(*StatusRequest)(nil),    // 2: anovel.jsonkeys.v2.StatusRequest
-	(*StatusResponse)(nil),   // 3: anovel.jsonkeys.v2.StatusResponse
+	(DependencyStatus)(0),         // 0: anovel.jsonkeys.v2.DependencyStatus
+	(ServiceStatus)(0),            // 1: anovel.jsonkeys.v2.ServiceStatus
+	(KeyHealthStatus)(0),          // 2: anovel.jsonkeys.v2.KeyHealthStatus
+	(*DependencyHealth)(nil),      // 3: anovel.jsonkeys.v2.DependencyHealth
+	(*KeyHealth)(nil),             // 4: anovel.jsonkeys.v2.KeyHealth
+	(*AlgMigration)(nil),          // 5: anovel.jsonkeys.v2.AlgMigration
+	(*RotationSchedule)(nil),      // 6: anovel.jsonkeys.v2.RotationSchedule
+	(*StatusRequest)(nil),         // 7: anovel.jsonkeys.v2.StatusRequest
+	(*StatusResponse)(nil),        // 8: anovel.jsonkeys.v2.StatusResponse
+	nil,                           // 9: anovel.jsonkeys.v2.StatusResponse.AlgMigrationsEntry
+	nil,                           // 10: anovel.jsonkeys.v2.StatusResponse.KeysEntry
+	(*durationpb.Duration)(nil),   // 11: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
 }
 var file_anovel_jsonkeys_v2_status_proto_depIdxs = []int32{
-	0, // 0: anovel.jsonkeys.v2.DependencyHealth.status:type_name -> anovel.jsonkeys.v2.DependencyStatus
-	1, // 1: anovel.jsonkeys.v2.StatusResponse.postgres:type_name -> anovel.jsonkeys.v2.DependencyHealth
-	2, // 2: anovel.jsonkeys.v2.StatusService.Status:input_type -> anovel.jsonkeys.v2.StatusRequest
-	3, // 3: anovel.jsonkeys.v2.StatusService.Status:output_type -> anovel.jsonkeys.v2.StatusResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: anovel.jsonkeys.v2.DependencyHealth.status:type_name -> anovel.jsonkeys.v2.DependencyStatus
+	2,  // 1: anovel.jsonkeys.v2.KeyHealth.status:type_name -> anovel.jsonkeys.v2.KeyHealthStatus
+	11, // 2: anovel.jsonkeys.v2.KeyHealth.main_key_age:type_name -> google.protobuf.Duration
+	11, // 3: anovel.jsonkeys.v2.KeyHealth.rotation:type_name -> google.protobuf.Duration
+	12, // 4: anovel.jsonkeys.v2.KeyHealth.next_expires_at:type_name -> google.protobuf.Timestamp
+	12, // 5: anovel.jsonkeys.v2.KeyHealth.signing_frozen_at:type_name -> google.protobuf.Timestamp
+	12, // 6: anovel.jsonkeys.v2.AlgMigration.legacy_expires_at:type_name -> google.protobuf.Timestamp
+	11, // 7: anovel.jsonkeys.v2.RotationSchedule.interval:type_name -> google.protobuf.Duration
+	12, // 8: anovel.jsonkeys.v2.RotationSchedule.last_run_at:type_name -> google.protobuf.Timestamp
+	12, // 9: anovel.jsonkeys.v2.RotationSchedule.next_run_at:type_name -> google.protobuf.Timestamp
+	3,  // 10: anovel.jsonkeys.v2.StatusResponse.postgres:type_name -> anovel.jsonkeys.v2.DependencyHealth
+	9,  // 11: anovel.jsonkeys.v2.StatusResponse.alg_migrations:type_name -> anovel.jsonkeys.v2.StatusResponse.AlgMigrationsEntry
+	10, // 12: anovel.jsonkeys.v2.StatusResponse.keys:type_name -> anovel.jsonkeys.v2.StatusResponse.KeysEntry
+	1,  // 13: anovel.jsonkeys.v2.StatusResponse.status:type_name -> anovel.jsonkeys.v2.ServiceStatus
+	6,  // 14: anovel.jsonkeys.v2.StatusResponse.rotation_schedule:type_name -> anovel.jsonkeys.v2.RotationSchedule
+	5,  // 15: anovel.jsonkeys.v2.StatusResponse.AlgMigrationsEntry.value:type_name -> anovel.jsonkeys.v2.AlgMigration
+	4,  // 16: anovel.jsonkeys.v2.StatusResponse.KeysEntry.value:type_name -> anovel.jsonkeys.v2.KeyHealth
+	7,  // 17: anovel.jsonkeys.v2.StatusService.Status:input_type -> anovel.jsonkeys.v2.StatusRequest
+	8,  // 18: anovel.jsonkeys.v2.StatusService.Status:output_type -> anovel.jsonkeys.v2.StatusResponse
+	18, // [18:19] is the sub-list for method output_type
+	17, // [17:18] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_anovel_jsonkeys_v2_status_proto_init() }
@@ -270,8 +788,8 @@ func file_anovel_jsonkeys_v2_status_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_anovel_jsonkeys_v2_status_proto_rawDesc), len(file_anovel_jsonkeys_v2_status_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      3,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+)
+
+// ErrRestUnauthenticated is returned when a request to an authenticated REST endpoint carries
+// no bearer token, or one that is not accepted.
+var ErrRestUnauthenticated = errors.New("missing or invalid bearer token")
+
+// restTokenCallerSize is the number of digest bytes that identify a static token in the audit
+// trail.
+const restTokenCallerSize = 4
+
+// RestBearerAuthService is the API key service dependency of [RestBearerAuth].
+type RestBearerAuthService interface {
+	Exec(ctx context.Context, request *core.ApiKeyAuthenticateRequest) (*core.ApiKey, error)
+}
+
+// RestBearerAuth is a middleware that gates REST endpoints behind bearer tokens, sent in the
+// "Authorization: Bearer <token>" header. A token is either one of the static tokens of the
+// configuration, or an API key.
+//
+// Only the SHA-256 digests of the static tokens are kept in memory, and a presented token is
+// compared against every one of them in constant time. Tokens that match none are checked as
+// API keys; the key is then made available to the handlers through [core.ApiKeyFromContext], so
+// they can check its permissions.
+//
+// Either way, the identity of the caller is recorded for the audit trail, see
+// [core.AuditCallerFromContext].
+type RestBearerAuth struct {
+	digests [][sha256.Size]byte
+	apiKeys RestBearerAuthService
+	logger  logging.Log
+}
+
+// NewRestBearerAuth returns a new RestBearerAuth middleware accepting the given tokens. Empty
+// tokens are ignored. API keys are only accepted when apiKeys is not nil; with neither tokens
+// nor API keys, every request is rejected.
+func NewRestBearerAuth(tokens []string, apiKeys RestBearerAuthService, logger logging.Log) *RestBearerAuth {
+	digests := make([][sha256.Size]byte, 0, len(tokens))
+
+	for _, token := range tokens {
+		if token != "" {
+			digests = append(digests, sha256.Sum256([]byte(token)))
+		}
+	}
+
+	return &RestBearerAuth{digests: digests, apiKeys: apiKeys, logger: logger}
+}
+
+// Handler wraps next, so it is only reached by authenticated requests. Other requests are
+// answered with 401 Unauthorized.
+func (auth *RestBearerAuth) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := otel.Tracer().Start(r.Context(), "rest.BearerAuth")
+
+		apiKey, caller, err := auth.authenticate(ctx, r.Header.Get("Authorization"))
+		if err != nil {
+			if errors.Is(err, ErrRestUnauthenticated) {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+			}
+
+			httpf.HandleError(ctx, auth.logger, w, span, httpf.ErrMap{
+				ErrRestUnauthenticated: http.StatusUnauthorized,
+			}, err)
+			span.End()
+
+			return
+		}
+
+		otel.ReportSuccessNoContent(span)
+		span.End()
+
+		if apiKey != nil {
+			r = r.WithContext(core.NewApiKeyContext(r.Context(), apiKey))
+		} else {
+			r = r.WithContext(core.NewAuditCallerContext(r.Context(), caller))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// authenticate returns the API key the request authenticated with. For a static token, it
+// returns the audit identity of the token instead, see [restTokenCaller].
+func (auth *RestBearerAuth) authenticate(ctx context.Context, header string) (*core.ApiKey, string, error) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return nil, "", ErrRestUnauthenticated
+	}
+
+	if digest, ok := auth.accept(token); ok {
+		return nil, restTokenCaller(digest), nil
+	}
+
+	if auth.apiKeys == nil {
+		return nil, "", ErrRestUnauthenticated
+	}
+
+	apiKey, err := auth.apiKeys.Exec(ctx, &core.ApiKeyAuthenticateRequest{Secret: token})
+	if errors.Is(err, core.ErrApiKeyInvalid) {
+		return nil, "", fmt.Errorf("%w: %w", ErrRestUnauthenticated, err)
+	}
+
+	if err != nil {
+		return nil, "", fmt.Errorf("authenticate api key: %w", err)
+	}
+
+	return apiKey, "", nil
+}
+
+// restTokenCaller identifies a static token in the audit trail by the start of its digest, so
+// operations can be told apart per token without recording the token.
+func restTokenCaller(digest [sha256.Size]byte) string {
+	return "bearer-token:" + hex.EncodeToString(digest[:restTokenCallerSize])
+}
+
+func (auth *RestBearerAuth) accept(token string) ([sha256.Size]byte, bool) {
+	digest := sha256.Sum256([]byte(token))
+	accepted := 0
+
+	// Every digest is compared, so the response time does not tell which token came close.
+	for _, candidate := range auth.digests {
+		accepted |= subtle.ConstantTimeCompare(digest[:], candidate[:])
+	}
+
+	return digest, accepted == 1
+}
@@ -0,0 +1,171 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+)
+
+func TestRestBearerAuth(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type apiKeysMock struct {
+		resp *core.ApiKey
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		tokens        []string
+		authorization string
+		apiKeysMock   *apiKeysMock
+
+		expectStatus int
+		expectApiKey bool
+		expectCaller string
+	}{
+		{
+			name: "Success",
+
+			tokens:        []string{"token-1", "token-2"},
+			authorization: "Bearer token-2",
+
+			expectStatus: http.StatusNoContent,
+			expectCaller: "bearer-token:0f6bffa9",
+		},
+		{
+			name: "Success/SchemeCase",
+
+			tokens:        []string{"token-1"},
+			authorization: "bearer token-1",
+
+			expectStatus: http.StatusNoContent,
+			expectCaller: "bearer-token:3f08aace",
+		},
+		{
+			name: "Success/ApiKey",
+
+			tokens:        []string{"token-1"},
+			authorization: "Bearer jsk_prefix_secret",
+			apiKeysMock:   &apiKeysMock{resp: &core.ApiKey{Name: "service-authentication"}},
+
+			expectStatus: http.StatusNoContent,
+			expectApiKey: true,
+			expectCaller: "api-key:service-authentication",
+		},
+		{
+			name: "Error/InvalidApiKey",
+
+			authorization: "Bearer jsk_prefix_secret",
+			apiKeysMock:   &apiKeysMock{err: core.ErrApiKeyInvalid},
+
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Error/ApiKeyInternal",
+
+			authorization: "Bearer jsk_prefix_secret",
+			apiKeysMock:   &apiKeysMock{err: errFoo},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "Error/NoHeader",
+
+			tokens: []string{"token-1"},
+
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Error/WrongToken",
+
+			tokens:        []string{"token-1"},
+			authorization: "Bearer token-2",
+
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Error/WrongScheme",
+
+			tokens:        []string{"token-1"},
+			authorization: "Basic token-1",
+
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Error/EmptyToken",
+
+			tokens:        []string{""},
+			authorization: "Bearer ",
+
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Error/NoTokens",
+
+			authorization: "Bearer token-1",
+
+			expectStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				gotApiKey bool
+				gotCaller string
+			)
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, gotApiKey = core.ApiKeyFromContext(r.Context())
+				gotCaller = core.AuditCallerFromContext(r.Context())
+
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			var apiKeys handlers.RestBearerAuthService
+
+			if testCase.apiKeysMock != nil {
+				service := handlersmocks.NewMockRestBearerAuthService(t)
+				service.EXPECT().
+					Exec(mock.Anything, mock.Anything).
+					Return(testCase.apiKeysMock.resp, testCase.apiKeysMock.err).
+					Once()
+
+				apiKeys = service
+			}
+
+			handler := handlers.NewRestBearerAuth(testCase.tokens, apiKeys, config.LoggerDev).Handler(next)
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/v2/claims/sign", nil)
+			if testCase.authorization != "" {
+				req.Header.Set("Authorization", testCase.authorization)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, testCase.expectStatus, w.Code)
+			require.Equal(t, testCase.expectApiKey, gotApiKey)
+			require.Equal(t, testCase.expectCaller, gotCaller)
+
+			if testCase.expectStatus == http.StatusUnauthorized {
+				require.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+)
+
+// restNoStore, used as a max age, forbids caches to store the response at all.
+const restNoStore time.Duration = -1
+
+// restCacheControl returns the Cache-Control value for a public response that may be reused
+// for maxAge. Without a max age, caches must revalidate every time, which stays cheap thanks
+// to the ETag.
+func restCacheControl(maxAge time.Duration) string {
+	if maxAge == restNoStore {
+		return "no-store"
+	}
+
+	if maxAge <= 0 {
+		return "no-cache"
+	}
+
+	return "public, max-age=" + strconv.FormatInt(int64(maxAge/time.Second), 10)
+}
+
+// restETag returns a strong entity tag for the given response body.
+func restETag(body []byte) string {
+	sum := sha256.Sum256(body)
+
+	return `"` + base64.RawURLEncoding.EncodeToString(sum[:]) + `"`
+}
+
+// restETagMatch reports whether an If-None-Match header value matches the given entity tag.
+// As required by RFC 9110, section 13.1.2, the comparison is weak: a "W/" prefix is ignored.
+func restETagMatch(ifNoneMatch, etag string) bool {
+	for candidate := range strings.SplitSeq(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// restSendCachedJSON is the cacheable counterpart of [httpf.SendJSONStatus]. It encodes data
+// as JSON, tags the response with an ETag and a Cache-Control header, and answers
+// 304 Not Modified instead of the body when the request's If-None-Match holds the same ETag.
+func restSendCachedJSON[Data any](
+	ctx context.Context,
+	logger logging.Log,
+	w http.ResponseWriter,
+	r *http.Request,
+	span trace.Span,
+	maxAge time.Duration,
+	data Data,
+) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		httpf.HandleError(ctx, logger, w, span, httpf.ErrMap{}, fmt.Errorf("encode response: %w", err))
+
+		return
+	}
+
+	etag := restETag(body)
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", restCacheControl(maxAge))
+
+	if restETagMatch(r.Header.Get("If-None-Match"), etag) {
+		span.SetAttributes(attribute.Bool("http.not_modified", true))
+		w.WriteHeader(http.StatusNotModified)
+		otel.ReportSuccessNoContent(span)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	_, err = w.Write(body)
+	if err != nil {
+		_ = otel.ReportError(span, err)
+
+		return
+	}
+
+	otel.ReportSuccessNoContent(span)
+}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+)
+
+var (
+	// ErrRestInvalidBody is returned when a REST request body cannot be decoded.
+	ErrRestInvalidBody = errors.New("invalid request body")
+	// ErrRestForbidden is returned when the API key of a request does not allow it.
+	ErrRestForbidden = errors.New("api key does not allow this operation")
+	// ErrRestProducersOnly is returned when a static token signs for a usage restricted to its
+	// producers.
+	ErrRestProducersOnly = errors.New("usage is restricted to its producers")
+)
+
+// RestClaimsSignService is the service dependency of [RestClaimsSign].
+type RestClaimsSignService interface {
+	Exec(ctx context.Context, request *core.ClaimsSignRequest) (string, error)
+}
+
+// RestClaimsSignRequest is the JSON body of a [RestClaimsSign] request.
+type RestClaimsSignRequest struct {
+	// Usage identifies the key and token parameters to sign with.
+	Usage string `json:"usage"`
+	// Payload holds the custom claims to embed in the token. The registered claims (iss, sub,
+	// aud, exp, nbf, iat, jti) are set by the service, and may not appear here.
+	Payload map[string]any `json:"payload"`
+	// MultiSignature requests a multi-signature JWS JSON serialization instead of a compact JWT.
+	MultiSignature bool `json:"multiSignature"`
+}
+
+// RestClaimsSignResponse is the JSON body of a successful [RestClaimsSign] response.
+type RestClaimsSignResponse struct {
+	// Token is the signed token.
+	Token string `json:"token"`
+}
+
+// RestClaimsSign is the REST handler that signs a set of claims, for callers that cannot use
+// the gRPC ClaimsSign service. It maps errors the same way as [GrpcClaimsSign]. It must only be
+// mounted behind an authentication middleware, such as [RestBearerAuth].
+//
+// Callers authenticated by an API key must be allowed to sign for the usage. Static tokens may
+// sign for any usage, except those restricted to their producers (see [config.Jwk.Producers]):
+// a static token carries no producer identity, so it would otherwise get around the restriction
+// [GrpcProducers] enforces. A multi-signature is also signed by the co-signers of the usage (see
+// [config.Jwk.CoSigners]), which the caller must be allowed to sign for as well.
+type RestClaimsSign struct {
+	service    RestClaimsSignService
+	keysConfig map[string]*config.Jwk
+	logger     logging.Log
+}
+
+// NewRestClaimsSign returns a new RestClaimsSign handler backed by the given service.
+func NewRestClaimsSign(
+	service RestClaimsSignService, keysConfig map[string]*config.Jwk, logger logging.Log,
+) *RestClaimsSign {
+	return &RestClaimsSign{service: service, keysConfig: keysConfig, logger: logger}
+}
+
+func (handler *RestClaimsSign) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.ClaimsSign")
+	defer span.End()
+
+	var request RestClaimsSignRequest
+
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			ErrRestInvalidBody: http.StatusBadRequest,
+		}, fmt.Errorf("%w: %w", ErrRestInvalidBody, err))
+
+		return
+	}
+
+	for _, usage := range signingUsages(handler.keysConfig, request.Usage, request.MultiSignature) {
+		err = handler.authorize(ctx, usage)
+		if err != nil {
+			httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+				ErrRestForbidden:     http.StatusForbidden,
+				ErrRestProducersOnly: http.StatusForbidden,
+			}, err)
+
+			return
+		}
+	}
+
+	signed, err := handler.service.Exec(ctx, &core.ClaimsSignRequest{
+		Claims:         request.Payload,
+		Usage:          request.Usage,
+		MultiSignature: request.MultiSignature,
+	})
+	// Same message as the gRPC handler: it names the refused set, without echoing the
+	// service's own error text.
+	if errors.Is(err, core.ErrReservedClaim) {
+		_ = otel.ReportError(span, err)
+
+		http.Error(w, "payload may not set a registered claim (iss, sub, aud, exp, nbf, iat, jti)", http.StatusBadRequest)
+
+		return
+	}
+
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			core.ErrConfigNotFound: http.StatusServiceUnavailable,
+			core.ErrSigningFrozen:  http.StatusConflict,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, RestClaimsSignResponse{Token: signed})
+}
+
+// authorize checks that the caller may sign with the key of usage.
+func (handler *RestClaimsSign) authorize(ctx context.Context, usage string) error {
+	apiKey, ok := core.ApiKeyFromContext(ctx)
+	if ok && !apiKey.Allows(core.ApiKeyOperationSign, usage) {
+		return fmt.Errorf("%w: sign %s", ErrRestForbidden, usage)
+	}
+
+	if keyConfig, hasConfig := handler.keysConfig[usage]; !ok && hasConfig && len(keyConfig.Producers) > 0 {
+		return fmt.Errorf("%w: sign %s", ErrRestProducersOnly, usage)
+	}
+
+	return nil
+}
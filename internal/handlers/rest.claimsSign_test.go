@@ -0,0 +1,306 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+)
+
+func TestRestClaimsSign(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	keysConfig := map[string]*config.Jwk{
+		"test-usage":     {},
+		"producers-only": {Producers: []string{"spiffe://a-novel/auth"}},
+		// A multi-signature of co-signed is also signed by the key of producers-only.
+		"co-signed": {CoSigners: []string{"producers-only"}},
+	}
+
+	type serviceMock struct {
+		request *core.ClaimsSignRequest
+
+		resp string
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		body   string
+		apiKey *core.ApiKey
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			body: `{"usage":"test-usage","payload":{"foo":"bar"}}`,
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsSignRequest{
+					Claims: map[string]any{"foo": "bar"},
+					Usage:  "test-usage",
+				},
+				resp: "test-token",
+			},
+
+			expectStatus:   http.StatusOK,
+			expectResponse: map[string]any{"token": "test-token"},
+		},
+		{
+			name: "Success/MultiSignature",
+
+			body: `{"usage":"test-usage","payload":{"foo":"bar"},"multiSignature":true}`,
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsSignRequest{
+					Claims:         map[string]any{"foo": "bar"},
+					Usage:          "test-usage",
+					MultiSignature: true,
+				},
+				resp: "test-token",
+			},
+
+			expectStatus:   http.StatusOK,
+			expectResponse: map[string]any{"token": "test-token"},
+		},
+		{
+			name: "Success/ApiKey",
+
+			body: `{"usage":"test-usage","payload":{"foo":"bar"}}`,
+			apiKey: &core.ApiKey{
+				Usages:     []string{"test-usage"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+			},
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsSignRequest{
+					Claims: map[string]any{"foo": "bar"},
+					Usage:  "test-usage",
+				},
+				resp: "test-token",
+			},
+
+			expectStatus:   http.StatusOK,
+			expectResponse: map[string]any{"token": "test-token"},
+		},
+		{
+			name: "Error/ApiKeyForbidden",
+
+			body: `{"usage":"test-usage","payload":{"foo":"bar"}}`,
+			apiKey: &core.ApiKey{
+				Usages:     []string{"other-usage"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Success/ApiKeyProducersOnly",
+
+			body: `{"usage":"producers-only","payload":{"foo":"bar"}}`,
+			apiKey: &core.ApiKey{
+				Usages:     []string{"producers-only"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+			},
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsSignRequest{
+					Claims: map[string]any{"foo": "bar"},
+					Usage:  "producers-only",
+				},
+				resp: "test-token",
+			},
+
+			expectStatus:   http.StatusOK,
+			expectResponse: map[string]any{"token": "test-token"},
+		},
+		{
+			name: "Error/StaticTokenProducersOnly",
+
+			body: `{"usage":"producers-only","payload":{"foo":"bar"}}`,
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Success/ApiKeyCoSigners",
+
+			body: `{"usage":"co-signed","payload":{"foo":"bar"},"multiSignature":true}`,
+			apiKey: &core.ApiKey{
+				Usages:     []string{"co-signed", "producers-only"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+			},
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsSignRequest{
+					Claims:         map[string]any{"foo": "bar"},
+					Usage:          "co-signed",
+					MultiSignature: true,
+				},
+				resp: "test-token",
+			},
+
+			expectStatus:   http.StatusOK,
+			expectResponse: map[string]any{"token": "test-token"},
+		},
+		{
+			// Co-signers only sign multi-signatures.
+			name: "Success/StaticTokenCoSignersSingleSignature",
+
+			body: `{"usage":"co-signed","payload":{"foo":"bar"}}`,
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsSignRequest{
+					Claims: map[string]any{"foo": "bar"},
+					Usage:  "co-signed",
+				},
+				resp: "test-token",
+			},
+
+			expectStatus:   http.StatusOK,
+			expectResponse: map[string]any{"token": "test-token"},
+		},
+		{
+			name: "Error/ApiKeyCoSignerForbidden",
+
+			body: `{"usage":"co-signed","payload":{"foo":"bar"},"multiSignature":true}`,
+			apiKey: &core.ApiKey{
+				Usages:     []string{"co-signed"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/StaticTokenCoSignerProducersOnly",
+
+			body: `{"usage":"co-signed","payload":{"foo":"bar"},"multiSignature":true}`,
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/InvalidBody",
+
+			body: `{"usage":`,
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/ReservedClaim",
+
+			body: `{"usage":"test-usage","payload":{"iss":"me"}}`,
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsSignRequest{
+					Claims: map[string]any{"iss": "me"},
+					Usage:  "test-usage",
+				},
+				err: core.ErrReservedClaim,
+			},
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/UnknownUsage",
+
+			body: `{"usage":"other","payload":{"foo":"bar"}}`,
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsSignRequest{
+					Claims: map[string]any{"foo": "bar"},
+					Usage:  "other",
+				},
+				err: core.ErrConfigNotFound,
+			},
+
+			expectStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name: "Error/SigningFrozen",
+
+			body: `{"usage":"auth","payload":{"foo":"bar"}}`,
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsSignRequest{
+					Claims: map[string]any{"foo": "bar"},
+					Usage:  "auth",
+				},
+				err: core.ErrSigningFrozen,
+			},
+
+			expectStatus: http.StatusConflict,
+		},
+		{
+			name: "Error/Internal",
+
+			body: `{"usage":"test-usage","payload":{"foo":"bar"}}`,
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsSignRequest{
+					Claims: map[string]any{"foo": "bar"},
+					Usage:  "test-usage",
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockRestClaimsSignService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.request).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewRestClaimsSign(service, keysConfig, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			ctx := t.Context()
+			if testCase.apiKey != nil {
+				ctx = core.NewApiKeyContext(ctx, testCase.apiKey)
+			}
+
+			handler.ServeHTTP(w, httptest.NewRequestWithContext(
+				ctx, http.MethodPost, "/v2/claims/sign", strings.NewReader(testCase.body),
+			))
+
+			res := w.Result()
+
+			service.AssertExpectations(t)
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/uptrace/bun"
@@ -10,6 +12,9 @@ import (
 	"github.com/a-novel-kit/golib/httpf"
 	"github.com/a-novel-kit/golib/otel"
 	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
 )
 
 const (
@@ -17,14 +22,36 @@ const (
 	RestHealthStatusUp = "up"
 	// RestHealthStatusDown is the JSON status value reported when a dependency is unhealthy.
 	RestHealthStatusDown = "down"
+	// RestHealthStatusDegraded is the overall JSON status value reported when a dependency is
+	// down, or a usage cannot sign.
+	RestHealthStatusDegraded = "degraded"
 )
 
-// RestHealthStatus is the JSON representation of a single dependency's health.
+const (
+	// RestKeyHealthStatusOk is reported for a usage with a main key, rotated on schedule.
+	RestKeyHealthStatusOk = "ok"
+	// RestKeyHealthStatusStalled is reported for a usage that can sign, but whose main key is
+	// older than [core.JwkRotationStalledFactor] rotation intervals.
+	RestKeyHealthStatusStalled = "stalled"
+	// RestKeyHealthStatusUnavailable is reported for a usage without any active key, that cannot sign.
+	RestKeyHealthStatusUnavailable = "unavailable"
+	// RestKeyHealthStatusUnknown is reported for a usage whose keys could not be read.
+	RestKeyHealthStatusUnknown = "unknown"
+)
+
+// RestHealthServiceLifecycle is the key lifecycle service dependency of [RestHealth].
+type RestHealthServiceLifecycle interface {
+	Exec(ctx context.Context, request *core.JwkLifecycleRequest) (*core.JwkLifecycleStatus, error)
+}
+
+// RestHealthStatus is the JSON representation of a single dependency's health, or of the
+// overall service health.
 // /v2/healthcheck is public and unauthenticated, so the body carries the status alone:
 // raw error messages embed internal hostnames, ports and schema names. The underlying
 // error is recorded on the trace span for operators.
 type RestHealthStatus struct {
-	// Status is either [RestHealthStatusUp] or [RestHealthStatusDown].
+	// Status is either [RestHealthStatusUp] or [RestHealthStatusDown]; the overall service
+	// health is either [RestHealthStatusUp] or [RestHealthStatusDegraded].
 	Status string `json:"status"`
 }
 
@@ -37,22 +64,124 @@ func NewRestHealthStatus(err error) *RestHealthStatus {
 	}
 }
 
+// RestKeyHealth is the JSON representation of the health of a usage's keys. Fields other than
+// Status are omitted when the keys could not be read.
+type RestKeyHealth struct {
+	// Status is one of [RestKeyHealthStatusOk], [RestKeyHealthStatusStalled],
+	// [RestKeyHealthStatusUnavailable] or [RestKeyHealthStatusUnknown].
+	Status string `json:"status"`
+	// HasMainKey is true when the usage has a key of its algorithm to sign with.
+	HasMainKey bool `json:"hasMainKey,omitempty"`
+	// MainKeyAge is the age of the main key, in seconds.
+	MainKeyAge int64 `json:"mainKeyAge,omitempty"`
+	// Rotation is the configured rotation interval, in seconds.
+	Rotation int64 `json:"rotation,omitempty"`
+	// LegacyKeys is the number of active keys other than the main key.
+	LegacyKeys int `json:"legacyKeys,omitempty"`
+	// NextExpiresAt is when the next active key expires.
+	NextExpiresAt *time.Time `json:"nextExpiresAt,omitempty"`
+	// RotationStalled is true when the rotation job looks stalled.
+	RotationStalled bool `json:"rotationStalled,omitempty"`
+}
+
+// NewRestKeyHealth converts the lifecycle of a usage's keys into a RestKeyHealth. A nil
+// lifecycle, for keys that could not be read, reports [RestKeyHealthStatusUnknown] alone.
+func NewRestKeyHealth(lifecycle *core.JwkLifecycleStatus) *RestKeyHealth {
+	if lifecycle == nil {
+		return &RestKeyHealth{Status: RestKeyHealthStatusUnknown}
+	}
+
+	output := &RestKeyHealth{
+		Status:          RestKeyHealthStatusOk,
+		HasMainKey:      lifecycle.CanSign(),
+		Rotation:        int64(lifecycle.Rotation.Seconds()),
+		LegacyKeys:      lifecycle.LegacyKeys,
+		RotationStalled: lifecycle.RotationStalled,
+	}
+
+	switch {
+	case !lifecycle.CanSign():
+		output.Status = RestKeyHealthStatusUnavailable
+	case lifecycle.RotationStalled:
+		output.Status = RestKeyHealthStatusStalled
+	}
+
+	if lifecycle.CanSign() {
+		output.MainKeyAge = int64(time.Since(lifecycle.MainCreatedAt).Seconds())
+	}
+
+	if !lifecycle.NextExpiresAt.IsZero() {
+		output.NextExpiresAt = &lifecycle.NextExpiresAt
+	}
+
+	return output
+}
+
 // RestHealth is the HTTP handler that reports the operational health of the service
-// and its dependencies as a JSON object.
-type RestHealth struct{}
+// and its dependencies as a JSON object, along with the health of each usage's keys, under
+// "keys:<usage>".
+//
+// The overall health, under "service", is [RestHealthStatusDegraded] when Postgres is down, or
+// a usage cannot sign; a stalled rotation alone does not degrade it. The response is always
+// sent with 200.
+type RestHealth struct {
+	serviceLifecycle RestHealthServiceLifecycle
+	keysConfig       map[string]*config.Jwk
+}
 
-// NewRestHealth returns a new RestHealth handler.
-func NewRestHealth() *RestHealth {
-	return &RestHealth{}
+// NewRestHealth returns a new RestHealth handler. Key health is reported for every usage in
+// keysConfig.
+func NewRestHealth(serviceLifecycle RestHealthServiceLifecycle, keysConfig map[string]*config.Jwk) *RestHealth {
+	return &RestHealth{serviceLifecycle: serviceLifecycle, keysConfig: keysConfig}
 }
 
 func (handler *RestHealth) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx, span := otel.Tracer().Start(r.Context(), "rest.Health")
 	defer span.End()
 
-	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, map[string]any{
-		"client:postgres": NewRestHealthStatus(handler.reportPostgres(ctx)),
-	})
+	postgresHealth := NewRestHealthStatus(handler.reportPostgres(ctx))
+
+	output := map[string]any{
+		"client:postgres": postgresHealth,
+	}
+
+	degraded := postgresHealth.Status != RestHealthStatusUp
+
+	for usage, health := range handler.reportKeys(ctx) {
+		output["keys:"+usage] = health
+
+		if !health.HasMainKey {
+			degraded = true
+		}
+	}
+
+	output["service"] = &RestHealthStatus{
+		Status: lo.Ternary(degraded, RestHealthStatusDegraded, RestHealthStatusUp),
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, output)
+}
+
+func (handler *RestHealth) reportKeys(ctx context.Context) map[string]*RestKeyHealth {
+	ctx, span := otel.Tracer().Start(ctx, "rest.Health(reportKeys)")
+	defer span.End()
+
+	output := make(map[string]*RestKeyHealth, len(handler.keysConfig))
+
+	for usage := range handler.keysConfig {
+		lifecycle, err := handler.serviceLifecycle.Exec(ctx, &core.JwkLifecycleRequest{Usage: usage})
+		if err != nil {
+			// The usage stays listed, as unknown: keys that cannot be read cannot sign either.
+			_ = otel.ReportError(span, fmt.Errorf("usage %s: %w", usage, err))
+			output[usage] = NewRestKeyHealth(nil)
+
+			continue
+		}
+
+		output[usage] = NewRestKeyHealth(lifecycle)
+	}
+
+	return output
 }
 
 func (handler *RestHealth) reportPostgres(ctx context.Context) error {
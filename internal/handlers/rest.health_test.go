@@ -7,18 +7,32 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel-kit/golib/postgres"
 
+	"github.com/a-novel/service-json-keys/v2/internal/config"
 	"github.com/a-novel/service-json-keys/v2/internal/config/configtest"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
 	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
 )
 
 func TestRestHealth(t *testing.T) {
 	t.Parallel()
 
+	errFoo := errors.New("foo")
+
+	expiresAt := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+
+	type serviceLifecycleMock struct {
+		resp *core.JwkLifecycleStatus
+		err  error
+	}
+
 	testCases := []struct {
 		name string
 
@@ -26,6 +40,9 @@ func TestRestHealth(t *testing.T) {
 
 		skipPostgres bool
 
+		keysConfig           map[string]*config.Jwk
+		serviceLifecycleMock *serviceLifecycleMock
+
 		expectStatus   int
 		expectResponse any
 	}{
@@ -38,6 +55,9 @@ func TestRestHealth(t *testing.T) {
 				"client:postgres": map[string]any{
 					"status": handlers.RestHealthStatusUp,
 				},
+				"service": map[string]any{
+					"status": handlers.RestHealthStatusUp,
+				},
 			},
 			expectStatus: http.StatusOK,
 		},
@@ -56,6 +76,92 @@ func TestRestHealth(t *testing.T) {
 				"client:postgres": map[string]any{
 					"status": handlers.RestHealthStatusDown,
 				},
+				"service": map[string]any{
+					"status": handlers.RestHealthStatusDegraded,
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			// Main keys are an hour old; the reported age is checked, then removed, in the test loop.
+			name: "Success/KeysStalled",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v2/healthcheck", nil),
+
+			keysConfig: map[string]*config.Jwk{"test-usage": {}},
+			serviceLifecycleMock: &serviceLifecycleMock{
+				resp: &core.JwkLifecycleStatus{
+					ActiveKeys:      2,
+					MainKID:         "kid-1",
+					MainCreatedAt:   time.Now().Add(-time.Hour),
+					LegacyKeys:      1,
+					SigningKeys:     2,
+					NextExpiresAt:   expiresAt,
+					Rotation:        20 * time.Minute,
+					RotationStalled: true,
+				},
+			},
+
+			expectResponse: map[string]any{
+				"client:postgres": map[string]any{
+					"status": handlers.RestHealthStatusUp,
+				},
+				"keys:test-usage": map[string]any{
+					"status":          handlers.RestKeyHealthStatusStalled,
+					"hasMainKey":      true,
+					"rotation":        float64(1200),
+					"legacyKeys":      float64(1),
+					"nextExpiresAt":   "2026-10-20T12:00:00Z",
+					"rotationStalled": true,
+				},
+				"service": map[string]any{
+					"status": handlers.RestHealthStatusUp,
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Success/KeysUnavailable",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v2/healthcheck", nil),
+
+			keysConfig: map[string]*config.Jwk{"test-usage": {}},
+			serviceLifecycleMock: &serviceLifecycleMock{
+				resp: &core.JwkLifecycleStatus{Rotation: 20 * time.Minute},
+			},
+
+			expectResponse: map[string]any{
+				"client:postgres": map[string]any{
+					"status": handlers.RestHealthStatusUp,
+				},
+				"keys:test-usage": map[string]any{
+					"status":   handlers.RestKeyHealthStatusUnavailable,
+					"rotation": float64(1200),
+				},
+				"service": map[string]any{
+					"status": handlers.RestHealthStatusDegraded,
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Success/KeysError",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v2/healthcheck", nil),
+
+			keysConfig:           map[string]*config.Jwk{"test-usage": {}},
+			serviceLifecycleMock: &serviceLifecycleMock{err: errFoo},
+
+			expectResponse: map[string]any{
+				"client:postgres": map[string]any{
+					"status": handlers.RestHealthStatusUp,
+				},
+				"keys:test-usage": map[string]any{
+					"status": handlers.RestKeyHealthStatusUnknown,
+				},
+				"service": map[string]any{
+					"status": handlers.RestHealthStatusDegraded,
+				},
 			},
 			expectStatus: http.StatusOK,
 		},
@@ -65,7 +171,15 @@ func TestRestHealth(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
 
-			handler := handlers.NewRestHealth()
+			serviceLifecycle := handlersmocks.NewMockRestHealthServiceLifecycle(t)
+
+			if testCase.serviceLifecycleMock != nil {
+				serviceLifecycle.EXPECT().
+					Exec(mock.Anything, &core.JwkLifecycleRequest{Usage: "test-usage"}).
+					Return(testCase.serviceLifecycleMock.resp, testCase.serviceLifecycleMock.err)
+			}
+
+			handler := handlers.NewRestHealth(serviceLifecycle, testCase.keysConfig)
 			w := httptest.NewRecorder()
 
 			rCtx := testCase.request.Context()
@@ -86,8 +200,19 @@ func TestRestHealth(t *testing.T) {
 				data, err := io.ReadAll(res.Body)
 				require.NoError(t, errors.Join(err, res.Body.Close()))
 
-				var jsonRes any
+				var jsonRes map[string]any
 				require.NoError(t, json.Unmarshal(data, &jsonRes))
+
+				for _, entry := range jsonRes {
+					health, ok := entry.(map[string]any)
+					require.True(t, ok)
+
+					if age, ok := health["mainKeyAge"].(float64); ok {
+						require.InDelta(t, time.Hour.Seconds(), age, 60)
+						delete(health, "mainKeyAge")
+					}
+				}
+
 				require.Equal(t, testCase.expectResponse, jsonRes)
 			}
 		})
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+)
+
+// ErrRestIntrospectNoToken is returned when an introspection request carries no token.
+var ErrRestIntrospectNoToken = errors.New("missing token")
+
+// RestIntrospectService is the service dependency of [RestIntrospect].
+type RestIntrospectService interface {
+	Exec(ctx context.Context, request *core.ClaimsInspectRequest) (*core.ClaimsInspectResult, error)
+}
+
+// RestIntrospectFailure reports a check an introspected token failed.
+type RestIntrospectFailure struct {
+	// Check is the check that failed, such as "expiration" or "signature". See [core.TokenCheck].
+	Check string `json:"check"`
+	// Message explains the failure, for humans.
+	Message string `json:"message"`
+}
+
+// RestIntrospectInactive is the JSON body of a [RestIntrospect] response for a token that failed
+// a check.
+type RestIntrospectInactive struct {
+	// Active is always false.
+	Active bool `json:"active"`
+	// Reason is the first check that failed: why the token is not active.
+	Reason string `json:"reason"`
+	// Failures lists every check that failed, in the order they run.
+	Failures []*RestIntrospectFailure `json:"failures"`
+}
+
+// RestIntrospect is the REST handler that verifies a token on the server, in the manner of an
+// OAuth 2.0 token introspection endpoint (RFC 7662). It maps errors the same way as
+// [GrpcClaimsVerify]. It must only be mounted behind an authentication middleware, such as
+// [RestBearerAuth].
+//
+// The request is form-encoded: "token" holds the token, and "usage" the usage it was signed for.
+// When the usage is not set, the handler falls back to its default usage. A valid token is
+// answered with its claims and "active": true; any other token with "active": false, the reason
+// and every failed check, as a [RestIntrospectInactive].
+//
+// Callers authenticated by an API key must be allowed to list the keys of the usage; static
+// tokens may introspect any usage.
+type RestIntrospect struct {
+	service      RestIntrospectService
+	defaultUsage string
+	logger       logging.Log
+}
+
+// NewRestIntrospect returns a new RestIntrospect handler backed by the given service. The default
+// usage may be empty.
+func NewRestIntrospect(service RestIntrospectService, defaultUsage string, logger logging.Log) *RestIntrospect {
+	return &RestIntrospect{service: service, defaultUsage: defaultUsage, logger: logger}
+}
+
+func (handler *RestIntrospect) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.Introspect")
+	defer span.End()
+
+	err := r.ParseForm()
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			ErrRestInvalidBody: http.StatusBadRequest,
+		}, fmt.Errorf("%w: %w", ErrRestInvalidBody, err))
+
+		return
+	}
+
+	token := r.PostForm.Get("token")
+	if token == "" {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			ErrRestIntrospectNoToken: http.StatusBadRequest,
+		}, ErrRestIntrospectNoToken)
+
+		return
+	}
+
+	usage := r.PostForm.Get("usage")
+	if usage == "" {
+		usage = handler.defaultUsage
+	}
+
+	apiKey, ok := core.ApiKeyFromContext(ctx)
+	if ok && !apiKey.Allows(core.ApiKeyOperationList, usage) {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			ErrRestForbidden: http.StatusForbidden,
+		}, fmt.Errorf("%w: list %s", ErrRestForbidden, usage))
+
+		return
+	}
+
+	result, err := handler.service.Exec(ctx, &core.ClaimsInspectRequest{Token: token, Usage: usage})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			core.ErrConfigNotFound: http.StatusServiceUnavailable,
+		}, err)
+
+		return
+	}
+
+	if !result.Valid() {
+		httpf.SendJSONStatus(ctx, w, span, http.StatusOK, RestIntrospectInactive{
+			Reason: string(result.Failures[0].Check),
+			Failures: lo.Map(result.Failures, func(item *core.TokenCheckFailure, _ int) *RestIntrospectFailure {
+				return &RestIntrospectFailure{Check: string(item.Check), Message: item.Message}
+			}),
+		})
+
+		return
+	}
+
+	// A valid token passed the claims checks, so its payload is a JSON object.
+	var claims map[string]any
+
+	err = json.Unmarshal(result.Claims, &claims)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{}, fmt.Errorf("decode claims: %w", err))
+
+		return
+	}
+
+	// The claims are returned at the top level, as RFC 7662 lays them out; "active" takes over any
+	// custom claim of that name.
+	claims["active"] = true
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, claims)
+}
@@ -0,0 +1,210 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-json-keys/v2/internal/handlers/mocks"
+)
+
+func TestRestIntrospect(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		request *core.ClaimsInspectRequest
+
+		resp *core.ClaimsInspectResult
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		form   url.Values
+		apiKey *core.ApiKey
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			form: url.Values{"token": {"test-token"}, "usage": {"test-usage"}},
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsInspectRequest{Token: "test-token", Usage: "test-usage"},
+				resp: &core.ClaimsInspectResult{
+					Claims: json.RawMessage(`{"foo":"bar","iss":"test-issuer"}`),
+					Signer: "test-usage",
+				},
+			},
+
+			expectStatus:   http.StatusOK,
+			expectResponse: map[string]any{"active": true, "foo": "bar", "iss": "test-issuer"},
+		},
+		{
+			name: "Success/DefaultUsage",
+
+			form: url.Values{"token": {"test-token"}},
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsInspectRequest{Token: "test-token", Usage: "default-usage"},
+				resp: &core.ClaimsInspectResult{
+					Claims: json.RawMessage(`{"foo":"bar"}`),
+					Signer: "default-usage",
+				},
+			},
+
+			expectStatus:   http.StatusOK,
+			expectResponse: map[string]any{"active": true, "foo": "bar"},
+		},
+		{
+			name: "Success/ApiKey",
+
+			form: url.Values{"token": {"test-token"}, "usage": {"test-usage"}},
+			apiKey: &core.ApiKey{
+				Usages:     []string{"test-usage"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationList},
+			},
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsInspectRequest{Token: "test-token", Usage: "test-usage"},
+				resp: &core.ClaimsInspectResult{
+					Claims: json.RawMessage(`{"foo":"bar"}`),
+					Signer: "test-usage",
+				},
+			},
+
+			expectStatus:   http.StatusOK,
+			expectResponse: map[string]any{"active": true, "foo": "bar"},
+		},
+		{
+			// Claims of a token that failed a check cannot be trusted, and are not returned.
+			name: "Success/Inactive",
+
+			form: url.Values{"token": {"test-token"}, "usage": {"test-usage"}},
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsInspectRequest{Token: "test-token", Usage: "test-usage"},
+				resp: &core.ClaimsInspectResult{
+					Claims: json.RawMessage(`{"foo":"bar"}`),
+					Failures: []*core.TokenCheckFailure{
+						{Check: core.TokenCheckAudience, Message: "wrong audience"},
+						{Check: core.TokenCheckExpiration, Message: "expired"},
+					},
+				},
+			},
+
+			expectStatus: http.StatusOK,
+			expectResponse: map[string]any{
+				"active": false,
+				"reason": "audience",
+				"failures": []any{
+					map[string]any{"check": "audience", "message": "wrong audience"},
+					map[string]any{"check": "expiration", "message": "expired"},
+				},
+			},
+		},
+		{
+			name: "Error/NoToken",
+
+			form: url.Values{"usage": {"test-usage"}},
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/ApiKeyForbidden",
+
+			form: url.Values{"token": {"test-token"}, "usage": {"test-usage"}},
+			apiKey: &core.ApiKey{
+				Usages:     []string{"test-usage"},
+				Operations: []core.ApiKeyOperation{core.ApiKeyOperationSign},
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/UnknownUsage",
+
+			form: url.Values{"token": {"test-token"}, "usage": {"other"}},
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsInspectRequest{Token: "test-token", Usage: "other"},
+				err:     core.ErrConfigNotFound,
+			},
+
+			expectStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name: "Error/Internal",
+
+			form: url.Values{"token": {"test-token"}, "usage": {"test-usage"}},
+
+			serviceMock: &serviceMock{
+				request: &core.ClaimsInspectRequest{Token: "test-token", Usage: "test-usage"},
+				err:     errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockRestIntrospectService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.request).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewRestIntrospect(service, "default-usage", config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			ctx := t.Context()
+			if testCase.apiKey != nil {
+				ctx = core.NewApiKeyContext(ctx, testCase.apiKey)
+			}
+
+			req := httptest.NewRequestWithContext(
+				ctx, http.MethodPost, "/v2/introspect", strings.NewReader(testCase.form.Encode()),
+			)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			handler.ServeHTTP(w, req)
+
+			res := w.Result()
+
+			service.AssertExpectations(t)
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/a-novel-kit/golib/logging"
 	"github.com/a-novel-kit/golib/otel"
 
+	"github.com/a-novel/service-json-keys/v2/internal/config"
 	"github.com/a-novel/service-json-keys/v2/internal/core"
 )
 
@@ -19,22 +21,38 @@ type RestJwkGetService interface {
 }
 
 // RestJwkGet is the REST handler that returns a single public JWK by its ID,
-// reading the key ID from the "id" query parameter.
+// reading the key ID from the "kid" path parameter, or from the "id" query parameter.
+//
+// Responses carry an ETag computed from the key. A key ID does not tell which usage the key
+// belongs to, so responses may be cached for the shortest [config.JwkKey.Cache] duration
+// among all usages.
 type RestJwkGet struct {
 	service RestJwkGetService
+	maxAge  time.Duration
 	logger  logging.Log
 }
 
 // NewRestJwkGet returns a new RestJwkGet handler backed by the given service.
-func NewRestJwkGet(service RestJwkGetService, logger logging.Log) *RestJwkGet {
-	return &RestJwkGet{service: service, logger: logger}
+func NewRestJwkGet(service RestJwkGetService, keysConfig map[string]*config.Jwk, logger logging.Log) *RestJwkGet {
+	var maxAge time.Duration
+
+	for _, keyConfig := range keysConfig {
+		if keyConfig.Key.Cache > 0 && (maxAge == 0 || keyConfig.Key.Cache < maxAge) {
+			maxAge = keyConfig.Key.Cache
+		}
+	}
+
+	return &RestJwkGet{service: service, maxAge: maxAge, logger: logger}
 }
 
 func (handler *RestJwkGet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx, span := otel.Tracer().Start(r.Context(), "rest.JwkGet")
 	defer span.End()
 
-	rawID := r.URL.Query().Get("id")
+	rawID := r.PathValue("kid")
+	if rawID == "" {
+		rawID = r.URL.Query().Get("id")
+	}
 
 	keyID, err := uuid.Parse(rawID)
 	if err != nil {
@@ -52,5 +70,5 @@ func (handler *RestJwkGet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, jwk)
+	restSendCachedJSON(ctx, handler.logger, w, r, span, handler.maxAge, jwk)
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -25,11 +26,25 @@ func TestRestJwkGet(t *testing.T) {
 
 	errFoo := errors.New("foo")
 
+	// The key's usage is unknown, so responses are cached for the shortest duration.
+	keysConfig := map[string]*config.Jwk{
+		"usage-1": {Key: config.JwkKey{Cache: 30 * time.Minute}},
+		"usage-2": {Key: config.JwkKey{Cache: 10 * time.Minute}},
+		"usage-3": {},
+	}
+
 	type serviceMock struct {
 		resp *core.Jwk
 		err  error
 	}
 
+	// withKid sets the "kid" path parameter, as the router does for /v2/jwks/{kid}.
+	withKid := func(req *http.Request, kid string) *http.Request {
+		req.SetPathValue("kid", kid)
+
+		return req
+	}
+
 	testCases := []struct {
 		name string
 
@@ -37,8 +52,9 @@ func TestRestJwkGet(t *testing.T) {
 
 		serviceMock *serviceMock
 
-		expectStatus   int
-		expectResponse any
+		expectStatus       int
+		expectResponse     any
+		expectCacheControl string
 	}{
 		{
 			name: "Success",
@@ -63,7 +79,8 @@ func TestRestJwkGet(t *testing.T) {
 				},
 			},
 
-			expectStatus: http.StatusOK,
+			expectStatus:       http.StatusOK,
+			expectCacheControl: "public, max-age=600",
 			expectResponse: map[string]any{
 				"kty":     "test-kty",
 				"use":     "test-use",
@@ -73,6 +90,39 @@ func TestRestJwkGet(t *testing.T) {
 				"x":       "test-x",
 			},
 		},
+		{
+			name: "Success/PathKid",
+
+			request: withKid(
+				httptest.NewRequestWithContext(
+					t.Context(),
+					http.MethodGet,
+					"/v2/jwks/00000000-0000-0000-0000-000000000001",
+					nil,
+				),
+				"00000000-0000-0000-0000-000000000001",
+			),
+
+			serviceMock: &serviceMock{
+				resp: &core.Jwk{
+					JWKCommon: jwa.JWKCommon{
+						KTY: "test-kty",
+						Alg: "test-alg",
+						KID: "00000000-0000-0000-0000-000000000001",
+					},
+					Payload: json.RawMessage(`{"x":"test-x"}`),
+				},
+			},
+
+			expectStatus:       http.StatusOK,
+			expectCacheControl: "public, max-age=600",
+			expectResponse: map[string]any{
+				"kty": "test-kty",
+				"alg": "test-alg",
+				"kid": "00000000-0000-0000-0000-000000000001",
+				"x":   "test-x",
+			},
+		},
 		{
 			name: "Error/InvalidID",
 
@@ -85,6 +135,16 @@ func TestRestJwkGet(t *testing.T) {
 
 			expectStatus: http.StatusBadRequest,
 		},
+		{
+			name: "Error/InvalidPathKid",
+
+			request: withKid(
+				httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v2/jwks/not-a-uuid", nil),
+				"not-a-uuid",
+			),
+
+			expectStatus: http.StatusBadRequest,
+		},
 		{
 			name: "Error/NotFound",
 
@@ -128,12 +188,12 @@ func TestRestJwkGet(t *testing.T) {
 			if testCase.serviceMock != nil {
 				service.EXPECT().
 					Exec(mock.Anything, &core.JwkSelectRequest{
-						ID: uuid.MustParse(testCase.request.URL.Query().Get("id")),
+						ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					}).
 					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
 			}
 
-			handler := handlers.NewRestJwkGet(service, config.LoggerDev)
+			handler := handlers.NewRestJwkGet(service, keysConfig, config.LoggerDev)
 			w := httptest.NewRecorder()
 
 			handler.ServeHTTP(w, testCase.request)
@@ -151,6 +211,23 @@ func TestRestJwkGet(t *testing.T) {
 				require.NoError(t, json.Unmarshal(data, &jsonRes))
 				require.Equal(t, testCase.expectResponse, jsonRes)
 			}
+
+			if testCase.expectCacheControl != "" {
+				require.Equal(t, testCase.expectCacheControl, res.Header.Get("Cache-Control"))
+
+				etag := res.Header.Get("ETag")
+				require.NotEmpty(t, etag)
+
+				// Weak validators match too, as required for If-None-Match.
+				revalidate := testCase.request.Clone(t.Context())
+				revalidate.Header.Set("If-None-Match", `"other", W/`+etag)
+
+				w = httptest.NewRecorder()
+				handler.ServeHTTP(w, revalidate)
+
+				require.Equal(t, http.StatusNotModified, w.Code)
+				require.Empty(t, w.Body.String())
+			}
 		})
 	}
 }
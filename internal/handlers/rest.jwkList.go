@@ -3,11 +3,13 @@ package handlers
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/a-novel-kit/golib/httpf"
 	"github.com/a-novel-kit/golib/logging"
 	"github.com/a-novel-kit/golib/otel"
 
+	"github.com/a-novel/service-json-keys/v2/internal/config"
 	"github.com/a-novel/service-json-keys/v2/internal/core"
 )
 
@@ -16,23 +18,47 @@ type RestJwkListService interface {
 	Exec(ctx context.Context, request *core.JwkSearchRequest) ([]*core.Jwk, error)
 }
 
+// RestJwkSet is a JWK Set document, as defined in RFC 7517, section 5. This is the format
+// expected by off-the-shelf JWT libraries and gateways when fetching a remote key set.
+type RestJwkSet struct {
+	Keys []*core.Jwk `json:"keys"`
+}
+
 // RestJwkList is the REST handler that returns the active public keys for a given usage,
-// reading the usage from the "usage" query parameter.
+// as a [RestJwkSet].
+//
+// The usage is read from the "usage" path parameter, then from the "usage" query parameter.
+// When neither is set, the handler falls back to its default usage.
+//
+// Responses carry an ETag computed from the key set, and may be cached for the usage's
+// [config.JwkKey.Cache] duration.
 type RestJwkList struct {
-	service RestJwkListService
-	logger  logging.Log
+	service      RestJwkListService
+	keysConfig   map[string]*config.Jwk
+	defaultUsage string
+	logger       logging.Log
 }
 
-// NewRestJwkList returns a new RestJwkList handler backed by the given service.
-func NewRestJwkList(service RestJwkListService, logger logging.Log) *RestJwkList {
-	return &RestJwkList{service: service, logger: logger}
+// NewRestJwkList returns a new RestJwkList handler backed by the given service. The default
+// usage may be empty.
+func NewRestJwkList(
+	service RestJwkListService, keysConfig map[string]*config.Jwk, defaultUsage string, logger logging.Log,
+) *RestJwkList {
+	return &RestJwkList{service: service, keysConfig: keysConfig, defaultUsage: defaultUsage, logger: logger}
 }
 
 func (handler *RestJwkList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx, span := otel.Tracer().Start(r.Context(), "rest.JwkList")
 	defer span.End()
 
-	usage := r.URL.Query().Get("usage")
+	usage := r.PathValue("usage")
+	if usage == "" {
+		usage = r.URL.Query().Get("usage")
+	}
+
+	if usage == "" {
+		usage = handler.defaultUsage
+	}
 
 	jwks, err := handler.service.Exec(ctx, &core.JwkSearchRequest{Usage: usage})
 	if err != nil {
@@ -41,5 +67,16 @@ func (handler *RestJwkList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, jwks)
+	// A JWK Set always carries a "keys" array, even when empty.
+	if jwks == nil {
+		jwks = []*core.Jwk{}
+	}
+
+	// Unknown usages have no cache duration: the (empty) set must be revalidated on every use.
+	var maxAge time.Duration
+	if keyConfig, ok := handler.keysConfig[usage]; ok {
+		maxAge = keyConfig.Key.Cache
+	}
+
+	restSendCachedJSON(ctx, handler.logger, w, r, span, maxAge, RestJwkSet{Keys: jwks})
 }
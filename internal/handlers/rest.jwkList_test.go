@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -24,20 +25,35 @@ func TestRestJwkList(t *testing.T) {
 
 	errFoo := errors.New("foo")
 
+	keysConfig := map[string]*config.Jwk{
+		"test-usage": {Key: config.JwkKey{Cache: 30 * time.Minute}},
+	}
+
 	type serviceMock struct {
+		usage string
+
 		resp []*core.Jwk
 		err  error
 	}
 
+	// withUsage sets the "usage" path parameter, as the router does for /.well-known/{usage}/jwks.json.
+	withUsage := func(req *http.Request, usage string) *http.Request {
+		req.SetPathValue("usage", usage)
+
+		return req
+	}
+
 	testCases := []struct {
 		name string
 
-		request *http.Request
+		request      *http.Request
+		defaultUsage string
 
 		serviceMock *serviceMock
 
-		expectStatus   int
-		expectResponse any
+		expectStatus       int
+		expectResponse     any
+		expectCacheControl string
 	}{
 		{
 			name: "Success",
@@ -45,6 +61,7 @@ func TestRestJwkList(t *testing.T) {
 			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v2/jwks?usage=test-usage", nil),
 
 			serviceMock: &serviceMock{
+				usage: "test-usage",
 				resp: []*core.Jwk{
 					{
 						JWKCommon: jwa.JWKCommon{
@@ -59,15 +76,18 @@ func TestRestJwkList(t *testing.T) {
 				},
 			},
 
-			expectStatus: http.StatusOK,
-			expectResponse: []any{
-				map[string]any{
-					"kty":     "test-kty",
-					"use":     "test-use",
-					"key_ops": []any{"sign", "verify"},
-					"alg":     "test-alg",
-					"kid":     "00000000-0000-0000-0000-000000000001",
-					"x":       "test-x",
+			expectStatus:       http.StatusOK,
+			expectCacheControl: "public, max-age=1800",
+			expectResponse: map[string]any{
+				"keys": []any{
+					map[string]any{
+						"kty":     "test-kty",
+						"use":     "test-use",
+						"key_ops": []any{"sign", "verify"},
+						"alg":     "test-alg",
+						"kid":     "00000000-0000-0000-0000-000000000001",
+						"x":       "test-x",
+					},
 				},
 			},
 		},
@@ -77,11 +97,59 @@ func TestRestJwkList(t *testing.T) {
 			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v2/jwks?usage=test-usage", nil),
 
 			serviceMock: &serviceMock{
-				resp: []*core.Jwk{},
+				usage: "test-usage",
+				resp:  []*core.Jwk{},
+			},
+
+			expectStatus:       http.StatusOK,
+			expectCacheControl: "public, max-age=1800",
+			expectResponse:     map[string]any{"keys": []any{}},
+		},
+		{
+			name: "Success/NilKeys",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v2/jwks?usage=test-usage", nil),
+
+			serviceMock: &serviceMock{
+				usage: "test-usage",
 			},
 
-			expectStatus:   http.StatusOK,
-			expectResponse: []any{},
+			expectStatus:       http.StatusOK,
+			expectCacheControl: "public, max-age=1800",
+			expectResponse:     map[string]any{"keys": []any{}},
+		},
+		{
+			name: "Success/PathUsage",
+
+			request: withUsage(
+				httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/.well-known/test-usage/jwks.json", nil),
+				"test-usage",
+			),
+			defaultUsage: "default-usage",
+
+			serviceMock: &serviceMock{
+				usage: "test-usage",
+				resp:  []*core.Jwk{},
+			},
+
+			expectStatus:       http.StatusOK,
+			expectCacheControl: "public, max-age=1800",
+			expectResponse:     map[string]any{"keys": []any{}},
+		},
+		{
+			name: "Success/DefaultUsage",
+
+			request:      httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/.well-known/jwks.json", nil),
+			defaultUsage: "default-usage",
+
+			serviceMock: &serviceMock{
+				usage: "default-usage",
+				resp:  []*core.Jwk{},
+			},
+
+			expectStatus:       http.StatusOK,
+			expectCacheControl: "no-cache",
+			expectResponse:     map[string]any{"keys": []any{}},
 		},
 		{
 			name: "Error/Internal",
@@ -89,7 +157,8 @@ func TestRestJwkList(t *testing.T) {
 			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v2/jwks?usage=test-usage", nil),
 
 			serviceMock: &serviceMock{
-				err: errFoo,
+				usage: "test-usage",
+				err:   errFoo,
 			},
 
 			expectStatus: http.StatusInternalServerError,
@@ -104,13 +173,11 @@ func TestRestJwkList(t *testing.T) {
 
 			if testCase.serviceMock != nil {
 				service.EXPECT().
-					Exec(mock.Anything, &core.JwkSearchRequest{
-						Usage: testCase.request.URL.Query().Get("usage"),
-					}).
+					Exec(mock.Anything, &core.JwkSearchRequest{Usage: testCase.serviceMock.usage}).
 					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
 			}
 
-			handler := handlers.NewRestJwkList(service, config.LoggerDev)
+			handler := handlers.NewRestJwkList(service, keysConfig, testCase.defaultUsage, config.LoggerDev)
 			w := httptest.NewRecorder()
 
 			handler.ServeHTTP(w, testCase.request)
@@ -128,6 +195,24 @@ func TestRestJwkList(t *testing.T) {
 				require.NoError(t, json.Unmarshal(data, &jsonRes))
 				require.Equal(t, testCase.expectResponse, jsonRes)
 			}
+
+			if testCase.expectCacheControl != "" {
+				require.Equal(t, testCase.expectCacheControl, res.Header.Get("Cache-Control"))
+
+				etag := res.Header.Get("ETag")
+				require.NotEmpty(t, etag)
+
+				// A client revalidating its cached copy gets a 304, without the body.
+				revalidate := testCase.request.Clone(t.Context())
+				revalidate.Header.Set("If-None-Match", etag)
+
+				w = httptest.NewRecorder()
+				handler.ServeHTTP(w, revalidate)
+
+				require.Equal(t, http.StatusNotModified, w.Code)
+				require.Equal(t, etag, w.Header().Get("ETag"))
+				require.Empty(t, w.Body.String())
+			}
 		})
 	}
 }
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+)
+
+// RestOpenIDProviderMetadata is the OpenID Connect discovery document of a usage (OpenID Connect
+// Discovery 1.0, section 3). This service only issues tokens, so the document describes the
+// issuer and its keys; it holds no authorization or token endpoints.
+//
+//nolint:tagliatelle // field names are set by the specification.
+type RestOpenIDProviderMetadata struct {
+	// Issuer is the "iss" claim of the usage's tokens. See [config.JwkToken.Issuer].
+	Issuer string `json:"issuer"`
+	// JwksURI is the absolute URL of the usage's JWK Set. See [RestJwkList].
+	JwksURI string `json:"jwks_uri"`
+	// ResponseTypesSupported is required by the specification. Tokens are only ever handed out
+	// directly, so it is always "id_token".
+	ResponseTypesSupported []string `json:"response_types_supported"`
+	// SubjectTypesSupported is required by the specification. Subjects are not pairwise.
+	SubjectTypesSupported []string `json:"subject_types_supported"`
+	// IDTokenSigningAlgValuesSupported lists the algorithms the usage's tokens may be signed
+	// with. See [config.Jwk.Algs].
+	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+}
+
+// RestOpenIDConfiguration is the REST handler that returns the [RestOpenIDProviderMetadata] of
+// a usage, read from the "usage" path parameter, or the default usage. Usages without a
+// configured issuer have no discovery document.
+//
+// The jwks_uri is built from the public URL of the server. When none is configured, it is
+// derived from the request, and the document is sent with "Cache-Control: no-store".
+type RestOpenIDConfiguration struct {
+	keysConfig   map[string]*config.Jwk
+	publicURL    string
+	defaultUsage string
+	logger       logging.Log
+}
+
+// NewRestOpenIDConfiguration returns a new RestOpenIDConfiguration handler. The public URL and
+// the default usage may be empty.
+func NewRestOpenIDConfiguration(
+	keysConfig map[string]*config.Jwk, publicURL, defaultUsage string, logger logging.Log,
+) *RestOpenIDConfiguration {
+	return &RestOpenIDConfiguration{
+		keysConfig:   keysConfig,
+		publicURL:    strings.TrimSuffix(publicURL, "/"),
+		defaultUsage: defaultUsage,
+		logger:       logger,
+	}
+}
+
+func (handler *RestOpenIDConfiguration) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.OpenIDConfiguration")
+	defer span.End()
+
+	usage := r.PathValue("usage")
+	if usage == "" {
+		usage = handler.defaultUsage
+	}
+
+	span.SetAttributes(attribute.String("key.usage", usage))
+
+	keyConfig, ok := handler.keysConfig[usage]
+	if !ok || keyConfig.Token.Issuer == "" {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			core.ErrConfigNotFound: http.StatusNotFound,
+		}, fmt.Errorf("%w: %s", core.ErrConfigNotFound, usage))
+
+		return
+	}
+
+	// A base URL read from the request depends on its Host field, which the client controls: shared
+	// caches must not serve that document to anyone else.
+	maxAge := keyConfig.Key.Cache
+	if handler.publicURL == "" {
+		maxAge = restNoStore
+
+		w.Header().Add("Vary", "Host")
+	}
+
+	restSendCachedJSON(ctx, handler.logger, w, r, span, maxAge, RestOpenIDProviderMetadata{
+		Issuer:                 keyConfig.Token.Issuer,
+		JwksURI:                handler.baseURL(r) + "/.well-known/" + url.PathEscape(usage) + "/jwks.json",
+		ResponseTypesSupported: []string{"id_token"},
+		SubjectTypesSupported:  []string{"public"},
+		IDTokenSigningAlgValuesSupported: lo.Map(keyConfig.Algs(), func(alg jwa.Alg, _ int) string {
+			return string(alg)
+		}),
+	})
+}
+
+func (handler *RestOpenIDConfiguration) baseURL(r *http.Request) string {
+	if handler.publicURL != "" {
+		return handler.publicURL
+	}
+
+	return lo.Ternary(r.TLS != nil, "https", "http") + "://" + r.Host
+}
@@ -0,0 +1,167 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/handlers"
+)
+
+func TestRestOpenIDConfiguration(t *testing.T) {
+	t.Parallel()
+
+	keysConfig := map[string]*config.Jwk{
+		"test-usage": {
+			Alg:          jwa.ES256,
+			PreviousAlgs: []jwa.Alg{jwa.EdDSA},
+			Key:          config.JwkKey{Cache: 30 * time.Minute},
+			Token:        config.JwkToken{Issuer: "test-issuer"},
+		},
+		"no-issuer": {Alg: jwa.EdDSA},
+	}
+
+	withUsage := func(req *http.Request, usage string) *http.Request {
+		req.SetPathValue("usage", usage)
+
+		return req
+	}
+
+	testCases := []struct {
+		name string
+
+		request      *http.Request
+		publicURL    string
+		defaultUsage string
+
+		expectStatus       int
+		expectCacheControl string
+		expectResponse     any
+	}{
+		{
+			name: "Success",
+
+			request: withUsage(
+				httptest.NewRequestWithContext(
+					t.Context(), http.MethodGet, "http://keys.local/.well-known/test-usage/openid-configuration", nil,
+				),
+				"test-usage",
+			),
+
+			expectStatus:       http.StatusOK,
+			expectCacheControl: "no-store",
+			expectResponse: map[string]any{
+				"issuer":                                "test-issuer",
+				"jwks_uri":                              "http://keys.local/.well-known/test-usage/jwks.json",
+				"response_types_supported":              []any{"id_token"},
+				"subject_types_supported":               []any{"public"},
+				"id_token_signing_alg_values_supported": []any{"ES256", "EdDSA"},
+			},
+		},
+		{
+			name: "Success/PublicURL",
+
+			request: withUsage(
+				httptest.NewRequestWithContext(
+					t.Context(), http.MethodGet, "http://10.0.0.1/.well-known/test-usage/openid-configuration", nil,
+				),
+				"test-usage",
+			),
+			publicURL: "https://keys.example.com/",
+
+			expectStatus:       http.StatusOK,
+			expectCacheControl: "public, max-age=1800",
+			expectResponse: map[string]any{
+				"issuer":                                "test-issuer",
+				"jwks_uri":                              "https://keys.example.com/.well-known/test-usage/jwks.json",
+				"response_types_supported":              []any{"id_token"},
+				"subject_types_supported":               []any{"public"},
+				"id_token_signing_alg_values_supported": []any{"ES256", "EdDSA"},
+			},
+		},
+		{
+			name: "Success/DefaultUsage",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(), http.MethodGet, "http://keys.local/.well-known/openid-configuration", nil,
+			),
+			defaultUsage: "test-usage",
+
+			expectStatus:       http.StatusOK,
+			expectCacheControl: "no-store",
+			expectResponse: map[string]any{
+				"issuer":                                "test-issuer",
+				"jwks_uri":                              "http://keys.local/.well-known/test-usage/jwks.json",
+				"response_types_supported":              []any{"id_token"},
+				"subject_types_supported":               []any{"public"},
+				"id_token_signing_alg_values_supported": []any{"ES256", "EdDSA"},
+			},
+		},
+		{
+			name: "Error/UnknownUsage",
+
+			request: withUsage(
+				httptest.NewRequestWithContext(
+					t.Context(), http.MethodGet, "http://keys.local/.well-known/other/openid-configuration", nil,
+				),
+				"other",
+			),
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/NoIssuer",
+
+			request: withUsage(
+				httptest.NewRequestWithContext(
+					t.Context(), http.MethodGet, "http://keys.local/.well-known/no-issuer/openid-configuration", nil,
+				),
+				"no-issuer",
+			),
+
+			expectStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := handlers.NewRestOpenIDConfiguration(
+				keysConfig, testCase.publicURL, testCase.defaultUsage, config.LoggerDev,
+			)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, testCase.request)
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				require.Equal(t, testCase.expectCacheControl, res.Header.Get("Cache-Control"))
+				require.NotEmpty(t, res.Header.Get("ETag"))
+
+				if testCase.publicURL == "" {
+					require.Equal(t, "Host", res.Header.Get("Vary"))
+				}
+
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
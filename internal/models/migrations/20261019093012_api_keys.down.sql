@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS api_keys_prefix_idx;
+
+DROP TABLE IF EXISTS api_keys;
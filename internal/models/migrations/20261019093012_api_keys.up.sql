@@ -0,0 +1,22 @@
+-- API keys let services authenticate without a client certificate.
+CREATE TABLE api_keys (
+  id uuid PRIMARY KEY NOT NULL,
+  /* Human-readable label, shown when listing keys. */
+  name text NOT NULL CHECK (name <> ''),
+  /* Public part of the key, embedded in the secret handed out to the caller. It identifies the row
+  without revealing the secret. */
+  prefix text NOT NULL CHECK (prefix <> ''),
+  /* SHA-256 digest of the secret part of the key, hex-encoded. The secret itself is never stored. */
+  secret_hash text NOT NULL CHECK (secret_hash <> ''),
+  /* Key usages the key grants access to. */
+  usages text[] NOT NULL,
+  /* Operations the key grants on those usages (sign, list, admin). */
+  operations text[] NOT NULL,
+  created_at timestamp(0) with time zone NOT NULL,
+  /* Updated each time the key authenticates a request. */
+  last_used_at timestamp(0) with time zone,
+  /* Set when the key is revoked. Revoked keys no longer authenticate, but stay for auditing. */
+  revoked_at timestamp(0) with time zone
+);
+
+CREATE UNIQUE INDEX api_keys_prefix_idx ON api_keys (prefix);
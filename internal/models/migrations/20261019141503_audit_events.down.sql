@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS audit_events_usage_occurred_at_idx;
+
+DROP INDEX IF EXISTS audit_events_occurred_at_idx;
+
+DROP TABLE IF EXISTS audit_events;
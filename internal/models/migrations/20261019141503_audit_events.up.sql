@@ -0,0 +1,23 @@
+-- Append-only trail of signing and key-management operations. DAOs only ever insert and read it.
+CREATE TABLE audit_events (
+  id uuid PRIMARY KEY NOT NULL,
+  occurred_at timestamp with time zone NOT NULL,
+  /* What happened, such as "claims.sign" or "jwk.rotate". */
+  action text NOT NULL CHECK (action <> ''),
+  outcome text NOT NULL CHECK (outcome IN ('success', 'failure')),
+  /* Who asked: an API key, a client certificate identity, or a job. Null when unknown. */
+  caller text,
+  usage text,
+  /* The key involved, when the operation used or produced one. */
+  kid text,
+  /* The "jti" claim of the token issued. */
+  token_id text,
+  /* SHA-256 digest of the token issued, hex-encoded. The token itself is never stored. */
+  token_hash text,
+  /* Free-form context, such as the reason of a failure. */
+  detail text
+);
+
+CREATE INDEX audit_events_occurred_at_idx ON audit_events (occurred_at);
+
+CREATE INDEX audit_events_usage_occurred_at_idx ON audit_events (usage, occurred_at);
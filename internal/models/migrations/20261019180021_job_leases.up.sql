@@ -0,0 +1,13 @@
+-- Leases elect the one server, among the replicas sharing the database, that runs a background
+-- job such as key rotation. The holder keeps its lease by renewing it before it expires; another
+-- server takes over once it has.
+CREATE TABLE job_leases (
+  name text PRIMARY KEY NOT NULL CHECK (name <> ''),
+  /* The server holding the lease. */
+  holder text NOT NULL CHECK (holder <> ''),
+  expires_at timestamp with time zone NOT NULL,
+  /* When a holder last completed the job, whatever its outcome. Kept across holders. */
+  last_run_at timestamp with time zone,
+  /* The error the last run ended with. Null when it succeeded. */
+  last_run_error text
+);
@@ -0,0 +1,8 @@
+-- A row freezes the signing of its usage: no token is issued for it until the row is deleted.
+-- Verification is not affected, and the public keys of the usage stay published.
+CREATE TABLE signing_freezes (
+  usage text PRIMARY KEY NOT NULL CHECK (usage <> ''),
+  /* The reason of the freeze, such as an incident reference. */
+  comment text NOT NULL CHECK (comment <> ''),
+  frozen_at timestamp with time zone NOT NULL
+);
@@ -0,0 +1,16 @@
+-- A row revokes a single token, by its "jti" claim: verifiers refuse the token, while the key that
+-- signed it keeps verifying the others. Rows are useless once the token expires, and purged.
+CREATE TABLE revoked_tokens (
+  /* A token ID is only unique within its usage: revoking it under one usage leaves the others. */
+  usage text NOT NULL CHECK (usage <> ''),
+  jti text NOT NULL CHECK (jti <> ''),
+  /* When the token stops verifying anyway, leeway included. */
+  expires_at timestamp with time zone NOT NULL,
+  revoked_at timestamp with time zone NOT NULL,
+  PRIMARY KEY (usage, jti)
+);
+
+/* Verifiers pull the revocations made since their last sync. */
+CREATE INDEX revoked_tokens_revoked_at_idx ON revoked_tokens (revoked_at);
+
+CREATE INDEX revoked_tokens_expires_at_idx ON revoked_tokens (expires_at);
@@ -0,0 +1,36 @@
+-- One active key, and one revoked key that was used before its revocation.
+INSERT INTO
+  api_keys (
+    id,
+    name,
+    prefix,
+    secret_hash,
+    usages,
+    operations,
+    created_at,
+    last_used_at,
+    revoked_at
+  )
+VALUES
+  (
+    '00000000-0000-0000-0000-000000000001',
+    'fixture-active',
+    'fixture1',
+    'a4b1e9ad2d4b4e46a05e0f0cbd3ad4a9d4f2c7f1a8e65b43c5a1f1bcb9d0e2a1',
+    '{roundtrip}',
+    '{sign,list}',
+    '2026-10-19T09:30:12Z',
+    NULL,
+    NULL
+  ),
+  (
+    '00000000-0000-0000-0000-000000000002',
+    'fixture-revoked',
+    'fixture2',
+    '5c3e3a3c1f8a0a7f7f0d5d6e1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e',
+    '{roundtrip}',
+    '{admin}',
+    '2026-10-19T09:30:12Z',
+    '2026-10-20T09:30:12Z',
+    '2026-10-21T09:30:12Z'
+  );
@@ -0,0 +1,39 @@
+-- One successful signature, and one failed rotation.
+INSERT INTO
+  audit_events (
+    id,
+    occurred_at,
+    action,
+    outcome,
+    caller,
+    usage,
+    kid,
+    token_id,
+    token_hash,
+    detail
+  )
+VALUES
+  (
+    '00000000-0000-0000-0000-000000000001',
+    '2026-10-19T14:15:03.123456Z',
+    'claims.sign',
+    'success',
+    'spiffe://anovel/authentication',
+    'roundtrip',
+    '00000000-0000-0000-0000-000000000001',
+    '2b1f5a9e-0c4d-4f3a-9a57-3f6f0b8e2d11',
+    'b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c',
+    NULL
+  ),
+  (
+    '00000000-0000-0000-0000-000000000002',
+    '2026-10-19T14:16:03Z',
+    'jwk.rotate',
+    'failure',
+    'job:rotate-keys',
+    'roundtrip',
+    NULL,
+    NULL,
+    NULL,
+    'fixture failure'
+  );
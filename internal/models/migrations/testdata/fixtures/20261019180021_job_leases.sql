@@ -0,0 +1,17 @@
+-- A lease whose last run failed.
+INSERT INTO
+  job_leases (
+    name,
+    holder,
+    expires_at,
+    last_run_at,
+    last_run_error
+  )
+VALUES
+  (
+    'jwk.rotate',
+    'grpc-0-roundtrip',
+    '2026-10-19T18:05:21Z',
+    '2026-10-19T18:00:21.123456Z',
+    'fixture failure'
+  );
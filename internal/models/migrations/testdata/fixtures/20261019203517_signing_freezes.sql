@@ -0,0 +1,9 @@
+-- A usage frozen during an incident.
+INSERT INTO
+  signing_freezes (usage, comment, frozen_at)
+VALUES
+  (
+    'auth',
+    'fixture incident',
+    '2026-10-19T20:35:17Z'
+  );
@@ -0,0 +1,10 @@
+-- A token revoked after it leaked.
+INSERT INTO
+  revoked_tokens (jti, usage, expires_at, revoked_at)
+VALUES
+  (
+    '6f1c2a8e-4b7d-4e0a-9c3f-2d5e8a1b7c40',
+    'auth',
+    '2026-10-19T23:10:44Z',
+    '2026-10-19T22:10:44Z'
+  );
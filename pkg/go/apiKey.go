@@ -0,0 +1,24 @@
+package servicejsonkeys
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// WithApiKey returns a dial option, for [NewClient], that authenticates every call with an API
+// key, sent as "authorization: Bearer <key>" metadata. API keys are issued by the service
+// operators, and are scoped to a set of usages and operations.
+//
+// The key is a secret: only send it over a TLS connection, or a private network.
+func WithApiKey(secret string) grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(func(
+		ctx context.Context, method string, req, reply any,
+		conn *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+	) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+secret)
+
+		return invoker(ctx, method, req, reply, conn, opts...)
+	})
+}
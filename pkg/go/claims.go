@@ -3,12 +3,18 @@ package servicejsonkeys
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/samber/lo"
 
+	"github.com/a-novel/service-json-keys/v2/internal/config"
 	"github.com/a-novel/service-json-keys/v2/internal/core"
 )
 
+// ErrAlgNotAllowed is returned by [ClaimsVerifier.VerifyClaims] when the token header names an
+// algorithm outside the usage's algorithm policy. Such tokens are refused before any signature check.
+var ErrAlgNotAllowed = config.ErrJwkPolicyAlgNotAllowed
+
 // KeyUsage identifies the intended purpose of a token. It selects the signing key and full
 // token configuration the service uses when signing, and the corresponding public keys and
 // parameters used to verify. Each producer service owns one or more usages, registered in
@@ -22,28 +28,62 @@ const (
 	KeyUsageAuthRefresh KeyUsage = "auth-refresh"
 )
 
+// SignaturePolicy decides how many signatures of a multi-signature token must verify. Tokens
+// signed with the multi_signature option of the ClaimsSign RPC carry one signature per signer of
+// the usage; compact tokens carry a single one.
+type SignaturePolicy = core.SignaturePolicy
+
+const (
+	// SignaturePolicyAny accepts a token as soon as the usage's own signature verifies; a token
+	// signed by a co-signer alone is refused. It is the default.
+	SignaturePolicyAny = core.SignaturePolicyAny
+	// SignaturePolicyAll accepts a token only if every signature verifies, and the usage and each of
+	// its co-signers contributed one.
+	SignaturePolicyAll = core.SignaturePolicyAll
+)
+
+// ErrMissingSignature is returned by [ClaimsVerifier.VerifyClaims] under [SignaturePolicyAll] when
+// a token lacks a valid signature from one of the usage's signers.
+var ErrMissingSignature = core.ErrClaimsVerifyMissingSignature
+
+// ErrTokenRevoked is returned by [ClaimsVerifier.VerifyClaims] when the token was revoked with the
+// RevokeToken RPC.
+var ErrTokenRevoked = core.ErrTokenRevoked
+
+// RevokedTokenSyncInterval is how often a [ClaimsVerifier] pulls new revocations from the service,
+// and so how long it keeps accepting a token after it is revoked.
+const RevokedTokenSyncInterval = 5 * time.Second
+
 // VerifyClaimsOptions configures optional behavior for a [ClaimsVerifier.VerifyClaims] call.
 type VerifyClaimsOptions struct {
 	// IgnoreExpired, when true, allows expired tokens to pass verification.
 	IgnoreExpired bool
+	// Signatures sets how many signatures of a multi-signature token must verify.
+	Signatures SignaturePolicy
 }
 
 // VerifyClaimsRequest holds the parameters for a [ClaimsVerifier.VerifyClaims] call.
 type VerifyClaimsRequest struct {
 	// Usage is the key usage the token was signed for; must match the value used at signing time. See [KeyUsage].
 	Usage KeyUsage
-	// AccessToken is the compact JWT to verify: the dot-separated base64url string
-	// (header.payload.signature) returned by the ClaimsSign RPC.
+	// AccessToken is the token returned by the ClaimsSign RPC: a compact JWT (the dot-separated
+	// base64url string header.payload.signature), or its JWS JSON serialization.
 	AccessToken string
 	// Options configures optional verification behavior for this call; nil means all defaults apply.
 	Options *VerifyClaimsOptions
 }
 
-// A ClaimsVerifier verifies a compact JWT and deserializes its payload into C.
+// A ClaimsVerifier verifies a JWT, compact or multi-signature, and deserializes its payload into C.
 // Verification is performed locally using public keys sourced from the [Client]; no network
 // call is made per verification. Obtain one with [NewClaimsVerifier].
+//
+// Revoked tokens are refused with [ErrTokenRevoked]. The verifier keeps a copy of the denylist,
+// pulled incrementally in the background every [RevokedTokenSyncInterval]: verifications never
+// wait for it. The first verification starts the first pull, so tokens are checked against the
+// denylist once it completes. When the service is unreachable, the last copy keeps applying;
+// services without the RevokedTokenSync RPC have an empty denylist.
 type ClaimsVerifier[C any] interface {
-	// VerifyClaims verifies the compact JWT in req and, if valid, returns the decoded claims.
+	// VerifyClaims verifies the token in req and, if valid, returns the decoded claims.
 	VerifyClaims(ctx context.Context, req *VerifyClaimsRequest) (*C, error)
 }
 
@@ -70,7 +110,9 @@ func NewClaimsVerifier[C any](c Client) (ClaimsVerifier[C], error) {
 		return nil, fmt.Errorf("(NewClaimsVerifier) new recipients: %w", err)
 	}
 
-	return &claimsVerifier[C]{service: core.NewClaimsVerify[C](recipients, c.Keys())}, nil
+	denylist := core.NewRevokedTokenCheck(newRevokedTokenSyncGrpc(c), RevokedTokenSyncInterval)
+
+	return &claimsVerifier[C]{service: core.NewClaimsVerify[C](recipients, denylist, c.Keys())}, nil
 }
 
 func (verifier *claimsVerifier[C]) VerifyClaims(ctx context.Context, req *VerifyClaimsRequest) (*C, error) {
@@ -78,5 +120,6 @@ func (verifier *claimsVerifier[C]) VerifyClaims(ctx context.Context, req *Verify
 		Token:         req.AccessToken,
 		Usage:         req.Usage,
 		IgnoreExpired: lo.FromPtr(req.Options).IgnoreExpired,
+		Signatures:    lo.FromPtr(req.Options).Signatures,
 	})
 }
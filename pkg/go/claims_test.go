@@ -1,16 +1,27 @@
 package servicejsonkeys_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	"github.com/a-novel-kit/golib/grpcf"
+	"github.com/a-novel-kit/jwt/v2/jwa"
+	"github.com/a-novel-kit/jwt/v2/jwk"
 
+	"github.com/a-novel/service-json-keys/v2/internal/config"
 	"github.com/a-novel/service-json-keys/v2/internal/config/env"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
 	"github.com/a-novel/service-json-keys/v2/pkg/go"
+	pkgmocks "github.com/a-novel/service-json-keys/v2/pkg/go/mocks"
 )
 
 func TestClaimsVerifier(t *testing.T) {
@@ -49,3 +60,65 @@ func TestClaimsVerifier(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &c, res)
 }
+
+func TestClaimsVerifierWithoutDenylist(t *testing.T) {
+	t.Parallel()
+
+	privateKey, publicKey, err := jwk.GenerateED25519()
+	require.NoError(t, err)
+
+	keysConfig := map[string]*config.Jwk{"test-usage": {
+		Alg:   jwa.EdDSA,
+		Token: config.JwkToken{TTL: time.Hour, Issuer: "test-issuer", Audience: "test-audience"},
+	}}
+
+	privateSources, err := core.NewJwkPrivateSource(staticKeySource{privateKey.JWK}, keysConfig)
+	require.NoError(t, err)
+
+	producers, err := core.NewJwkProducers(privateSources, keysConfig)
+	require.NoError(t, err)
+
+	token, err := core.NewClaimsSign(producers, nil, keysConfig).Exec(
+		t.Context(), &core.ClaimsSignRequest{Claims: map[string]any{"foo": "bar"}, Usage: "test-usage"},
+	)
+	require.NoError(t, err)
+
+	synced := make(chan struct{})
+
+	client := pkgmocks.NewMockClient(t)
+
+	client.EXPECT().Keys().Return(keysConfig).Maybe()
+	client.EXPECT().
+		JwkList(mock.Anything, mock.Anything).
+		Return(&servicejsonkeys.JwkListResponse{Keys: []*jsonkeysv2.Jwk{{
+			Kty:     string(publicKey.KTY),
+			Use:     string(publicKey.Use),
+			KeyOps:  []string{string(jwa.KeyOpVerify)},
+			Alg:     string(publicKey.Alg),
+			Kid:     publicKey.KID,
+			Payload: publicKey.Payload,
+		}}}, nil).
+		Maybe()
+	// A service older than revocation does not serve the denylist.
+	client.EXPECT().
+		RevokedTokenSync(mock.Anything, mock.Anything).
+		RunAndReturn(func(
+			context.Context, *servicejsonkeys.RevokedTokenSyncRequest, ...grpc.CallOption,
+		) (*servicejsonkeys.RevokedTokenSyncResponse, error) {
+			close(synced)
+
+			return nil, status.Error(codes.Unimplemented, "unknown service")
+		}).
+		Once()
+
+	verifier, err := servicejsonkeys.NewClaimsVerifier[map[string]any](client)
+	require.NoError(t, err)
+
+	claims, err := verifier.VerifyClaims(t.Context(), &servicejsonkeys.VerifyClaimsRequest{
+		Usage: "test-usage", AccessToken: token,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "bar", (*claims)["foo"])
+
+	<-synced
+}
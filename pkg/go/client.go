@@ -13,14 +13,44 @@ import (
 )
 
 type (
-	StatusRequest      = jsonkeysv2.StatusRequest
-	StatusResponse     = jsonkeysv2.StatusResponse
-	JwkListRequest     = jsonkeysv2.JwkListRequest
-	JwkListResponse    = jsonkeysv2.JwkListResponse
-	JwkGetRequest      = jsonkeysv2.JwkGetRequest
-	JwkGetResponse     = jsonkeysv2.JwkGetResponse
-	ClaimsSignRequest  = jsonkeysv2.ClaimsSignRequest
-	ClaimsSignResponse = jsonkeysv2.ClaimsSignResponse
+	StatusRequest       = jsonkeysv2.StatusRequest
+	StatusResponse      = jsonkeysv2.StatusResponse
+	JwkListRequest      = jsonkeysv2.JwkListRequest
+	JwkListResponse     = jsonkeysv2.JwkListResponse
+	JwkGetRequest       = jsonkeysv2.JwkGetRequest
+	JwkGetResponse      = jsonkeysv2.JwkGetResponse
+	ClaimsSignRequest   = jsonkeysv2.ClaimsSignRequest
+	ClaimsSignResponse  = jsonkeysv2.ClaimsSignResponse
+	PayloadSignRequest  = jsonkeysv2.PayloadSignRequest
+	PayloadSignResponse = jsonkeysv2.PayloadSignResponse
+
+	HttpSignatureSignRequest  = jsonkeysv2.HttpSignatureSignRequest
+	HttpSignatureSignResponse = jsonkeysv2.HttpSignatureSignResponse
+	HttpSignatureComponent    = jsonkeysv2.HttpSignatureComponent
+
+	AuditEventSearchRequest  = jsonkeysv2.AuditEventSearchRequest
+	AuditEventSearchResponse = jsonkeysv2.AuditEventSearchResponse
+	AuditEvent               = jsonkeysv2.AuditEvent
+
+	JwkRotateRequest  = jsonkeysv2.JwkRotateRequest
+	JwkRotateResponse = jsonkeysv2.JwkRotateResponse
+	PreviousKeyStatus = jsonkeysv2.PreviousKeyStatus
+	JwkBurnRequest    = jsonkeysv2.JwkBurnRequest
+	JwkBurnResponse   = jsonkeysv2.JwkBurnResponse
+
+	SigningFreezeRequest  = jsonkeysv2.SigningFreezeRequest
+	SigningFreezeResponse = jsonkeysv2.SigningFreezeResponse
+
+	ClaimsVerifyRequest  = jsonkeysv2.ClaimsVerifyRequest
+	ClaimsVerifyResponse = jsonkeysv2.ClaimsVerifyResponse
+	TokenCheckFailure    = jsonkeysv2.TokenCheckFailure
+	TokenCheck           = jsonkeysv2.TokenCheck
+
+	RevokeTokenRequest       = jsonkeysv2.RevokeTokenRequest
+	RevokeTokenResponse      = jsonkeysv2.RevokeTokenResponse
+	RevokedToken             = jsonkeysv2.RevokedToken
+	RevokedTokenSyncRequest  = jsonkeysv2.RevokedTokenSyncRequest
+	RevokedTokenSyncResponse = jsonkeysv2.RevokedTokenSyncResponse
 
 	// JwkConfig holds the full configuration for a single key usage — the signing algorithm
 	// and the key and token parameters applied to every JWT signed under it.
@@ -48,12 +78,55 @@ type BaseClient interface {
 	JwkList(ctx context.Context, req *JwkListRequest, opts ...grpc.CallOption) (*JwkListResponse, error)
 	// ClaimsSign asks the service to sign claims and returns a compact JWT.
 	// The request payload must be a protobuf Any wrapping the claims to embed;
-	// the response token is the resulting compact JWT string.
+	// the response token is the resulting compact JWT string. With MultiSignature set, it is a
+	// JWS JSON serialization signed by the usage and each of its co-signers instead.
 	//
 	// The payload carries application claims only. The registered ones — iss,
 	// sub, aud, exp, nbf, iat, jti — come from the usage's server-side config,
 	// and a payload naming one fails with InvalidArgument.
 	ClaimsSign(ctx context.Context, req *ClaimsSignRequest, opts ...grpc.CallOption) (*ClaimsSignResponse, error)
+	// ClaimsVerify asks the service to verify a token, and returns its claims, or the checks it
+	// failed. Go consumers should prefer [NewClaimsVerifier], which verifies tokens locally without
+	// a call per token.
+	ClaimsVerify(
+		ctx context.Context, req *ClaimsVerifyRequest, opts ...grpc.CallOption,
+	) (*ClaimsVerifyResponse, error)
+	// PayloadSign asks the service to sign arbitrary bytes and returns a detached JWS: the payload
+	// is left out of the signature, and travels separately. With Unencoded set, the payload is signed
+	// as is (RFC 7797). Verify the signature with [NewPayloadVerifier].
+	PayloadSign(ctx context.Context, req *PayloadSignRequest, opts ...grpc.CallOption) (*PayloadSignResponse, error)
+	// HttpSignatureSign asks the service to sign the covered components of an HTTP message,
+	// following RFC 9421. Prefer [NewHttpSigner], which extracts the components from an
+	// http.Request and sets the signature fields.
+	HttpSignatureSign(
+		ctx context.Context, req *HttpSignatureSignRequest, opts ...grpc.CallOption,
+	) (*HttpSignatureSignResponse, error)
+	// AuditEventSearch returns the audit trail of signing and key-management operations within a
+	// time range, newest first, optionally for a single usage. Tokens are never part of the trail,
+	// only their "jti" claim and digest.
+	AuditEventSearch(
+		ctx context.Context, req *AuditEventSearchRequest, opts ...grpc.CallOption,
+	) (*AuditEventSearchResponse, error)
+	// JwkRotate generates a new main key for a usage right away, whatever the age of the current
+	// one, and optionally revokes the previous key in the same transaction.
+	JwkRotate(ctx context.Context, req *JwkRotateRequest, opts ...grpc.CallOption) (*JwkRotateResponse, error)
+	// JwkBurn revokes every active key of a usage and generates a new main key, in a single
+	// transaction, and makes every server drop its cached keys of the usage.
+	JwkBurn(ctx context.Context, req *JwkBurnRequest, opts ...grpc.CallOption) (*JwkBurnResponse, error)
+	// SigningFreeze freezes the signing of a usage, or lifts its freeze. While a usage is frozen,
+	// ClaimsSign fails with FailedPrecondition for it; its tokens still verify.
+	SigningFreeze(
+		ctx context.Context, req *SigningFreezeRequest, opts ...grpc.CallOption,
+	) (*SigningFreezeResponse, error)
+	// RevokeToken revokes a single token, by its "jti" claim, until it expires. Verifiers refuse it
+	// from then on, while the key that signed it keeps verifying other tokens. Pass the token, or
+	// its ID when the token is lost.
+	RevokeToken(ctx context.Context, req *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error)
+	// RevokedTokenSync returns the revocations of unexpired tokens made since a cursor. Verifiers
+	// built with [NewClaimsVerifier] call it to keep their copy of the denylist.
+	RevokedTokenSync(
+		ctx context.Context, req *RevokedTokenSyncRequest, opts ...grpc.CallOption,
+	) (*RevokedTokenSyncResponse, error)
 
 	// Close releases the underlying gRPC connection. Typically called via defer after NewClient.
 	Close()
@@ -76,6 +149,15 @@ type client struct {
 	jsonkeysv2.JwkGetServiceClient
 	jsonkeysv2.JwkListServiceClient
 	jsonkeysv2.ClaimsSignServiceClient
+	jsonkeysv2.ClaimsVerifyServiceClient
+	jsonkeysv2.PayloadSignServiceClient
+	jsonkeysv2.HttpSignatureSignServiceClient
+	jsonkeysv2.AuditEventSearchServiceClient
+	jsonkeysv2.JwkRotateServiceClient
+	jsonkeysv2.JwkBurnServiceClient
+	jsonkeysv2.SigningFreezeServiceClient
+	jsonkeysv2.RevokeTokenServiceClient
+	jsonkeysv2.RevokedTokenSyncServiceClient
 
 	keys map[string]*JwkConfig
 
@@ -100,13 +182,22 @@ func NewClient(addr string, opts ...grpc.DialOption) (Client, error) {
 	}
 
 	c := &client{
-		EchoServiceClient:       golibproto.NewEchoServiceClient(conn),
-		StatusServiceClient:     jsonkeysv2.NewStatusServiceClient(conn),
-		JwkGetServiceClient:     jsonkeysv2.NewJwkGetServiceClient(conn),
-		JwkListServiceClient:    jsonkeysv2.NewJwkListServiceClient(conn),
-		ClaimsSignServiceClient: jsonkeysv2.NewClaimsSignServiceClient(conn),
-		keys:                    config.JwkPresetDefault,
-		conn:                    conn,
+		EchoServiceClient:              golibproto.NewEchoServiceClient(conn),
+		StatusServiceClient:            jsonkeysv2.NewStatusServiceClient(conn),
+		JwkGetServiceClient:            jsonkeysv2.NewJwkGetServiceClient(conn),
+		JwkListServiceClient:           jsonkeysv2.NewJwkListServiceClient(conn),
+		ClaimsSignServiceClient:        jsonkeysv2.NewClaimsSignServiceClient(conn),
+		ClaimsVerifyServiceClient:      jsonkeysv2.NewClaimsVerifyServiceClient(conn),
+		PayloadSignServiceClient:       jsonkeysv2.NewPayloadSignServiceClient(conn),
+		HttpSignatureSignServiceClient: jsonkeysv2.NewHttpSignatureSignServiceClient(conn),
+		AuditEventSearchServiceClient:  jsonkeysv2.NewAuditEventSearchServiceClient(conn),
+		JwkRotateServiceClient:         jsonkeysv2.NewJwkRotateServiceClient(conn),
+		JwkBurnServiceClient:           jsonkeysv2.NewJwkBurnServiceClient(conn),
+		SigningFreezeServiceClient:     jsonkeysv2.NewSigningFreezeServiceClient(conn),
+		RevokeTokenServiceClient:       jsonkeysv2.NewRevokeTokenServiceClient(conn),
+		RevokedTokenSyncServiceClient:  jsonkeysv2.NewRevokedTokenSyncServiceClient(conn),
+		keys:                           config.JwkPresetDefault,
+		conn:                           conn,
 	}
 
 	return c, nil
@@ -15,6 +15,23 @@
 // JSON object carrying the caller-supplied claims wrapped in the standard JWT claim
 // envelope added by the service. Claims can be any JSON-serializable value.
 //
+// A usage configured with co-signers can also issue multi-signature tokens, in the JWS JSON
+// general serialization (RFC 7515, section 7.2.1). [ClaimsVerifier] accepts both forms; set
+// [VerifyClaimsOptions.Signatures] to require every signature rather than any.
+//
+// # Detached payload signatures
+//
+// The PayloadSign RPC signs arbitrary bytes instead of claims, and returns a detached JWS
+// (RFC 7515, appendix F): the payload is left out of the signature and sent alongside it.
+// Optionally, the payload is signed unencoded (RFC 7797). [PayloadVerifier] checks such a
+// signature against the payload, locally.
+//
+// # HTTP message signatures
+//
+// [HttpSigner] signs an http.Request following RFC 9421, through the HttpSignatureSign RPC, and
+// [HttpSignatureVerifier] verifies the signature on the receiving end, resolving its keyid
+// among the public keys of the usage.
+//
 // # Using this package
 //
 // [NewClient] dials the service and sets up per-usage public-key sources. Keys are fetched
@@ -0,0 +1,446 @@
+package servicejsonkeys
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+var (
+	// ErrHttpSignatureNotFound is returned by [HttpSignatureVerifier.VerifyHttp] when the request
+	// carries no signature under the expected label.
+	ErrHttpSignatureNotFound = errors.New("http signature not found")
+	// ErrHttpSignatureMissingComponent is returned when a covered component is absent from the
+	// request, or when a signature does not cover a component the verifier requires.
+	ErrHttpSignatureMissingComponent = errors.New("http signature component missing")
+	// ErrHttpSignatureTooOld is returned by [HttpSignatureVerifier.VerifyHttp] when a signature was
+	// created longer ago than the maximum age allowed, or has no creation time.
+	ErrHttpSignatureTooOld = errors.New("http signature too old")
+	// ErrHttpSignatureNotYetValid is returned by [HttpSignatureVerifier.VerifyHttp] when a signature
+	// claims to be created in the future.
+	ErrHttpSignatureNotYetValid = errors.New("http signature not yet valid")
+	// ErrContentDigestMismatch is returned when the Content-Digest field of a request does not match
+	// its body.
+	ErrContentDigestMismatch = errors.New("content digest mismatch")
+	// ErrHttpSignatureExpired is returned by [HttpSignatureVerifier.VerifyHttp] when a signature is
+	// past its expiration time.
+	ErrHttpSignatureExpired = core.ErrHttpSignatureExpired
+	// ErrHttpSignatureMalformed is returned by [HttpSignatureVerifier.VerifyHttp] when the
+	// Signature-Input or Signature field cannot be parsed.
+	ErrHttpSignatureMalformed = core.ErrHttpSignatureMalformed
+)
+
+const (
+	// DefaultHttpSignatureLabel labels signatures when no other label is set.
+	DefaultHttpSignatureLabel = "sig1"
+	// DefaultHttpSignatureMaxAge is the maximum age of a signature when a [VerifyHttpRequest] sets
+	// none.
+	DefaultHttpSignatureMaxAge = 5 * time.Minute
+
+	// httpSignatureClockSkew tolerates clocks of the signer running ahead of the verifier.
+	httpSignatureClockSkew = 30 * time.Second
+
+	httpHeaderSignatureInput = "Signature-Input"
+	httpHeaderSignature      = "Signature"
+	httpHeaderContentDigest  = "Content-Digest"
+	httpComponentDigest      = "content-digest"
+)
+
+// DefaultHttpSignatureComponents are the components covered when a [SignHttpRequest] lists none.
+// The Content-Digest field is covered as well when the request has a body.
+var DefaultHttpSignatureComponents = []string{"@method", "@target-uri"}
+
+// httpContentDigestAlgs maps the Content-Digest algorithms (RFC 9530) this package computes or
+// checks to their hash function. The first one is used when computing a digest.
+var httpContentDigestAlgs = []struct {
+	name string
+	hash func() hash.Hash
+}{
+	{"sha-256", sha256.New},
+	{"sha-512", sha512.New},
+}
+
+// SignHttpRequest holds the parameters for a [HttpSigner.SignHttp] call.
+type SignHttpRequest struct {
+	// Usage is the key usage to sign with. See [KeyUsage].
+	Usage KeyUsage
+	// Request is the request to sign. Its Signature-Input and Signature fields receive the
+	// signature, and its Content-Digest field is set when covered and missing.
+	Request *http.Request
+	// Components lists the covered components, in order: derived components ("@method",
+	// "@target-uri", "@authority", "@scheme", "@request-target", "@path", "@query") or lowercase
+	// field names. Nil means [DefaultHttpSignatureComponents].
+	Components []string
+	// Label names the signature in the Signature-Input and Signature fields. Empty means
+	// [DefaultHttpSignatureLabel].
+	Label string
+	// TTL sets the expires parameter, relative to the creation time. Zero leaves it out.
+	TTL time.Duration
+	// Nonce sets the nonce parameter, when not empty.
+	Nonce string
+	// Tag sets the tag parameter, when not empty.
+	Tag string
+}
+
+// A HttpSigner signs HTTP requests following RFC 9421 (HTTP Message Signatures). The signature
+// is computed by the service, so private keys never leave it. Obtain one with [NewHttpSigner].
+type HttpSigner interface {
+	// SignHttp signs the request in req, and adds the signature to its Signature-Input and
+	// Signature fields.
+	SignHttp(ctx context.Context, req *SignHttpRequest) error
+}
+
+type httpSigner struct {
+	client BaseClient
+}
+
+// NewHttpSigner creates a HTTP request signer backed by the HttpSignatureSign RPC of c.
+func NewHttpSigner(c BaseClient) HttpSigner {
+	return &httpSigner{client: c}
+}
+
+func (signer *httpSigner) SignHttp(ctx context.Context, req *SignHttpRequest) error {
+	components := req.Components
+	if components == nil {
+		components = slices.Clone(DefaultHttpSignatureComponents)
+
+		if req.Request.Body != nil && req.Request.Body != http.NoBody {
+			components = append(components, httpComponentDigest)
+		}
+	}
+
+	if slices.Contains(components, httpComponentDigest) && req.Request.Header.Get(httpHeaderContentDigest) == "" {
+		err := setContentDigest(req.Request)
+		if err != nil {
+			return fmt.Errorf("(SignHttp) %w", err)
+		}
+	}
+
+	request := &HttpSignatureSignRequest{
+		Usage:      req.Usage,
+		Components: make([]*jsonkeysv2.HttpSignatureComponent, len(components)),
+		Created:    timestamppb.New(time.Now()),
+		Nonce:      req.Nonce,
+		Tag:        req.Tag,
+	}
+
+	if req.TTL > 0 {
+		request.Expires = timestamppb.New(request.GetCreated().AsTime().Add(req.TTL))
+	}
+
+	for i, component := range components {
+		value, err := httpSignatureComponentValue(req.Request, component)
+		if err != nil {
+			return fmt.Errorf("(SignHttp) %w", err)
+		}
+
+		request.Components[i] = &jsonkeysv2.HttpSignatureComponent{Name: component, Value: value}
+	}
+
+	signed, err := signer.client.HttpSignatureSign(ctx, request)
+	if err != nil {
+		return fmt.Errorf("(SignHttp) sign: %w", err)
+	}
+
+	label := lo.CoalesceOrEmpty(req.Label, DefaultHttpSignatureLabel)
+
+	req.Request.Header.Add(httpHeaderSignatureInput, label+"="+signed.GetSignatureParams())
+	req.Request.Header.Add(
+		httpHeaderSignature, label+"=:"+base64.StdEncoding.EncodeToString(signed.GetSignature())+":",
+	)
+
+	return nil
+}
+
+// VerifyHttpRequest holds the parameters for a [HttpSignatureVerifier.VerifyHttp] call.
+type VerifyHttpRequest struct {
+	// Usage is the key usage the request was signed for. See [KeyUsage].
+	Usage KeyUsage
+	// Request is the signed request. When its Content-Digest field is covered, its body is read
+	// to check the digest, then restored.
+	Request *http.Request
+	// Label selects the signature to verify. Empty means the only signature of the request, or
+	// [DefaultHttpSignatureLabel] when it carries several.
+	Label string
+	// RequiredComponents lists components the signature must cover, such as "content-digest". Nil
+	// means [DefaultHttpSignatureComponents]; an empty, non-nil slice requires none.
+	RequiredComponents []string
+	// MaxAge refuses signatures created longer ago than this. Zero means
+	// [DefaultHttpSignatureMaxAge]. Signatures without a creation time are always refused.
+	MaxAge time.Duration
+}
+
+// A HttpSignatureVerifier verifies RFC 9421 signatures added by a [HttpSigner]. The keyid of a
+// signature is resolved among the public keys of the usage, sourced from the [Client]; no network
+// call is made per verification. Obtain one with [NewHttpSignatureVerifier].
+type HttpSignatureVerifier interface {
+	// VerifyHttp returns nil if the request in req carries a valid signature. A signature that does
+	// not match yields an error wrapping jws.ErrInvalidSignature.
+	VerifyHttp(ctx context.Context, req *VerifyHttpRequest) error
+}
+
+type httpSignatureVerifier struct {
+	service *core.HttpSignatureVerify
+}
+
+// NewHttpSignatureVerifier creates a HTTP signature verifier backed by the key configuration
+// carried by c. It builds the cached public-key sources used for local verification, returning an
+// error if the configuration references an unsupported algorithm.
+func NewHttpSignatureVerifier(c Client) (HttpSignatureVerifier, error) {
+	sources, err := core.NewJwkPublicSource(newJwkExportGrpc(c), c.Keys())
+	if err != nil {
+		return nil, fmt.Errorf("(NewHttpSignatureVerifier) new public sources: %w", err)
+	}
+
+	return &httpSignatureVerifier{service: core.NewHttpSignatureVerify(sources, c.Keys())}, nil
+}
+
+func (verifier *httpSignatureVerifier) VerifyHttp(ctx context.Context, req *VerifyHttpRequest) error {
+	inputs, err := core.ParseHttpSignatureInput(strings.Join(req.Request.Header.Values(httpHeaderSignatureInput), ", "))
+	if err != nil {
+		return fmt.Errorf("(VerifyHttp) parse %s: %w", httpHeaderSignatureInput, err)
+	}
+
+	signatures, err := core.ParseHttpByteSequences(strings.Join(req.Request.Header.Values(httpHeaderSignature), ", "))
+	if err != nil {
+		return fmt.Errorf("(VerifyHttp) parse %s: %w", httpHeaderSignature, err)
+	}
+
+	label := req.Label
+	if label == "" {
+		label = DefaultHttpSignatureLabel
+
+		if len(inputs) == 1 {
+			label = lo.Keys(inputs)[0]
+		}
+	}
+
+	params, hasParams := inputs[label]
+	signature, hasSignature := signatures[label]
+
+	if !hasParams || !hasSignature {
+		return fmt.Errorf("(VerifyHttp) %w: %s", ErrHttpSignatureNotFound, label)
+	}
+
+	required := req.RequiredComponents
+	if required == nil {
+		required = DefaultHttpSignatureComponents
+	}
+
+	for _, component := range required {
+		if !slices.Contains(params.Components, component) {
+			return fmt.Errorf("(VerifyHttp) %w: %s is not covered", ErrHttpSignatureMissingComponent, component)
+		}
+	}
+
+	// Without a creation time, a captured signature could be replayed forever.
+	maxAge := lo.CoalesceOrEmpty(req.MaxAge, DefaultHttpSignatureMaxAge)
+	if params.Created.IsZero() || time.Since(params.Created) > maxAge {
+		return fmt.Errorf("(VerifyHttp) %w: created at %s", ErrHttpSignatureTooOld, params.Created)
+	}
+
+	if time.Until(params.Created) > httpSignatureClockSkew {
+		return fmt.Errorf("(VerifyHttp) %w: created at %s", ErrHttpSignatureNotYetValid, params.Created)
+	}
+
+	values := make([]string, len(params.Components))
+
+	for i, component := range params.Components {
+		values[i], err = httpSignatureComponentValue(req.Request, component)
+		if err != nil {
+			return fmt.Errorf("(VerifyHttp) %w", err)
+		}
+	}
+
+	err = verifier.service.Exec(ctx, &core.HttpSignatureVerifyRequest{
+		Usage:     req.Usage,
+		Params:    params,
+		Values:    values,
+		Signature: signature,
+	})
+	if err != nil {
+		return fmt.Errorf("(VerifyHttp) %w", err)
+	}
+
+	// The signature only vouches for the digest field; the body must still match it.
+	if slices.Contains(params.Components, httpComponentDigest) {
+		err = checkContentDigest(req.Request)
+		if err != nil {
+			return fmt.Errorf("(VerifyHttp) %w", err)
+		}
+	}
+
+	return nil
+}
+
+// httpSignatureComponentValue extracts the value of a covered component from req.
+//
+// See RFC 9421, sections 2.1 and 2.2.
+func httpSignatureComponentValue(req *http.Request, component string) (string, error) {
+	target := httpSignatureTargetURI(req)
+
+	switch component {
+	case "@method":
+		return strings.ToUpper(lo.CoalesceOrEmpty(req.Method, http.MethodGet)), nil
+	case "@target-uri":
+		return target.String(), nil
+	case "@authority":
+		return httpSignatureAuthority(target), nil
+	case "@scheme":
+		return target.Scheme, nil
+	case "@request-target":
+		return target.RequestURI(), nil
+	case "@path":
+		return lo.CoalesceOrEmpty(target.EscapedPath(), "/"), nil
+	case "@query":
+		return "?" + target.RawQuery, nil
+	}
+
+	// Values returns the backing slice of the header: trimming it in place would alter the request.
+	values := slices.Clone(req.Header.Values(component))
+	if len(values) == 0 {
+		return "", fmt.Errorf("%w: %s", ErrHttpSignatureMissingComponent, component)
+	}
+
+	for i, value := range values {
+		values[i] = strings.TrimSpace(value)
+	}
+
+	return strings.Join(values, ", "), nil
+}
+
+// httpSignatureTargetURI returns the absolute URI of req. Server-side requests only carry the
+// path: the authority comes from the Host field, and the scheme from the connection. Behind a proxy
+// terminating TLS, set req.URL.Scheme before verifying a signature covering "@target-uri" or
+// "@scheme".
+func httpSignatureTargetURI(req *http.Request) *url.URL {
+	target := *req.URL
+	target.User = nil
+	target.Fragment = ""
+	target.RawFragment = ""
+
+	if target.Host == "" {
+		target.Host = req.Host
+	}
+
+	if target.Scheme == "" {
+		target.Scheme = "http"
+
+		if req.TLS != nil {
+			target.Scheme = "https"
+		}
+	}
+
+	target.Scheme = strings.ToLower(target.Scheme)
+
+	return &target
+}
+
+// httpSignatureAuthority returns the lowercase authority of target, without its port when it is the
+// default one for the scheme.
+func httpSignatureAuthority(target *url.URL) string {
+	host, port, err := net.SplitHostPort(target.Host)
+	if err != nil {
+		return strings.ToLower(target.Host)
+	}
+
+	if (target.Scheme == "http" && port == "80") || (target.Scheme == "https" && port == "443") {
+		return strings.ToLower(host)
+	}
+
+	return strings.ToLower(target.Host)
+}
+
+// readBody reads the body of req, and puts back a fresh reader in its place.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+
+	_ = req.Body.Close()
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return body, nil
+}
+
+// setContentDigest sets the Content-Digest field of req from its body (RFC 9530).
+func setContentDigest(req *http.Request) error {
+	body, err := readBody(req)
+	if err != nil {
+		return err
+	}
+
+	alg := httpContentDigestAlgs[0]
+	hasher := alg.hash()
+	hasher.Write(body)
+
+	req.Header.Set(httpHeaderContentDigest, alg.name+"=:"+base64.StdEncoding.EncodeToString(hasher.Sum(nil))+":")
+
+	return nil
+}
+
+// checkContentDigest checks the Content-Digest field of req against its body. Every digest of a
+// known algorithm must match, and there must be at least one.
+func checkContentDigest(req *http.Request) error {
+	digests, err := core.ParseHttpByteSequences(strings.Join(req.Header.Values(httpHeaderContentDigest), ", "))
+	if err != nil {
+		return fmt.Errorf("parse %s: %w", httpHeaderContentDigest, err)
+	}
+
+	body, err := readBody(req)
+	if err != nil {
+		return err
+	}
+
+	checked := false
+
+	for _, alg := range httpContentDigestAlgs {
+		digest, ok := digests[alg.name]
+		if !ok {
+			continue
+		}
+
+		hasher := alg.hash()
+		hasher.Write(body)
+
+		if subtle.ConstantTimeCompare(hasher.Sum(nil), digest) != 1 {
+			return fmt.Errorf("%w: %s", ErrContentDigestMismatch, alg.name)
+		}
+
+		checked = true
+	}
+
+	if !checked {
+		return fmt.Errorf("%w: no supported digest algorithm", ErrContentDigestMismatch)
+	}
+
+	return nil
+}
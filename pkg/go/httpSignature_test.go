@@ -0,0 +1,312 @@
+package servicejsonkeys_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/a-novel-kit/jwt/v2/jwa"
+	"github.com/a-novel-kit/jwt/v2/jwk"
+	"github.com/a-novel-kit/jwt/v2/jws"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config"
+	"github.com/a-novel/service-json-keys/v2/internal/config/env"
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+	"github.com/a-novel/service-json-keys/v2/pkg/go"
+	pkgmocks "github.com/a-novel/service-json-keys/v2/pkg/go/mocks"
+)
+
+type staticKeySource []*jwa.JWK
+
+func (source staticKeySource) SearchKeys(_ context.Context, _ string) ([]*jwa.JWK, error) {
+	return source, nil
+}
+
+// newLocalHttpSignatureClient returns a client mock whose HttpSignatureSign and JwkList RPCs are
+// served locally, by a freshly generated key.
+func newLocalHttpSignatureClient(t *testing.T) *pkgmocks.MockClient {
+	t.Helper()
+
+	privateKey, publicKey, err := jwk.GenerateED25519()
+	require.NoError(t, err)
+
+	keysConfig := map[string]*config.Jwk{"test-usage": {Alg: jwa.EdDSA}}
+
+	privateSources, err := core.NewJwkPrivateSource(staticKeySource{privateKey.JWK}, keysConfig)
+	require.NoError(t, err)
+
+	service := core.NewHttpSignatureSign(privateSources, nil, keysConfig)
+
+	client := pkgmocks.NewMockClient(t)
+
+	client.EXPECT().Keys().Return(keysConfig).Maybe()
+	client.EXPECT().
+		JwkList(mock.Anything, mock.Anything).
+		Return(&servicejsonkeys.JwkListResponse{Keys: []*jsonkeysv2.Jwk{{
+			Kty:     string(publicKey.KTY),
+			Use:     string(publicKey.Use),
+			KeyOps:  []string{string(jwa.KeyOpVerify)},
+			Alg:     string(publicKey.Alg),
+			Kid:     publicKey.KID,
+			Payload: publicKey.Payload,
+		}}}, nil).
+		Maybe()
+	client.EXPECT().
+		HttpSignatureSign(mock.Anything, mock.Anything).
+		RunAndReturn(func(
+			ctx context.Context, req *servicejsonkeys.HttpSignatureSignRequest, _ ...grpc.CallOption,
+		) (*servicejsonkeys.HttpSignatureSignResponse, error) {
+			params := &core.HttpSignatureParams{Nonce: req.GetNonce(), Tag: req.GetTag()}
+			values := make([]string, 0, len(req.GetComponents()))
+
+			if req.GetCreated() != nil {
+				params.Created = req.GetCreated().AsTime()
+			}
+
+			if req.GetExpires() != nil {
+				params.Expires = req.GetExpires().AsTime()
+			}
+
+			for _, component := range req.GetComponents() {
+				params.Components = append(params.Components, component.GetName())
+				values = append(values, component.GetValue())
+			}
+
+			signed, err := service.Exec(ctx, &core.HttpSignatureSignRequest{
+				Usage: req.GetUsage(), Params: params, Values: values,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return &servicejsonkeys.HttpSignatureSignResponse{
+				SignatureParams: signed.Params.String(),
+				Signature:       signed.Signature,
+			}, nil
+		}).
+		Maybe()
+
+	return client
+}
+
+func TestHttpSignature(t *testing.T) {
+	t.Parallel()
+
+	const body = `{"event":"order.paid","amount":"12.50"}`
+
+	// receive turns a signed outgoing request into the request the receiving server sees.
+	receive := func(sent *http.Request, update func(req *http.Request)) *http.Request {
+		received := httptest.NewRequestWithContext(sent.Context(), sent.Method, sent.URL.String(), strings.NewReader(body))
+		received.Header = sent.Header.Clone()
+
+		if update != nil {
+			update(received)
+		}
+
+		return received
+	}
+
+	testCases := []struct {
+		name string
+
+		sign   *servicejsonkeys.SignHttpRequest
+		update func(req *http.Request)
+		verify *servicejsonkeys.VerifyHttpRequest
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			sign: &servicejsonkeys.SignHttpRequest{Usage: "test-usage"},
+			verify: &servicejsonkeys.VerifyHttpRequest{
+				Usage:              "test-usage",
+				RequiredComponents: []string{"@method", "@target-uri", "content-digest"},
+				MaxAge:             time.Minute,
+			},
+		},
+		{
+			name: "Success/CustomComponents",
+
+			sign: &servicejsonkeys.SignHttpRequest{
+				Usage:      "test-usage",
+				Components: []string{"@method", "@authority", "@path", "@query", "content-type"},
+				Label:      "webhook",
+				TTL:        time.Minute,
+				Tag:        "webhooks",
+			},
+			verify: &servicejsonkeys.VerifyHttpRequest{
+				Usage:              "test-usage",
+				Label:              "webhook",
+				RequiredComponents: []string{"@method", "@authority", "@path"},
+			},
+		},
+		{
+			name: "Error/TamperedBody",
+
+			sign: &servicejsonkeys.SignHttpRequest{Usage: "test-usage"},
+			update: func(req *http.Request) {
+				req.Body = io.NopCloser(strings.NewReader(`{"event":"order.paid","amount":"1250"}`))
+			},
+			verify: &servicejsonkeys.VerifyHttpRequest{Usage: "test-usage"},
+
+			expectErr: servicejsonkeys.ErrContentDigestMismatch,
+		},
+		{
+			name: "Error/TamperedMethod",
+
+			sign: &servicejsonkeys.SignHttpRequest{Usage: "test-usage"},
+			update: func(req *http.Request) {
+				req.Method = http.MethodPut
+			},
+			verify: &servicejsonkeys.VerifyHttpRequest{Usage: "test-usage"},
+
+			expectErr: jws.ErrInvalidSignature,
+		},
+		{
+			name: "Error/ComponentNotCovered",
+
+			sign: &servicejsonkeys.SignHttpRequest{Usage: "test-usage", Components: []string{"@method"}},
+			verify: &servicejsonkeys.VerifyHttpRequest{
+				Usage:              "test-usage",
+				RequiredComponents: []string{"content-digest"},
+			},
+
+			expectErr: servicejsonkeys.ErrHttpSignatureMissingComponent,
+		},
+		{
+			name: "Error/DefaultComponentNotCovered",
+
+			sign:   &servicejsonkeys.SignHttpRequest{Usage: "test-usage", Components: []string{"@method"}},
+			verify: &servicejsonkeys.VerifyHttpRequest{Usage: "test-usage"},
+
+			expectErr: servicejsonkeys.ErrHttpSignatureMissingComponent,
+		},
+		{
+			name: "Error/CoveredFieldRemoved",
+
+			sign: &servicejsonkeys.SignHttpRequest{Usage: "test-usage", Components: []string{"content-type"}},
+			update: func(req *http.Request) {
+				req.Header.Del("Content-Type")
+			},
+			verify: &servicejsonkeys.VerifyHttpRequest{Usage: "test-usage"},
+
+			expectErr: servicejsonkeys.ErrHttpSignatureMissingComponent,
+		},
+		{
+			name: "Error/UnknownLabel",
+
+			sign:   &servicejsonkeys.SignHttpRequest{Usage: "test-usage"},
+			verify: &servicejsonkeys.VerifyHttpRequest{Usage: "test-usage", Label: "other"},
+
+			expectErr: servicejsonkeys.ErrHttpSignatureNotFound,
+		},
+		{
+			name: "Error/TooOld",
+
+			sign:   &servicejsonkeys.SignHttpRequest{Usage: "test-usage"},
+			verify: &servicejsonkeys.VerifyHttpRequest{Usage: "test-usage", MaxAge: time.Nanosecond},
+
+			expectErr: servicejsonkeys.ErrHttpSignatureTooOld,
+		},
+		{
+			name: "Error/NoCreated",
+
+			sign: &servicejsonkeys.SignHttpRequest{Usage: "test-usage"},
+			update: func(req *http.Request) {
+				req.Header.Set("Signature-Input", `sig1=("@method" "@target-uri");keyid="test"`)
+			},
+			verify: &servicejsonkeys.VerifyHttpRequest{Usage: "test-usage"},
+
+			expectErr: servicejsonkeys.ErrHttpSignatureTooOld,
+		},
+		{
+			name: "Error/CreatedInFuture",
+
+			sign: &servicejsonkeys.SignHttpRequest{Usage: "test-usage"},
+			update: func(req *http.Request) {
+				req.Header.Set("Signature-Input", fmt.Sprintf(
+					`sig1=("@method" "@target-uri");created=%d;keyid="test"`, time.Now().Add(time.Hour).Unix(),
+				))
+			},
+			verify: &servicejsonkeys.VerifyHttpRequest{Usage: "test-usage"},
+
+			expectErr: servicejsonkeys.ErrHttpSignatureNotYetValid,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newLocalHttpSignatureClient(t)
+
+			sent, err := http.NewRequestWithContext(
+				t.Context(), http.MethodPost, "https://example.com:443/hooks/orders?id=1", strings.NewReader(body),
+			)
+			require.NoError(t, err)
+
+			// Padded on purpose: the signer trims field values, but must not alter the request.
+			sent.Header.Set("Content-Type", " application/json ")
+
+			testCase.sign.Request = sent
+
+			err = servicejsonkeys.NewHttpSigner(client).SignHttp(t.Context(), testCase.sign)
+			require.NoError(t, err)
+			require.Equal(t, " application/json ", sent.Header.Get("Content-Type"))
+
+			// The signer reads the body to digest it, but leaves it in place.
+			sentBody, err := io.ReadAll(sent.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, body, string(sentBody))
+
+			verifier, err := servicejsonkeys.NewHttpSignatureVerifier(client)
+			require.NoError(t, err)
+
+			testCase.verify.Request = receive(sent, testCase.update)
+
+			err = verifier.VerifyHttp(t.Context(), testCase.verify)
+			require.ErrorIs(t, err, testCase.expectErr)
+		})
+	}
+}
+
+func TestHttpSignatureLive(t *testing.T) {
+	t.Parallel()
+
+	client, err := servicejsonkeys.NewClient(env.GrpcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	req, err := http.NewRequestWithContext(
+		t.Context(), http.MethodPost, "https://example.com/hooks", strings.NewReader(`{"foo":"bar"}`),
+	)
+	require.NoError(t, err)
+
+	err = servicejsonkeys.NewHttpSigner(client).SignHttp(t.Context(), &servicejsonkeys.SignHttpRequest{
+		Usage:   servicejsonkeys.KeyUsageAuth,
+		Request: req,
+	})
+	require.NoError(t, err)
+
+	verifier, err := servicejsonkeys.NewHttpSignatureVerifier(client)
+	require.NoError(t, err)
+
+	require.NoError(t, verifier.VerifyHttp(t.Context(), &servicejsonkeys.VerifyHttpRequest{
+		Usage:              servicejsonkeys.KeyUsageAuth,
+		Request:            req,
+		RequiredComponents: []string{"content-digest"},
+	}))
+}
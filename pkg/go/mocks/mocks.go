@@ -135,8 +135,8 @@ func (_m *MockBaseClient) EXPECT() *MockBaseClient_Expecter {
 	return &MockBaseClient_Expecter{mock: &_m.Mock}
 }
 
-// ClaimsSign provides a mock function for the type MockBaseClient
-func (_mock *MockBaseClient) ClaimsSign(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error) {
+// AuditEventSearch provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) AuditEventSearch(ctx context.Context, req *servicejsonkeys.AuditEventSearchRequest, opts ...grpc.CallOption) (*servicejsonkeys.AuditEventSearchResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, req, opts)
@@ -146,22 +146,22 @@ func (_mock *MockBaseClient) ClaimsSign(ctx context.Context, req *servicejsonkey
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for ClaimsSign")
+		panic("no return value specified for AuditEventSearch")
 	}
 
-	var r0 *servicejsonkeys.ClaimsSignResponse
+	var r0 *servicejsonkeys.AuditEventSearchResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.AuditEventSearchRequest, ...grpc.CallOption) (*servicejsonkeys.AuditEventSearchResponse, error)); ok {
 		return returnFunc(ctx, req, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) *servicejsonkeys.ClaimsSignResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.AuditEventSearchRequest, ...grpc.CallOption) *servicejsonkeys.AuditEventSearchResponse); ok {
 		r0 = returnFunc(ctx, req, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*servicejsonkeys.ClaimsSignResponse)
+			r0 = ret.Get(0).(*servicejsonkeys.AuditEventSearchResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.AuditEventSearchRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, req, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -169,29 +169,29 @@ func (_mock *MockBaseClient) ClaimsSign(ctx context.Context, req *servicejsonkey
 	return r0, r1
 }
 
-// MockBaseClient_ClaimsSign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimsSign'
-type MockBaseClient_ClaimsSign_Call struct {
+// MockBaseClient_AuditEventSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditEventSearch'
+type MockBaseClient_AuditEventSearch_Call struct {
 	*mock.Call
 }
 
-// ClaimsSign is a helper method to define mock.On call
+// AuditEventSearch is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *servicejsonkeys.ClaimsSignRequest
+//   - req *servicejsonkeys.AuditEventSearchRequest
 //   - opts ...grpc.CallOption
-func (_e *MockBaseClient_Expecter) ClaimsSign(ctx any, req any, opts ...any) *MockBaseClient_ClaimsSign_Call {
-	return &MockBaseClient_ClaimsSign_Call{Call: _e.mock.On("ClaimsSign",
+func (_e *MockBaseClient_Expecter) AuditEventSearch(ctx any, req any, opts ...any) *MockBaseClient_AuditEventSearch_Call {
+	return &MockBaseClient_AuditEventSearch_Call{Call: _e.mock.On("AuditEventSearch",
 		append([]any{ctx, req}, opts...)...)}
 }
 
-func (_c *MockBaseClient_ClaimsSign_Call) Run(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption)) *MockBaseClient_ClaimsSign_Call {
+func (_c *MockBaseClient_AuditEventSearch_Call) Run(run func(ctx context.Context, req *servicejsonkeys.AuditEventSearchRequest, opts ...grpc.CallOption)) *MockBaseClient_AuditEventSearch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *servicejsonkeys.ClaimsSignRequest
+		var arg1 *servicejsonkeys.AuditEventSearchRequest
 		if args[1] != nil {
-			arg1 = args[1].(*servicejsonkeys.ClaimsSignRequest)
+			arg1 = args[1].(*servicejsonkeys.AuditEventSearchRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -208,51 +208,18 @@ func (_c *MockBaseClient_ClaimsSign_Call) Run(run func(ctx context.Context, req
 	return _c
 }
 
-func (_c *MockBaseClient_ClaimsSign_Call) Return(v *servicejsonkeys.ClaimsSignResponse, err error) *MockBaseClient_ClaimsSign_Call {
+func (_c *MockBaseClient_AuditEventSearch_Call) Return(v *servicejsonkeys.AuditEventSearchResponse, err error) *MockBaseClient_AuditEventSearch_Call {
 	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockBaseClient_ClaimsSign_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)) *MockBaseClient_ClaimsSign_Call {
+func (_c *MockBaseClient_AuditEventSearch_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.AuditEventSearchRequest, opts ...grpc.CallOption) (*servicejsonkeys.AuditEventSearchResponse, error)) *MockBaseClient_AuditEventSearch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Close provides a mock function for the type MockBaseClient
-func (_mock *MockBaseClient) Close() {
-	_mock.Called()
-	return
-}
-
-// MockBaseClient_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
-type MockBaseClient_Close_Call struct {
-	*mock.Call
-}
-
-// Close is a helper method to define mock.On call
-func (_e *MockBaseClient_Expecter) Close() *MockBaseClient_Close_Call {
-	return &MockBaseClient_Close_Call{Call: _e.mock.On("Close")}
-}
-
-func (_c *MockBaseClient_Close_Call) Run(run func()) *MockBaseClient_Close_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
-	return _c
-}
-
-func (_c *MockBaseClient_Close_Call) Return() *MockBaseClient_Close_Call {
-	_c.Call.Return()
-	return _c
-}
-
-func (_c *MockBaseClient_Close_Call) RunAndReturn(run func()) *MockBaseClient_Close_Call {
-	_c.Run(run)
-	return _c
-}
-
-// JwkGet provides a mock function for the type MockBaseClient
-func (_mock *MockBaseClient) JwkGet(ctx context.Context, req *servicejsonkeys.JwkGetRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkGetResponse, error) {
+// ClaimsSign provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) ClaimsSign(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, req, opts)
@@ -262,22 +229,22 @@ func (_mock *MockBaseClient) JwkGet(ctx context.Context, req *servicejsonkeys.Jw
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for JwkGet")
+		panic("no return value specified for ClaimsSign")
 	}
 
-	var r0 *servicejsonkeys.JwkGetResponse
+	var r0 *servicejsonkeys.ClaimsSignResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkGetRequest, ...grpc.CallOption) (*servicejsonkeys.JwkGetResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)); ok {
 		return returnFunc(ctx, req, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkGetRequest, ...grpc.CallOption) *servicejsonkeys.JwkGetResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) *servicejsonkeys.ClaimsSignResponse); ok {
 		r0 = returnFunc(ctx, req, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*servicejsonkeys.JwkGetResponse)
+			r0 = ret.Get(0).(*servicejsonkeys.ClaimsSignResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.JwkGetRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, req, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -285,29 +252,29 @@ func (_mock *MockBaseClient) JwkGet(ctx context.Context, req *servicejsonkeys.Jw
 	return r0, r1
 }
 
-// MockBaseClient_JwkGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JwkGet'
-type MockBaseClient_JwkGet_Call struct {
+// MockBaseClient_ClaimsSign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimsSign'
+type MockBaseClient_ClaimsSign_Call struct {
 	*mock.Call
 }
 
-// JwkGet is a helper method to define mock.On call
+// ClaimsSign is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *servicejsonkeys.JwkGetRequest
+//   - req *servicejsonkeys.ClaimsSignRequest
 //   - opts ...grpc.CallOption
-func (_e *MockBaseClient_Expecter) JwkGet(ctx any, req any, opts ...any) *MockBaseClient_JwkGet_Call {
-	return &MockBaseClient_JwkGet_Call{Call: _e.mock.On("JwkGet",
+func (_e *MockBaseClient_Expecter) ClaimsSign(ctx any, req any, opts ...any) *MockBaseClient_ClaimsSign_Call {
+	return &MockBaseClient_ClaimsSign_Call{Call: _e.mock.On("ClaimsSign",
 		append([]any{ctx, req}, opts...)...)}
 }
 
-func (_c *MockBaseClient_JwkGet_Call) Run(run func(ctx context.Context, req *servicejsonkeys.JwkGetRequest, opts ...grpc.CallOption)) *MockBaseClient_JwkGet_Call {
+func (_c *MockBaseClient_ClaimsSign_Call) Run(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption)) *MockBaseClient_ClaimsSign_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *servicejsonkeys.JwkGetRequest
+		var arg1 *servicejsonkeys.ClaimsSignRequest
 		if args[1] != nil {
-			arg1 = args[1].(*servicejsonkeys.JwkGetRequest)
+			arg1 = args[1].(*servicejsonkeys.ClaimsSignRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -324,18 +291,18 @@ func (_c *MockBaseClient_JwkGet_Call) Run(run func(ctx context.Context, req *ser
 	return _c
 }
 
-func (_c *MockBaseClient_JwkGet_Call) Return(v *servicejsonkeys.JwkGetResponse, err error) *MockBaseClient_JwkGet_Call {
+func (_c *MockBaseClient_ClaimsSign_Call) Return(v *servicejsonkeys.ClaimsSignResponse, err error) *MockBaseClient_ClaimsSign_Call {
 	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockBaseClient_JwkGet_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.JwkGetRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkGetResponse, error)) *MockBaseClient_JwkGet_Call {
+func (_c *MockBaseClient_ClaimsSign_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)) *MockBaseClient_ClaimsSign_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// JwkList provides a mock function for the type MockBaseClient
-func (_mock *MockBaseClient) JwkList(ctx context.Context, req *servicejsonkeys.JwkListRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkListResponse, error) {
+// ClaimsVerify provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) ClaimsVerify(ctx context.Context, req *servicejsonkeys.ClaimsVerifyRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsVerifyResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, req, opts)
@@ -345,22 +312,22 @@ func (_mock *MockBaseClient) JwkList(ctx context.Context, req *servicejsonkeys.J
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for JwkList")
+		panic("no return value specified for ClaimsVerify")
 	}
 
-	var r0 *servicejsonkeys.JwkListResponse
+	var r0 *servicejsonkeys.ClaimsVerifyResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkListRequest, ...grpc.CallOption) (*servicejsonkeys.JwkListResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsVerifyRequest, ...grpc.CallOption) (*servicejsonkeys.ClaimsVerifyResponse, error)); ok {
 		return returnFunc(ctx, req, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkListRequest, ...grpc.CallOption) *servicejsonkeys.JwkListResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsVerifyRequest, ...grpc.CallOption) *servicejsonkeys.ClaimsVerifyResponse); ok {
 		r0 = returnFunc(ctx, req, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*servicejsonkeys.JwkListResponse)
+			r0 = ret.Get(0).(*servicejsonkeys.ClaimsVerifyResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.JwkListRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.ClaimsVerifyRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, req, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -368,29 +335,29 @@ func (_mock *MockBaseClient) JwkList(ctx context.Context, req *servicejsonkeys.J
 	return r0, r1
 }
 
-// MockBaseClient_JwkList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JwkList'
-type MockBaseClient_JwkList_Call struct {
+// MockBaseClient_ClaimsVerify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimsVerify'
+type MockBaseClient_ClaimsVerify_Call struct {
 	*mock.Call
 }
 
-// JwkList is a helper method to define mock.On call
+// ClaimsVerify is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *servicejsonkeys.JwkListRequest
+//   - req *servicejsonkeys.ClaimsVerifyRequest
 //   - opts ...grpc.CallOption
-func (_e *MockBaseClient_Expecter) JwkList(ctx any, req any, opts ...any) *MockBaseClient_JwkList_Call {
-	return &MockBaseClient_JwkList_Call{Call: _e.mock.On("JwkList",
+func (_e *MockBaseClient_Expecter) ClaimsVerify(ctx any, req any, opts ...any) *MockBaseClient_ClaimsVerify_Call {
+	return &MockBaseClient_ClaimsVerify_Call{Call: _e.mock.On("ClaimsVerify",
 		append([]any{ctx, req}, opts...)...)}
 }
 
-func (_c *MockBaseClient_JwkList_Call) Run(run func(ctx context.Context, req *servicejsonkeys.JwkListRequest, opts ...grpc.CallOption)) *MockBaseClient_JwkList_Call {
+func (_c *MockBaseClient_ClaimsVerify_Call) Run(run func(ctx context.Context, req *servicejsonkeys.ClaimsVerifyRequest, opts ...grpc.CallOption)) *MockBaseClient_ClaimsVerify_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *servicejsonkeys.JwkListRequest
+		var arg1 *servicejsonkeys.ClaimsVerifyRequest
 		if args[1] != nil {
-			arg1 = args[1].(*servicejsonkeys.JwkListRequest)
+			arg1 = args[1].(*servicejsonkeys.ClaimsVerifyRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -407,18 +374,51 @@ func (_c *MockBaseClient_JwkList_Call) Run(run func(ctx context.Context, req *se
 	return _c
 }
 
-func (_c *MockBaseClient_JwkList_Call) Return(v *servicejsonkeys.JwkListResponse, err error) *MockBaseClient_JwkList_Call {
+func (_c *MockBaseClient_ClaimsVerify_Call) Return(v *servicejsonkeys.ClaimsVerifyResponse, err error) *MockBaseClient_ClaimsVerify_Call {
 	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockBaseClient_JwkList_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.JwkListRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkListResponse, error)) *MockBaseClient_JwkList_Call {
+func (_c *MockBaseClient_ClaimsVerify_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.ClaimsVerifyRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsVerifyResponse, error)) *MockBaseClient_ClaimsVerify_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Status provides a mock function for the type MockBaseClient
-func (_mock *MockBaseClient) Status(ctx context.Context, req *servicejsonkeys.StatusRequest, opts ...grpc.CallOption) (*servicejsonkeys.StatusResponse, error) {
+// Close provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) Close() {
+	_mock.Called()
+	return
+}
+
+// MockBaseClient_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockBaseClient_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockBaseClient_Expecter) Close() *MockBaseClient_Close_Call {
+	return &MockBaseClient_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockBaseClient_Close_Call) Run(run func()) *MockBaseClient_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockBaseClient_Close_Call) Return() *MockBaseClient_Close_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockBaseClient_Close_Call) RunAndReturn(run func()) *MockBaseClient_Close_Call {
+	_c.Run(run)
+	return _c
+}
+
+// HttpSignatureSign provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) HttpSignatureSign(ctx context.Context, req *servicejsonkeys.HttpSignatureSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.HttpSignatureSignResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, req, opts)
@@ -428,22 +428,22 @@ func (_mock *MockBaseClient) Status(ctx context.Context, req *servicejsonkeys.St
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for Status")
+		panic("no return value specified for HttpSignatureSign")
 	}
 
-	var r0 *servicejsonkeys.StatusResponse
+	var r0 *servicejsonkeys.HttpSignatureSignResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.StatusRequest, ...grpc.CallOption) (*servicejsonkeys.StatusResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.HttpSignatureSignRequest, ...grpc.CallOption) (*servicejsonkeys.HttpSignatureSignResponse, error)); ok {
 		return returnFunc(ctx, req, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.StatusRequest, ...grpc.CallOption) *servicejsonkeys.StatusResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.HttpSignatureSignRequest, ...grpc.CallOption) *servicejsonkeys.HttpSignatureSignResponse); ok {
 		r0 = returnFunc(ctx, req, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*servicejsonkeys.StatusResponse)
+			r0 = ret.Get(0).(*servicejsonkeys.HttpSignatureSignResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.StatusRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.HttpSignatureSignRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, req, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -451,29 +451,29 @@ func (_mock *MockBaseClient) Status(ctx context.Context, req *servicejsonkeys.St
 	return r0, r1
 }
 
-// MockBaseClient_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
-type MockBaseClient_Status_Call struct {
+// MockBaseClient_HttpSignatureSign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HttpSignatureSign'
+type MockBaseClient_HttpSignatureSign_Call struct {
 	*mock.Call
 }
 
-// Status is a helper method to define mock.On call
+// HttpSignatureSign is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *servicejsonkeys.StatusRequest
+//   - req *servicejsonkeys.HttpSignatureSignRequest
 //   - opts ...grpc.CallOption
-func (_e *MockBaseClient_Expecter) Status(ctx any, req any, opts ...any) *MockBaseClient_Status_Call {
-	return &MockBaseClient_Status_Call{Call: _e.mock.On("Status",
+func (_e *MockBaseClient_Expecter) HttpSignatureSign(ctx any, req any, opts ...any) *MockBaseClient_HttpSignatureSign_Call {
+	return &MockBaseClient_HttpSignatureSign_Call{Call: _e.mock.On("HttpSignatureSign",
 		append([]any{ctx, req}, opts...)...)}
 }
 
-func (_c *MockBaseClient_Status_Call) Run(run func(ctx context.Context, req *servicejsonkeys.StatusRequest, opts ...grpc.CallOption)) *MockBaseClient_Status_Call {
+func (_c *MockBaseClient_HttpSignatureSign_Call) Run(run func(ctx context.Context, req *servicejsonkeys.HttpSignatureSignRequest, opts ...grpc.CallOption)) *MockBaseClient_HttpSignatureSign_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *servicejsonkeys.StatusRequest
+		var arg1 *servicejsonkeys.HttpSignatureSignRequest
 		if args[1] != nil {
-			arg1 = args[1].(*servicejsonkeys.StatusRequest)
+			arg1 = args[1].(*servicejsonkeys.HttpSignatureSignRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -490,18 +490,18 @@ func (_c *MockBaseClient_Status_Call) Run(run func(ctx context.Context, req *ser
 	return _c
 }
 
-func (_c *MockBaseClient_Status_Call) Return(v *servicejsonkeys.StatusResponse, err error) *MockBaseClient_Status_Call {
+func (_c *MockBaseClient_HttpSignatureSign_Call) Return(v *servicejsonkeys.HttpSignatureSignResponse, err error) *MockBaseClient_HttpSignatureSign_Call {
 	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockBaseClient_Status_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.StatusRequest, opts ...grpc.CallOption) (*servicejsonkeys.StatusResponse, error)) *MockBaseClient_Status_Call {
+func (_c *MockBaseClient_HttpSignatureSign_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.HttpSignatureSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.HttpSignatureSignResponse, error)) *MockBaseClient_HttpSignatureSign_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UnaryEcho provides a mock function for the type MockBaseClient
-func (_mock *MockBaseClient) UnaryEcho(ctx context.Context, req *golibproto.UnaryEchoRequest, opts ...grpc.CallOption) (*golibproto.UnaryEchoResponse, error) {
+// JwkBurn provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) JwkBurn(ctx context.Context, req *servicejsonkeys.JwkBurnRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkBurnResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, req, opts)
@@ -511,22 +511,22 @@ func (_mock *MockBaseClient) UnaryEcho(ctx context.Context, req *golibproto.Unar
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for UnaryEcho")
+		panic("no return value specified for JwkBurn")
 	}
 
-	var r0 *golibproto.UnaryEchoResponse
+	var r0 *servicejsonkeys.JwkBurnResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *golibproto.UnaryEchoRequest, ...grpc.CallOption) (*golibproto.UnaryEchoResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkBurnRequest, ...grpc.CallOption) (*servicejsonkeys.JwkBurnResponse, error)); ok {
 		return returnFunc(ctx, req, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *golibproto.UnaryEchoRequest, ...grpc.CallOption) *golibproto.UnaryEchoResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkBurnRequest, ...grpc.CallOption) *servicejsonkeys.JwkBurnResponse); ok {
 		r0 = returnFunc(ctx, req, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*golibproto.UnaryEchoResponse)
+			r0 = ret.Get(0).(*servicejsonkeys.JwkBurnResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *golibproto.UnaryEchoRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.JwkBurnRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, req, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -534,29 +534,29 @@ func (_mock *MockBaseClient) UnaryEcho(ctx context.Context, req *golibproto.Unar
 	return r0, r1
 }
 
-// MockBaseClient_UnaryEcho_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnaryEcho'
-type MockBaseClient_UnaryEcho_Call struct {
+// MockBaseClient_JwkBurn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JwkBurn'
+type MockBaseClient_JwkBurn_Call struct {
 	*mock.Call
 }
 
-// UnaryEcho is a helper method to define mock.On call
+// JwkBurn is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *golibproto.UnaryEchoRequest
+//   - req *servicejsonkeys.JwkBurnRequest
 //   - opts ...grpc.CallOption
-func (_e *MockBaseClient_Expecter) UnaryEcho(ctx any, req any, opts ...any) *MockBaseClient_UnaryEcho_Call {
-	return &MockBaseClient_UnaryEcho_Call{Call: _e.mock.On("UnaryEcho",
+func (_e *MockBaseClient_Expecter) JwkBurn(ctx any, req any, opts ...any) *MockBaseClient_JwkBurn_Call {
+	return &MockBaseClient_JwkBurn_Call{Call: _e.mock.On("JwkBurn",
 		append([]any{ctx, req}, opts...)...)}
 }
 
-func (_c *MockBaseClient_UnaryEcho_Call) Run(run func(ctx context.Context, req *golibproto.UnaryEchoRequest, opts ...grpc.CallOption)) *MockBaseClient_UnaryEcho_Call {
+func (_c *MockBaseClient_JwkBurn_Call) Run(run func(ctx context.Context, req *servicejsonkeys.JwkBurnRequest, opts ...grpc.CallOption)) *MockBaseClient_JwkBurn_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *golibproto.UnaryEchoRequest
+		var arg1 *servicejsonkeys.JwkBurnRequest
 		if args[1] != nil {
-			arg1 = args[1].(*golibproto.UnaryEchoRequest)
+			arg1 = args[1].(*servicejsonkeys.JwkBurnRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -573,45 +573,18 @@ func (_c *MockBaseClient_UnaryEcho_Call) Run(run func(ctx context.Context, req *
 	return _c
 }
 
-func (_c *MockBaseClient_UnaryEcho_Call) Return(unaryEchoResponse *golibproto.UnaryEchoResponse, err error) *MockBaseClient_UnaryEcho_Call {
-	_c.Call.Return(unaryEchoResponse, err)
+func (_c *MockBaseClient_JwkBurn_Call) Return(v *servicejsonkeys.JwkBurnResponse, err error) *MockBaseClient_JwkBurn_Call {
+	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockBaseClient_UnaryEcho_Call) RunAndReturn(run func(ctx context.Context, req *golibproto.UnaryEchoRequest, opts ...grpc.CallOption) (*golibproto.UnaryEchoResponse, error)) *MockBaseClient_UnaryEcho_Call {
+func (_c *MockBaseClient_JwkBurn_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.JwkBurnRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkBurnResponse, error)) *MockBaseClient_JwkBurn_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockClient creates a new instance of MockClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockClient(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockClient {
-	mock := &MockClient{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockClient is an autogenerated mock type for the Client type
-type MockClient struct {
-	mock.Mock
-}
-
-type MockClient_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockClient) EXPECT() *MockClient_Expecter {
-	return &MockClient_Expecter{mock: &_m.Mock}
-}
-
-// ClaimsSign provides a mock function for the type MockClient
-func (_mock *MockClient) ClaimsSign(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error) {
+// JwkGet provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) JwkGet(ctx context.Context, req *servicejsonkeys.JwkGetRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkGetResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, req, opts)
@@ -621,22 +594,22 @@ func (_mock *MockClient) ClaimsSign(ctx context.Context, req *servicejsonkeys.Cl
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for ClaimsSign")
+		panic("no return value specified for JwkGet")
 	}
 
-	var r0 *servicejsonkeys.ClaimsSignResponse
+	var r0 *servicejsonkeys.JwkGetResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkGetRequest, ...grpc.CallOption) (*servicejsonkeys.JwkGetResponse, error)); ok {
 		return returnFunc(ctx, req, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) *servicejsonkeys.ClaimsSignResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkGetRequest, ...grpc.CallOption) *servicejsonkeys.JwkGetResponse); ok {
 		r0 = returnFunc(ctx, req, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*servicejsonkeys.ClaimsSignResponse)
+			r0 = ret.Get(0).(*servicejsonkeys.JwkGetResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.JwkGetRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, req, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -644,29 +617,29 @@ func (_mock *MockClient) ClaimsSign(ctx context.Context, req *servicejsonkeys.Cl
 	return r0, r1
 }
 
-// MockClient_ClaimsSign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimsSign'
-type MockClient_ClaimsSign_Call struct {
+// MockBaseClient_JwkGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JwkGet'
+type MockBaseClient_JwkGet_Call struct {
 	*mock.Call
 }
 
-// ClaimsSign is a helper method to define mock.On call
+// JwkGet is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *servicejsonkeys.ClaimsSignRequest
+//   - req *servicejsonkeys.JwkGetRequest
 //   - opts ...grpc.CallOption
-func (_e *MockClient_Expecter) ClaimsSign(ctx any, req any, opts ...any) *MockClient_ClaimsSign_Call {
-	return &MockClient_ClaimsSign_Call{Call: _e.mock.On("ClaimsSign",
+func (_e *MockBaseClient_Expecter) JwkGet(ctx any, req any, opts ...any) *MockBaseClient_JwkGet_Call {
+	return &MockBaseClient_JwkGet_Call{Call: _e.mock.On("JwkGet",
 		append([]any{ctx, req}, opts...)...)}
 }
 
-func (_c *MockClient_ClaimsSign_Call) Run(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption)) *MockClient_ClaimsSign_Call {
+func (_c *MockBaseClient_JwkGet_Call) Run(run func(ctx context.Context, req *servicejsonkeys.JwkGetRequest, opts ...grpc.CallOption)) *MockBaseClient_JwkGet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *servicejsonkeys.ClaimsSignRequest
+		var arg1 *servicejsonkeys.JwkGetRequest
 		if args[1] != nil {
-			arg1 = args[1].(*servicejsonkeys.ClaimsSignRequest)
+			arg1 = args[1].(*servicejsonkeys.JwkGetRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -683,51 +656,1618 @@ func (_c *MockClient_ClaimsSign_Call) Run(run func(ctx context.Context, req *ser
 	return _c
 }
 
-func (_c *MockClient_ClaimsSign_Call) Return(v *servicejsonkeys.ClaimsSignResponse, err error) *MockClient_ClaimsSign_Call {
+func (_c *MockBaseClient_JwkGet_Call) Return(v *servicejsonkeys.JwkGetResponse, err error) *MockBaseClient_JwkGet_Call {
 	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockClient_ClaimsSign_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)) *MockClient_ClaimsSign_Call {
+func (_c *MockBaseClient_JwkGet_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.JwkGetRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkGetResponse, error)) *MockBaseClient_JwkGet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Close provides a mock function for the type MockClient
-func (_mock *MockClient) Close() {
-	_mock.Called()
-	return
-}
-
-// MockClient_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
-type MockClient_Close_Call struct {
-	*mock.Call
+// JwkList provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) JwkList(ctx context.Context, req *servicejsonkeys.JwkListRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkListResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for JwkList")
+	}
+
+	var r0 *servicejsonkeys.JwkListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkListRequest, ...grpc.CallOption) (*servicejsonkeys.JwkListResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkListRequest, ...grpc.CallOption) *servicejsonkeys.JwkListResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.JwkListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.JwkListRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBaseClient_JwkList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JwkList'
+type MockBaseClient_JwkList_Call struct {
+	*mock.Call
+}
+
+// JwkList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.JwkListRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBaseClient_Expecter) JwkList(ctx any, req any, opts ...any) *MockBaseClient_JwkList_Call {
+	return &MockBaseClient_JwkList_Call{Call: _e.mock.On("JwkList",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockBaseClient_JwkList_Call) Run(run func(ctx context.Context, req *servicejsonkeys.JwkListRequest, opts ...grpc.CallOption)) *MockBaseClient_JwkList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.JwkListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.JwkListRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBaseClient_JwkList_Call) Return(v *servicejsonkeys.JwkListResponse, err error) *MockBaseClient_JwkList_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockBaseClient_JwkList_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.JwkListRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkListResponse, error)) *MockBaseClient_JwkList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JwkRotate provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) JwkRotate(ctx context.Context, req *servicejsonkeys.JwkRotateRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkRotateResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for JwkRotate")
+	}
+
+	var r0 *servicejsonkeys.JwkRotateResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkRotateRequest, ...grpc.CallOption) (*servicejsonkeys.JwkRotateResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkRotateRequest, ...grpc.CallOption) *servicejsonkeys.JwkRotateResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.JwkRotateResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.JwkRotateRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBaseClient_JwkRotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JwkRotate'
+type MockBaseClient_JwkRotate_Call struct {
+	*mock.Call
+}
+
+// JwkRotate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.JwkRotateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBaseClient_Expecter) JwkRotate(ctx any, req any, opts ...any) *MockBaseClient_JwkRotate_Call {
+	return &MockBaseClient_JwkRotate_Call{Call: _e.mock.On("JwkRotate",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockBaseClient_JwkRotate_Call) Run(run func(ctx context.Context, req *servicejsonkeys.JwkRotateRequest, opts ...grpc.CallOption)) *MockBaseClient_JwkRotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.JwkRotateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.JwkRotateRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBaseClient_JwkRotate_Call) Return(v *servicejsonkeys.JwkRotateResponse, err error) *MockBaseClient_JwkRotate_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockBaseClient_JwkRotate_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.JwkRotateRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkRotateResponse, error)) *MockBaseClient_JwkRotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PayloadSign provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) PayloadSign(ctx context.Context, req *servicejsonkeys.PayloadSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.PayloadSignResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PayloadSign")
+	}
+
+	var r0 *servicejsonkeys.PayloadSignResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.PayloadSignRequest, ...grpc.CallOption) (*servicejsonkeys.PayloadSignResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.PayloadSignRequest, ...grpc.CallOption) *servicejsonkeys.PayloadSignResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.PayloadSignResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.PayloadSignRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBaseClient_PayloadSign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PayloadSign'
+type MockBaseClient_PayloadSign_Call struct {
+	*mock.Call
+}
+
+// PayloadSign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.PayloadSignRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBaseClient_Expecter) PayloadSign(ctx any, req any, opts ...any) *MockBaseClient_PayloadSign_Call {
+	return &MockBaseClient_PayloadSign_Call{Call: _e.mock.On("PayloadSign",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockBaseClient_PayloadSign_Call) Run(run func(ctx context.Context, req *servicejsonkeys.PayloadSignRequest, opts ...grpc.CallOption)) *MockBaseClient_PayloadSign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.PayloadSignRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.PayloadSignRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBaseClient_PayloadSign_Call) Return(v *servicejsonkeys.PayloadSignResponse, err error) *MockBaseClient_PayloadSign_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockBaseClient_PayloadSign_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.PayloadSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.PayloadSignResponse, error)) *MockBaseClient_PayloadSign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeToken provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) RevokeToken(ctx context.Context, req *servicejsonkeys.RevokeTokenRequest, opts ...grpc.CallOption) (*servicejsonkeys.RevokeTokenResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeToken")
+	}
+
+	var r0 *servicejsonkeys.RevokeTokenResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.RevokeTokenRequest, ...grpc.CallOption) (*servicejsonkeys.RevokeTokenResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.RevokeTokenRequest, ...grpc.CallOption) *servicejsonkeys.RevokeTokenResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.RevokeTokenResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.RevokeTokenRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBaseClient_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type MockBaseClient_RevokeToken_Call struct {
+	*mock.Call
+}
+
+// RevokeToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.RevokeTokenRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBaseClient_Expecter) RevokeToken(ctx any, req any, opts ...any) *MockBaseClient_RevokeToken_Call {
+	return &MockBaseClient_RevokeToken_Call{Call: _e.mock.On("RevokeToken",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockBaseClient_RevokeToken_Call) Run(run func(ctx context.Context, req *servicejsonkeys.RevokeTokenRequest, opts ...grpc.CallOption)) *MockBaseClient_RevokeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.RevokeTokenRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.RevokeTokenRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBaseClient_RevokeToken_Call) Return(v *servicejsonkeys.RevokeTokenResponse, err error) *MockBaseClient_RevokeToken_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockBaseClient_RevokeToken_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.RevokeTokenRequest, opts ...grpc.CallOption) (*servicejsonkeys.RevokeTokenResponse, error)) *MockBaseClient_RevokeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokedTokenSync provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) RevokedTokenSync(ctx context.Context, req *servicejsonkeys.RevokedTokenSyncRequest, opts ...grpc.CallOption) (*servicejsonkeys.RevokedTokenSyncResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokedTokenSync")
+	}
+
+	var r0 *servicejsonkeys.RevokedTokenSyncResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.RevokedTokenSyncRequest, ...grpc.CallOption) (*servicejsonkeys.RevokedTokenSyncResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.RevokedTokenSyncRequest, ...grpc.CallOption) *servicejsonkeys.RevokedTokenSyncResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.RevokedTokenSyncResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.RevokedTokenSyncRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBaseClient_RevokedTokenSync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokedTokenSync'
+type MockBaseClient_RevokedTokenSync_Call struct {
+	*mock.Call
+}
+
+// RevokedTokenSync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.RevokedTokenSyncRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBaseClient_Expecter) RevokedTokenSync(ctx any, req any, opts ...any) *MockBaseClient_RevokedTokenSync_Call {
+	return &MockBaseClient_RevokedTokenSync_Call{Call: _e.mock.On("RevokedTokenSync",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockBaseClient_RevokedTokenSync_Call) Run(run func(ctx context.Context, req *servicejsonkeys.RevokedTokenSyncRequest, opts ...grpc.CallOption)) *MockBaseClient_RevokedTokenSync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.RevokedTokenSyncRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.RevokedTokenSyncRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBaseClient_RevokedTokenSync_Call) Return(v *servicejsonkeys.RevokedTokenSyncResponse, err error) *MockBaseClient_RevokedTokenSync_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockBaseClient_RevokedTokenSync_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.RevokedTokenSyncRequest, opts ...grpc.CallOption) (*servicejsonkeys.RevokedTokenSyncResponse, error)) *MockBaseClient_RevokedTokenSync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SigningFreeze provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) SigningFreeze(ctx context.Context, req *servicejsonkeys.SigningFreezeRequest, opts ...grpc.CallOption) (*servicejsonkeys.SigningFreezeResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for SigningFreeze")
+	}
+
+	var r0 *servicejsonkeys.SigningFreezeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.SigningFreezeRequest, ...grpc.CallOption) (*servicejsonkeys.SigningFreezeResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.SigningFreezeRequest, ...grpc.CallOption) *servicejsonkeys.SigningFreezeResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.SigningFreezeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.SigningFreezeRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBaseClient_SigningFreeze_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SigningFreeze'
+type MockBaseClient_SigningFreeze_Call struct {
+	*mock.Call
+}
+
+// SigningFreeze is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.SigningFreezeRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBaseClient_Expecter) SigningFreeze(ctx any, req any, opts ...any) *MockBaseClient_SigningFreeze_Call {
+	return &MockBaseClient_SigningFreeze_Call{Call: _e.mock.On("SigningFreeze",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockBaseClient_SigningFreeze_Call) Run(run func(ctx context.Context, req *servicejsonkeys.SigningFreezeRequest, opts ...grpc.CallOption)) *MockBaseClient_SigningFreeze_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.SigningFreezeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.SigningFreezeRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBaseClient_SigningFreeze_Call) Return(v *servicejsonkeys.SigningFreezeResponse, err error) *MockBaseClient_SigningFreeze_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockBaseClient_SigningFreeze_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.SigningFreezeRequest, opts ...grpc.CallOption) (*servicejsonkeys.SigningFreezeResponse, error)) *MockBaseClient_SigningFreeze_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Status provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) Status(ctx context.Context, req *servicejsonkeys.StatusRequest, opts ...grpc.CallOption) (*servicejsonkeys.StatusResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 *servicejsonkeys.StatusResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.StatusRequest, ...grpc.CallOption) (*servicejsonkeys.StatusResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.StatusRequest, ...grpc.CallOption) *servicejsonkeys.StatusResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.StatusResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.StatusRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBaseClient_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type MockBaseClient_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.StatusRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBaseClient_Expecter) Status(ctx any, req any, opts ...any) *MockBaseClient_Status_Call {
+	return &MockBaseClient_Status_Call{Call: _e.mock.On("Status",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockBaseClient_Status_Call) Run(run func(ctx context.Context, req *servicejsonkeys.StatusRequest, opts ...grpc.CallOption)) *MockBaseClient_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.StatusRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.StatusRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBaseClient_Status_Call) Return(v *servicejsonkeys.StatusResponse, err error) *MockBaseClient_Status_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockBaseClient_Status_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.StatusRequest, opts ...grpc.CallOption) (*servicejsonkeys.StatusResponse, error)) *MockBaseClient_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnaryEcho provides a mock function for the type MockBaseClient
+func (_mock *MockBaseClient) UnaryEcho(ctx context.Context, req *golibproto.UnaryEchoRequest, opts ...grpc.CallOption) (*golibproto.UnaryEchoResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnaryEcho")
+	}
+
+	var r0 *golibproto.UnaryEchoResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *golibproto.UnaryEchoRequest, ...grpc.CallOption) (*golibproto.UnaryEchoResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *golibproto.UnaryEchoRequest, ...grpc.CallOption) *golibproto.UnaryEchoResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*golibproto.UnaryEchoResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *golibproto.UnaryEchoRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBaseClient_UnaryEcho_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnaryEcho'
+type MockBaseClient_UnaryEcho_Call struct {
+	*mock.Call
+}
+
+// UnaryEcho is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *golibproto.UnaryEchoRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBaseClient_Expecter) UnaryEcho(ctx any, req any, opts ...any) *MockBaseClient_UnaryEcho_Call {
+	return &MockBaseClient_UnaryEcho_Call{Call: _e.mock.On("UnaryEcho",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockBaseClient_UnaryEcho_Call) Run(run func(ctx context.Context, req *golibproto.UnaryEchoRequest, opts ...grpc.CallOption)) *MockBaseClient_UnaryEcho_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *golibproto.UnaryEchoRequest
+		if args[1] != nil {
+			arg1 = args[1].(*golibproto.UnaryEchoRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBaseClient_UnaryEcho_Call) Return(unaryEchoResponse *golibproto.UnaryEchoResponse, err error) *MockBaseClient_UnaryEcho_Call {
+	_c.Call.Return(unaryEchoResponse, err)
+	return _c
+}
+
+func (_c *MockBaseClient_UnaryEcho_Call) RunAndReturn(run func(ctx context.Context, req *golibproto.UnaryEchoRequest, opts ...grpc.CallOption) (*golibproto.UnaryEchoResponse, error)) *MockBaseClient_UnaryEcho_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockClient creates a new instance of MockClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClient {
+	mock := &MockClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockClient is an autogenerated mock type for the Client type
+type MockClient struct {
+	mock.Mock
+}
+
+type MockClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClient) EXPECT() *MockClient_Expecter {
+	return &MockClient_Expecter{mock: &_m.Mock}
+}
+
+// AuditEventSearch provides a mock function for the type MockClient
+func (_mock *MockClient) AuditEventSearch(ctx context.Context, req *servicejsonkeys.AuditEventSearchRequest, opts ...grpc.CallOption) (*servicejsonkeys.AuditEventSearchResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditEventSearch")
+	}
+
+	var r0 *servicejsonkeys.AuditEventSearchResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.AuditEventSearchRequest, ...grpc.CallOption) (*servicejsonkeys.AuditEventSearchResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.AuditEventSearchRequest, ...grpc.CallOption) *servicejsonkeys.AuditEventSearchResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.AuditEventSearchResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.AuditEventSearchRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_AuditEventSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditEventSearch'
+type MockClient_AuditEventSearch_Call struct {
+	*mock.Call
+}
+
+// AuditEventSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.AuditEventSearchRequest
+//   - opts ...grpc.CallOption
+func (_e *MockClient_Expecter) AuditEventSearch(ctx any, req any, opts ...any) *MockClient_AuditEventSearch_Call {
+	return &MockClient_AuditEventSearch_Call{Call: _e.mock.On("AuditEventSearch",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockClient_AuditEventSearch_Call) Run(run func(ctx context.Context, req *servicejsonkeys.AuditEventSearchRequest, opts ...grpc.CallOption)) *MockClient_AuditEventSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.AuditEventSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.AuditEventSearchRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_AuditEventSearch_Call) Return(v *servicejsonkeys.AuditEventSearchResponse, err error) *MockClient_AuditEventSearch_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_AuditEventSearch_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.AuditEventSearchRequest, opts ...grpc.CallOption) (*servicejsonkeys.AuditEventSearchResponse, error)) *MockClient_AuditEventSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimsSign provides a mock function for the type MockClient
+func (_mock *MockClient) ClaimsSign(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimsSign")
+	}
+
+	var r0 *servicejsonkeys.ClaimsSignResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) *servicejsonkeys.ClaimsSignResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.ClaimsSignResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ClaimsSign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimsSign'
+type MockClient_ClaimsSign_Call struct {
+	*mock.Call
+}
+
+// ClaimsSign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.ClaimsSignRequest
+//   - opts ...grpc.CallOption
+func (_e *MockClient_Expecter) ClaimsSign(ctx any, req any, opts ...any) *MockClient_ClaimsSign_Call {
+	return &MockClient_ClaimsSign_Call{Call: _e.mock.On("ClaimsSign",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockClient_ClaimsSign_Call) Run(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption)) *MockClient_ClaimsSign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.ClaimsSignRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.ClaimsSignRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ClaimsSign_Call) Return(v *servicejsonkeys.ClaimsSignResponse, err error) *MockClient_ClaimsSign_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_ClaimsSign_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)) *MockClient_ClaimsSign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimsVerify provides a mock function for the type MockClient
+func (_mock *MockClient) ClaimsVerify(ctx context.Context, req *servicejsonkeys.ClaimsVerifyRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsVerifyResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimsVerify")
+	}
+
+	var r0 *servicejsonkeys.ClaimsVerifyResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsVerifyRequest, ...grpc.CallOption) (*servicejsonkeys.ClaimsVerifyResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsVerifyRequest, ...grpc.CallOption) *servicejsonkeys.ClaimsVerifyResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.ClaimsVerifyResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.ClaimsVerifyRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ClaimsVerify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimsVerify'
+type MockClient_ClaimsVerify_Call struct {
+	*mock.Call
+}
+
+// ClaimsVerify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.ClaimsVerifyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockClient_Expecter) ClaimsVerify(ctx any, req any, opts ...any) *MockClient_ClaimsVerify_Call {
+	return &MockClient_ClaimsVerify_Call{Call: _e.mock.On("ClaimsVerify",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockClient_ClaimsVerify_Call) Run(run func(ctx context.Context, req *servicejsonkeys.ClaimsVerifyRequest, opts ...grpc.CallOption)) *MockClient_ClaimsVerify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.ClaimsVerifyRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.ClaimsVerifyRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ClaimsVerify_Call) Return(v *servicejsonkeys.ClaimsVerifyResponse, err error) *MockClient_ClaimsVerify_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_ClaimsVerify_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.ClaimsVerifyRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsVerifyResponse, error)) *MockClient_ClaimsVerify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Close provides a mock function for the type MockClient
+func (_mock *MockClient) Close() {
+	_mock.Called()
+	return
+}
+
+// MockClient_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockClient_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockClient_Expecter) Close() *MockClient_Close_Call {
+	return &MockClient_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockClient_Close_Call) Run(run func()) *MockClient_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClient_Close_Call) Return() *MockClient_Close_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockClient_Close_Call) RunAndReturn(run func()) *MockClient_Close_Call {
+	_c.Run(run)
+	return _c
+}
+
+// HttpSignatureSign provides a mock function for the type MockClient
+func (_mock *MockClient) HttpSignatureSign(ctx context.Context, req *servicejsonkeys.HttpSignatureSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.HttpSignatureSignResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for HttpSignatureSign")
+	}
+
+	var r0 *servicejsonkeys.HttpSignatureSignResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.HttpSignatureSignRequest, ...grpc.CallOption) (*servicejsonkeys.HttpSignatureSignResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.HttpSignatureSignRequest, ...grpc.CallOption) *servicejsonkeys.HttpSignatureSignResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.HttpSignatureSignResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.HttpSignatureSignRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_HttpSignatureSign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HttpSignatureSign'
+type MockClient_HttpSignatureSign_Call struct {
+	*mock.Call
+}
+
+// HttpSignatureSign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.HttpSignatureSignRequest
+//   - opts ...grpc.CallOption
+func (_e *MockClient_Expecter) HttpSignatureSign(ctx any, req any, opts ...any) *MockClient_HttpSignatureSign_Call {
+	return &MockClient_HttpSignatureSign_Call{Call: _e.mock.On("HttpSignatureSign",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockClient_HttpSignatureSign_Call) Run(run func(ctx context.Context, req *servicejsonkeys.HttpSignatureSignRequest, opts ...grpc.CallOption)) *MockClient_HttpSignatureSign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.HttpSignatureSignRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.HttpSignatureSignRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_HttpSignatureSign_Call) Return(v *servicejsonkeys.HttpSignatureSignResponse, err error) *MockClient_HttpSignatureSign_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_HttpSignatureSign_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.HttpSignatureSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.HttpSignatureSignResponse, error)) *MockClient_HttpSignatureSign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JwkBurn provides a mock function for the type MockClient
+func (_mock *MockClient) JwkBurn(ctx context.Context, req *servicejsonkeys.JwkBurnRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkBurnResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for JwkBurn")
+	}
+
+	var r0 *servicejsonkeys.JwkBurnResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkBurnRequest, ...grpc.CallOption) (*servicejsonkeys.JwkBurnResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkBurnRequest, ...grpc.CallOption) *servicejsonkeys.JwkBurnResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.JwkBurnResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.JwkBurnRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_JwkBurn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JwkBurn'
+type MockClient_JwkBurn_Call struct {
+	*mock.Call
+}
+
+// JwkBurn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.JwkBurnRequest
+//   - opts ...grpc.CallOption
+func (_e *MockClient_Expecter) JwkBurn(ctx any, req any, opts ...any) *MockClient_JwkBurn_Call {
+	return &MockClient_JwkBurn_Call{Call: _e.mock.On("JwkBurn",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockClient_JwkBurn_Call) Run(run func(ctx context.Context, req *servicejsonkeys.JwkBurnRequest, opts ...grpc.CallOption)) *MockClient_JwkBurn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.JwkBurnRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.JwkBurnRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_JwkBurn_Call) Return(v *servicejsonkeys.JwkBurnResponse, err error) *MockClient_JwkBurn_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_JwkBurn_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.JwkBurnRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkBurnResponse, error)) *MockClient_JwkBurn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JwkGet provides a mock function for the type MockClient
+func (_mock *MockClient) JwkGet(ctx context.Context, req *servicejsonkeys.JwkGetRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkGetResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for JwkGet")
+	}
+
+	var r0 *servicejsonkeys.JwkGetResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkGetRequest, ...grpc.CallOption) (*servicejsonkeys.JwkGetResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkGetRequest, ...grpc.CallOption) *servicejsonkeys.JwkGetResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.JwkGetResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.JwkGetRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_JwkGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JwkGet'
+type MockClient_JwkGet_Call struct {
+	*mock.Call
+}
+
+// JwkGet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.JwkGetRequest
+//   - opts ...grpc.CallOption
+func (_e *MockClient_Expecter) JwkGet(ctx any, req any, opts ...any) *MockClient_JwkGet_Call {
+	return &MockClient_JwkGet_Call{Call: _e.mock.On("JwkGet",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockClient_JwkGet_Call) Run(run func(ctx context.Context, req *servicejsonkeys.JwkGetRequest, opts ...grpc.CallOption)) *MockClient_JwkGet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.JwkGetRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.JwkGetRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_JwkGet_Call) Return(v *servicejsonkeys.JwkGetResponse, err error) *MockClient_JwkGet_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_JwkGet_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.JwkGetRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkGetResponse, error)) *MockClient_JwkGet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JwkList provides a mock function for the type MockClient
+func (_mock *MockClient) JwkList(ctx context.Context, req *servicejsonkeys.JwkListRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkListResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for JwkList")
+	}
+
+	var r0 *servicejsonkeys.JwkListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkListRequest, ...grpc.CallOption) (*servicejsonkeys.JwkListResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkListRequest, ...grpc.CallOption) *servicejsonkeys.JwkListResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.JwkListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.JwkListRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_JwkList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JwkList'
+type MockClient_JwkList_Call struct {
+	*mock.Call
+}
+
+// JwkList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.JwkListRequest
+//   - opts ...grpc.CallOption
+func (_e *MockClient_Expecter) JwkList(ctx any, req any, opts ...any) *MockClient_JwkList_Call {
+	return &MockClient_JwkList_Call{Call: _e.mock.On("JwkList",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockClient_JwkList_Call) Run(run func(ctx context.Context, req *servicejsonkeys.JwkListRequest, opts ...grpc.CallOption)) *MockClient_JwkList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.JwkListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.JwkListRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
 }
 
-// Close is a helper method to define mock.On call
-func (_e *MockClient_Expecter) Close() *MockClient_Close_Call {
-	return &MockClient_Close_Call{Call: _e.mock.On("Close")}
+func (_c *MockClient_JwkList_Call) Return(v *servicejsonkeys.JwkListResponse, err error) *MockClient_JwkList_Call {
+	_c.Call.Return(v, err)
+	return _c
 }
 
-func (_c *MockClient_Close_Call) Run(run func()) *MockClient_Close_Call {
+func (_c *MockClient_JwkList_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.JwkListRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkListResponse, error)) *MockClient_JwkList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JwkRotate provides a mock function for the type MockClient
+func (_mock *MockClient) JwkRotate(ctx context.Context, req *servicejsonkeys.JwkRotateRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkRotateResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for JwkRotate")
+	}
+
+	var r0 *servicejsonkeys.JwkRotateResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkRotateRequest, ...grpc.CallOption) (*servicejsonkeys.JwkRotateResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkRotateRequest, ...grpc.CallOption) *servicejsonkeys.JwkRotateResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.JwkRotateResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.JwkRotateRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_JwkRotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JwkRotate'
+type MockClient_JwkRotate_Call struct {
+	*mock.Call
+}
+
+// JwkRotate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.JwkRotateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockClient_Expecter) JwkRotate(ctx any, req any, opts ...any) *MockClient_JwkRotate_Call {
+	return &MockClient_JwkRotate_Call{Call: _e.mock.On("JwkRotate",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockClient_JwkRotate_Call) Run(run func(ctx context.Context, req *servicejsonkeys.JwkRotateRequest, opts ...grpc.CallOption)) *MockClient_JwkRotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.JwkRotateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.JwkRotateRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_JwkRotate_Call) Return(v *servicejsonkeys.JwkRotateResponse, err error) *MockClient_JwkRotate_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_JwkRotate_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.JwkRotateRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkRotateResponse, error)) *MockClient_JwkRotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Keys provides a mock function for the type MockClient
+func (_mock *MockClient) Keys() map[string]*servicejsonkeys.JwkConfig {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Keys")
+	}
+
+	var r0 map[string]*servicejsonkeys.JwkConfig
+	if returnFunc, ok := ret.Get(0).(func() map[string]*servicejsonkeys.JwkConfig); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*servicejsonkeys.JwkConfig)
+		}
+	}
+	return r0
+}
+
+// MockClient_Keys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Keys'
+type MockClient_Keys_Call struct {
+	*mock.Call
+}
+
+// Keys is a helper method to define mock.On call
+func (_e *MockClient_Expecter) Keys() *MockClient_Keys_Call {
+	return &MockClient_Keys_Call{Call: _e.mock.On("Keys")}
+}
+
+func (_c *MockClient_Keys_Call) Run(run func()) *MockClient_Keys_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockClient_Close_Call) Return() *MockClient_Close_Call {
-	_c.Call.Return()
+func (_c *MockClient_Keys_Call) Return(stringToV map[string]*servicejsonkeys.JwkConfig) *MockClient_Keys_Call {
+	_c.Call.Return(stringToV)
 	return _c
 }
 
-func (_c *MockClient_Close_Call) RunAndReturn(run func()) *MockClient_Close_Call {
-	_c.Run(run)
+func (_c *MockClient_Keys_Call) RunAndReturn(run func() map[string]*servicejsonkeys.JwkConfig) *MockClient_Keys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PayloadSign provides a mock function for the type MockClient
+func (_mock *MockClient) PayloadSign(ctx context.Context, req *servicejsonkeys.PayloadSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.PayloadSignResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PayloadSign")
+	}
+
+	var r0 *servicejsonkeys.PayloadSignResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.PayloadSignRequest, ...grpc.CallOption) (*servicejsonkeys.PayloadSignResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.PayloadSignRequest, ...grpc.CallOption) *servicejsonkeys.PayloadSignResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.PayloadSignResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.PayloadSignRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_PayloadSign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PayloadSign'
+type MockClient_PayloadSign_Call struct {
+	*mock.Call
+}
+
+// PayloadSign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.PayloadSignRequest
+//   - opts ...grpc.CallOption
+func (_e *MockClient_Expecter) PayloadSign(ctx any, req any, opts ...any) *MockClient_PayloadSign_Call {
+	return &MockClient_PayloadSign_Call{Call: _e.mock.On("PayloadSign",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockClient_PayloadSign_Call) Run(run func(ctx context.Context, req *servicejsonkeys.PayloadSignRequest, opts ...grpc.CallOption)) *MockClient_PayloadSign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.PayloadSignRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.PayloadSignRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_PayloadSign_Call) Return(v *servicejsonkeys.PayloadSignResponse, err error) *MockClient_PayloadSign_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_PayloadSign_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.PayloadSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.PayloadSignResponse, error)) *MockClient_PayloadSign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeToken provides a mock function for the type MockClient
+func (_mock *MockClient) RevokeToken(ctx context.Context, req *servicejsonkeys.RevokeTokenRequest, opts ...grpc.CallOption) (*servicejsonkeys.RevokeTokenResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeToken")
+	}
+
+	var r0 *servicejsonkeys.RevokeTokenResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.RevokeTokenRequest, ...grpc.CallOption) (*servicejsonkeys.RevokeTokenResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.RevokeTokenRequest, ...grpc.CallOption) *servicejsonkeys.RevokeTokenResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.RevokeTokenResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.RevokeTokenRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type MockClient_RevokeToken_Call struct {
+	*mock.Call
+}
+
+// RevokeToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.RevokeTokenRequest
+//   - opts ...grpc.CallOption
+func (_e *MockClient_Expecter) RevokeToken(ctx any, req any, opts ...any) *MockClient_RevokeToken_Call {
+	return &MockClient_RevokeToken_Call{Call: _e.mock.On("RevokeToken",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockClient_RevokeToken_Call) Run(run func(ctx context.Context, req *servicejsonkeys.RevokeTokenRequest, opts ...grpc.CallOption)) *MockClient_RevokeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.RevokeTokenRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.RevokeTokenRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_RevokeToken_Call) Return(v *servicejsonkeys.RevokeTokenResponse, err error) *MockClient_RevokeToken_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_RevokeToken_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.RevokeTokenRequest, opts ...grpc.CallOption) (*servicejsonkeys.RevokeTokenResponse, error)) *MockClient_RevokeToken_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// JwkGet provides a mock function for the type MockClient
-func (_mock *MockClient) JwkGet(ctx context.Context, req *servicejsonkeys.JwkGetRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkGetResponse, error) {
+// RevokedTokenSync provides a mock function for the type MockClient
+func (_mock *MockClient) RevokedTokenSync(ctx context.Context, req *servicejsonkeys.RevokedTokenSyncRequest, opts ...grpc.CallOption) (*servicejsonkeys.RevokedTokenSyncResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, req, opts)
@@ -737,22 +2277,22 @@ func (_mock *MockClient) JwkGet(ctx context.Context, req *servicejsonkeys.JwkGet
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for JwkGet")
+		panic("no return value specified for RevokedTokenSync")
 	}
 
-	var r0 *servicejsonkeys.JwkGetResponse
+	var r0 *servicejsonkeys.RevokedTokenSyncResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkGetRequest, ...grpc.CallOption) (*servicejsonkeys.JwkGetResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.RevokedTokenSyncRequest, ...grpc.CallOption) (*servicejsonkeys.RevokedTokenSyncResponse, error)); ok {
 		return returnFunc(ctx, req, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkGetRequest, ...grpc.CallOption) *servicejsonkeys.JwkGetResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.RevokedTokenSyncRequest, ...grpc.CallOption) *servicejsonkeys.RevokedTokenSyncResponse); ok {
 		r0 = returnFunc(ctx, req, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*servicejsonkeys.JwkGetResponse)
+			r0 = ret.Get(0).(*servicejsonkeys.RevokedTokenSyncResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.JwkGetRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.RevokedTokenSyncRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, req, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -760,29 +2300,29 @@ func (_mock *MockClient) JwkGet(ctx context.Context, req *servicejsonkeys.JwkGet
 	return r0, r1
 }
 
-// MockClient_JwkGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JwkGet'
-type MockClient_JwkGet_Call struct {
+// MockClient_RevokedTokenSync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokedTokenSync'
+type MockClient_RevokedTokenSync_Call struct {
 	*mock.Call
 }
 
-// JwkGet is a helper method to define mock.On call
+// RevokedTokenSync is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *servicejsonkeys.JwkGetRequest
+//   - req *servicejsonkeys.RevokedTokenSyncRequest
 //   - opts ...grpc.CallOption
-func (_e *MockClient_Expecter) JwkGet(ctx any, req any, opts ...any) *MockClient_JwkGet_Call {
-	return &MockClient_JwkGet_Call{Call: _e.mock.On("JwkGet",
+func (_e *MockClient_Expecter) RevokedTokenSync(ctx any, req any, opts ...any) *MockClient_RevokedTokenSync_Call {
+	return &MockClient_RevokedTokenSync_Call{Call: _e.mock.On("RevokedTokenSync",
 		append([]any{ctx, req}, opts...)...)}
 }
 
-func (_c *MockClient_JwkGet_Call) Run(run func(ctx context.Context, req *servicejsonkeys.JwkGetRequest, opts ...grpc.CallOption)) *MockClient_JwkGet_Call {
+func (_c *MockClient_RevokedTokenSync_Call) Run(run func(ctx context.Context, req *servicejsonkeys.RevokedTokenSyncRequest, opts ...grpc.CallOption)) *MockClient_RevokedTokenSync_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *servicejsonkeys.JwkGetRequest
+		var arg1 *servicejsonkeys.RevokedTokenSyncRequest
 		if args[1] != nil {
-			arg1 = args[1].(*servicejsonkeys.JwkGetRequest)
+			arg1 = args[1].(*servicejsonkeys.RevokedTokenSyncRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -799,18 +2339,18 @@ func (_c *MockClient_JwkGet_Call) Run(run func(ctx context.Context, req *service
 	return _c
 }
 
-func (_c *MockClient_JwkGet_Call) Return(v *servicejsonkeys.JwkGetResponse, err error) *MockClient_JwkGet_Call {
+func (_c *MockClient_RevokedTokenSync_Call) Return(v *servicejsonkeys.RevokedTokenSyncResponse, err error) *MockClient_RevokedTokenSync_Call {
 	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockClient_JwkGet_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.JwkGetRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkGetResponse, error)) *MockClient_JwkGet_Call {
+func (_c *MockClient_RevokedTokenSync_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.RevokedTokenSyncRequest, opts ...grpc.CallOption) (*servicejsonkeys.RevokedTokenSyncResponse, error)) *MockClient_RevokedTokenSync_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// JwkList provides a mock function for the type MockClient
-func (_mock *MockClient) JwkList(ctx context.Context, req *servicejsonkeys.JwkListRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkListResponse, error) {
+// SigningFreeze provides a mock function for the type MockClient
+func (_mock *MockClient) SigningFreeze(ctx context.Context, req *servicejsonkeys.SigningFreezeRequest, opts ...grpc.CallOption) (*servicejsonkeys.SigningFreezeResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, req, opts)
@@ -820,22 +2360,22 @@ func (_mock *MockClient) JwkList(ctx context.Context, req *servicejsonkeys.JwkLi
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for JwkList")
+		panic("no return value specified for SigningFreeze")
 	}
 
-	var r0 *servicejsonkeys.JwkListResponse
+	var r0 *servicejsonkeys.SigningFreezeResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkListRequest, ...grpc.CallOption) (*servicejsonkeys.JwkListResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.SigningFreezeRequest, ...grpc.CallOption) (*servicejsonkeys.SigningFreezeResponse, error)); ok {
 		return returnFunc(ctx, req, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.JwkListRequest, ...grpc.CallOption) *servicejsonkeys.JwkListResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.SigningFreezeRequest, ...grpc.CallOption) *servicejsonkeys.SigningFreezeResponse); ok {
 		r0 = returnFunc(ctx, req, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*servicejsonkeys.JwkListResponse)
+			r0 = ret.Get(0).(*servicejsonkeys.SigningFreezeResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.JwkListRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.SigningFreezeRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, req, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -843,29 +2383,29 @@ func (_mock *MockClient) JwkList(ctx context.Context, req *servicejsonkeys.JwkLi
 	return r0, r1
 }
 
-// MockClient_JwkList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JwkList'
-type MockClient_JwkList_Call struct {
+// MockClient_SigningFreeze_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SigningFreeze'
+type MockClient_SigningFreeze_Call struct {
 	*mock.Call
 }
 
-// JwkList is a helper method to define mock.On call
+// SigningFreeze is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *servicejsonkeys.JwkListRequest
+//   - req *servicejsonkeys.SigningFreezeRequest
 //   - opts ...grpc.CallOption
-func (_e *MockClient_Expecter) JwkList(ctx any, req any, opts ...any) *MockClient_JwkList_Call {
-	return &MockClient_JwkList_Call{Call: _e.mock.On("JwkList",
+func (_e *MockClient_Expecter) SigningFreeze(ctx any, req any, opts ...any) *MockClient_SigningFreeze_Call {
+	return &MockClient_SigningFreeze_Call{Call: _e.mock.On("SigningFreeze",
 		append([]any{ctx, req}, opts...)...)}
 }
 
-func (_c *MockClient_JwkList_Call) Run(run func(ctx context.Context, req *servicejsonkeys.JwkListRequest, opts ...grpc.CallOption)) *MockClient_JwkList_Call {
+func (_c *MockClient_SigningFreeze_Call) Run(run func(ctx context.Context, req *servicejsonkeys.SigningFreezeRequest, opts ...grpc.CallOption)) *MockClient_SigningFreeze_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *servicejsonkeys.JwkListRequest
+		var arg1 *servicejsonkeys.SigningFreezeRequest
 		if args[1] != nil {
-			arg1 = args[1].(*servicejsonkeys.JwkListRequest)
+			arg1 = args[1].(*servicejsonkeys.SigningFreezeRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -882,58 +2422,12 @@ func (_c *MockClient_JwkList_Call) Run(run func(ctx context.Context, req *servic
 	return _c
 }
 
-func (_c *MockClient_JwkList_Call) Return(v *servicejsonkeys.JwkListResponse, err error) *MockClient_JwkList_Call {
+func (_c *MockClient_SigningFreeze_Call) Return(v *servicejsonkeys.SigningFreezeResponse, err error) *MockClient_SigningFreeze_Call {
 	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockClient_JwkList_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.JwkListRequest, opts ...grpc.CallOption) (*servicejsonkeys.JwkListResponse, error)) *MockClient_JwkList_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// Keys provides a mock function for the type MockClient
-func (_mock *MockClient) Keys() map[string]*servicejsonkeys.JwkConfig {
-	ret := _mock.Called()
-
-	if len(ret) == 0 {
-		panic("no return value specified for Keys")
-	}
-
-	var r0 map[string]*servicejsonkeys.JwkConfig
-	if returnFunc, ok := ret.Get(0).(func() map[string]*servicejsonkeys.JwkConfig); ok {
-		r0 = returnFunc()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]*servicejsonkeys.JwkConfig)
-		}
-	}
-	return r0
-}
-
-// MockClient_Keys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Keys'
-type MockClient_Keys_Call struct {
-	*mock.Call
-}
-
-// Keys is a helper method to define mock.On call
-func (_e *MockClient_Expecter) Keys() *MockClient_Keys_Call {
-	return &MockClient_Keys_Call{Call: _e.mock.On("Keys")}
-}
-
-func (_c *MockClient_Keys_Call) Run(run func()) *MockClient_Keys_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
-	return _c
-}
-
-func (_c *MockClient_Keys_Call) Return(stringToV map[string]*servicejsonkeys.JwkConfig) *MockClient_Keys_Call {
-	_c.Call.Return(stringToV)
-	return _c
-}
-
-func (_c *MockClient_Keys_Call) RunAndReturn(run func() map[string]*servicejsonkeys.JwkConfig) *MockClient_Keys_Call {
+func (_c *MockClient_SigningFreeze_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.SigningFreezeRequest, opts ...grpc.CallOption) (*servicejsonkeys.SigningFreezeResponse, error)) *MockClient_SigningFreeze_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1103,3 +2597,255 @@ func (_c *MockClient_UnaryEcho_Call) RunAndReturn(run func(ctx context.Context,
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockHttpSigner creates a new instance of MockHttpSigner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHttpSigner(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHttpSigner {
+	mock := &MockHttpSigner{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockHttpSigner is an autogenerated mock type for the HttpSigner type
+type MockHttpSigner struct {
+	mock.Mock
+}
+
+type MockHttpSigner_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHttpSigner) EXPECT() *MockHttpSigner_Expecter {
+	return &MockHttpSigner_Expecter{mock: &_m.Mock}
+}
+
+// SignHttp provides a mock function for the type MockHttpSigner
+func (_mock *MockHttpSigner) SignHttp(ctx context.Context, req *servicejsonkeys.SignHttpRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignHttp")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.SignHttpRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockHttpSigner_SignHttp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignHttp'
+type MockHttpSigner_SignHttp_Call struct {
+	*mock.Call
+}
+
+// SignHttp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.SignHttpRequest
+func (_e *MockHttpSigner_Expecter) SignHttp(ctx any, req any) *MockHttpSigner_SignHttp_Call {
+	return &MockHttpSigner_SignHttp_Call{Call: _e.mock.On("SignHttp", ctx, req)}
+}
+
+func (_c *MockHttpSigner_SignHttp_Call) Run(run func(ctx context.Context, req *servicejsonkeys.SignHttpRequest)) *MockHttpSigner_SignHttp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.SignHttpRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.SignHttpRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHttpSigner_SignHttp_Call) Return(err error) *MockHttpSigner_SignHttp_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockHttpSigner_SignHttp_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.SignHttpRequest) error) *MockHttpSigner_SignHttp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockHttpSignatureVerifier creates a new instance of MockHttpSignatureVerifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHttpSignatureVerifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHttpSignatureVerifier {
+	mock := &MockHttpSignatureVerifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockHttpSignatureVerifier is an autogenerated mock type for the HttpSignatureVerifier type
+type MockHttpSignatureVerifier struct {
+	mock.Mock
+}
+
+type MockHttpSignatureVerifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHttpSignatureVerifier) EXPECT() *MockHttpSignatureVerifier_Expecter {
+	return &MockHttpSignatureVerifier_Expecter{mock: &_m.Mock}
+}
+
+// VerifyHttp provides a mock function for the type MockHttpSignatureVerifier
+func (_mock *MockHttpSignatureVerifier) VerifyHttp(ctx context.Context, req *servicejsonkeys.VerifyHttpRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyHttp")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.VerifyHttpRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockHttpSignatureVerifier_VerifyHttp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyHttp'
+type MockHttpSignatureVerifier_VerifyHttp_Call struct {
+	*mock.Call
+}
+
+// VerifyHttp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.VerifyHttpRequest
+func (_e *MockHttpSignatureVerifier_Expecter) VerifyHttp(ctx any, req any) *MockHttpSignatureVerifier_VerifyHttp_Call {
+	return &MockHttpSignatureVerifier_VerifyHttp_Call{Call: _e.mock.On("VerifyHttp", ctx, req)}
+}
+
+func (_c *MockHttpSignatureVerifier_VerifyHttp_Call) Run(run func(ctx context.Context, req *servicejsonkeys.VerifyHttpRequest)) *MockHttpSignatureVerifier_VerifyHttp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.VerifyHttpRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.VerifyHttpRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHttpSignatureVerifier_VerifyHttp_Call) Return(err error) *MockHttpSignatureVerifier_VerifyHttp_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockHttpSignatureVerifier_VerifyHttp_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.VerifyHttpRequest) error) *MockHttpSignatureVerifier_VerifyHttp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPayloadVerifier creates a new instance of MockPayloadVerifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPayloadVerifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPayloadVerifier {
+	mock := &MockPayloadVerifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPayloadVerifier is an autogenerated mock type for the PayloadVerifier type
+type MockPayloadVerifier struct {
+	mock.Mock
+}
+
+type MockPayloadVerifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPayloadVerifier) EXPECT() *MockPayloadVerifier_Expecter {
+	return &MockPayloadVerifier_Expecter{mock: &_m.Mock}
+}
+
+// VerifyPayload provides a mock function for the type MockPayloadVerifier
+func (_mock *MockPayloadVerifier) VerifyPayload(ctx context.Context, req *servicejsonkeys.VerifyPayloadRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyPayload")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.VerifyPayloadRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPayloadVerifier_VerifyPayload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyPayload'
+type MockPayloadVerifier_VerifyPayload_Call struct {
+	*mock.Call
+}
+
+// VerifyPayload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.VerifyPayloadRequest
+func (_e *MockPayloadVerifier_Expecter) VerifyPayload(ctx any, req any) *MockPayloadVerifier_VerifyPayload_Call {
+	return &MockPayloadVerifier_VerifyPayload_Call{Call: _e.mock.On("VerifyPayload", ctx, req)}
+}
+
+func (_c *MockPayloadVerifier_VerifyPayload_Call) Run(run func(ctx context.Context, req *servicejsonkeys.VerifyPayloadRequest)) *MockPayloadVerifier_VerifyPayload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.VerifyPayloadRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.VerifyPayloadRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPayloadVerifier_VerifyPayload_Call) Return(err error) *MockPayloadVerifier_VerifyPayload_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPayloadVerifier_VerifyPayload_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.VerifyPayloadRequest) error) *MockPayloadVerifier_VerifyPayload_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,64 @@
+package servicejsonkeys
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+)
+
+var (
+	// ErrMalformedSignature is returned by [PayloadVerifier.VerifyPayload] when the signature is not a
+	// detached JWS.
+	ErrMalformedSignature = core.ErrJwsDetachedMalformed
+	// ErrUnsupportedCrit is returned by [PayloadVerifier.VerifyPayload] when the signature marks as
+	// critical a header parameter the verifier does not understand.
+	ErrUnsupportedCrit = core.ErrJwsDetachedUnsupportedCrit
+	// ErrSignatureType is returned by [PayloadVerifier.VerifyPayload] when the signature is typed as
+	// another kind of object, such as a JWT.
+	ErrSignatureType = core.ErrJwsDetachedType
+)
+
+// VerifyPayloadRequest holds the parameters for a [PayloadVerifier.VerifyPayload] call.
+type VerifyPayloadRequest struct {
+	// Usage is the key usage the payload was signed for; must match the value used at signing time.
+	// See [KeyUsage].
+	Usage KeyUsage
+	// Signature is the detached JWS returned by the PayloadSign RPC (base64url header..signature).
+	Signature string
+	// Payload is the content the signature was issued for, byte for byte.
+	Payload []byte
+}
+
+// A PayloadVerifier verifies detached signatures returned by the PayloadSign RPC. Verification is
+// performed locally using public keys sourced from the [Client]; no network call is made per
+// verification. Obtain one with [NewPayloadVerifier].
+type PayloadVerifier interface {
+	// VerifyPayload returns nil if the signature in req is valid for its payload. A signature that
+	// does not match yields an error wrapping jws.ErrInvalidSignature.
+	VerifyPayload(ctx context.Context, req *VerifyPayloadRequest) error
+}
+
+type payloadVerifier struct {
+	service *core.PayloadVerify
+}
+
+// NewPayloadVerifier creates a detached signature verifier backed by the key configuration carried
+// by c. It builds the cached public-key sources used for local verification, returning an error if
+// the configuration references an unsupported algorithm.
+func NewPayloadVerifier(c Client) (PayloadVerifier, error) {
+	sources, err := core.NewJwkPublicSource(newJwkExportGrpc(c), c.Keys())
+	if err != nil {
+		return nil, fmt.Errorf("(NewPayloadVerifier) new public sources: %w", err)
+	}
+
+	return &payloadVerifier{service: core.NewPayloadVerify(sources, c.Keys())}, nil
+}
+
+func (verifier *payloadVerifier) VerifyPayload(ctx context.Context, req *VerifyPayloadRequest) error {
+	return verifier.service.Exec(ctx, &core.PayloadVerifyRequest{
+		Signature: req.Signature,
+		Payload:   req.Payload,
+		Usage:     req.Usage,
+	})
+}
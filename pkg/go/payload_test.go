@@ -0,0 +1,50 @@
+package servicejsonkeys_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/a-novel-kit/jwt/v2/jws"
+
+	"github.com/a-novel/service-json-keys/v2/internal/config/env"
+	"github.com/a-novel/service-json-keys/v2/pkg/go"
+)
+
+func TestPayloadVerifier(t *testing.T) {
+	t.Parallel()
+
+	client, err := servicejsonkeys.NewClient(env.GrpcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	verifier, err := servicejsonkeys.NewPayloadVerifier(client)
+	require.NoError(t, err)
+
+	payload := []byte(`{"amount":"12.50"}`)
+
+	for _, unencoded := range []bool{false, true} {
+		signed, err := client.PayloadSign(t.Context(), &servicejsonkeys.PayloadSignRequest{
+			Usage:     servicejsonkeys.KeyUsageAuth,
+			Payload:   payload,
+			Unencoded: unencoded,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, signed.GetSignature())
+
+		require.NoError(t, verifier.VerifyPayload(t.Context(), &servicejsonkeys.VerifyPayloadRequest{
+			Usage:     servicejsonkeys.KeyUsageAuth,
+			Signature: signed.GetSignature(),
+			Payload:   payload,
+		}))
+
+		require.ErrorIs(t, verifier.VerifyPayload(t.Context(), &servicejsonkeys.VerifyPayloadRequest{
+			Usage:     servicejsonkeys.KeyUsageAuth,
+			Signature: signed.GetSignature(),
+			Payload:   []byte(`{"amount":"1250"}`),
+		}), jws.ErrInvalidSignature)
+	}
+}
@@ -0,0 +1,62 @@
+package servicejsonkeys
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/a-novel/service-json-keys/v2/internal/core"
+	jsonkeysv2 "github.com/a-novel/service-json-keys/v2/internal/handlers/protogen/anovel/jsonkeys/v2"
+)
+
+// A revokedTokenSyncGrpc adapts a [BaseClient] to the sync interface of the denylist kept by a
+// [ClaimsVerifier], bridging the gRPC RevokedTokenSync call into it.
+//
+// A service that does not serve RevokedTokenSync predates revocation, and has no revoked token:
+// its denylist reads as empty.
+type revokedTokenSyncGrpc struct {
+	client BaseClient
+}
+
+func newRevokedTokenSyncGrpc(client BaseClient) *revokedTokenSyncGrpc {
+	return &revokedTokenSyncGrpc{client: client}
+}
+
+func (api *revokedTokenSyncGrpc) Exec(
+	ctx context.Context, request *core.RevokedTokenSyncRequest,
+) (*core.RevokedTokenSyncResult, error) {
+	req := new(RevokedTokenSyncRequest)
+	if !request.Since.IsZero() {
+		req.Since = timestamppb.New(request.Since)
+	}
+
+	res, err := api.client.RevokedTokenSync(ctx, req)
+	if status.Code(err) == codes.Unimplemented {
+		return &core.RevokedTokenSyncResult{Cursor: request.Since}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &core.RevokedTokenSyncResult{
+		Tokens: lo.Map(res.GetTokens(), func(item *jsonkeysv2.RevokedToken, _ int) *core.RevokedToken {
+			return &core.RevokedToken{
+				TokenID:   item.GetTokenId(),
+				Usage:     item.GetUsage(),
+				ExpiresAt: item.GetExpiresAt().AsTime(),
+				RevokedAt: item.GetRevokedAt().AsTime(),
+			}
+		}),
+		Cursor: request.Since,
+	}
+
+	if res.GetCursor() != nil {
+		output.Cursor = res.GetCursor().AsTime()
+	}
+
+	return output, nil
+}
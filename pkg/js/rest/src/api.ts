@@ -10,8 +10,33 @@ async function decodeRawHttpResponse<T>(response: Response): Promise<T> {
 
 /** Status of a single health-check dependency reported by the `/v2/healthcheck` endpoint. */
 export type HealthDependency = {
-  /** Whether the dependency is reachable. */
-  status: "up" | "down";
+  /**
+   * Whether the dependency is reachable. The overall `service` entry reports `degraded`
+   * instead of `down` when a dependency is down or a usage cannot sign.
+   */
+  status: "up" | "down" | "degraded";
+};
+
+/** Health of a usage's keys, reported under `keys:<usage>` by the `/v2/healthcheck` endpoint. */
+export type KeyHealth = {
+  /**
+   * `ok` when the usage has a main key rotated on schedule, `stalled` when its main key is older
+   * than twice its rotation interval, `unavailable` when it has no key to sign with, and `unknown`
+   * when its keys could not be read. Other fields are omitted for `unknown`.
+   */
+  status: "ok" | "stalled" | "unavailable" | "unknown";
+  /** Whether the usage has a main key to sign with. */
+  hasMainKey?: boolean;
+  /** Age of the main key, in seconds. */
+  mainKeyAge?: number;
+  /** Configured rotation interval, in seconds. */
+  rotation?: number;
+  /** Number of active keys other than the main key. */
+  legacyKeys?: number;
+  /** When the next active key expires, as an RFC 3339 timestamp. */
+  nextExpiresAt?: string;
+  /** Whether the rotation job looks stalled. */
+  rotationStalled?: boolean;
 };
 
 /**
@@ -54,11 +79,12 @@ export class JsonKeysApi {
   }
 
   /**
-   * Returns the health status of every service dependency, keyed by dependency name.
-   * The endpoint always responds 200; a degraded dependency shows as a `down` entry,
-   * so inspect each entry's `status` field to detect one.
+   * Returns the health status of every service dependency, keyed by dependency name, the
+   * health of each usage's keys, keyed `keys:<usage>`, and the overall health, keyed `service`.
+   * The endpoint always responds 200; a degraded service shows as a `degraded` entry,
+   * so inspect the `service` entry's `status` field to detect one.
    */
-  async health(): Promise<Record<string, HealthDependency>> {
+  async health(): Promise<Record<string, HealthDependency | KeyHealth>> {
     return await this.fetch("/v2/healthcheck", undefined, { method: "GET" });
   }
 }
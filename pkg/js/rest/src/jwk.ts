@@ -48,6 +48,18 @@ export const JwkSchema = z.looseObject({
  */
 export type Jwk = z.infer<typeof JwkSchema>;
 
+/**
+ * Parses a JWK Set document (RFC 7517, section 5), as served by `/v2/jwks` and the
+ * `/.well-known/{usage}/jwks.json` routes.
+ */
+export const JwkSetSchema = z.object({
+  /** Active public keys, newest first. */
+  keys: z.array(JwkSchema),
+});
+
+/** A JSON Web Key Set (RFC 7517). */
+export type JwkSet = z.infer<typeof JwkSetSchema>;
+
 /**
  * Returns all active public keys for the given usage.
  *
@@ -58,10 +70,11 @@ export async function jwkList(api: JsonKeysApi, usage?: string): Promise<Jwk[]>
   const params = new URLSearchParams();
   if (usage) params.set("usage", usage);
   const query = params.toString();
-  return await api.fetch(`/v2/jwks${query ? `?${query}` : ""}`, z.array(JwkSchema), {
+  const set = await api.fetch(`/v2/jwks${query ? `?${query}` : ""}`, JwkSetSchema, {
     method: "GET",
     headers: HTTP_HEADERS.JSON,
   });
+  return set.keys;
 }
 
 /**
@@ -12,8 +12,9 @@ describe("ping", () => {
 describe("health", () => {
   it("returns success", async () => {
     const api = new JsonKeysApi(process.env.REST_URL!);
-    await expect(api.health()).resolves.toEqual({
+    await expect(api.health()).resolves.toMatchObject({
       "client:postgres": { status: "up" },
+      service: { status: "up" },
     });
   });
 });
@@ -1,7 +1,7 @@
 import { describe, expect, it } from "vitest";
 
 import { expectStatus } from "@a-novel-kit/nodelib-test/http";
-import { JsonKeysApi, jwkGet, jwkList } from "@a-novel/service-json-keys-rest";
+import { JsonKeysApi, jwkGet, jwkList, JwkSchema, JwkSetSchema } from "@a-novel/service-json-keys-rest";
 
 describe("jwkList", () => {
   it("returns keys for a known usage", async () => {
@@ -68,3 +68,23 @@ describe("jwkGet", () => {
     expect(key.alg).toBeTruthy();
   });
 });
+
+describe("well-known JWK Set", () => {
+  it("serves the usage's keys as a JWK Set", async () => {
+    const api = new JsonKeysApi(process.env.REST_URL!);
+    const set = await api.fetch("/.well-known/auth/jwks.json", JwkSetSchema, { method: "GET" });
+    const keys = await jwkList(api, "auth");
+
+    expect(set.keys.map((key) => key.kid)).toEqual(keys.map((key) => key.kid));
+  });
+
+  it("retrieves a key from the key set path", async () => {
+    const api = new JsonKeysApi(process.env.REST_URL!);
+    const keys = await jwkList(api, "auth");
+
+    expect(keys.length).toBeGreaterThan(0);
+
+    const key = await api.fetch(`/v2/jwks/${keys[0].kid}`, JwkSchema, { method: "GET" });
+    expect(key.kid).toBe(keys[0].kid);
+  });
+});